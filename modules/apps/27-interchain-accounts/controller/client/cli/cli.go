@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
 
@@ -15,7 +16,39 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdClientStatus(),
+		GetCmdInterchainAccount(),
+		GetCmdPorts(),
+		GetCmdPortsByConnection(),
+		GetCmdConnection(),
+		GetCmdCounterparty(),
+		GetCmdSendQueueDepth(),
+		GetCmdPacketTimeout(),
+		GetCmdPendingPackets(),
+		GetCmdPendingUnbondings(),
+		GetCmdStaleInterchainAccounts(),
+		GetCmdNextChannelID(),
+		GetCmdCompatibleVersion(),
 	)
 
 	return queryCmd
 }
+
+// NewTxCmd returns the transaction commands for the ICA controller submodule
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "controller",
+		Short:                      "interchain-accounts controller subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewGenerateCompoundRewardsPacketDataCmd(),
+		NewSetWithdrawAddressCmd(),
+		NewUndelegateCmd(),
+	)
+
+	return txCmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -9,14 +10,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 )
 
 // GetCmdParams returns the command handler for the controller submodule parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "params",
-		Short:   "Query the current interchain-accounts controller submodule parameters",
-		Long:    "Query the current interchain-accounts controller submodule parameters",
+		Use:   "params",
+		Short: "Query the current interchain-accounts controller submodule parameters",
+		Long: `Query the current interchain-accounts controller submodule parameters.
+The parameters in effect at a past block may be queried using the --height flag, provided the queried node
+has not pruned the state at that height.`,
 		Args:    cobra.NoArgs,
 		Example: fmt.Sprintf("%s query interchain-accounts controller params", version.AppName),
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -26,7 +31,11 @@ func GetCmdParams() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, _ := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
 			return clientCtx.PrintProto(res.Params)
 		},
 	}
@@ -35,3 +44,484 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdClientStatus returns the command handler for querying the status of the light client backing
+// the connection of an interchain account.
+func GetCmdClientStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-status [owner] [connection-id]",
+		Short:   "Query the status of the light client backing an interchain account connection",
+		Long:    "Query the client identifier, client type, status and latest height of the light client backing the connection of an interchain account",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller client-status cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountClientStatusRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.InterchainAccountClientStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccount returns the command handler for querying the address of the interchain account registered by
+// an owner on a connection.
+func GetCmdInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [owner] [connection-id]",
+		Short:   "Query the interchain account address of an owner on a connection",
+		Long:    "Query the host chain address of the interchain account registered by the provided owner on the provided connection",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPorts returns the command handler for querying all ports bound by the controller submodule
+// together with their active channel and interchain account address.
+func GetCmdPorts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ports",
+		Short:   "Query all ports bound by the interchain-accounts controller submodule",
+		Long:    "Query all ports bound by the interchain-accounts controller submodule together with their active channel and interchain account address",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller ports", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInterchainAccountPortsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InterchainAccountPorts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ports")
+
+	return cmd
+}
+
+// GetCmdPortsByConnection returns the command handler for querying all ports bound by the controller submodule
+// grouped by the connection on which their active channel runs.
+func GetCmdPortsByConnection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ports-by-connection",
+		Short:   "Query all ports bound by the interchain-accounts controller submodule grouped by connection",
+		Long:    "Query all ports bound by the interchain-accounts controller submodule grouped by the connection on which their active channel runs. Ports without an active channel are grouped as unassigned",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller ports-by-connection", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InterchainAccountPortsByConnection(cmd.Context(), &types.QueryInterchainAccountPortsByConnectionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdConnection returns the command handler for querying the connection on which the active channel of an
+// interchain account port runs.
+func GetCmdConnection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "connection [port-id]",
+		Short:   "Query the connection of the active channel of an interchain account port",
+		Long:    "Query the identifier of the connection on which the active channel of an interchain-accounts controller port runs",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller connection icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountConnectionRequest{
+				PortId: args[0],
+			}
+
+			res, err := queryClient.InterchainAccountConnection(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCounterparty returns the command handler for querying the host chain port and channel identifiers of the
+// active channel of an interchain account port.
+func GetCmdCounterparty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty [port-id]",
+		Short:   "Query the counterparty of the active channel of an interchain account port",
+		Long:    "Query the host chain port and channel identifiers of the active channel of an interchain-accounts controller port",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller counterparty icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountCounterpartyRequest{
+				PortId: args[0],
+			}
+
+			res, err := queryClient.InterchainAccountCounterparty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSendQueueDepth returns the command handler for querying the number of transactions held by the send queue
+// of an interchain account port.
+func GetCmdSendQueueDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-queue-depth [port-id]",
+		Short:   "Query the number of transactions held by the send queue of an interchain account port",
+		Long:    "Query the number of transactions held by the send queue of an interchain-accounts controller port which have not yet been released",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller send-queue-depth icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySendQueueDepthRequest{
+				PortId: args[0],
+			}
+
+			res, err := queryClient.SendQueueDepth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPacketTimeout returns the command handler for querying the timeout of an outstanding interchain account packet.
+func GetCmdPacketTimeout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-timeout [port-id] [sequence]",
+		Short:   "Query the timeout of an outstanding interchain account packet",
+		Long:    "Query the timeout height and timestamp of a packet sent over the active channel of an interchain-accounts controller port which has not yet been acknowledged or timed out. Packet timeouts are only retained if packet data retention is enabled by the channel parameters",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller packet-timeout icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketTimeoutRequest{
+				PortId:   args[0],
+				Sequence: sequence,
+			}
+
+			res, err := queryClient.PacketTimeout(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPendingPackets defines the command to query the unacknowledged packets of an interchain account port
+func GetCmdPendingPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-packets [port-id]",
+		Short:   "Query the unacknowledged packets of an interchain account port",
+		Long:    "Query the packets sent over the active channel of an interchain-accounts controller port which have not yet been acknowledged, along with their advisory priorities",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller pending-packets icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingPacketsRequest{
+				PortId: args[0],
+			}
+
+			res, err := queryClient.PendingPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPendingUnbondings defines the command to query the pending unbondings of an interchain account port
+func GetCmdPendingUnbondings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-unbondings [port-id]",
+		Short:   "Query the pending unbondings of an interchain account port",
+		Long:    "Query the undelegations sent by the interchain account of an interchain-accounts controller port which have not yet completed, along with their completion times",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller pending-unbondings icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingUnbondingsRequest{
+				PortId: args[0],
+			}
+
+			res, err := queryClient.PendingUnbondings(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdStaleInterchainAccounts returns the command handler for querying the interchain accounts whose active channel
+// has had no packet activity for more than the provided number of blocks.
+func GetCmdStaleInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stale-accounts [inactive-blocks]",
+		Short:   "Query the interchain accounts without recent packet activity",
+		Long:    "Query the interchain accounts whose active channel has had no packet activity, such as sending a packet or receiving an acknowledgement, for more than the provided number of blocks",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller stale-accounts 100000", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			inactiveBlocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryStaleInterchainAccountsRequest{
+				InactiveBlocks: inactiveBlocks,
+			}
+
+			res, err := queryClient.StaleInterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdNextChannelID returns the command handler for querying the controller port identifier of an owner on a
+// connection, along with the channel identifier to be allocated to the next channel opened on the controller chain.
+func GetCmdNextChannelID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-channel-id [owner] [connection-id]",
+		Short: "Query the port and next channel identifier of an interchain account registration",
+		Long: `Query the controller port identifier of the provided owner on the provided connection, along with the channel identifier to be allocated to the next channel opened on the controller chain.
+Channel identifiers are allocated sequentially across all ports. The returned channel identifier is a prediction only, any channel handshake initiated before the registration, including concurrent registrations in the same block, shifts the identifier allocated to the interchain account channel.`,
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller next-channel-id cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNextChannelIDRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.NextChannelID(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCompatibleVersion returns the command handler for selecting the channel version to propose when registering
+// an interchain account, given the interchain accounts features supported by the host chain.
+func GetCmdCompatibleVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compatible-version [connection-id] [counterparty-connection-id]",
+		Short: "Query the host chain capabilities and select a compatible interchain account channel version",
+		Long: `Query the interchain accounts features supported by the host chain using the node provided by the --host-node
+flag and print the channel version a controller chain should propose to register an interchain account of the provided
+transaction type over the provided connection. A version encoded as ICAMetadata is preferred over the legacy version format.`,
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller compatible-version connection-0 connection-0 --host-node tcp://localhost:26657", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hostNode, err := cmd.Flags().GetString(flagHostNode)
+			if err != nil {
+				return err
+			}
+
+			if hostNode == "" {
+				return fmt.Errorf("the --%s flag is required", flagHostNode)
+			}
+
+			txType, err := cmd.Flags().GetString(flagTxType)
+			if err != nil {
+				return err
+			}
+
+			rpcClient, err := client.NewClientFromNode(hostNode)
+			if err != nil {
+				return err
+			}
+
+			queryClient := hosttypes.NewQueryClient(clientCtx.WithNodeURI(hostNode).WithClient(rpcClient))
+
+			res, err := queryClient.HostCapabilities(cmd.Context(), &hosttypes.QueryHostCapabilitiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			version, err := icatypes.NewCompatibleVersion(res.Capabilities, args[0], args[1], txType)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", version))
+		},
+	}
+
+	cmd.Flags().String(flagHostNode, "", "<host>:<port> to tendermint rpc interface of the host chain")
+	cmd.Flags().String(flagTxType, icatypes.TxTypeSDKMultiMsg, fmt.Sprintf("transaction type of the interchain account, one of %v", icatypes.SupportedTxTypes))
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
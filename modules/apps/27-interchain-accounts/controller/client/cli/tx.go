@@ -0,0 +1,336 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	flagEncoding    = "encoding"
+	flagCompression = "compression"
+	flagMemo        = "memo"
+	flagHostNode    = "host-node"
+	flagTxType      = "tx-type"
+	flagOwner       = "owner"
+)
+
+// NewGenerateCompoundRewardsPacketDataCmd returns the command handler for generating the interchain account packet data
+// which compounds the staking rewards of an interchain account.
+func NewGenerateCompoundRewardsPacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-compound-rewards-packet-data [interchain-account-address] [validator-address] [rewards] [[validator-address] [rewards]...]",
+		Short: "Generate interchain account packet data compounding staking rewards",
+		Long: `Generate interchain account packet data which withdraws the staking rewards of an interchain account from each
+provided validator and delegates the provided rewards back to that validator. Validators with zero rewards are only
+withdrawn from. The host chain executes the packet atomically, if any withdrawal or delegation fails none are applied.
+The generated packet data may be sent by an authentication module on behalf of the interchain account owner.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 3 || len(args)%2 != 1 {
+				return fmt.Errorf("expected an interchain account address followed by validator address and rewards pairs, got %d arguments", len(args))
+			}
+
+			return nil
+		},
+		Example: fmt.Sprintf("%s tx interchain-accounts controller generate-compound-rewards-packet-data cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs cosmosvaloper1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k 1000stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var rewards []types.ValidatorRewards
+			for i := 1; i < len(args); i += 2 {
+				amount, err := sdk.ParseCoinNormalized(args[i+1])
+				if err != nil {
+					return err
+				}
+
+				rewards = append(rewards, types.NewValidatorRewards(args[i], amount))
+			}
+
+			msgs, err := types.NewCompoundRewardsMsgs(args[0], rewards)
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
+			compression, err := cmd.Flags().GetString(flagCompression)
+			if err != nil {
+				return err
+			}
+
+			data, err := icatypes.SerializeCosmosTx(clientCtx.Codec, msgs, encoding, compression)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: memo,
+			}
+
+			if err := packetData.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&packetData)
+		},
+	}
+
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, fmt.Sprintf("encoding format of the interchain account transaction, one of %v", icatypes.SupportedEncodings))
+	cmd.Flags().String(flagCompression, "", fmt.Sprintf("compression format of the interchain account transaction, empty or one of %v", icatypes.SupportedCompressions))
+	cmd.Flags().String(flagMemo, "", "memo to include in the interchain account packet data")
+
+	return cmd
+}
+
+// NewSetWithdrawAddressCmd returns the command handler for generating the interchain account packet data which sets
+// the address to which the staking rewards of an interchain account are withdrawn.
+func NewSetWithdrawAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-address [connection-id] [withdraw-address]",
+		Short: "Generate interchain account packet data setting the staking rewards withdraw address",
+		Long: `Generate interchain account packet data which sets the address to which the staking rewards of the interchain
+account registered by the provided owner on the provided connection are withdrawn. The withdraw address is an account
+on the host chain and must use the bech32 prefix of the host chain. The generated packet data may be sent by an
+authentication module on behalf of the interchain account owner.`,
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller set-withdraw-address connection-0 cosmos1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k --owner cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), &types.QueryInterchainAccountRequest{
+				Owner:        owner,
+				ConnectionId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewSetWithdrawAddressMsg(res.Address, args[1])
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
+			compression, err := cmd.Flags().GetString(flagCompression)
+			if err != nil {
+				return err
+			}
+
+			data, err := icatypes.SerializeCosmosTx(clientCtx.Codec, []sdk.Msg{msg}, encoding, compression)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: memo,
+			}
+
+			if err := packetData.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&packetData)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "owner address of the interchain account")
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, fmt.Sprintf("encoding format of the interchain account transaction, one of %v", icatypes.SupportedEncodings))
+	cmd.Flags().String(flagCompression, "", fmt.Sprintf("compression format of the interchain account transaction, empty or one of %v", icatypes.SupportedCompressions))
+	cmd.Flags().String(flagMemo, "", "memo to include in the interchain account packet data")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flagOwner)
+
+	return cmd
+}
+
+// NewUndelegateCmd returns the command handler for generating the interchain account packet data which undelegates
+// the staked tokens of an interchain account from a validator.
+func NewUndelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate [connection-id] [validator-address] [amount]",
+		Short: "Generate interchain account packet data undelegating staked tokens from a validator",
+		Long: `Generate interchain account packet data which undelegates the provided amount of the interchain account
+registered by the provided owner on the provided connection from the provided validator of the host chain. The packet
+data uses the EXECUTE_TX_WITH_EVENTS type such that the completion time of the unbonding is returned in the
+acknowledgement. The generated packet data may be sent by an authentication module on behalf of the interchain account
+owner, unbondings are only tracked by the controller chain if the undelegation is sent using TrySendUndelegateTx.`,
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller undelegate connection-0 cosmosvaloper1qnk2n4nlkpw9xfqntladh74w6ujtulwnlxr4pj 1000stake --owner cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), &types.QueryInterchainAccountRequest{
+				Owner:        owner,
+				ConnectionId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewUndelegateMsg(res.Address, args[1], amount)
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
+			compression, err := cmd.Flags().GetString(flagCompression)
+			if err != nil {
+				return err
+			}
+
+			data, err := icatypes.SerializeCosmosTx(clientCtx.Codec, []sdk.Msg{msg}, encoding, compression)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX_WITH_EVENTS,
+				Data: data,
+				Memo: memo,
+			}
+
+			if err := packetData.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&packetData)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "owner address of the interchain account")
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, fmt.Sprintf("encoding format of the interchain account transaction, one of %v", icatypes.SupportedEncodings))
+	cmd.Flags().String(flagCompression, "", fmt.Sprintf("compression format of the interchain account transaction, empty or one of %v", icatypes.SupportedCompressions))
+	cmd.Flags().String(flagMemo, "", "memo to include in the interchain account packet data")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flagOwner)
+
+	return cmd
+}
+
+// NewCmdSubmitReconcileActiveChannelsProposal implements a command handler for submitting an interchain accounts
+// controller active channel reconciliation proposal transaction.
+func NewCmdSubmitReconcileActiveChannelsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile-ica-active-channels",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to reconcile the interchain accounts controller active channels",
+		Long: "Submit a proposal to reconcile the interchain accounts controller active channels along with an initial deposit.\n" +
+			"Active channels whose channel is missing or no longer open are removed.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal reconcile-ica-active-channels --title=<title> --description=<description> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewReconcileActiveChannelsProposal(title, description)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/client/cli"
+)
+
+var ReconcileActiveChannelsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitReconcileActiveChannelsProposal, emptyRestHandler)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-ica-controller",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC proposals")
+		},
+	}
+}
@@ -143,6 +143,10 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return types.ErrControllerSubModuleDisabled
 	}
 
+	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement); err != nil {
+		return err
+	}
+
 	// call underlying app's OnAcknowledgementPacket callback.
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
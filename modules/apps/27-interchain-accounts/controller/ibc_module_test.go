@@ -120,7 +120,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, types.DefaultMaxRelativeTimeout, 0, 0, false))
 			}, false,
 		},
 		{
@@ -259,7 +259,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenAck() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, types.DefaultMaxRelativeTimeout, 0, 0, false))
 			}, false,
 		},
 		{
@@ -502,7 +502,7 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, types.DefaultMaxRelativeTimeout, 0, 0, false))
 			}, false,
 		},
 		{
@@ -573,7 +573,7 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, types.DefaultMaxRelativeTimeout, 0, 0, false))
 			}, false,
 		},
 		{
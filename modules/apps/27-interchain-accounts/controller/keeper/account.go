@@ -4,8 +4,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
@@ -15,7 +18,115 @@ import (
 // call 04-channel 'ChanOpenInit'. An error is returned if the port identifier is
 // already in use. Gaining access to interchain accounts whose channels have closed
 // cannot be done with this function. A regular MsgChanOpenInit must be used.
+// The legacy channel version format is proposed, see InitInterchainAccountWithMetadata
+// to propose a version encoded as ICAMetadata.
 func (k Keeper) InitInterchainAccount(ctx sdk.Context, connectionID, counterpartyConnectionID, owner string) error {
+	return k.initInterchainAccount(ctx, connectionID, counterpartyConnectionID, owner, icatypes.VersionPrefix)
+}
+
+// InitInterchainAccountWithMetadata registers an interchain account in the same manner as InitInterchainAccount,
+// proposing the default ICAMetadata for the provided connection identifiers as the channel version.
+// The host chain must support version negotiation using ICAMetadata.
+func (k Keeper) InitInterchainAccountWithMetadata(ctx sdk.Context, connectionID, counterpartyConnectionID, owner string) error {
+	metadata := icatypes.NewDefaultICAMetadata(connectionID, counterpartyConnectionID)
+	return k.initInterchainAccount(ctx, connectionID, counterpartyConnectionID, owner, icatypes.EncodeICAMetadata(metadata))
+}
+
+// InitReadOnlyInterchainAccount registers a read-only interchain account in the same manner as InitInterchainAccountWithMetadata,
+// proposing the query only transaction type. The host chain restricts the messages a read-only interchain account may execute
+// to those it considers not to mutate state. The mode of an interchain account is fixed upon registration.
+func (k Keeper) InitReadOnlyInterchainAccount(ctx sdk.Context, connectionID, counterpartyConnectionID, owner string) error {
+	metadata := icatypes.NewDefaultICAMetadata(connectionID, counterpartyConnectionID)
+	metadata.TxType = icatypes.TxTypeSDKQueryOnly
+
+	return k.initInterchainAccount(ctx, connectionID, counterpartyConnectionID, owner, icatypes.EncodeICAMetadata(metadata))
+}
+
+// InitInterchainAccountWithCompression registers an interchain account in the same manner as InitInterchainAccountWithMetadata,
+// proposing the provided compression format for the interchain account transactions sent over the channel. The compression
+// format must be one of the SupportedCompressions and is fixed for the lifetime of the channel.
+func (k Keeper) InitInterchainAccountWithCompression(ctx sdk.Context, connectionID, counterpartyConnectionID, owner, compression string) error {
+	metadata := icatypes.NewDefaultICAMetadata(connectionID, counterpartyConnectionID)
+	metadata.Compression = compression
+
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.initInterchainAccount(ctx, connectionID, counterpartyConnectionID, owner, icatypes.EncodeICAMetadata(metadata))
+}
+
+// InitInterchainAccountWithHostCapabilities registers an interchain account of the provided transaction type in the
+// same manner as InitInterchainAccount, proposing a channel version compatible with the provided capabilities of the
+// host chain. The capabilities are expected to be queried from the host chain using the Query/HostCapabilities gRPC
+// method prior to registration. See icatypes.NewCompatibleVersion for the rules applied to select the channel version.
+func (k Keeper) InitInterchainAccountWithHostCapabilities(ctx sdk.Context, connectionID, counterpartyConnectionID, owner, txType string, capabilities hosttypes.HostCapabilities) error {
+	version, err := icatypes.NewCompatibleVersion(capabilities, connectionID, counterpartyConnectionID, txType)
+	if err != nil {
+		return err
+	}
+
+	return k.initInterchainAccount(ctx, connectionID, counterpartyConnectionID, owner, version)
+}
+
+// InitInterchainAccountWithLabel registers an interchain account in the same manner as InitInterchainAccount and
+// assigns the provided human-readable label to it. The label is local metadata of the controller chain and is never
+// sent to the host chain. See UpdateInterchainAccountLabel for the rules applied to labels.
+func (k Keeper) InitInterchainAccountWithLabel(ctx sdk.Context, connectionID, counterpartyConnectionID, owner, label string) error {
+	if err := types.ValidateLabel(label); err != nil {
+		return err
+	}
+
+	if err := k.InitInterchainAccount(ctx, connectionID, counterpartyConnectionID, owner); err != nil {
+		return err
+	}
+
+	portID, err := icatypes.GeneratePortID(owner, connectionID, counterpartyConnectionID)
+	if err != nil {
+		return err
+	}
+
+	return k.UpdateInterchainAccountLabel(ctx, portID, label)
+}
+
+// UpdateInterchainAccountLabel assigns the provided label to the interchain account associated with the provided portID,
+// replacing any existing label. An empty label clears the existing label. The portID must be bound by the controller
+// submodule and a label may be assigned to at most one interchain account of the same owner.
+func (k Keeper) UpdateInterchainAccountLabel(ctx sdk.Context, portID, label string) error {
+	if !k.hasPort(ctx, portID) {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "port %s is not bound by the controller submodule", portID)
+	}
+
+	if label == "" {
+		k.DeleteInterchainAccountLabel(ctx, portID)
+		return nil
+	}
+
+	if err := types.ValidateLabel(label); err != nil {
+		return err
+	}
+
+	owner, err := icatypes.ParseControllerPortOwner(portID)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range k.GetAllInterchainAccountLabels(ctx) {
+		if existing.PortId == portID || existing.Label != label {
+			continue
+		}
+
+		if existingOwner, err := icatypes.ParseControllerPortOwner(existing.PortId); err == nil && existingOwner == owner {
+			return sdkerrors.Wrapf(types.ErrLabelAlreadyInUse, "label %s is already assigned to the interchain account on port %s", label, existing.PortId)
+		}
+	}
+
+	k.SetInterchainAccountLabel(ctx, portID, label)
+
+	return nil
+}
+
+func (k Keeper) initInterchainAccount(ctx sdk.Context, connectionID, counterpartyConnectionID, owner, version string) error {
 	portID, err := icatypes.GeneratePortID(owner, connectionID, counterpartyConnectionID)
 	if err != nil {
 		return err
@@ -30,7 +141,7 @@ func (k Keeper) InitInterchainAccount(ctx sdk.Context, connectionID, counterpart
 		return sdkerrors.Wrap(err, "unable to bind to newly generated portID")
 	}
 
-	msg := channeltypes.NewMsgChannelOpenInit(portID, icatypes.VersionPrefix, channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
+	msg := channeltypes.NewMsgChannelOpenInit(portID, version, channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
 	handler := k.msgRouter.Handler(msg)
 	if _, err := handler(ctx, msg); err != nil {
 		return err
@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"strings"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -70,3 +74,297 @@ func (suite *KeeperTestSuite) TestInitInterchainAccount() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestInitInterchainAccountWithMetadata() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	path.EndpointA.ChannelConfig.Version = icatypes.EncodeICAMetadata(metadata)
+
+	metadata.Address = TestAccAddress.String()
+	path.EndpointB.ChannelConfig.Version = icatypes.EncodeICAMetadata(metadata)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	err := suite.chainA.GetSimApp().ICAControllerKeeper.InitInterchainAccountWithMetadata(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// commit state changes for proof verification
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	interchainAccAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(TestAccAddress.String(), interchainAccAddr)
+
+	channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(icatypes.EncodeICAMetadata(metadata), channel.Version)
+}
+
+func (suite *KeeperTestSuite) TestInitReadOnlyInterchainAccount() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	metadata.TxType = icatypes.TxTypeSDKQueryOnly
+	path.EndpointA.ChannelConfig.Version = icatypes.EncodeICAMetadata(metadata)
+
+	metadata.Address = TestAccAddress.String()
+	path.EndpointB.ChannelConfig.Version = icatypes.EncodeICAMetadata(metadata)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	err := suite.chainA.GetSimApp().ICAControllerKeeper.InitReadOnlyInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// commit state changes for proof verification
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	interchainAccAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(TestAccAddress.String(), interchainAccAddr)
+
+	// the interchain account is registered in read-only mode on the host chain
+	suite.Require().True(suite.chainB.GetSimApp().ICAHostKeeper.IsReadOnlyInterchainAccount(suite.chainB.GetContext(), interchainAccAddr))
+}
+
+func (suite *KeeperTestSuite) TestInitInterchainAccountWithCompression() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	metadata.Compression = icatypes.CompressionGzip
+	path.EndpointA.ChannelConfig.Version = icatypes.EncodeICAMetadata(metadata)
+
+	metadata.Address = TestAccAddress.String()
+	path.EndpointB.ChannelConfig.Version = icatypes.EncodeICAMetadata(metadata)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	err := suite.chainA.GetSimApp().ICAControllerKeeper.InitInterchainAccountWithCompression(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, TestOwnerAddress, icatypes.CompressionGzip)
+	suite.Require().NoError(err)
+
+	// commit state changes for proof verification
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	// the negotiated compression format is stored in the channel version
+	channel := path.EndpointA.GetChannel()
+	negotiated, err := icatypes.ParseICAMetadata(channel.Version)
+	suite.Require().NoError(err)
+	suite.Require().Equal(icatypes.CompressionGzip, negotiated.Compression)
+
+	// unsupported compression formats are rejected
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.InitInterchainAccountWithCompression(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, "owner-2", "zstd")
+	suite.Require().ErrorIs(err, icatypes.ErrInvalidCodec)
+}
+
+func (suite *KeeperTestSuite) TestInitInterchainAccountWithHostCapabilities() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	// the controller queries the capabilities of the host chain prior to registration
+	capabilities := suite.chainB.GetSimApp().ICAHostKeeper.GetHostCapabilities(suite.chainB.GetContext())
+
+	metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	path.EndpointA.ChannelConfig.Version = icatypes.EncodeICAMetadata(metadata)
+
+	metadata.Address = TestAccAddress.String()
+	path.EndpointB.ChannelConfig.Version = icatypes.EncodeICAMetadata(metadata)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	err := suite.chainA.GetSimApp().ICAControllerKeeper.InitInterchainAccountWithHostCapabilities(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, TestOwnerAddress, icatypes.TxTypeSDKMultiMsg, capabilities)
+	suite.Require().NoError(err)
+
+	// commit state changes for proof verification
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	interchainAccAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(TestAccAddress.String(), interchainAccAddr)
+
+	// registration fails if no compatible channel version exists
+	capabilities.HostEnabled = false
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.InitInterchainAccountWithHostCapabilities(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, "owner-2", icatypes.TxTypeSDKMultiMsg, capabilities)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestInitInterchainAccountWithLabel() {
+	var label string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty label", func() {
+				label = ""
+			}, false,
+		},
+		{
+			"label exceeds maximum length", func() {
+				label = strings.Repeat("a", types.MaximumLabelLength+1)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			label = "treasury" // must be explicitly changed
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			tc.malleate() // malleate mutates test data
+
+			err := suite.chainA.GetSimApp().ICAControllerKeeper.InitInterchainAccountWithLabel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, TestOwnerAddress, label)
+
+			storedLabel, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountLabel(suite.chainA.GetContext(), TestPortID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(label, storedLabel)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+				suite.Require().False(suite.chainA.GetSimApp().IBCKeeper.PortKeeper.IsBound(suite.chainA.GetContext(), TestPortID))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateInterchainAccountLabel() {
+	var (
+		portID string
+		label  string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success - replace existing label", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountLabel(suite.chainA.GetContext(), portID, "savings")
+			}, true,
+		},
+		{
+			"success - empty label clears existing label", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountLabel(suite.chainA.GetContext(), portID, "savings")
+				label = ""
+			}, true,
+		},
+		{
+			"success - label in use by interchain account of another owner", func() {
+				otherPortID, err := icatypes.GeneratePortID(TestAccAddress.String(), ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.BindPort(suite.chainA.GetContext(), otherPortID)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountLabel(suite.chainA.GetContext(), otherPortID, label)
+			}, true,
+		},
+		{
+			"port is not bound by the controller submodule", func() {
+				portID = "invalid-port-id"
+			}, false,
+		},
+		{
+			"label contains leading whitespace", func() {
+				label = " treasury"
+			}, false,
+		},
+		{
+			"label in use by another interchain account of the same owner", func() {
+				otherPortID, err := icatypes.GeneratePortID(TestOwnerAddress, ibctesting.FirstConnectionID, "connection-1")
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.BindPort(suite.chainA.GetContext(), otherPortID)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountLabel(suite.chainA.GetContext(), otherPortID, label)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			label = "treasury"
+
+			tc.malleate() // malleate mutates test data
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.UpdateInterchainAccountLabel(suite.chainA.GetContext(), portID, label)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				storedLabel, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountLabel(suite.chainA.GetContext(), portID)
+				suite.Require().Equal(label != "", found)
+				suite.Require().Equal(label, storedLabel)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// GetLastActivityHeight retrieves the block height of the last packet activity on the active channel of the provided
+// portID. Opening the channel, sending a packet and receiving the acknowledgement of a packet count as activity
+func (k Keeper) GetLastActivityHeight(ctx sdk.Context, portID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLastActivity(portID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetLastActivityHeight stores the block height of the last packet activity on the active channel of the provided portID
+func (k Keeper) SetLastActivityHeight(ctx sdk.Context, portID string, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyLastActivity(portID), sdk.Uint64ToBigEndian(height))
+}
+
+// recordActivity records the current block height as the last packet activity on the active channel of the provided portID
+func (k Keeper) recordActivity(ctx sdk.Context, portID string) {
+	k.SetLastActivityHeight(ctx, portID, uint64(ctx.BlockHeight()))
+}
+
+// GetStaleInterchainAccounts returns the interchain accounts whose active channel has had no packet activity for more
+// than the provided number of blocks, ordered by port identifier. Active channels opened before activity tracking was
+// introduced have no recorded activity until their next packet, they are reported with a last activity height of 0
+func (k Keeper) GetStaleInterchainAccounts(ctx sdk.Context, inactiveBlocks uint64) []types.StaleInterchainAccount {
+	currentHeight := uint64(ctx.BlockHeight())
+
+	staleAccounts := []types.StaleInterchainAccount{}
+	for _, activeChannel := range k.GetAllActiveChannels(ctx) {
+		lastActivityHeight, _ := k.GetLastActivityHeight(ctx, activeChannel.PortId)
+		if currentHeight-lastActivityHeight <= inactiveBlocks {
+			continue
+		}
+
+		accAddr, _ := k.GetInterchainAccountAddress(ctx, activeChannel.PortId)
+
+		staleAccounts = append(staleAccounts, types.StaleInterchainAccount{
+			PortId:             activeChannel.PortId,
+			ChannelId:          activeChannel.ChannelId,
+			AccountAddress:     accAddr,
+			LastActivityHeight: lastActivityHeight,
+		})
+	}
+
+	return staleAccounts
+}
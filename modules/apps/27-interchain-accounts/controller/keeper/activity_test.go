@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordActivity() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	portID := path.EndpointA.ChannelConfig.PortID
+
+	// opening the channel is recorded as activity
+	openHeight, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetLastActivityHeight(suite.chainA.GetContext(), portID)
+	suite.Require().True(found)
+	suite.Require().NotZero(openHeight)
+
+	suite.coordinator.CommitNBlocks(suite.chainA, 5)
+
+	// sending a packet is recorded as activity
+	sequence := suite.sendPendingTestPacket(path, 0)
+
+	lastActivityHeight, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetLastActivityHeight(suite.chainA.GetContext(), portID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(suite.chainA.GetContext().BlockHeight()), lastActivityHeight)
+	suite.Require().Greater(lastActivityHeight, openHeight)
+
+	suite.coordinator.CommitNBlocks(suite.chainA, 5)
+
+	// receiving an acknowledgement is recorded as activity
+	packet := channeltypes.NewPacket(nil, sequence, portID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	suite.Require().NoError(err)
+
+	ackHeight, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetLastActivityHeight(suite.chainA.GetContext(), portID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(suite.chainA.GetContext().BlockHeight()), ackHeight)
+	suite.Require().Greater(ackHeight, lastActivityHeight)
+}
+
+func (suite *KeeperTestSuite) TestGetStaleInterchainAccounts() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	portID := path.EndpointA.ChannelConfig.PortID
+	ctx := suite.chainA.GetContext()
+	currentHeight := uint64(ctx.BlockHeight())
+
+	accAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(ctx, portID)
+	suite.Require().True(found)
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetLastActivityHeight(ctx, portID, currentHeight-10)
+
+	// the interchain account is stale once inactive for more than the provided number of blocks
+	suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetStaleInterchainAccounts(ctx, 10))
+
+	expAccounts := []types.StaleInterchainAccount{
+		{
+			PortId:             portID,
+			ChannelId:          path.EndpointA.ChannelID,
+			AccountAddress:     accAddr,
+			LastActivityHeight: currentHeight - 10,
+		},
+	}
+	suite.Require().Equal(expAccounts, suite.chainA.GetSimApp().ICAControllerKeeper.GetStaleInterchainAccounts(ctx, 9))
+
+	// interchain accounts without an active channel are not reported
+	suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(ctx, portID)
+	suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetStaleInterchainAccounts(ctx, 9))
+}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
@@ -28,6 +31,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.ControllerGenesi
 		keeper.SetInterchainAccountAddress(ctx, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, label := range state.Labels {
+		keeper.SetInterchainAccountLabel(ctx, label.PortId, label.Label)
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
@@ -38,5 +45,55 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) icatypes.ControllerGenesisSta
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
+		keeper.GetAllInterchainAccountLabels(ctx),
 	)
 }
+
+// RebuildGenesisFromState reconstructs the interchain accounts controller genesis state by iterating the live store
+// and validates its consistency with the state of the port and channel modules. Each port must be bound, each active
+// channel must exist and be OPEN and each interchain account and label must be assigned to a known port.
+// For a well-formed chain the reconstructed genesis state is identical to the exported genesis state.
+func (k Keeper) RebuildGenesisFromState(ctx sdk.Context) (icatypes.ControllerGenesisState, error) {
+	ports := k.GetAllPorts(ctx)
+	boundPorts := make(map[string]bool, len(ports))
+	for _, portID := range ports {
+		if !k.IsBound(ctx, portID) {
+			return icatypes.ControllerGenesisState{}, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "port %s is not bound", portID)
+		}
+
+		boundPorts[portID] = true
+	}
+
+	activeChannels := k.GetAllActiveChannels(ctx)
+	for _, ch := range activeChannels {
+		channel, found := k.channelKeeper.GetChannel(ctx, ch.PortId, ch.ChannelId)
+		if !found {
+			return icatypes.ControllerGenesisState{}, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", ch.PortId, ch.ChannelId)
+		}
+
+		if channel.State != channeltypes.OPEN {
+			return icatypes.ControllerGenesisState{}, sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "active channel %s on port %s is in state %s, expected %s", ch.ChannelId, ch.PortId, channel.State, channeltypes.OPEN)
+		}
+	}
+
+	interchainAccounts := k.GetAllInterchainAccounts(ctx)
+	for _, acc := range interchainAccounts {
+		if !boundPorts[acc.PortId] {
+			return icatypes.ControllerGenesisState{}, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "interchain account %s is registered on unknown port %s", acc.AccountAddress, acc.PortId)
+		}
+	}
+
+	labels := k.GetAllInterchainAccountLabels(ctx)
+	for _, label := range labels {
+		if !boundPorts[label.PortId] {
+			return icatypes.ControllerGenesisState{}, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "label %s is assigned to unknown port %s", label.Label, label.PortId)
+		}
+	}
+
+	genesisState := icatypes.NewControllerGenesisState(activeChannels, interchainAccounts, ports, k.GetParams(ctx), labels)
+	if err := genesisState.Validate(); err != nil {
+		return icatypes.ControllerGenesisState{}, err
+	}
+
+	return genesisState, nil
+}
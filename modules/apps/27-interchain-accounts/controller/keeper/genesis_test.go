@@ -24,6 +24,12 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: []string{TestPortID},
+		Labels: []icatypes.InterchainAccountLabel{
+			{
+				PortId: TestPortID,
+				Label:  "treasury",
+			},
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper, genesisState)
@@ -36,7 +42,11 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(TestAccAddress.String(), accountAdrr)
 
-	expParams := types.NewParams(false)
+	label, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountLabel(suite.chainA.GetContext(), TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal("treasury", label)
+
+	expParams := types.NewParams(false, 0, 0, 0, false)
 	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 
@@ -51,6 +61,8 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	err := SetupICAPath(path, TestOwnerAddress)
 	suite.Require().NoError(err)
 
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountLabel(suite.chainA.GetContext(), TestPortID, "treasury")
+
 	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
 
 	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -61,6 +73,70 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
 
+	suite.Require().Equal([]icatypes.InterchainAccountLabel{{PortId: TestPortID, Label: "treasury"}}, genesisState.GetLabels())
+
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
+
+func (suite *KeeperTestSuite) TestRebuildGenesisFromState() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"active channel not found", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, "channel-100")
+			}, false,
+		},
+		{
+			"active channel is not OPEN", func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"interchain account registered on unknown port", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "icacontroller-unknown", TestAccAddress.String())
+			}, false,
+		},
+		{
+			"label assigned to unknown port", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountLabel(suite.chainA.GetContext(), "icacontroller-unknown", "treasury")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			genesisState, err := suite.chainA.GetSimApp().ICAControllerKeeper.RebuildGenesisFromState(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				expGenesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
+				suite.Require().Equal(expGenesisState, genesisState)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -2,8 +2,22 @@ package keeper
 
 import (
 	"context"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -17,3 +31,358 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccountClientStatus implements the Query/InterchainAccountClientStatus gRPC method
+func (q Keeper) InterchainAccountClientStatus(c context.Context, req *types.QueryInterchainAccountClientStatusRequest) (*types.QueryInterchainAccountClientStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	connection, found := q.connectionKeeper.GetConnection(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, req.ConnectionId).Error())
+	}
+
+	portID, err := icatypes.GeneratePortID(req.Owner, req.ConnectionId, connection.GetCounterparty().GetConnectionID())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, found := q.GetInterchainAccountAddress(ctx, portID); !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID).Error())
+	}
+
+	clientState, found := q.clientKeeper.GetClientState(ctx, connection.GetClientID())
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.GetClientID()).Error())
+	}
+
+	clientStore := q.clientKeeper.ClientStore(ctx, connection.GetClientID())
+	clientStatus := clientState.Status(ctx, clientStore, q.cdc)
+	latestHeight := clientState.GetLatestHeight()
+
+	return &types.QueryInterchainAccountClientStatusResponse{
+		ClientId:     connection.GetClientID(),
+		ClientType:   clientState.ClientType(),
+		Status:       clientStatus.String(),
+		LatestHeight: clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()),
+	}, nil
+}
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (q Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	connection, found := q.connectionKeeper.GetConnection(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, req.ConnectionId).Error())
+	}
+
+	portID, err := icatypes.GeneratePortID(req.Owner, req.ConnectionId, connection.GetCounterparty().GetConnectionID())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	address, found := q.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID).Error())
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Address: address,
+	}, nil
+}
+
+// InterchainAccountPorts implements the Query/InterchainAccountPorts gRPC method
+func (q Keeper) InterchainAccountPorts(c context.Context, req *types.QueryInterchainAccountPortsRequest) (*types.QueryInterchainAccountPortsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ports := []types.InterchainAccountPort{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.PortKeyPrefix))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		portID := strings.TrimPrefix(string(key), "/")
+
+		channelID, active := q.GetActiveChannelID(ctx, portID)
+		accAddr, _ := q.GetInterchainAccountAddress(ctx, portID)
+		label, _ := q.GetInterchainAccountLabel(ctx, portID)
+
+		ports = append(ports, types.InterchainAccountPort{
+			PortId:         portID,
+			ChannelId:      channelID,
+			Active:         active,
+			AccountAddress: accAddr,
+			Label:          label,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountPortsResponse{
+		Ports:      ports,
+		Pagination: pageRes,
+	}, nil
+}
+
+// InterchainAccountPortsByConnection implements the Query/InterchainAccountPortsByConnection gRPC method
+func (q Keeper) InterchainAccountPortsByConnection(c context.Context, req *types.QueryInterchainAccountPortsByConnectionRequest) (*types.QueryInterchainAccountPortsByConnectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		connectionIDs   []string
+		unassignedPorts []string
+	)
+	portsByConnection := make(map[string][]string)
+	for _, portID := range q.GetAllPorts(ctx) {
+		connectionID, err := q.GetInterchainAccountConnectionID(ctx, portID)
+		if err != nil {
+			unassignedPorts = append(unassignedPorts, portID)
+			continue
+		}
+
+		if _, found := portsByConnection[connectionID]; !found {
+			connectionIDs = append(connectionIDs, connectionID)
+		}
+
+		portsByConnection[connectionID] = append(portsByConnection[connectionID], portID)
+	}
+
+	sort.Strings(connectionIDs)
+
+	connections := []types.ConnectionPorts{}
+	for _, connectionID := range connectionIDs {
+		connections = append(connections, types.ConnectionPorts{
+			ConnectionId: connectionID,
+			PortIds:      portsByConnection[connectionID],
+		})
+	}
+
+	if len(unassignedPorts) > 0 {
+		connections = append(connections, types.ConnectionPorts{
+			ConnectionId: types.UnassignedConnectionID,
+			PortIds:      unassignedPorts,
+		})
+	}
+
+	return &types.QueryInterchainAccountPortsByConnectionResponse{
+		Connections: connections,
+	}, nil
+}
+
+// InterchainAccountConnection implements the Query/InterchainAccountConnection gRPC method
+func (q Keeper) InterchainAccountConnection(c context.Context, req *types.QueryInterchainAccountConnectionRequest) (*types.QueryInterchainAccountConnectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	connectionID, err := q.GetInterchainAccountConnectionID(ctx, req.PortId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryInterchainAccountConnectionResponse{
+		ConnectionId: connectionID,
+	}, nil
+}
+
+// InterchainAccountCounterparty implements the Query/InterchainAccountCounterparty gRPC method
+func (q Keeper) InterchainAccountCounterparty(c context.Context, req *types.QueryInterchainAccountCounterpartyRequest) (*types.QueryInterchainAccountCounterpartyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	activeChannelID, found := q.GetActiveChannelID(ctx, req.PortId)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", req.PortId).Error())
+	}
+
+	channel, found := q.channelKeeper.GetChannel(ctx, req.PortId, activeChannelID)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, activeChannelID).Error())
+	}
+
+	if channel.State != channeltypes.OPEN {
+		return nil, status.Error(codes.FailedPrecondition, sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "expected %s, got %s", channeltypes.OPEN, channel.State).Error())
+	}
+
+	return &types.QueryInterchainAccountCounterpartyResponse{
+		ChannelId:             activeChannelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+	}, nil
+}
+
+// SendQueueDepth implements the Query/SendQueueDepth gRPC method
+func (q Keeper) SendQueueDepth(c context.Context, req *types.QuerySendQueueDepthRequest) (*types.QuerySendQueueDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySendQueueDepthResponse{
+		Depth: q.GetSendQueueDepth(ctx, req.PortId),
+	}, nil
+}
+
+// PacketTimeout implements the Query/PacketTimeout gRPC method
+func (q Keeper) PacketTimeout(c context.Context, req *types.QueryPacketTimeoutRequest) (*types.QueryPacketTimeoutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	packetTimeout, err := q.GetPacketTimeout(ctx, req.PortId, req.Sequence)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPacketTimeoutResponse{
+		TimeoutHeight:    packetTimeout.TimeoutHeight,
+		TimeoutTimestamp: packetTimeout.TimeoutTimestamp,
+	}, nil
+}
+
+// PendingPackets implements the Query/PendingPackets gRPC method
+func (q Keeper) PendingPackets(c context.Context, req *types.QueryPendingPacketsRequest) (*types.QueryPendingPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channelID, pendingPackets, err := q.GetPendingPackets(ctx, req.PortId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	var maxPriority uint32
+	for _, pendingPacket := range pendingPackets {
+		if pendingPacket.Priority > maxPriority {
+			maxPriority = pendingPacket.Priority
+		}
+	}
+
+	return &types.QueryPendingPacketsResponse{
+		ChannelId:   channelID,
+		Packets:     pendingPackets,
+		MaxPriority: maxPriority,
+	}, nil
+}
+
+// PendingUnbondings implements the Query/PendingUnbondings gRPC method
+func (q Keeper) PendingUnbondings(c context.Context, req *types.QueryPendingUnbondingsRequest) (*types.QueryPendingUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := q.GetInterchainAccountAddress(ctx, req.PortId); !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for port %s", req.PortId).Error())
+	}
+
+	return &types.QueryPendingUnbondingsResponse{
+		Unbondings: q.GetPendingUnbondings(ctx, req.PortId),
+	}, nil
+}
+
+// StaleInterchainAccounts implements the Query/StaleInterchainAccounts gRPC method
+func (q Keeper) StaleInterchainAccounts(c context.Context, req *types.QueryStaleInterchainAccountsRequest) (*types.QueryStaleInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.InactiveBlocks == 0 {
+		return nil, status.Error(codes.InvalidArgument, "inactive blocks must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryStaleInterchainAccountsResponse{
+		Accounts: q.GetStaleInterchainAccounts(ctx, req.InactiveBlocks),
+	}, nil
+}
+
+// NextChannelID implements the Query/NextChannelID gRPC method. The returned channel identifier is a prediction only,
+// any channel opened on the controller chain prior to the registration of the interchain account shifts it.
+func (q Keeper) NextChannelID(c context.Context, req *types.QueryNextChannelIDRequest) (*types.QueryNextChannelIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	connection, found := q.connectionKeeper.GetConnection(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, req.ConnectionId).Error())
+	}
+
+	portID, err := icatypes.GeneratePortID(req.Owner, req.ConnectionId, connection.GetCounterparty().GetConnectionID())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryNextChannelIDResponse{
+		PortId:    portID,
+		ChannelId: channeltypes.FormatChannelIdentifier(q.channelKeeper.GetNextChannelSequence(ctx)),
+	}, nil
+}
@@ -1,9 +1,22 @@
 package keeper_test
 
 import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +25,1013 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryParamsAtHeight() {
+	// params in effect at a past block
+	expParams := types.NewParams(false, types.DefaultMaxRelativeTimeout, 0, 0, false)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	suite.coordinator.CommitBlock(suite.chainA)
+	historicalHeight := suite.chainA.App.LastBlockHeight()
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	req := &types.QueryParamsRequest{}
+	bz, err := req.Marshal()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		height    int64
+		expParams types.Params
+	}{
+		{"latest height", 0, types.DefaultParams()},
+		{"historical height", historicalHeight, expParams},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// the gRPC gateway and gRPC server route queries setting the x-cosmos-block-height header through ABCI
+			resp := suite.chainA.App.Query(abci.RequestQuery{
+				Path:   "/ibc.applications.interchain_accounts.controller.v1.Query/Params",
+				Data:   bz,
+				Height: tc.height,
+			})
+			suite.Require().True(resp.IsOK(), resp.Log)
+
+			var res types.QueryParamsResponse
+			suite.Require().NoError(res.Unmarshal(resp.Value))
+			suite.Require().Equal(tc.expParams, *res.Params)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountClientStatus() {
+	var (
+		req       *types.QueryInterchainAccountClientStatusRequest
+		path      *ibctesting.Path
+		expStatus string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: active client", func() {}, true,
+		},
+		{
+			"success: frozen client", func() {
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+
+				expStatus = exported.Frozen.String()
+			}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid connection identifier", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+		{
+			"connection not found", func() {
+				req.ConnectionId = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"invalid owner address", func() {
+				req.Owner = ""
+			}, false,
+		},
+		{
+			"interchain account not found", func() {
+				req.Owner = "invalid-owner"
+			}, false,
+		},
+		{
+			"client not found", func() {
+				connection := path.EndpointA.GetConnection()
+				connection.ClientId = ibctesting.InvalidID
+				path.EndpointA.SetConnection(connection)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountClientStatusRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: path.EndpointA.ConnectionID,
+			}
+			expStatus = exported.Active.String()
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountClientStatus(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				clientState := path.EndpointA.GetClientState()
+				suite.Require().Equal(path.EndpointA.ClientID, res.ClientId)
+				suite.Require().Equal(clientState.ClientType(), res.ClientType)
+				suite.Require().Equal(expStatus, res.Status)
+				suite.Require().Equal(clientState.GetLatestHeight(), res.LatestHeight)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var (
+		req  *types.QueryInterchainAccountRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid connection identifier", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+		{
+			"connection not found", func() {
+				req.ConnectionId = "connection-100"
+			}, false,
+		},
+		{
+			"invalid owner address", func() {
+				req.Owner = ""
+			}, false,
+		},
+		{
+			"interchain account not found", func() {
+				req.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: path.EndpointA.ConnectionID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(ctx, req)
+
+			if tc.expPass {
+				expAddress, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAddress, res.Address)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountConnection() {
+	var (
+		req  *types.QueryInterchainAccountConnectionRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port identifier", func() {
+				req.PortId = ""
+			}, false,
+		},
+		{
+			"active channel not found", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			}, false,
+		},
+		{
+			"channel not found", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountConnectionRequest{
+				PortId: path.EndpointA.ChannelConfig.PortID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountConnection(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(path.EndpointA.ConnectionID, res.ConnectionId)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountCounterparty() {
+	var (
+		req  *types.QueryInterchainAccountCounterpartyRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port identifier", func() {
+				req.PortId = ""
+			}, false,
+		},
+		{
+			"active channel not found", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			}, false,
+		},
+		{
+			"channel not found", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID)
+			}, false,
+		},
+		{
+			"channel is not open", func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountCounterpartyRequest{
+				PortId: path.EndpointA.ChannelConfig.PortID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountCounterparty(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+				suite.Require().Equal(path.EndpointB.ChannelConfig.PortID, res.CounterpartyPortId)
+				suite.Require().Equal(path.EndpointB.ChannelID, res.CounterpartyChannelId)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountPorts() {
+	var (
+		req      *types.QueryInterchainAccountPortsRequest
+		expPorts []types.InterchainAccountPort
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryInterchainAccountPortsRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := NewICAPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountLabel(suite.chainA.GetContext(), TestPortID, "treasury")
+
+				// bind an additional port without an active channel, interchain account or label
+				suite.chainA.GetSimApp().ICAControllerKeeper.BindPort(suite.chainA.GetContext(), "test-port")
+
+				expPorts = []types.InterchainAccountPort{
+					{
+						PortId:         TestPortID,
+						ChannelId:      path.EndpointA.ChannelID,
+						Active:         true,
+						AccountAddress: TestAccAddress.String(),
+						Label:          "treasury",
+					},
+					{
+						PortId: "test-port",
+					},
+				}
+
+				req = &types.QueryInterchainAccountPortsRequest{
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expPorts = []types.InterchainAccountPort{}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountPorts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPorts, res.Ports)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountPortsByConnection() {
+	var (
+		req            *types.QueryInterchainAccountPortsByConnectionRequest
+		expConnections []types.ConnectionPorts
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no ports bound",
+			func() {},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := NewICAPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				pathAToC := NewICAPath(suite.chainA, suite.chainC)
+				suite.coordinator.SetupConnections(pathAToC)
+
+				// the host chain derives a different interchain account address for the port of the second connection
+				portID, err := icatypes.GeneratePortID(TestOwnerAddress, pathAToC.EndpointA.ConnectionID, pathAToC.EndpointB.ConnectionID)
+				suite.Require().NoError(err)
+
+				accAddr := icatypes.GenerateAddress(sdk.AccAddress(crypto.AddressHash([]byte(icatypes.ModuleName))), portID)
+				pathAToC.EndpointB.ChannelConfig.Version = icatypes.NewAppVersion(icatypes.VersionPrefix, accAddr.String())
+
+				err = SetupICAPath(pathAToC, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				// bind an additional port without an active channel
+				suite.chainA.GetSimApp().ICAControllerKeeper.BindPort(suite.chainA.GetContext(), "test-port")
+
+				expConnections = []types.ConnectionPorts{
+					{
+						ConnectionId: path.EndpointA.ConnectionID,
+						PortIds:      []string{path.EndpointA.ChannelConfig.PortID},
+					},
+					{
+						ConnectionId: pathAToC.EndpointA.ConnectionID,
+						PortIds:      []string{pathAToC.EndpointA.ChannelConfig.PortID},
+					},
+					{
+						ConnectionId: types.UnassignedConnectionID,
+						PortIds:      []string{"test-port"},
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			req = &types.QueryInterchainAccountPortsByConnectionRequest{}
+			expConnections = []types.ConnectionPorts{}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountPortsByConnection(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expConnections, res.Connections)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerySendQueueDepth() {
+	var (
+		req      *types.QuerySendQueueDepthRequest
+		path     *ibctesting.Path
+		expDepth uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: empty send queue", func() {}, true,
+		},
+		{
+			"success", func() {
+				chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				suite.Require().True(ok)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, types.DefaultMaxRelativeTimeout, 1, 0, false))
+
+				packetData := suite.newQueueTestPacketData(path)
+				for i := 0; i < 2; i++ {
+					_, err := suite.chainA.GetSimApp().ICAControllerKeeper.EnqueueTx(suite.chainA.GetContext(), chanCap, path.EndpointA.ChannelConfig.PortID, packetData)
+					suite.Require().NoError(err)
+				}
+
+				expDepth = 2
+			}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port identifier", func() {
+				req.PortId = ""
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QuerySendQueueDepthRequest{
+				PortId: path.EndpointA.ChannelConfig.PortID,
+			}
+			expDepth = 0
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendQueueDepth(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expDepth, res.Depth)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketTimeout() {
+	var (
+		req  *types.QueryPacketTimeoutRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port identifier", func() {
+				req.PortId = ""
+			}, false,
+		},
+		{
+			"zero sequence", func() {
+				req.Sequence = 0
+			}, false,
+		},
+		{
+			"packet not sent", func() {
+				req.Sequence++
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			portID := path.EndpointA.ChannelConfig.PortID
+			chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), channeltypes.NewParams(true, 0, false, 0))
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendTx(suite.chainA.GetContext(), chanCap, portID, suite.newQueueTestPacketData(path))
+			suite.Require().NoError(err)
+
+			req = &types.QueryPacketTimeoutRequest{
+				PortId:   portID,
+				Sequence: sequence,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PacketTimeout(ctx, req)
+
+			if tc.expPass {
+				expTimeout, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketTimeout(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID, sequence)
+				suite.Require().True(found)
+
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTimeout.TimeoutHeight, res.TimeoutHeight)
+				suite.Require().Equal(expTimeout.TimeoutTimestamp, res.TimeoutTimestamp)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingPackets() {
+	var (
+		req            *types.QueryPendingPacketsRequest
+		path           *ibctesting.Path
+		expPackets     []types.PendingPacket
+		expMaxPriority uint32
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no pending packets", func() {}, true,
+		},
+		{
+			"success: priorities reported from retained packet data", func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), channeltypes.NewParams(true, 0, false, 0))
+
+				for _, priority := range []uint32{3, 0, 7} {
+					sequence := suite.sendPendingTestPacket(path, priority)
+					expPackets = append(expPackets, types.PendingPacket{Sequence: sequence, Priority: priority})
+				}
+
+				expMaxPriority = 7
+			}, true,
+		},
+		{
+			"success: priorities not reported without retained packet data", func() {
+				for _, priority := range []uint32{3, 7} {
+					sequence := suite.sendPendingTestPacket(path, priority)
+					expPackets = append(expPackets, types.PendingPacket{Sequence: sequence})
+				}
+			}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port identifier", func() {
+				req.PortId = ""
+			}, false,
+		},
+		{
+			"active channel not found", func() {
+				req.PortId = "icacontroller-cosmos1unknown"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			expPackets = []types.PendingPacket{}
+			expMaxPriority = 0
+
+			req = &types.QueryPendingPacketsRequest{
+				PortId: path.EndpointA.ChannelConfig.PortID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PendingPackets(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+				suite.Require().Equal(expPackets, res.Packets)
+				suite.Require().Equal(expMaxPriority, res.MaxPriority)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// sendPendingTestPacket sends an interchain account packet with the provided priority over the active channel of the path
+func (suite *KeeperTestSuite) sendPendingTestPacket(path *ibctesting.Path, priority uint32) uint64 {
+	portID := path.EndpointA.ChannelConfig.PortID
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	packetData := suite.newQueueTestPacketData(path)
+	packetData.Priority = priority
+
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendTx(suite.chainA.GetContext(), chanCap, portID, packetData)
+	suite.Require().NoError(err)
+
+	return sequence
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingUnbondings() {
+	var (
+		req           *types.QueryPendingUnbondingsRequest
+		path          *ibctesting.Path
+		expUnbondings []types.Unbonding
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no pending unbondings", func() {}, true,
+		},
+		{
+			"success: pending unbondings", func() {
+				for i := 0; i < 2; i++ {
+					sequence := suite.sendUndelegateTestPacket(path)
+					expUnbondings = append(expUnbondings, types.NewUnbonding(path.EndpointA.ChannelID, sequence, testValidatorAddress, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+				}
+			}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port identifier", func() {
+				req.PortId = ""
+			}, false,
+		},
+		{
+			"interchain account not found", func() {
+				req.PortId = "icacontroller-cosmos1unknown"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			expUnbondings = []types.Unbonding{}
+
+			req = &types.QueryPendingUnbondingsRequest{
+				PortId: path.EndpointA.ChannelConfig.PortID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PendingUnbondings(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expUnbondings, res.Unbondings)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryStaleInterchainAccounts() {
+	var (
+		req         *types.QueryStaleInterchainAccountsRequest
+		path        *ibctesting.Path
+		expAccounts []types.StaleInterchainAccount
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no stale interchain accounts", func() {}, true,
+		},
+		{
+			"success: stale interchain account", func() {
+				ctx := suite.chainA.GetContext()
+				portID := path.EndpointA.ChannelConfig.PortID
+				accAddr, _ := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(ctx, portID)
+
+				lastActivityHeight := uint64(ctx.BlockHeight()) - req.InactiveBlocks - 1
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetLastActivityHeight(ctx, portID, lastActivityHeight)
+
+				expAccounts = []types.StaleInterchainAccount{
+					{
+						PortId:             portID,
+						ChannelId:          path.EndpointA.ChannelID,
+						AccountAddress:     accAddr,
+						LastActivityHeight: lastActivityHeight,
+					},
+				}
+			}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"zero inactive blocks", func() {
+				req.InactiveBlocks = 0
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			expAccounts = []types.StaleInterchainAccount{}
+
+			req = &types.QueryStaleInterchainAccountsRequest{
+				InactiveBlocks: 5,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.StaleInterchainAccounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAccounts, res.Accounts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryNextChannelID() {
+	var (
+		req  *types.QueryNextChannelIDRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid connection identifier", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+		{
+			"connection not found", func() {
+				req.ConnectionId = "connection-100"
+			}, false,
+		},
+		{
+			"invalid owner address", func() {
+				req.Owner = ""
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			req = &types.QueryNextChannelIDRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: path.EndpointA.ConnectionID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.NextChannelID(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// the registration of the interchain account produces the predicted port and channel identifiers
+				err = SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, res.PortId)
+				suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), res.PortId)
+				suite.Require().True(found)
+				suite.Require().Equal(res.ChannelId, activeChannelID)
+
+				// channels opened in the meantime shift the next channel identifier
+				res2, err := suite.chainA.GetSimApp().ICAControllerKeeper.NextChannelID(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+				suite.Require().NoError(err)
+				suite.Require().NotEqual(res.ChannelId, res2.ChannelId)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
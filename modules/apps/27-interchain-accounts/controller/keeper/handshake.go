@@ -12,9 +12,9 @@ import (
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
-// The channel order must be ORDERED, the counterparty port identifier
+// The channel order must be supported by interchain accounts channels, the counterparty port identifier
 // must be the host chain representation as defined in the types package,
-// the channel version must be equal to the version in the types package,
+// the channel version must be one of the supported versions in the types package,
 // there must not be an active channel for the specfied port identifier,
 // and the interchain accounts module must be able to claim the channel
 // capability.
@@ -28,8 +28,8 @@ func (k Keeper) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) error {
-	if order != channeltypes.ORDERED {
-		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if err := icatypes.ValidateChannelOrdering(order); err != nil {
+		return err
 	}
 
 	connSequence, err := icatypes.ParseControllerConnSequence(portID)
@@ -50,8 +50,12 @@ func (k Keeper) OnChanOpenInit(
 		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "expected %s, got %s", icatypes.PortID, counterparty.PortId)
 	}
 
-	if version != icatypes.VersionPrefix {
-		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "expected %s, got %s", icatypes.VersionPrefix, version)
+	if icatypes.IsICAMetadataVersion(version) {
+		if err := k.validateProposedMetadata(ctx, connectionHops[0], version); err != nil {
+			return sdkerrors.Wrap(err, "version validation failed")
+		}
+	} else if !icatypes.IsSupportedVersion(version) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "expected one of %s, got %s", icatypes.SupportedVersions, version)
 	}
 
 	activeChannelID, found := k.GetActiveChannelID(ctx, portID)
@@ -63,7 +67,9 @@ func (k Keeper) OnChanOpenInit(
 }
 
 // OnChanOpenAck sets the active channel for the interchain account/owner pair
-// and stores the associated interchain account address in state keyed by it's corresponding port identifier
+// and stores the associated interchain account address in state keyed by it's corresponding port identifier.
+// The number of automatic reopen attempts made for the port identifier is reset.
+// The registered controller hooks are notified once the interchain account is ready to be used
 func (k Keeper) OnChanOpenAck(
 	ctx sdk.Context,
 	portID,
@@ -74,19 +80,31 @@ func (k Keeper) OnChanOpenAck(
 		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "portID cannot be host chain port ID: %s", icatypes.PortID)
 	}
 
-	if err := icatypes.ValidateVersion(counterpartyVersion); err != nil {
+	accAddr, err := k.parseAccountAddressFromCounterpartyVersion(ctx, portID, channelID, counterpartyVersion)
+	if err != nil {
 		return sdkerrors.Wrap(err, "counterparty version validation failed")
 	}
 
-	k.SetActiveChannelID(ctx, portID, channelID)
-
-	accAddr, err := icatypes.ParseAddressFromVersion(counterpartyVersion)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "expected format <app-version%saccount-address>, got %s", icatypes.Delimiter, counterpartyVersion)
+	// Check to ensure that the host chain derived the account address using the configured address generator
+	if k.addressGenerator != nil {
+		if err := icatypes.ValidateDerivedAccountAddress(k.addressGenerator, portID, accAddr); err != nil {
+			return sdkerrors.Wrap(err, "counterparty version contains invalid account address")
+		}
 	}
 
+	k.SetActiveChannelID(ctx, portID, channelID)
+
 	k.SetInterchainAccountAddress(ctx, portID, accAddr)
 
+	// opening the channel counts as activity, such that the inactivity of a newly opened channel is measured from its opening
+	k.recordActivity(ctx, portID)
+
+	k.deleteReopenAttempts(ctx, portID)
+
+	if k.hooks != nil {
+		k.hooks.OnInterchainAccountCreated(ctx, portID, channelID, accAddr)
+	}
+
 	return nil
 }
 
@@ -102,6 +120,82 @@ func (k Keeper) OnChanCloseConfirm(
 	return nil
 }
 
+// validateProposedMetadata performs basic validation of the ICAMetadata proposed by the controller chain and
+// asserts the connection identifiers match the provided controller connection and its counterparty
+func (k Keeper) validateProposedMetadata(ctx sdk.Context, connectionID, version string) error {
+	metadata, err := icatypes.ParseICAMetadata(version)
+	if err != nil {
+		return err
+	}
+
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !icatypes.IsSupportedVersion(metadata.Version) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "expected one of %s, got %s", icatypes.SupportedVersions, metadata.Version)
+	}
+
+	if metadata.Address != "" {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "proposed metadata must not contain an account address, got %s", metadata.Address)
+	}
+
+	if metadata.ControllerConnectionId != connectionID {
+		return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "expected controller connection ID %s, got %s", connectionID, metadata.ControllerConnectionId)
+	}
+
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if metadata.HostConnectionId != connection.GetCounterparty().GetConnectionID() {
+		return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "expected host connection ID %s, got %s", connection.GetCounterparty().GetConnectionID(), metadata.HostConnectionId)
+	}
+
+	return nil
+}
+
+// parseAccountAddressFromCounterpartyVersion validates the counterparty channel version and returns the interchain
+// account address it contains. If the controller proposed a version encoded as ICAMetadata, the counterparty version
+// must contain ICAMetadata consistent with the proposal, otherwise the legacy version format is expected. The host
+// chain may negotiate any supported version lower than the proposed version.
+func (k Keeper) parseAccountAddressFromCounterpartyVersion(ctx sdk.Context, portID, channelID, counterpartyVersion string) (string, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID %s channel ID %s", portID, channelID)
+	}
+
+	if !icatypes.IsICAMetadataVersion(channel.Version) {
+		if err := icatypes.ValidateVersion(counterpartyVersion); err != nil {
+			return "", err
+		}
+
+		accAddr, err := icatypes.ParseAddressFromVersion(counterpartyVersion)
+		if err != nil {
+			return "", sdkerrors.Wrapf(err, "expected format <app-version%saccount-address>, got %s", icatypes.Delimiter, counterpartyVersion)
+		}
+
+		return accAddr, nil
+	}
+
+	proposedMetadata, err := icatypes.ParseICAMetadata(channel.Version)
+	if err != nil {
+		return "", err
+	}
+
+	metadata, err := icatypes.ParseICAMetadata(counterpartyVersion)
+	if err != nil {
+		return "", err
+	}
+
+	if err := icatypes.ValidateNegotiatedICAMetadata(proposedMetadata, metadata); err != nil {
+		return "", err
+	}
+
+	return metadata.Address, nil
+}
+
 // validateControllerPortParams asserts the provided connection sequence and counterparty connection sequence
 // match that of the associated connection stored in state
 func (k Keeper) validateControllerPortParams(ctx sdk.Context, channelID, portID string, connectionSeq, counterpartyConnectionSeq uint64) error {
@@ -1,14 +1,27 @@
 package keeper_test
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	controllerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
+// mockControllerHooks records the interchain accounts controller hook invocations
+type mockControllerHooks struct {
+	created []string
+}
+
+func (h *mockControllerHooks) OnInterchainAccountCreated(_ sdk.Context, portID, channelID, address string) {
+	h.created = append(h.created, fmt.Sprintf("%s/%s/%s", portID, channelID, address))
+}
+
 func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 	var (
 		channel *channeltypes.Channel
@@ -59,6 +72,89 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			},
 			false,
 		},
+		{
+			"unsupported version",
+			func() {
+				path.EndpointA.SetChannel(*channel)
+				channel.Version = "ics27-2"
+			},
+			false,
+		},
+		{
+			"success: ICAMetadata version",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointA.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"unsupported ICAMetadata version",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Version = "ics27-2"
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointA.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"success: ICAMetadata version without encoding",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Encoding = ""
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointA.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"invalid ICAMetadata version - invalid JSON",
+			func() {
+				path.EndpointA.SetChannel(*channel)
+				channel.Version = "{invalid-metadata"
+			},
+			false,
+		},
+		{
+			"invalid ICAMetadata version - unsupported encoding",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Encoding = "amino"
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointA.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"invalid ICAMetadata version - controller connection mismatch",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata("connection-10", path.EndpointB.ConnectionID)
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointA.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"invalid ICAMetadata version - host connection mismatch",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, "connection-10")
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointA.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"invalid ICAMetadata version - account address provided",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointA.SetChannel(*channel)
+			},
+			false,
+		},
 		{
 			"channel not found",
 			func() {
@@ -158,6 +254,17 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 		counterpartyVersion string
 	)
 
+	// proposeMetadata sets the default ICAMetadata as the controller channel version and returns it
+	proposeMetadata := func() icatypes.ICAMetadata {
+		metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+
+		channel := path.EndpointA.GetChannel()
+		channel.Version = icatypes.EncodeICAMetadata(metadata)
+		path.EndpointA.SetChannel(channel)
+
+		return metadata
+	}
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -174,12 +281,60 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 			},
 			false,
 		},
+		{
+			"success: address generator configured", func() {
+				controllerkeeper.WithAddressGenerator(icatypes.GenerateAddress)(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			}, true,
+		},
+		{
+			"address generator mismatch", func() {
+				controllerkeeper.WithAddressGenerator(customAddressGenerator)(&suite.chainA.GetSimApp().ICAControllerKeeper)
+				expectedChannelID = ""
+			}, false,
+		},
 		{
 			"invalid portID", func() {
 				path.EndpointA.ChannelConfig.PortID = icatypes.PortID
 				expectedChannelID = ""
 			}, false,
 		},
+		{
+			"success: ICAMetadata version", func() {
+				metadata := proposeMetadata()
+				metadata.Address = TestAccAddress.String()
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"ICAMetadata counterparty version for legacy proposed version", func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Address = TestAccAddress.String()
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+				expectedChannelID = ""
+			}, false,
+		},
+		{
+			"legacy counterparty version for ICAMetadata proposed version", func() {
+				proposeMetadata()
+				expectedChannelID = ""
+			}, false,
+		},
+		{
+			"ICAMetadata counterparty version missing account address", func() {
+				metadata := proposeMetadata()
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+				expectedChannelID = ""
+			}, false,
+		},
+		{
+			"ICAMetadata counterparty version with host connection mismatch", func() {
+				metadata := proposeMetadata()
+				metadata.Address = TestAccAddress.String()
+				metadata.HostConnectionId = "connection-10"
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+				expectedChannelID = ""
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -199,6 +354,9 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 			suite.Require().NoError(err)
 			expectedChannelID = path.EndpointA.ChannelID
 
+			hooks := &mockControllerHooks{}
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetHooks(hooks)
+
 			tc.malleate() // malleate mutates test data
 
 			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnChanOpenAck(suite.chainA.GetContext(),
@@ -211,8 +369,10 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+				suite.Require().Equal([]string{fmt.Sprintf("%s/%s/%s", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, TestAccAddress)}, hooks.created)
 			} else {
 				suite.Require().Error(err)
+				suite.Require().Empty(hooks.created)
 			}
 		})
 	}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// RegisterInvariants registers the interchain accounts controller invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.SubModuleName, "active-channels", ActiveChannelsInvariant(k))
+}
+
+// ActiveChannelsInvariant checks that every active channel refers to an open channel. A broken invariant may be
+// repaired using a ReconcileActiveChannelsProposal
+func ActiveChannelsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, activeChannel := range k.GetStaleActiveChannels(ctx) {
+			broken = true
+			msg += fmt.Sprintf("\tactive channel %s for port %s is missing or not open\n", activeChannel.ChannelId, activeChannel.PortId)
+		}
+
+		return sdk.FormatInvariant(types.SubModuleName, "active-channels", msg), broken
+	}
+}
@@ -7,6 +7,7 @@ import (
 	baseapp "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -14,30 +15,62 @@ import (
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // Keeper defines the IBC interchain accounts controller keeper
 type Keeper struct {
 	storeKey   sdk.StoreKey
-	cdc        codec.BinaryCodec
+	cdc        codec.Codec
 	paramSpace paramtypes.Subspace
 
-	ics4Wrapper   icatypes.ICS4Wrapper
-	channelKeeper icatypes.ChannelKeeper
-	portKeeper    icatypes.PortKeeper
-	accountKeeper icatypes.AccountKeeper
+	ics4Wrapper      icatypes.ICS4Wrapper
+	channelKeeper    icatypes.ChannelKeeper
+	portKeeper       icatypes.PortKeeper
+	connectionKeeper icatypes.ConnectionKeeper
+	clientKeeper     icatypes.ClientKeeper
+	accountKeeper    icatypes.AccountKeeper
 
 	scopedKeeper capabilitykeeper.ScopedKeeper
 
 	msgRouter *baseapp.MsgServiceRouter
+
+	addressGenerator icatypes.AddressGenerator
+
+	authCapabilityKeeper types.AuthCapabilityKeeper
+
+	hooks types.ControllerHooks
+}
+
+// Option defines a functional option used to configure the interchain accounts controller Keeper
+type Option func(*Keeper)
+
+// WithAddressGenerator enables validation of the interchain account address provided by the host chain
+// during the channel handshake. The address is expected to be derived using the provided AddressGenerator,
+// which must be identical to the one configured on the host chain.
+// NOTE: this validation is incompatible with host chains which allow interchain accounts to be reused.
+func WithAddressGenerator(generator icatypes.AddressGenerator) Option {
+	return func(k *Keeper) {
+		k.addressGenerator = generator
+	}
+}
+
+// WithSendQueue enables the send queue used to buffer the transactions of interchain accounts. The scoped capability
+// keeper of the authentication module is used to retrieve the channel capabilities required to release queued
+// transactions, as the channel capabilities are owned by the authentication module.
+func WithSendQueue(authCapabilityKeeper types.AuthCapabilityKeeper) Option {
+	return func(k *Keeper) {
+		k.authCapabilityKeeper = authCapabilityKeeper
+	}
 }
 
 // NewKeeper creates a new interchain accounts controller Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.Codec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	ics4Wrapper icatypes.ICS4Wrapper, channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
-	accountKeeper icatypes.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+	connectionKeeper icatypes.ConnectionKeeper, clientKeeper icatypes.ClientKeeper, accountKeeper icatypes.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+	opts ...Option,
 ) Keeper {
 
 	// set KeyTable if it has not already been set
@@ -45,17 +78,37 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		ics4Wrapper:   ics4Wrapper,
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
-		accountKeeper: accountKeeper,
-		scopedKeeper:  scopedKeeper,
-		msgRouter:     msgRouter,
+	k := Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		ics4Wrapper:      ics4Wrapper,
+		channelKeeper:    channelKeeper,
+		portKeeper:       portKeeper,
+		connectionKeeper: connectionKeeper,
+		clientKeeper:     clientKeeper,
+		accountKeeper:    accountKeeper,
+		scopedKeeper:     scopedKeeper,
+		msgRouter:        msgRouter,
+	}
+
+	for _, opt := range opts {
+		opt(&k)
+	}
+
+	return k
+}
+
+// SetHooks sets the interchain accounts controller hooks. It must be called before the keeper is
+// passed to the controller IBC module, which holds a copy of the keeper.
+func (k *Keeper) SetHooks(hooks types.ControllerHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set interchain accounts controller hooks twice")
 	}
+
+	k.hooks = hooks
+
+	return k
 }
 
 // Logger returns the application logger, scoped to the associated module
@@ -87,6 +140,12 @@ func (k Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capabi
 	return k.portKeeper.BindPort(ctx, portID)
 }
 
+// hasPort returns true if the provided portID has been stored by the interchain account controller module, otherwise false
+func (k Keeper) hasPort(ctx sdk.Context, portID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(icatypes.KeyPort(portID))
+}
+
 // IsBound checks if the interchain account controller module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
@@ -154,6 +213,26 @@ func (k Keeper) IsActiveChannel(ctx sdk.Context, portID string) bool {
 	return ok
 }
 
+// GetInterchainAccountConnectionID returns the identifier of the connection on which the active channel associated
+// with the provided portID runs. An error is returned if no active channel exists for the provided portID.
+func (k Keeper) GetInterchainAccountConnectionID(ctx sdk.Context, portID string) (string, error) {
+	activeChannelID, found := k.GetActiveChannelID(ctx, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, activeChannelID)
+	}
+
+	if len(channel.ConnectionHops) == 0 {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannel, "channel %s on port %s has no connection hops", activeChannelID, portID)
+	}
+
+	return channel.ConnectionHops[0], nil
+}
+
 // GetInterchainAccountAddress retrieves the InterchainAccount address from the store keyed by the provided portID
 func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, portID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -191,3 +270,48 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, portID string, addr
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID), []byte(address))
 }
+
+// GetInterchainAccountLabel retrieves the label of the interchain account from the store keyed by the provided portID
+func (k Keeper) GetInterchainAccountLabel(ctx sdk.Context, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyLabel(portID)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// GetAllInterchainAccountLabels returns a list of all interchain account labels and their associated controller port identifiers
+func (k Keeper) GetAllInterchainAccountLabels(ctx sdk.Context) []icatypes.InterchainAccountLabel {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.LabelKeyPrefix))
+	defer iterator.Close()
+
+	var labels []icatypes.InterchainAccountLabel
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		label := icatypes.InterchainAccountLabel{
+			PortId: keySplit[1],
+			Label:  string(iterator.Value()),
+		}
+
+		labels = append(labels, label)
+	}
+
+	return labels
+}
+
+// SetInterchainAccountLabel stores the label of the interchain account, keyed by the associated portID
+func (k Keeper) SetInterchainAccountLabel(ctx sdk.Context, portID, label string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyLabel(portID), []byte(label))
+}
+
+// DeleteInterchainAccountLabel removes the label of the interchain account keyed by the provided portID stored in state
+func (k Keeper) DeleteInterchainAccountLabel(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLabel(portID))
+}
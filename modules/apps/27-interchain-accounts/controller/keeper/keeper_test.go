@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +26,11 @@ var (
 	TestVersion = icatypes.NewAppVersion(icatypes.VersionPrefix, TestAccAddress.String())
 )
 
+// customAddressGenerator defines an interchain account address generator which differs from icatypes.GenerateAddress
+func customAddressGenerator(moduleAccAddr sdk.AccAddress, portID string) sdk.AccAddress {
+	return icatypes.GenerateAddress(moduleAccAddr, fmt.Sprintf("custom/%s", portID))
+}
+
 type KeeperTestSuite struct {
 	suite.Suite
 
@@ -233,6 +239,27 @@ func (suite *KeeperTestSuite) TestIsActiveChannel() {
 	suite.Require().Equal(isActive, true)
 }
 
+func (suite *KeeperTestSuite) TestGetInterchainAccountConnectionID() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+	portID := path.EndpointA.ChannelConfig.PortID
+
+	connectionID, err := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountConnectionID(suite.chainA.GetContext(), portID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.ConnectionID, connectionID)
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), portID)
+
+	connectionID, err = suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountConnectionID(suite.chainA.GetContext(), portID)
+	suite.Require().Error(err)
+	suite.Require().Empty(connectionID)
+}
+
 func (suite *KeeperTestSuite) TestSetInterchainAccountAddress() {
 	var (
 		expectedAccAddr string = "test-acc-addr"
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration
+// - sets the controller submodule parameters introduced since version 2 to their defaults
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := types.DefaultParams()
+
+	m.setParamIfNotExists(ctx, types.KeySendQueueReleaseRate, params.SendQueueReleaseRate)
+	m.setParamIfNotExists(ctx, types.KeySendQueueMaxInFlight, params.SendQueueMaxInFlight)
+	m.setParamIfNotExists(ctx, types.KeyAutoReopenOnClose, params.AutoReopenOnClose)
+	m.setParamIfNotExists(ctx, types.KeyMaxRelativeTimeout, params.MaxRelativeTimeout)
+
+	return nil
+}
+
+// setParamIfNotExists sets the controller submodule parameter stored under the provided key to the provided value
+// unless it is already set, such that parameters updated by governance are preserved.
+func (m Migrator) setParamIfNotExists(ctx sdk.Context, key []byte, value interface{}) {
+	if !m.keeper.paramSpace.Has(ctx, key) {
+		m.keeper.paramSpace.Set(ctx, key, value)
+	}
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	controllerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// migratedParamKeys holds the store keys of the controller submodule parameters introduced since version 2
+var migratedParamKeys = [][]byte{
+	types.KeySendQueueReleaseRate,
+	types.KeySendQueueMaxInFlight,
+	types.KeyAutoReopenOnClose,
+	types.KeyMaxRelativeTimeout,
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	var expParams types.Params
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: parameters missing from the store are set to their defaults",
+			func() {
+				paramStore := prefix.NewStore(suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+				for _, key := range migratedParamKeys {
+					paramStore.Delete(key)
+					suite.Require().False(suite.chainA.GetSimApp().GetSubspace(types.SubModuleName).Has(suite.chainA.GetContext(), key))
+				}
+			},
+		},
+		{
+			"success: parameters set in the store are preserved",
+			func() {
+				expParams.SendQueueReleaseRate = 10
+				expParams.SendQueueMaxInFlight = 5
+				expParams.AutoReopenOnClose = true
+				expParams.MaxRelativeTimeout = time.Hour
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), expParams)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			expParams = types.DefaultParams()
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), expParams)
+
+			tc.malleate()
+
+			err := controllerkeeper.NewMigrator(&suite.chainA.GetSimApp().ICAControllerKeeper).Migrate2to3(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(expParams, suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext()))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer implements the interchain accounts controller Msg service. It wraps the Keeper as the
+// Keeper exposes a SetInterchainAccountLabel store method under the same name.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the interchain accounts controller Msg service for the provided Keeper
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SetInterchainAccountLabel defines a rpc handler method for MsgSetInterchainAccountLabel.
+func (k msgServer) SetInterchainAccountLabel(goCtx context.Context, msg *types.MsgSetInterchainAccountLabel) (*types.MsgSetInterchainAccountLabelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	connection, found := k.connectionKeeper.GetConnection(ctx, msg.ConnectionId)
+	if !found {
+		return nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, msg.ConnectionId)
+	}
+
+	portID, err := icatypes.GeneratePortID(msg.Owner, msg.ConnectionId, connection.GetCounterparty().GetConnectionID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.UpdateInterchainAccountLabel(ctx, portID, msg.Label); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetInterchainAccountLabelResponse{}, nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestSetInterchainAccountLabel() {
+	var msg *types.MsgSetInterchainAccountLabel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success - empty label clears existing label", func() {
+				msg.Label = ""
+			}, true,
+		},
+		{
+			"connection not found", func() {
+				msg.ConnectionId = "connection-100"
+			}, false,
+		},
+		{
+			"interchain account not registered by owner", func() {
+				msg.Owner = TestAccAddress.String()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountLabel(suite.chainA.GetContext(), TestPortID, "savings")
+
+			msg = types.NewMsgSetInterchainAccountLabel(TestOwnerAddress, ibctesting.FirstConnectionID, "treasury")
+
+			tc.malleate() // malleate mutates test data
+
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SetInterchainAccountLabel(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			label, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountLabel(suite.chainA.GetContext(), TestPortID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(msg.Label != "", found)
+				suite.Require().Equal(msg.Label, label)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				suite.Require().Equal("savings", label)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
@@ -14,9 +16,40 @@ func (k Keeper) IsControllerEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetMaxRelativeTimeout retrieves the maximum relative timeout of interchain account transactions from the paramstore.
+func (k Keeper) GetMaxRelativeTimeout(ctx sdk.Context) time.Duration {
+	var res time.Duration
+	k.paramSpace.Get(ctx, types.KeyMaxRelativeTimeout, &res)
+	return res
+}
+
+// GetSendQueueReleaseRate retrieves the maximum number of packets released from the send queue of a controller port
+// per block from the paramstore.
+func (k Keeper) GetSendQueueReleaseRate(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeySendQueueReleaseRate, &res)
+	return res
+}
+
+// GetSendQueueMaxInFlight retrieves the maximum number of unacknowledged packets for which queued packets are still
+// released from the paramstore.
+func (k Keeper) GetSendQueueMaxInFlight(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeySendQueueMaxInFlight, &res)
+	return res
+}
+
+// IsAutoReopenOnCloseEnabled retrieves the auto reopen on close boolean from the paramstore.
+// True is returned if channels closed due to a packet timeout are reopened automatically.
+func (k Keeper) IsAutoReopenOnCloseEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyAutoReopenOnClose, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsControllerEnabled(ctx))
+	return types.NewParams(k.IsControllerEnabled(ctx), k.GetMaxRelativeTimeout(ctx), k.GetSendQueueReleaseRate(ctx), k.GetSendQueueMaxInFlight(ctx), k.IsAutoReopenOnCloseEnabled(ctx))
 }
 
 // SetParams sets the total set of the host submodule parameters.
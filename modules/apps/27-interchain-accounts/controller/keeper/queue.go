@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// EnqueueTx takes in a transaction from an authentication module and appends it to the send queue of the provided
+// portID instead of sending it immediately. The send queue must be enabled using the WithSendQueue option. Queued transactions are sent in the order in which they were enqueued
+// at the end of a later block, at most send queue release rate per block and only while the number of unacknowledged
+// packets on the active channel is below the send queue max in flight param. The packet sequence is assigned once the
+// transaction is released, the returned queue index identifies the transaction until then.
+// NOTE: queueing prevents bursts of transactions from flooding the ORDERED channel at the cost of the latency added
+// while a transaction is held by the send queue.
+func (k Keeper) EnqueueTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID string, icaPacketData icatypes.InterchainAccountPacketData) (uint64, error) {
+	if k.authCapabilityKeeper == nil || k.GetSendQueueReleaseRate(ctx) == 0 {
+		return 0, types.ErrSendQueueDisabled
+	}
+
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	activeChannelID, found := k.GetActiveChannelID(ctx, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
+	}
+
+	if !k.authCapabilityKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, activeChannelID)) {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "failed to authenticate capability of channel %s on port %s", activeChannelID, portID)
+	}
+
+	index := k.getNextSendQueueIndex(ctx, portID)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeySendQueuePacket(portID, index), k.cdc.MustMarshal(&icaPacketData))
+	store.Set(types.KeySendQueueIndex(portID), sdk.Uint64ToBigEndian(index+1))
+
+	return index, nil
+}
+
+// GetSendQueueDepth returns the number of transactions held by the send queue of the provided portID
+func (k Keeper) GetSendQueueDepth(ctx sdk.Context, portID string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeySendQueuePrefix(portID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var depth uint64
+	for ; iterator.Valid(); iterator.Next() {
+		depth++
+	}
+
+	return depth
+}
+
+// ReleaseQueuedTxs sends the transactions held by the send queue of each controller port with an active channel,
+// as permitted by the send queue release rate and max in flight params. It is invoked at the end of every block
+func (k Keeper) ReleaseQueuedTxs(ctx sdk.Context) {
+	if k.authCapabilityKeeper == nil {
+		return
+	}
+
+	releaseRate := k.GetSendQueueReleaseRate(ctx)
+	if releaseRate == 0 {
+		return
+	}
+
+	for _, activeChannel := range k.GetAllActiveChannels(ctx) {
+		k.releaseQueuedTxs(ctx, activeChannel.PortId, activeChannel.ChannelId, releaseRate)
+	}
+}
+
+// releaseQueuedTxs sends up to the provided number of transactions held by the send queue of the provided portID
+// over its active channel. Releasing is halted at the first transaction which fails to be sent, such that the
+// transaction and its successors remain queued
+func (k Keeper) releaseQueuedTxs(ctx sdk.Context, portID, channelID string, limit uint64) {
+	if maxInFlight := k.GetSendQueueMaxInFlight(ctx); maxInFlight != 0 {
+		inFlight := k.getInFlightPackets(ctx, portID, channelID)
+		if inFlight >= maxInFlight {
+			return
+		}
+
+		if available := maxInFlight - inFlight; available < limit {
+			limit = available
+		}
+	}
+
+	keys, packets := k.getQueuedTxs(ctx, portID, limit)
+	if len(keys) == 0 {
+		return
+	}
+
+	chanCap, found := k.authCapabilityKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		k.Logger(ctx).Error("failed to retrieve channel capability to release queued transactions", "port-id", portID, "channel-id", channelID)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for i, icaPacketData := range packets {
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		sequence, err := k.trySendTx(cacheCtx, chanCap, portID, icaPacketData, maxTimeoutTimestamp)
+		if err != nil {
+			k.Logger(ctx).Error("failed to release queued transaction", "port-id", portID, "channel-id", channelID, "error", err.Error())
+			return
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		store.Delete(keys[i])
+
+		k.Logger(ctx).Info("released queued transaction", "port-id", portID, "channel-id", channelID, "sequence", sequence)
+	}
+}
+
+// getQueuedTxs returns the store keys and the transactions of up to the provided number of transactions held by the
+// send queue of the provided portID in the order in which they were enqueued
+func (k Keeper) getQueuedTxs(ctx sdk.Context, portID string, limit uint64) ([][]byte, []icatypes.InterchainAccountPacketData) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeySendQueuePrefix(portID))
+	defer iterator.Close()
+
+	var (
+		keys    [][]byte
+		packets []icatypes.InterchainAccountPacketData
+	)
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		var icaPacketData icatypes.InterchainAccountPacketData
+		k.cdc.MustUnmarshal(iterator.Value(), &icaPacketData)
+
+		keys = append(keys, iterator.Key())
+		packets = append(packets, icaPacketData)
+	}
+
+	return keys, packets
+}
+
+// getNextSendQueueIndex returns the index to be assigned to the next transaction appended to the send queue of the
+// provided portID
+func (k Keeper) getNextSendQueueIndex(ctx sdk.Context, portID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeySendQueueIndex(portID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// getInFlightPackets returns the number of packets sent over the provided ORDERED channel which have not yet been
+// acknowledged
+func (k Keeper) getInFlightPackets(ctx sdk.Context, portID, channelID string) uint64 {
+	nextSequenceSend, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0
+	}
+
+	nextSequenceAck, found := k.channelKeeper.GetNextSequenceAck(ctx, portID, channelID)
+	if !found || nextSequenceAck > nextSequenceSend {
+		return 0
+	}
+
+	return nextSequenceSend - nextSequenceAck
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestEnqueueTx() {
+	var (
+		path       *ibctesting.Path
+		chanCap    *capabilitytypes.Capability
+		packetData icatypes.InterchainAccountPacketData
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"send queue is disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+			},
+			false,
+		},
+		{
+			"active channel not found",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			},
+			false,
+		},
+		{
+			"invalid channel capability",
+			func() {
+				chanCap = capabilitytypes.NewCapability(100)
+			},
+			false,
+		},
+		{
+			"invalid packet data",
+			func() {
+				packetData.Data = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var ok bool
+			chanCap, ok = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			packetData = suite.newQueueTestPacketData(path)
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, types.DefaultMaxRelativeTimeout, 1, 0, false))
+
+			tc.malleate() // malleate mutates test data
+
+			portID := path.EndpointA.ChannelConfig.PortID
+			index, err := suite.chainA.GetSimApp().ICAControllerKeeper.EnqueueTx(suite.chainA.GetContext(), chanCap, portID, packetData)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(0), index)
+				suite.Require().Equal(uint64(1), suite.chainA.GetSimApp().ICAControllerKeeper.GetSendQueueDepth(suite.chainA.GetContext(), portID))
+
+				// queue indices are assigned sequentially
+				index, err = suite.chainA.GetSimApp().ICAControllerKeeper.EnqueueTx(suite.chainA.GetContext(), chanCap, portID, packetData)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), index)
+				suite.Require().Equal(uint64(2), suite.chainA.GetSimApp().ICAControllerKeeper.GetSendQueueDepth(suite.chainA.GetContext(), portID))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Zero(suite.chainA.GetSimApp().ICAControllerKeeper.GetSendQueueDepth(suite.chainA.GetContext(), portID))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReleaseQueuedTxs() {
+	testCases := []struct {
+		msg         string
+		releaseRate uint64
+		maxInFlight uint64
+		inFlight    int
+		expReleased uint64
+	}{
+		{"released at the release rate", 2, 0, 0, 2},
+		{"all queued transactions released", 5, 0, 0, 3},
+		{"released up to max in flight", 5, 2, 0, 2},
+		{"released up to max in flight with unacknowledged packets", 5, 2, 1, 1},
+		{"none released once max in flight is reached", 5, 2, 2, 0},
+		{"none released if the send queue is disabled", 0, 0, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainA.GetContext()
+			portID := path.EndpointA.ChannelConfig.PortID
+			controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+
+			chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			packetData := suite.newQueueTestPacketData(path)
+
+			for i := 0; i < tc.inFlight; i++ {
+				_, err := controllerKeeper.TrySendTx(ctx, chanCap, portID, packetData)
+				suite.Require().NoError(err)
+			}
+
+			controllerKeeper.SetParams(ctx, types.NewParams(true, types.DefaultMaxRelativeTimeout, 1, 0, false))
+			for i := 0; i < 3; i++ {
+				_, err := controllerKeeper.EnqueueTx(ctx, chanCap, portID, packetData)
+				suite.Require().NoError(err)
+			}
+
+			controllerKeeper.SetParams(ctx, types.NewParams(true, types.DefaultMaxRelativeTimeout, tc.releaseRate, tc.maxInFlight, false))
+
+			sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(ctx, portID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+
+			controllerKeeper.ReleaseQueuedTxs(ctx)
+
+			nextSequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(ctx, portID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(sequence+tc.expReleased, nextSequence)
+			suite.Require().Equal(3-tc.expReleased, controllerKeeper.GetSendQueueDepth(ctx, portID))
+
+			for seq := sequence; seq < nextSequence; seq++ {
+				suite.Require().NotNil(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(ctx, portID, path.EndpointA.ChannelID, seq))
+			}
+		})
+	}
+}
+
+// newQueueTestPacketData returns interchain account packet data sending tokens from the interchain account of the
+// provided path
+func (suite *KeeperTestSuite) newQueueTestPacketData(path *ibctesting.Path) icatypes.InterchainAccountPacketData {
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+	suite.Require().NoError(err)
+
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// GetStaleActiveChannels returns the active channels whose channel is missing from the channel keeper or is not open.
+// Active channels are set once the channel handshake completes and removed once the channel is closed, stale active
+// channels may only result from state drifting apart, for example due to faulty migrations
+func (k Keeper) GetStaleActiveChannels(ctx sdk.Context) []icatypes.ActiveChannel {
+	var staleChannels []icatypes.ActiveChannel
+	for _, activeChannel := range k.GetAllActiveChannels(ctx) {
+		channel, found := k.channelKeeper.GetChannel(ctx, activeChannel.PortId, activeChannel.ChannelId)
+		if !found || channel.State != channeltypes.OPEN {
+			staleChannels = append(staleChannels, activeChannel)
+		}
+	}
+
+	return staleChannels
+}
+
+// ReconcileActiveChannels removes the stale active channels, see GetStaleActiveChannels. Packets can no longer be sent
+// over the removed active channels and their interchain accounts may be reopened by registering them again.
+// An event is emitted for each removed active channel and the removed active channels are returned
+func (k Keeper) ReconcileActiveChannels(ctx sdk.Context) []icatypes.ActiveChannel {
+	staleChannels := k.GetStaleActiveChannels(ctx)
+	for _, activeChannel := range staleChannels {
+		k.DeleteActiveChannelID(ctx, activeChannel.PortId)
+
+		k.Logger(ctx).Info("removed stale interchain account active channel", "port-id", activeChannel.PortId, "channel-id", activeChannel.ChannelId)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReconcileActiveChannel,
+				sdk.NewAttribute(types.AttributeKeyPortID, activeChannel.PortId),
+				sdk.NewAttribute(types.AttributeKeyChannelID, activeChannel.ChannelId),
+			),
+		)
+	}
+
+	return staleChannels
+}
+
+// ReconcileActiveChannelsProposal reconciles the active channels with the state of their channels as specified in the proposal
+func (k Keeper) ReconcileActiveChannelsProposal(ctx sdk.Context, p *types.ReconcileActiveChannelsProposal) error {
+	staleChannels := k.ReconcileActiveChannels(ctx)
+
+	k.Logger(ctx).Info("interchain account active channels reconciled", "removed", len(staleChannels))
+
+	return nil
+}
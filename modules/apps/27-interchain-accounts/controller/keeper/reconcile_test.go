@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestReconcileActiveChannelsProposal() {
+	var (
+		path            *ibctesting.Path
+		expStaleChannel *icatypes.ActiveChannel
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: open active channel is retained",
+			func() {},
+		},
+		{
+			"success: active channel of closed channel is removed",
+			func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+
+				expStaleChannel = &icatypes.ActiveChannel{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID}
+			},
+		},
+		{
+			"success: active channel of missing channel is removed",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID)
+
+				expStaleChannel = &icatypes.ActiveChannel{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: ibctesting.InvalidID}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			expStaleChannel = nil
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+
+			_, broken := keeper.ActiveChannelsInvariant(controllerKeeper)(ctx)
+			suite.Require().Equal(expStaleChannel != nil, broken)
+
+			proposal := types.NewReconcileActiveChannelsProposal(ibctesting.Title, ibctesting.Description).(*types.ReconcileActiveChannelsProposal)
+			err = controllerKeeper.ReconcileActiveChannelsProposal(ctx, proposal)
+			suite.Require().NoError(err)
+
+			_, broken = keeper.ActiveChannelsInvariant(controllerKeeper)(ctx)
+			suite.Require().False(broken)
+
+			activeChannelID, found := controllerKeeper.GetActiveChannelID(ctx, path.EndpointA.ChannelConfig.PortID)
+			if expStaleChannel == nil {
+				suite.Require().True(found)
+				suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+			} else {
+				suite.Require().False(found)
+			}
+		})
+	}
+}
@@ -1,18 +1,54 @@
 package keeper
 
 import (
+	"strconv"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
+// maxTimeoutTimestamp is the timeout timestamp used by packets sent without a relative timeout. It is set to be a max
+// number here so that we never recieve a timeout as ics-27-1 uses ordered channels which can close upon recieving a
+// timeout, which is an undesired effect. The unsigned bit is shifted to satisfy hermes relayer timestamp conversion.
+const maxTimeoutTimestamp = ^uint64(0) >> 1
+
 // TrySendTx takes in a transaction from an authentication module and attempts to send the packet
 // if the base application has the capability to send on the provided portID
 func (k Keeper) TrySendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID string, icaPacketData icatypes.InterchainAccountPacketData) (uint64, error) {
+	return k.trySendTx(ctx, chanCap, portID, icaPacketData, maxTimeoutTimestamp)
+}
+
+// TrySendTxWithRelativeTimeout sends the transaction in the same manner as TrySendTx, timing out the packet once the
+// provided duration has elapsed after the current block time. The relative timeout must be positive and may not exceed
+// the max relative timeout param, relative timeouts are disabled if the param is set to 0. The resulting timeout timestamp is clamped to the timeout timestamp used by TrySendTx.
+// NOTE: interchain accounts channels are ORDERED, a timed out packet results in the closure of the active channel.
+func (k Keeper) TrySendTxWithRelativeTimeout(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID string, icaPacketData icatypes.InterchainAccountPacketData, relativeTimeout time.Duration) (uint64, error) {
+	if relativeTimeout <= 0 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidRelativeTimeout, "relative timeout must be positive, got %s", relativeTimeout)
+	}
+
+	if maxRelativeTimeout := k.GetMaxRelativeTimeout(ctx); relativeTimeout > maxRelativeTimeout {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidRelativeTimeout, "relative timeout %s exceeds max relative timeout %s", relativeTimeout, maxRelativeTimeout)
+	}
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+
+	timeoutTimestamp := maxTimeoutTimestamp
+	if blockTime < maxTimeoutTimestamp-uint64(relativeTimeout) {
+		timeoutTimestamp = blockTime + uint64(relativeTimeout)
+	}
+
+	return k.trySendTx(ctx, chanCap, portID, icaPacketData, timeoutTimestamp)
+}
+
+func (k Keeper) trySendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
 	// Check for the active channel
 	activeChannelID, found := k.GetActiveChannelID(ctx, portID)
 	if !found {
@@ -27,7 +63,7 @@ func (k Keeper) TrySendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability,
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
-	return k.createOutgoingPacket(ctx, portID, activeChannelID, destinationPort, destinationChannel, chanCap, icaPacketData)
+	return k.createOutgoingPacket(ctx, portID, activeChannelID, destinationPort, destinationChannel, chanCap, icaPacketData, timeoutTimestamp)
 }
 
 func (k Keeper) createOutgoingPacket(
@@ -38,6 +74,7 @@ func (k Keeper) createOutgoingPacket(
 	destinationChannel string,
 	chanCap *capabilitytypes.Capability,
 	icaPacketData icatypes.InterchainAccountPacketData,
+	timeoutTimestamp uint64,
 ) (uint64, error) {
 	if err := icaPacketData.ValidateBasic(); err != nil {
 		return 0, sdkerrors.Wrap(err, "invalid interchain account packet data")
@@ -49,10 +86,6 @@ func (k Keeper) createOutgoingPacket(
 		return 0, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "failed to retrieve next sequence send for channel %s on port %s", sourceChannel, sourcePort)
 	}
 
-	// timeoutTimestamp is set to be a max number here so that we never recieve a timeout
-	// ics-27-1 uses ordered channels which can close upon recieving a timeout, which is an undesired effect
-	const timeoutTimestamp = ^uint64(0) >> 1 // Shift the unsigned bit to satisfy hermes relayer timestamp conversion
-
 	packet := channeltypes.NewPacket(
 		icaPacketData.GetBytes(),
 		sequence,
@@ -68,13 +101,113 @@ func (k Keeper) createOutgoingPacket(
 		return 0, err
 	}
 
+	k.recordActivity(ctx, sourcePort)
+
+	// the priority is an advisory hint for relayers and is therefore only surfaced through events and queries
+	if icaPacketData.Priority != 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacketPriority,
+				sdk.NewAttribute(types.AttributeKeyPortID, sourcePort),
+				sdk.NewAttribute(types.AttributeKeyChannelID, sourceChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyPriority, strconv.FormatUint(uint64(icaPacketData.Priority), 10)),
+			),
+		)
+	}
+
 	return packet.Sequence, nil
 }
 
+// GetPacketTimeout returns the timeout of an outstanding packet sent over the active channel of the provided portID.
+// An error is returned if the packet has not been sent, has already been acknowledged or timed out, or if its timeout
+// was not retained. Packet timeouts are only retained if packet data retention is enabled by the channel parameters.
+func (k Keeper) GetPacketTimeout(ctx sdk.Context, portID string, sequence uint64) (channeltypes.PacketTimeout, error) {
+	activeChannelID, found := k.GetActiveChannelID(ctx, portID)
+	if !found {
+		return channeltypes.PacketTimeout{}, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
+	}
+
+	nextSequenceSend, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, activeChannelID)
+	if !found {
+		return channeltypes.PacketTimeout{}, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "failed to retrieve next sequence send for channel %s on port %s", activeChannelID, portID)
+	}
+
+	if sequence == 0 || sequence >= nextSequenceSend {
+		return channeltypes.PacketTimeout{}, sdkerrors.Wrapf(channeltypes.ErrInvalidPacket, "packet with sequence %d has not been sent on channel %s", sequence, activeChannelID)
+	}
+
+	if !k.channelKeeper.HasPacketCommitment(ctx, portID, activeChannelID, sequence) {
+		return channeltypes.PacketTimeout{}, sdkerrors.Wrapf(channeltypes.ErrPacketCommitmentNotFound, "packet with sequence %d on channel %s has already been acknowledged or timed out", sequence, activeChannelID)
+	}
+
+	packetTimeout, found := k.channelKeeper.GetPacketTimeout(ctx, portID, activeChannelID, sequence)
+	if !found {
+		return channeltypes.PacketTimeout{}, sdkerrors.Wrapf(channeltypes.ErrPacketTimeoutNotFound, "timeout of packet with sequence %d on channel %s was not retained", sequence, activeChannelID)
+	}
+
+	return packetTimeout, nil
+}
+
+// GetPendingPackets returns the active channel of the provided portID and the packets sent over it which have not yet
+// been acknowledged, ordered by sequence. The priority of each packet is decoded from its retained packet data and is
+// therefore only reported if packet data retention is enabled by the channel parameters, otherwise it is 0.
+func (k Keeper) GetPendingPackets(ctx sdk.Context, portID string) (string, []types.PendingPacket, error) {
+	activeChannelID, found := k.GetActiveChannelID(ctx, portID)
+	if !found {
+		return "", nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
+	}
+
+	nextSequenceSend, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, activeChannelID)
+	if !found {
+		return "", nil, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "failed to retrieve next sequence send for channel %s on port %s", activeChannelID, portID)
+	}
+
+	nextSequenceAck, found := k.channelKeeper.GetNextSequenceAck(ctx, portID, activeChannelID)
+	if !found {
+		return "", nil, sdkerrors.Wrapf(channeltypes.ErrSequenceAckNotFound, "failed to retrieve next sequence ack for channel %s on port %s", activeChannelID, portID)
+	}
+
+	pendingPackets := []types.PendingPacket{}
+	for sequence := nextSequenceAck; sequence < nextSequenceSend; sequence++ {
+		if !k.channelKeeper.HasPacketCommitment(ctx, portID, activeChannelID, sequence) {
+			continue
+		}
+
+		pendingPacket := types.PendingPacket{Sequence: sequence}
+		if bz, found := k.channelKeeper.GetPacketData(ctx, portID, activeChannelID, sequence); found {
+			var data icatypes.InterchainAccountPacketData
+			if err := icatypes.ModuleCdc.UnmarshalJSON(bz, &data); err == nil {
+				pendingPacket.Priority = data.Priority
+			}
+		}
+
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return activeChannelID, pendingPackets, nil
+}
+
+// OnAcknowledgementPacket records the acknowledgement as activity on the channel over which the provided packet was
+// sent and records the completion time of the undelegation carried by the packet, if any
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	k.recordActivity(ctx, packet.SourcePort)
+	k.onUnbondingAcknowledged(ctx, packet, acknowledgement)
+
+	return nil
+}
+
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// due to the semantics of ORDERED channels. The undelegations awaiting acknowledgement on the channel are no longer
+// tracked. If the auto reopen on close param is enabled, a new channel is initialized for the interchain account, see
+// tryReopenInterchainAccount
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	k.DeleteActiveChannelID(ctx, packet.SourcePort)
+	k.deleteUnacknowledgedUnbondings(ctx, packet.SourcePort, packet.SourceChannel)
+
+	if k.IsAutoReopenOnCloseEnabled(ctx) {
+		k.tryReopenInterchainAccount(ctx, packet.SourcePort, packet.SourceChannel)
+	}
 
 	return nil
 }
@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"math"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -36,7 +40,7 @@ func (suite *KeeperTestSuite) TestTrySendTx() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				packetData = icatypes.InterchainAccountPacketData{
@@ -65,7 +69,7 @@ func (suite *KeeperTestSuite) TestTrySendTx() {
 					},
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), msgsBankSend)
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), msgsBankSend, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				packetData = icatypes.InterchainAccountPacketData{
@@ -145,6 +149,225 @@ func (suite *KeeperTestSuite) TestTrySendTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTrySendTxWithRelativeTimeout() {
+	var (
+		ctx                 sdk.Context
+		relativeTimeout     time.Duration
+		expTimeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: max relative timeout",
+			func() {
+				relativeTimeout = types.DefaultMaxRelativeTimeout
+				expTimeoutTimestamp = uint64(ctx.BlockTime().Add(relativeTimeout).UnixNano())
+			},
+			true,
+		},
+		{
+			"success: timeout timestamp is clamped",
+			func() {
+				relativeTimeout = time.Duration(math.MaxInt64)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(ctx, types.NewParams(true, relativeTimeout, 0, 0, false))
+
+				expTimeoutTimestamp = uint64(math.MaxInt64)
+			},
+			true,
+		},
+		{
+			"relative timeout is zero",
+			func() {
+				relativeTimeout = 0
+			},
+			false,
+		},
+		{
+			"relative timeout is negative",
+			func() {
+				relativeTimeout = -time.Hour
+			},
+			false,
+		},
+		{
+			"relative timeout exceeds max relative timeout",
+			func() {
+				relativeTimeout = types.DefaultMaxRelativeTimeout + time.Nanosecond
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			ctx = suite.chainA.GetContext()
+			relativeTimeout = time.Hour
+			expTimeoutTimestamp = uint64(ctx.BlockTime().Add(relativeTimeout).UnixNano())
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendTxWithRelativeTimeout(ctx, chanCap, path.EndpointA.ChannelConfig.PortID, packetData, relativeTimeout)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(
+					packetData.GetBytes(),
+					sequence,
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.ZeroHeight(),
+					expTimeoutTimestamp,
+				)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().Equal(channeltypes.CommitPacket(suite.chainA.App.AppCodec(), packet), commitment)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidRelativeTimeout)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetPacketTimeout() {
+	var (
+		path     *ibctesting.Path
+		portID   string
+		sequence uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"active channel not found",
+			func() {
+				portID = "invalid-port-id"
+			},
+			false,
+		},
+		{
+			"packet not sent",
+			func() {
+				sequence++
+			},
+			false,
+		},
+		{
+			"zero sequence",
+			func() {
+				sequence = 0
+			},
+			false,
+		},
+		{
+			"packet already acknowledged or timed out",
+			func() {
+				// the packet commitment of a completed packet is deleted
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID, sequence+2)
+				sequence++
+			},
+			false,
+		},
+		{
+			"packet timeout not retained",
+			func() {
+				chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, path.EndpointA.ChannelID))
+				suite.Require().True(ok)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), channeltypes.NewParams(false, 0, false, 0))
+
+				var err error
+				sequence, err = suite.chainA.GetSimApp().ICAControllerKeeper.TrySendTx(suite.chainA.GetContext(), chanCap, portID, suite.newQueueTestPacketData(path))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainA.GetContext()
+			portID = path.EndpointA.ChannelConfig.PortID
+
+			chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(ctx, channeltypes.NewParams(true, 0, false, 0))
+
+			relativeTimeout := time.Hour
+			sequence, err = suite.chainA.GetSimApp().ICAControllerKeeper.TrySendTxWithRelativeTimeout(ctx, chanCap, portID, suite.newQueueTestPacketData(path), relativeTimeout)
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			packetTimeout, err := suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketTimeout(suite.chainA.GetContext(), portID, sequence)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(clienttypes.ZeroHeight(), packetTimeout.TimeoutHeight)
+				suite.Require().Equal(uint64(ctx.BlockTime().Add(relativeTimeout).UnixNano()), packetTimeout.TimeoutTimestamp)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 	var (
 		path *ibctesting.Path
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// ProcessPendingReopens retries the automatic reopen attempts scheduled up to and including the current block height.
+// Scheduled attempts are dropped if the auto reopen on close param has since been disabled or if an active channel
+// has since been opened for the controller port. It is invoked at the end of every block
+func (k Keeper) ProcessPendingReopens(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	prefixLen := len(types.KeyPendingReopenHeight(0))
+
+	iterator := store.Iterator(types.KeyPendingReopenPrefix(), types.KeyPendingReopenHeight(uint64(ctx.BlockHeight())+1))
+
+	var (
+		keys       [][]byte
+		portIDs    []string
+		channelIDs []string
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		portIDs = append(portIDs, string(iterator.Key()[prefixLen+1:]))
+		channelIDs = append(channelIDs, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	enabled := k.IsAutoReopenOnCloseEnabled(ctx)
+	for i, key := range keys {
+		store.Delete(key)
+
+		if !enabled || k.IsActiveChannel(ctx, portIDs[i]) {
+			continue
+		}
+
+		k.tryReopenInterchainAccount(ctx, portIDs[i], channelIDs[i])
+	}
+}
+
+// GetReopenAttempts returns the number of consecutive automatic reopen attempts made for the channel of the provided portID
+func (k Keeper) GetReopenAttempts(ctx sdk.Context, portID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyReopenAttempts(portID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setReopenAttempts stores the number of consecutive automatic reopen attempts made for the channel of the provided portID
+func (k Keeper) setReopenAttempts(ctx sdk.Context, portID string, attempts uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyReopenAttempts(portID), sdk.Uint64ToBigEndian(attempts))
+}
+
+// deleteReopenAttempts resets the number of consecutive automatic reopen attempts made for the channel of the provided portID
+func (k Keeper) deleteReopenAttempts(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyReopenAttempts(portID))
+}
+
+// schedulePendingReopen schedules an automatic reopen attempt of the provided closed channel for the provided block height
+func (k Keeper) schedulePendingReopen(ctx sdk.Context, height uint64, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPendingReopen(height, portID), []byte(channelID))
+}
+
+// tryReopenInterchainAccount attempts to reopen the channel of the interchain account associated with the provided portID,
+// which was closed due to a packet timeout on the provided channelID. A failed attempt is retried after a backoff which
+// doubles with each consecutive failure, attempts are abandoned once types.MaxAutoReopenAttempts is reached. The number
+// of attempts is reset once a channel is opened for the portID. An event is emitted for each attempt
+func (k Keeper) tryReopenInterchainAccount(ctx sdk.Context, portID, channelID string) {
+	attempt := k.GetReopenAttempts(ctx, portID) + 1
+	if attempt > types.MaxAutoReopenAttempts {
+		k.Logger(ctx).Info("abandoned automatic reopen of interchain account channel", "port-id", portID, "channel-id", channelID, "attempts", attempt-1)
+		return
+	}
+
+	k.setReopenAttempts(ctx, portID, attempt)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	err := k.reopenInterchainAccount(cacheCtx, portID, channelID)
+	if err == nil {
+		writeCache()
+		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeyAttempt, strconv.FormatUint(attempt, 10)),
+		sdk.NewAttribute(types.AttributeKeyReopenSuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyReopenError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAutoReopen, attributes...))
+
+	if err != nil {
+		k.Logger(ctx).Error("failed to automatically reopen interchain account channel", "port-id", portID, "channel-id", channelID, "attempt", attempt, "error", err.Error())
+
+		if attempt < types.MaxAutoReopenAttempts {
+			k.schedulePendingReopen(ctx, uint64(ctx.BlockHeight())+types.AutoReopenBackoff(attempt), portID, channelID)
+		}
+
+		return
+	}
+
+	k.Logger(ctx).Info("automatically reopening interchain account channel", "port-id", portID, "channel-id", channelID, "attempt", attempt)
+}
+
+// reopenInterchainAccount calls 04-channel 'ChanOpenInit' for the provided portID, proposing the version and connection
+// hops of the provided closed channel. Reopening the channel on the same portID grants access to the existing interchain
+// account once the channel handshake completes
+func (k Keeper) reopenInterchainAccount(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID %s channel ID %s", portID, channelID)
+	}
+
+	version := channel.Version
+	if icatypes.IsICAMetadataVersion(version) {
+		metadata, err := icatypes.ParseICAMetadata(version)
+		if err != nil {
+			return err
+		}
+
+		// the account address is assigned by the host chain during the channel handshake
+		metadata.Address = ""
+		version = icatypes.EncodeICAMetadata(metadata)
+	} else {
+		version = strings.Split(version, icatypes.Delimiter)[0]
+	}
+
+	msg := channeltypes.NewMsgChannelOpenInit(portID, version, channeltypes.ORDERED, channel.ConnectionHops, icatypes.PortID, icatypes.ModuleName)
+	handler := k.msgRouter.Handler(msg)
+	if _, err := handler(ctx, msg); err != nil {
+		return err
+	}
+
+	return nil
+}
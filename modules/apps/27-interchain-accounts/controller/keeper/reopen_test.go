@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacketAutoReopen() {
+	var (
+		path        *ibctesting.Path
+		expReopen   bool
+		expAttempts uint64
+		expPending  bool
+		expEvent    bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: channel reopened",
+			func() {},
+		},
+		{
+			"auto reopen disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+
+				expReopen = false
+				expAttempts = 0
+				expEvent = false
+			},
+		},
+		{
+			"failed attempt is scheduled for retry",
+			func() {
+				suite.setUnorderedConnection(path)
+
+				expReopen = false
+				expPending = true
+			},
+		},
+		{
+			"failed final attempt is not scheduled for retry",
+			func() {
+				suite.setUnorderedConnection(path)
+
+				suite.setReopenAttempts(path.EndpointA.ChannelConfig.PortID, types.MaxAutoReopenAttempts-1)
+
+				expReopen = false
+				expAttempts = types.MaxAutoReopenAttempts
+			},
+		},
+		{
+			"attempts exhausted",
+			func() {
+				suite.setReopenAttempts(path.EndpointA.ChannelConfig.PortID, types.MaxAutoReopenAttempts)
+
+				expReopen = false
+				expAttempts = types.MaxAutoReopenAttempts
+				expEvent = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := types.DefaultParams()
+			params.AutoReopenOnClose = true
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			expReopen = true
+			expAttempts = 1
+			expPending = false
+			expEvent = true
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			portID := path.EndpointA.ChannelConfig.PortID
+			packet := channeltypes.NewPacket(
+				[]byte{},
+				1,
+				portID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(ctx, packet)
+			suite.Require().NoError(err)
+
+			reopenedChannelID := channeltypes.FormatChannelIdentifier(1)
+			channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(ctx, portID, reopenedChannelID)
+			suite.Require().Equal(expReopen, found)
+			suite.Require().Equal(expAttempts, suite.chainA.GetSimApp().ICAControllerKeeper.GetReopenAttempts(ctx, portID))
+
+			pendingKey := types.KeyPendingReopen(uint64(ctx.BlockHeight())+types.AutoReopenBackoff(expAttempts), portID)
+			store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+			suite.Require().Equal(expPending, store.Has(pendingKey))
+
+			var emitted bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeAutoReopen {
+					emitted = true
+				}
+			}
+			suite.Require().Equal(expEvent, emitted)
+
+			if !expReopen {
+				return
+			}
+
+			suite.Require().Equal(channeltypes.INIT, channel.State)
+			suite.Require().Equal(path.EndpointA.ChannelConfig.Version, channel.Version)
+
+			// complete the channel handshake once the timed out channel is closed
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+			suite.Require().NoError(path.EndpointB.SetChannelClosed())
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), portID)
+			suite.Require().True(found)
+
+			path.EndpointA.ChannelID = reopenedChannelID
+			path.EndpointB.ChannelID = ""
+			suite.Require().NoError(path.EndpointB.ChanOpenTry())
+			suite.Require().NoError(path.EndpointA.ChanOpenAck())
+			suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+			activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), portID)
+			suite.Require().True(found)
+			suite.Require().Equal(reopenedChannelID, activeChannelID)
+			suite.Require().Zero(suite.chainA.GetSimApp().ICAControllerKeeper.GetReopenAttempts(suite.chainA.GetContext(), portID))
+
+			// the existing interchain account is reused
+			reopenedAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), portID)
+			suite.Require().True(found)
+			suite.Require().Equal(interchainAccountAddr, reopenedAddr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProcessPendingReopens() {
+	var (
+		path      *ibctesting.Path
+		height    uint64
+		expReopen bool
+		expRetain bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: scheduled attempt is retried",
+			func() {},
+		},
+		{
+			"attempt scheduled for a later height is retained",
+			func() {
+				height++
+
+				expReopen = false
+				expRetain = true
+			},
+		},
+		{
+			"attempt is dropped if auto reopen is disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+
+				expReopen = false
+			},
+		},
+		{
+			"attempt is dropped if an active channel exists",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+				expReopen = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			portID := path.EndpointA.ChannelConfig.PortID
+			suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), portID)
+
+			params := types.DefaultParams()
+			params.AutoReopenOnClose = true
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			height = uint64(suite.chainA.GetContext().BlockHeight())
+			expReopen = true
+			expRetain = false
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+			store.Set(types.KeyPendingReopen(height, portID), []byte(path.EndpointA.ChannelID))
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.ProcessPendingReopens(ctx)
+
+			channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(ctx, portID, channeltypes.FormatChannelIdentifier(1))
+			suite.Require().Equal(expReopen, found)
+			suite.Require().Equal(expRetain, store.Has(types.KeyPendingReopen(height, portID)))
+
+			if expReopen {
+				suite.Require().Equal(channeltypes.INIT, channel.State)
+				suite.Require().Equal(uint64(1), suite.chainA.GetSimApp().ICAControllerKeeper.GetReopenAttempts(ctx, portID))
+			}
+		})
+	}
+}
+
+// setReopenAttempts stores the number of consecutive automatic reopen attempts made for the provided portID on chainA
+func (suite *KeeperTestSuite) setReopenAttempts(portID string, attempts uint64) {
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Set(types.KeyReopenAttempts(portID), sdk.Uint64ToBigEndian(attempts))
+}
+
+// setUnorderedConnection restricts the connection of the provided path on chainA to UNORDERED channels, such that
+// interchain accounts channels may not be opened over it
+func (suite *KeeperTestSuite) setUnorderedConnection(path *ibctesting.Path) {
+	connection := path.EndpointA.GetConnection()
+	connection.Versions = []*connectiontypes.Version{
+		connectiontypes.NewVersion(connectiontypes.DefaultIBCVersionIdentifier, []string{channeltypes.UNORDERED.String()}),
+	}
+	path.EndpointA.SetConnection(connection)
+}
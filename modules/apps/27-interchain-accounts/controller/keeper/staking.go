@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+// TrySendCompoundRewardsTx constructs the messages withdrawing the staking rewards of the interchain account associated with
+// the provided portID from each validator and delegating the provided rewards back to that validator, and attempts to send
+// them to the host chain in a single transaction. The host chain executes the transaction atomically, if the withdrawal or
+// delegation for any validator fails then no rewards are withdrawn or delegated. The messages are serialized using the
+// encoding format negotiated for the active channel.
+func (k Keeper) TrySendCompoundRewardsTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID string, rewards []types.ValidatorRewards) (uint64, error) {
+	accAddr, found := k.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for port %s", portID)
+	}
+
+	msgs, err := types.NewCompoundRewardsMsgs(accAddr, rewards)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.trySendMsgs(ctx, chanCap, portID, icatypes.EXECUTE_TX, msgs)
+}
+
+// TrySendSetWithdrawAddressTx constructs a MsgSetWithdrawAddress setting the host chain address to which the staking
+// rewards of the interchain account associated with the provided portID are withdrawn, and attempts to send it to the
+// host chain. The withdraw address must be an account on the host chain. The message is serialized using the encoding
+// format negotiated for the active channel.
+func (k Keeper) TrySendSetWithdrawAddressTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID, withdrawAddress string) (uint64, error) {
+	accAddr, found := k.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for port %s", portID)
+	}
+
+	msg, err := types.NewSetWithdrawAddressMsg(accAddr, withdrawAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.trySendMsgs(ctx, chanCap, portID, icatypes.EXECUTE_TX, []sdk.Msg{msg})
+}
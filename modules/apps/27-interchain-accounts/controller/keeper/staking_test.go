@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestTrySendCompoundRewardsTx() {
+	var (
+		path    *ibctesting.Path
+		chanCap *capabilitytypes.Capability
+		portID  string
+		rewards []types.ValidatorRewards
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success - no rewards to delegate",
+			func() {
+				rewards[0].Amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
+			},
+			true,
+		},
+		{
+			"no validators provided",
+			func() {
+				rewards = nil
+			},
+			false,
+		},
+		{
+			"invalid validator address",
+			func() {
+				rewards[0].ValidatorAddress = "invalid"
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				portID = "invalid-port-id"
+			},
+			false,
+		},
+		{
+			"active channel not found",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			},
+			false,
+		},
+		{
+			"invalid channel capability provided",
+			func() {
+				chanCap = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var ok bool
+			chanCap, ok = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			validatorAddr := sdk.ValAddress(suite.chainB.Vals.Validators[0].Address)
+			rewards = []types.ValidatorRewards{
+				types.NewValidatorRewards(validatorAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendCompoundRewardsTx(suite.chainA.GetContext(), chanCap, portID, rewards)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTrySendSetWithdrawAddressTx() {
+	var (
+		path            *ibctesting.Path
+		chanCap         *capabilitytypes.Capability
+		portID          string
+		withdrawAddress string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid withdraw address",
+			func() {
+				withdrawAddress = "invalid"
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				portID = "invalid-port-id"
+			},
+			false,
+		},
+		{
+			"active channel not found",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			},
+			false,
+		},
+		{
+			"invalid channel capability provided",
+			func() {
+				chanCap = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var ok bool
+			chanCap, ok = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			withdrawAddress = suite.chainB.SenderAccount.GetAddress().String()
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendSetWithdrawAddressTx(suite.chainA.GetContext(), chanCap, portID, withdrawAddress)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
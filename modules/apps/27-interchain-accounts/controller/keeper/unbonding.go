@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TrySendUndelegateTx constructs a MsgUndelegate undelegating the provided amount of the interchain account associated
+// with the provided portID from the provided validator, and attempts to send it to the host chain. The packet is sent
+// using the EXECUTE_TX_WITH_EVENTS packet data type, such that the events emitted by the host chain are returned in
+// the acknowledgement. The undelegation is tracked as a pending unbonding, its completion time is recorded from the
+// acknowledgement, see onUnbondingAcknowledged.
+func (k Keeper) TrySendUndelegateTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID, validatorAddress string, amount sdk.Coin) (uint64, error) {
+	accAddr, found := k.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for port %s", portID)
+	}
+
+	msg, err := types.NewUndelegateMsg(accAddr, validatorAddress, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.trySendMsgs(ctx, chanCap, portID, icatypes.EXECUTE_TX_WITH_EVENTS, []sdk.Msg{msg})
+	if err != nil {
+		return 0, err
+	}
+
+	// the active channel exists as the packet was sent over it
+	channelID, _ := k.GetActiveChannelID(ctx, portID)
+	k.SetUnbonding(ctx, portID, types.NewUnbonding(channelID, sequence, validatorAddress, amount))
+
+	return sequence, nil
+}
+
+// GetUnbonding retrieves the undelegation sent by the interchain account of the provided portID in the packet with the
+// provided channel identifier and sequence
+func (k Keeper) GetUnbonding(ctx sdk.Context, portID, channelID string, sequence uint64) (types.Unbonding, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyUnbonding(portID, channelID, sequence))
+	if bz == nil {
+		return types.Unbonding{}, false
+	}
+
+	var unbonding types.Unbonding
+	k.cdc.MustUnmarshal(bz, &unbonding)
+
+	return unbonding, true
+}
+
+// SetUnbonding stores the provided undelegation sent by the interchain account of the provided portID. Acknowledged
+// undelegations are indexed by completion time, such that they are pruned once completed
+func (k Keeper) SetUnbonding(ctx sdk.Context, portID string, unbonding types.Unbonding) {
+	// remove the completion time index entry of the undelegation being replaced
+	k.DeleteUnbonding(ctx, portID, unbonding.ChannelId, unbonding.Sequence)
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyUnbonding(portID, unbonding.ChannelId, unbonding.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&unbonding))
+
+	if unbonding.IsAcknowledged() {
+		store.Set(types.KeyUnbondingQueue(unbonding.CompletionTime, portID, unbonding.ChannelId, unbonding.Sequence), key)
+	}
+}
+
+// DeleteUnbonding removes the undelegation sent by the interchain account of the provided portID in the packet with
+// the provided channel identifier and sequence, along with its completion time index entry
+func (k Keeper) DeleteUnbonding(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	unbonding, found := k.GetUnbonding(ctx, portID, channelID, sequence)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyUnbonding(portID, channelID, sequence))
+
+	if unbonding.IsAcknowledged() {
+		store.Delete(types.KeyUnbondingQueue(unbonding.CompletionTime, portID, channelID, sequence))
+	}
+}
+
+// GetPendingUnbondings returns the undelegations sent by the interchain account of the provided portID which have not
+// yet completed, ordered by channel identifier and packet sequence. Undelegations awaiting acknowledgement are
+// included without a completion time
+func (k Keeper) GetPendingUnbondings(ctx sdk.Context, portID string) []types.Unbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyUnbondingPrefix(portID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	unbondings := []types.Unbonding{}
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding types.Unbonding
+		k.cdc.MustUnmarshal(iterator.Value(), &unbonding)
+
+		if unbonding.IsAcknowledged() && !unbonding.CompletionTime.After(ctx.BlockTime()) {
+			continue
+		}
+
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings
+}
+
+// PruneCompletedUnbondings removes the undelegations whose completion time has elapsed according to the block time of
+// the controller chain. Only the undelegations completing up to and including the current block time are iterated,
+// using the completion time index. It is invoked at the end of every block
+func (k Keeper) PruneCompletedUnbondings(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.KeyUnbondingQueuePrefix(), sdk.PrefixEndBytes(types.KeyUnbondingQueueTime(ctx.BlockTime())))
+
+	var keys, unbondingKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		unbondingKeys = append(unbondingKeys, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		store.Delete(unbondingKeys[i])
+	}
+}
+
+// onUnbondingAcknowledged records the completion time of the undelegation carried by the provided packet, if it was
+// sent using TrySendUndelegateTx. The completion time is parsed from the unbond event emitted by the host chain. The
+// undelegation is no longer tracked if the acknowledgement is an error or does not contain the completion time
+func (k Keeper) onUnbondingAcknowledged(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	unbonding, found := k.GetUnbonding(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	completionTime, err := parseUnbondingCompletionTime(acknowledgement)
+	if err != nil {
+		k.Logger(ctx).Info("interchain account undelegation is no longer tracked", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error())
+		k.DeleteUnbonding(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+		return
+	}
+
+	unbonding.CompletionTime = completionTime
+	k.SetUnbonding(ctx, packet.SourcePort, unbonding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbonding,
+			sdk.NewAttribute(types.AttributeKeyPortID, packet.SourcePort),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+}
+
+// deleteUnacknowledgedUnbondings removes the undelegations sent by the interchain account of the provided portID over
+// the provided channel which await acknowledgement. It is invoked once the channel is closed due to a packet timeout,
+// as the remaining packets of the ORDERED channel are never received by the host chain
+func (k Keeper) deleteUnacknowledgedUnbondings(ctx sdk.Context, portID, channelID string) {
+	for _, unbonding := range k.GetPendingUnbondings(ctx, portID) {
+		if unbonding.ChannelId == channelID && !unbonding.IsAcknowledged() {
+			k.DeleteUnbonding(ctx, portID, channelID, unbonding.Sequence)
+		}
+	}
+}
+
+// parseUnbondingCompletionTime parses the completion time of an undelegation from the unbond event included in the
+// acknowledgement of a packet of type EXECUTE_TX_WITH_EVENTS
+func parseUnbondingCompletionTime(acknowledgement []byte) (time.Time, error) {
+	ack, err := icatypes.ParseAcknowledgement(acknowledgement)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	txEvents, err := ack.GetTxEvents()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, event := range txEvents.Events {
+		if event.Type != stakingtypes.EventTypeUnbond {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == stakingtypes.AttributeKeyCompletionTime {
+				return time.Parse(time.RFC3339, attr.Value)
+			}
+		}
+	}
+
+	return time.Time{}, sdkerrors.Wrapf(types.ErrCompletionTimeNotFound, "%s event not found in acknowledgement (truncated: %t)", stakingtypes.EventTypeUnbond, txEvents.Truncated)
+}
@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var testValidatorAddress = sdk.ValAddress([]byte("validator___________")).String()
+
+func (suite *KeeperTestSuite) TestTrySendUndelegateTx() {
+	var (
+		path             *ibctesting.Path
+		chanCap          *capabilitytypes.Capability
+		portID           string
+		validatorAddress string
+		amount           sdk.Coin
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid validator address",
+			func() {
+				validatorAddress = "invalid"
+			},
+			false,
+		},
+		{
+			"zero amount",
+			func() {
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				portID = "invalid-port-id"
+			},
+			false,
+		},
+		{
+			"active channel not found",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			},
+			false,
+		},
+		{
+			"invalid channel capability provided",
+			func() {
+				chanCap = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var ok bool
+			chanCap, ok = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			validatorAddress = testValidatorAddress
+			amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendUndelegateTx(suite.chainA.GetContext(), chanCap, portID, validatorAddress, amount)
+
+			unbondings := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingUnbondings(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().NotEmpty(commitment)
+
+				suite.Require().Equal([]types.Unbonding{types.NewUnbonding(path.EndpointA.ChannelID, sequence, validatorAddress, amount)}, unbondings)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Empty(unbondings)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacketUnbonding() {
+	var (
+		path            *ibctesting.Path
+		sequence        uint64
+		acknowledgement []byte
+		completionTime  time.Time
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expFound bool
+	}{
+		{
+			"success: completion time recorded",
+			func() {},
+			true,
+		},
+		{
+			"error acknowledgement",
+			func() {
+				acknowledgement = channeltypes.NewErrorAcknowledgement("failed to undelegate").Acknowledgement()
+			},
+			false,
+		},
+		{
+			"unbond event not included in acknowledgement",
+			func() {
+				acknowledgement = newTxEventsAcknowledgement(icatypes.Event{Type: sdk.EventTypeMessage})
+			},
+			false,
+		},
+		{
+			"invalid completion time",
+			func() {
+				acknowledgement = newTxEventsAcknowledgement(newUnbondEvent("invalid"))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			sequence = suite.sendUndelegateTestPacket(path)
+
+			completionTime = suite.chainA.GetContext().BlockTime().Add(time.Hour).UTC().Truncate(time.Second)
+			acknowledgement = newTxEventsAcknowledgement(newUnbondEvent(completionTime.Format(time.RFC3339)))
+
+			tc.malleate() // malleate mutates test data
+
+			packet := channeltypes.NewPacket(nil, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, acknowledgement)
+			suite.Require().NoError(err)
+
+			unbonding, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetUnbonding(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+			suite.Require().Equal(tc.expFound, found)
+
+			if tc.expFound {
+				suite.Require().True(unbonding.IsAcknowledged())
+				suite.Require().Equal(completionTime, unbonding.CompletionTime)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneCompletedUnbondings() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	portID := path.EndpointA.ChannelConfig.PortID
+	ctx := suite.chainA.GetContext()
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	completed := types.NewUnbonding(path.EndpointA.ChannelID, 1, testValidatorAddress, amount)
+	completed.CompletionTime = ctx.BlockTime().UTC()
+
+	pending := types.NewUnbonding(path.EndpointA.ChannelID, 2, testValidatorAddress, amount)
+	pending.CompletionTime = ctx.BlockTime().Add(time.Hour).UTC()
+
+	unacknowledged := types.NewUnbonding(path.EndpointA.ChannelID, 3, testValidatorAddress, amount)
+
+	for _, unbonding := range []types.Unbonding{completed, pending, unacknowledged} {
+		suite.chainA.GetSimApp().ICAControllerKeeper.SetUnbonding(ctx, portID, unbonding)
+	}
+
+	// completed unbondings are not pending prior to being pruned
+	suite.Require().Equal([]types.Unbonding{pending, unacknowledged}, suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingUnbondings(ctx, portID))
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.PruneCompletedUnbondings(ctx)
+
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetUnbonding(ctx, portID, path.EndpointA.ChannelID, completed.Sequence)
+	suite.Require().False(found)
+
+	suite.Require().Equal([]types.Unbonding{pending, unacknowledged}, suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingUnbondings(ctx, portID))
+
+	// only the completion time index entries of the remaining acknowledged unbondings are retained
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	suite.Require().False(store.Has(types.KeyUnbondingQueue(completed.CompletionTime, portID, path.EndpointA.ChannelID, completed.Sequence)))
+	suite.Require().True(store.Has(types.KeyUnbondingQueue(pending.CompletionTime, portID, path.EndpointA.ChannelID, pending.Sequence)))
+
+	// the completion time index entry is replaced once the completion time of an unbonding is updated
+	rescheduled := pending
+	rescheduled.CompletionTime = pending.CompletionTime.Add(time.Hour)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetUnbonding(ctx, portID, rescheduled)
+	suite.Require().False(store.Has(types.KeyUnbondingQueue(pending.CompletionTime, portID, path.EndpointA.ChannelID, pending.Sequence)))
+	suite.Require().True(store.Has(types.KeyUnbondingQueue(rescheduled.CompletionTime, portID, path.EndpointA.ChannelID, rescheduled.Sequence)))
+
+	// unbondings are not pruned prior to their completion time
+	suite.chainA.GetSimApp().ICAControllerKeeper.PruneCompletedUnbondings(ctx.WithBlockTime(pending.CompletionTime))
+	suite.Require().Equal([]types.Unbonding{rescheduled, unacknowledged}, suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingUnbondings(ctx, portID))
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.PruneCompletedUnbondings(ctx.WithBlockTime(rescheduled.CompletionTime))
+	suite.Require().False(store.Has(types.KeyUnbondingQueue(rescheduled.CompletionTime, portID, path.EndpointA.ChannelID, rescheduled.Sequence)))
+	suite.Require().Equal([]types.Unbonding{unacknowledged}, suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingUnbondings(ctx, portID))
+
+	// unbondings awaiting acknowledgement are no longer tracked once the channel is closed due to a packet timeout
+	packet := channeltypes.NewPacket(nil, unacknowledged.Sequence, portID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(ctx, packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingUnbondings(ctx, portID))
+}
+
+// sendUndelegateTestPacket sends an undelegation over the active channel of the path
+func (suite *KeeperTestSuite) sendUndelegateTestPacket(path *ibctesting.Path) uint64 {
+	portID := path.EndpointA.ChannelConfig.PortID
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendUndelegateTx(suite.chainA.GetContext(), chanCap, portID, testValidatorAddress, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	suite.Require().NoError(err)
+
+	return sequence
+}
+
+// newUnbondEvent returns the unbond event emitted by the host chain with the provided completion time
+func newUnbondEvent(completionTime string) icatypes.Event {
+	return icatypes.Event{
+		Type: stakingtypes.EventTypeUnbond,
+		Attributes: []icatypes.EventAttribute{
+			{Key: stakingtypes.AttributeKeyValidator, Value: testValidatorAddress},
+			{Key: stakingtypes.AttributeKeyCompletionTime, Value: completionTime},
+		},
+	}
+}
+
+// newTxEventsAcknowledgement returns the acknowledgement of a packet of type EXECUTE_TX_WITH_EVENTS including the provided events
+func newTxEventsAcknowledgement(events ...icatypes.Event) []byte {
+	txEvents := icatypes.TxEvents{Events: events}
+	return channeltypes.NewResultAcknowledgement(txEvents.GetBytes()).Acknowledgement()
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// GetHostParams retrieves the cached host chain parameters of the provided connection from the store
+func (k Keeper) GetHostParams(ctx sdk.Context, connectionID string) (hosttypes.Params, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyHostParams(connectionID))
+	if bz == nil {
+		return hosttypes.Params{}, false
+	}
+
+	var params hosttypes.Params
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params, true
+}
+
+// SetHostParams caches the host chain parameters of the provided connection. The parameters are expected to be
+// obtained by querying the host chain, for example using the host submodule Params query, and should be refreshed
+// whenever the host chain parameters change.
+func (k Keeper) SetHostParams(ctx sdk.Context, connectionID string, params hosttypes.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyHostParams(connectionID), k.cdc.MustMarshal(&params))
+}
+
+// DeleteHostParams removes the cached host chain parameters of the provided connection from the store
+func (k Keeper) DeleteHostParams(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyHostParams(connectionID))
+}
+
+// ValidatePacketData performs the static checks of the host chain on the provided packet data using the cached host
+// chain parameters of the provided connection. The packet data is deserialized and every message must be allowed by
+// the host chain and require the signature of the same interchain account registered on the connection.
+//
+// NOTE: the validation is best-effort, the authoritative checks are performed by the host chain upon execution.
+// The cached host chain parameters may be outdated and messages passing validation may still fail on execution.
+func (k Keeper) ValidatePacketData(ctx sdk.Context, connectionID string, data icatypes.InterchainAccountPacketData) error {
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return err
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	params, found := k.GetHostParams(ctx, connectionID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrHostParamsNotFound, "connection %s", connectionID)
+	}
+
+	if !params.HostEnabled {
+		return sdkerrors.Wrapf(hosttypes.ErrHostSubModuleDisabled, "connection %s", connectionID)
+	}
+
+	if data.Type != icatypes.EXECUTE_TX && data.Type != icatypes.EXECUTE_TX_WITH_EVENTS {
+		return sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "packet data type %s", data.Type)
+	}
+
+	msgs, err := k.deserializePacketMsgs(data.Data)
+	if err != nil {
+		return err
+	}
+
+	accounts := k.getConnectionInterchainAccounts(ctx, connectionID)
+
+	var interchainAccountAddr string
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d (%s) failed basic validation", i, sdk.MsgTypeURL(msg))
+		}
+
+		if !hosttypes.ContainsMsgType(params.AllowMessages, msg) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+		}
+
+		var signer string
+		for _, addr := range msg.GetSigners() {
+			if accounts[addr.String()] {
+				signer = addr.String()
+				break
+			}
+		}
+
+		if signer == "" {
+			return sdkerrors.Wrapf(hosttypes.ErrUnauthorizedSigner, "message %d (%s) does not require an interchain account registered on connection %s as a signer", i, sdk.MsgTypeURL(msg), connectionID)
+		}
+
+		if interchainAccountAddr == "" {
+			interchainAccountAddr = signer
+		}
+
+		if signer != interchainAccountAddr {
+			return sdkerrors.Wrapf(hosttypes.ErrUnauthorizedSigner, "message %d (%s) requires interchain account %s, expected %s", i, sdk.MsgTypeURL(msg), signer, interchainAccountAddr)
+		}
+	}
+
+	return nil
+}
+
+// deserializePacketMsgs deserializes the provided interchain account transaction using the first supported encoding
+// and compression formats able to decode it. Uncompressed transactions are attempted first.
+func (k Keeper) deserializePacketMsgs(data []byte) ([]sdk.Msg, error) {
+	var err error
+	for _, compression := range append([]string{""}, icatypes.SupportedCompressions...) {
+		for _, encoding := range icatypes.SupportedEncodings {
+			var msgs []sdk.Msg
+			if msgs, err = icatypes.DeserializeCosmosTx(k.cdc, data, encoding, compression); err == nil {
+				return msgs, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+// getConnectionInterchainAccounts returns the set of addresses of the interchain accounts with an active channel
+// on the provided connection
+func (k Keeper) getConnectionInterchainAccounts(ctx sdk.Context, connectionID string) map[string]bool {
+	accounts := make(map[string]bool)
+	for _, account := range k.GetAllInterchainAccounts(ctx) {
+		accountConnectionID, err := k.GetInterchainAccountConnectionID(ctx, account.PortId)
+		if err != nil || accountConnectionID != connectionID {
+			continue
+		}
+
+		accounts[account.AccountAddress] = true
+	}
+
+	return accounts
+}
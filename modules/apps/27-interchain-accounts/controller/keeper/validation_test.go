@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestSetHostParams() {
+	suite.SetupTest()
+
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetHostParams(suite.chainA.GetContext(), ibctesting.FirstConnectionID)
+	suite.Require().False(found)
+
+	params := hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, false, nil, true, 0, false, nil, 0, 0)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetHostParams(suite.chainA.GetContext(), ibctesting.FirstConnectionID, params)
+
+	cached, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetHostParams(suite.chainA.GetContext(), ibctesting.FirstConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(params, cached)
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.DeleteHostParams(suite.chainA.GetContext(), ibctesting.FirstConnectionID)
+
+	_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetHostParams(suite.chainA.GetContext(), ibctesting.FirstConnectionID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestValidatePacketData() {
+	var (
+		path          *ibctesting.Path
+		connectionID  string
+		msgs          []sdk.Msg
+		encoding      string
+		icaPacketData icatypes.InterchainAccountPacketData
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(interchainAccountAddr string)
+		expPass  bool
+	}{
+		{
+			"success",
+			func(interchainAccountAddr string) {},
+			true,
+		},
+		{
+			"success: proto3json encoding",
+			func(interchainAccountAddr string) {
+				encoding = icatypes.EncodingProto3JSON
+			},
+			true,
+		},
+		{
+			"invalid connection identifier",
+			func(interchainAccountAddr string) {
+				connectionID = "invalid|connection"
+			},
+			false,
+		},
+		{
+			"host parameters not cached",
+			func(interchainAccountAddr string) {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteHostParams(suite.chainA.GetContext(), connectionID)
+			},
+			false,
+		},
+		{
+			"host submodule disabled",
+			func(interchainAccountAddr string) {
+				params := hosttypes.NewParams(false, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetHostParams(suite.chainA.GetContext(), connectionID, params)
+			},
+			false,
+		},
+		{
+			"message type not allowed",
+			func(interchainAccountAddr string) {
+				params := hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetHostParams(suite.chainA.GetContext(), connectionID, params)
+			},
+			false,
+		},
+		{
+			"signer is not an interchain account",
+			func(interchainAccountAddr string) {
+				msgs = []sdk.Msg{&banktypes.MsgSend{
+					FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
+					ToAddress:   interchainAccountAddr,
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}}
+			},
+			false,
+		},
+		{
+			"interchain account is registered on another connection",
+			func(interchainAccountAddr string) {
+				connectionID = "connection-1"
+
+				params := hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetHostParams(suite.chainA.GetContext(), connectionID, params)
+			},
+			false,
+		},
+		{
+			"message fails basic validation",
+			func(interchainAccountAddr string) {
+				msgs = []sdk.Msg{&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				}}
+			},
+			false,
+		},
+		{
+			"invalid packet data type",
+			func(interchainAccountAddr string) {
+				icaPacketData.Type = icatypes.UNSPECIFIED
+			},
+			false,
+		},
+		{
+			"undecodable transaction",
+			func(interchainAccountAddr string) {
+				icaPacketData.Data = []byte("invalid tx")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			connectionID = path.EndpointA.ConnectionID
+			encoding = icatypes.EncodingProtobuf
+			msgs = []sdk.Msg{&banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}}
+			icaPacketData = icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX}
+
+			params := hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, false, nil, true, 0, false, nil, 0, 0)
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetHostParams(suite.chainA.GetContext(), connectionID, params)
+
+			tc.malleate(interchainAccountAddr) // malleate mutates test data
+
+			if icaPacketData.Data == nil {
+				icaPacketData.Data, err = icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, encoding, "")
+				suite.Require().NoError(err)
+			}
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.ValidatePacketData(suite.chainA.GetContext(), connectionID, icaPacketData)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TrySendVoteTx constructs a governance MsgVote on behalf of the interchain account associated with the provided portID
+// and attempts to send it to the host chain. The message is serialized using the encoding format negotiated for the active channel.
+func (k Keeper) TrySendVoteTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID string, proposalID uint64, option govtypes.VoteOption) (uint64, error) {
+	if proposalID == 0 {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal ID cannot be zero")
+	}
+
+	accAddr, found := k.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for port %s", portID)
+	}
+
+	msg := &govtypes.MsgVote{
+		ProposalId: proposalID,
+		Voter:      accAddr,
+		Option:     option,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	return k.trySendMsgs(ctx, chanCap, portID, icatypes.EXECUTE_TX, []sdk.Msg{msg})
+}
+
+// TrySendWeightedVoteTx constructs a governance MsgVoteWeighted on behalf of the interchain account associated with the provided
+// portID and attempts to send it to the host chain. The message is serialized using the encoding format negotiated for the active channel.
+func (k Keeper) TrySendWeightedVoteTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID string, proposalID uint64, options govtypes.WeightedVoteOptions) (uint64, error) {
+	if proposalID == 0 {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal ID cannot be zero")
+	}
+
+	accAddr, found := k.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for port %s", portID)
+	}
+
+	msg := &govtypes.MsgVoteWeighted{
+		ProposalId: proposalID,
+		Voter:      accAddr,
+		Options:    options,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	return k.trySendMsgs(ctx, chanCap, portID, icatypes.EXECUTE_TX, []sdk.Msg{msg})
+}
+
+// trySendMsgs serializes the provided msgs using the encoding format negotiated for the active channel associated with
+// the provided portID and attempts to send them to the host chain as a single interchain account transaction of the
+// provided packet data type
+func (k Keeper) trySendMsgs(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID string, packetType icatypes.Type, msgs []sdk.Msg) (uint64, error) {
+	activeChannelID, found := k.GetActiveChannelID(ctx, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, activeChannelID)
+	}
+
+	// channels using the legacy version format predate encoding negotiation and use the protobuf encoding format
+	// without compression
+	encoding, compression := icatypes.EncodingProtobuf, ""
+	if icatypes.IsICAMetadataVersion(channel.Version) {
+		metadata, err := icatypes.ParseICAMetadata(channel.Version)
+		if err != nil {
+			return 0, err
+		}
+
+		encoding, compression = metadata.Encoding, metadata.Compression
+	}
+
+	data, err := icatypes.SerializeCosmosTx(k.cdc, msgs, encoding, compression)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: packetType,
+		Data: data,
+	}
+
+	return k.TrySendTx(ctx, chanCap, portID, packetData)
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestTrySendVoteTx() {
+	var (
+		path       *ibctesting.Path
+		chanCap    *capabilitytypes.Capability
+		portID     string
+		proposalID uint64
+		option     govtypes.VoteOption
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"proposal ID is zero",
+			func() {
+				proposalID = 0
+			},
+			false,
+		},
+		{
+			"invalid vote option",
+			func() {
+				option = govtypes.OptionEmpty
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				portID = "invalid-port-id"
+			},
+			false,
+		},
+		{
+			"active channel not found",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			},
+			false,
+		},
+		{
+			"invalid channel capability provided",
+			func() {
+				chanCap = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var ok bool
+			chanCap, ok = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			proposalID = 1
+			option = govtypes.OptionYes
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendVoteTx(suite.chainA.GetContext(), chanCap, portID, proposalID, option)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTrySendWeightedVoteTx() {
+	var (
+		path       *ibctesting.Path
+		chanCap    *capabilitytypes.Capability
+		proposalID uint64
+		options    govtypes.WeightedVoteOptions
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"proposal ID is zero",
+			func() {
+				proposalID = 0
+			},
+			false,
+		},
+		{
+			"total weight is not equal to one",
+			func() {
+				options = govtypes.WeightedVoteOptions{
+					{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+				}
+			},
+			false,
+		},
+		{
+			"duplicate vote options",
+			func() {
+				options = govtypes.WeightedVoteOptions{
+					{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+					{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var ok bool
+			chanCap, ok = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			proposalID = 1
+			options = govtypes.WeightedVoteOptions{
+				{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+				{Option: govtypes.OptionAbstain, Weight: sdk.NewDecWithPrec(4, 1)},
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.TrySendWeightedVoteTx(suite.chainA.GetContext(), chanCap, path.EndpointA.ChannelConfig.PortID, proposalID, options)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -6,17 +6,23 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +35,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty" yaml:"controller_enabled"`
+	// max_relative_timeout defines the maximum duration after the current block time which may be used as the
+	// relative timeout of an interchain account transaction. Relative timeouts are disabled if set to 0.
+	MaxRelativeTimeout time.Duration `protobuf:"bytes,2,opt,name=max_relative_timeout,json=maxRelativeTimeout,proto3,stdduration" json:"max_relative_timeout" yaml:"max_relative_timeout"`
+	// send_queue_release_rate defines the maximum number of packets released from the send queue of a controller port
+	// at the end of each block. Queued packets are held until they are released, trading latency for a steady packet
+	// flow on the ordered channel. The send queue is disabled if set to 0.
+	SendQueueReleaseRate uint64 `protobuf:"varint,3,opt,name=send_queue_release_rate,json=sendQueueReleaseRate,proto3" json:"send_queue_release_rate,omitempty" yaml:"send_queue_release_rate"`
+	// send_queue_max_in_flight defines the maximum number of unacknowledged packets on the active channel of a
+	// controller port for which queued packets are still released. Queued packets are released without regard to
+	// acknowledgements if set to 0.
+	SendQueueMaxInFlight uint64 `protobuf:"varint,4,opt,name=send_queue_max_in_flight,json=sendQueueMaxInFlight,proto3" json:"send_queue_max_in_flight,omitempty" yaml:"send_queue_max_in_flight"`
+	// auto_reopen_on_close enables the automatic reopening of the channel of an interchain account which is closed due
+	// to a packet timeout. The existing interchain account is reused. Reopen attempts are backed off and abandoned once
+	// the maximum number of consecutive attempts is exceeded, see the controller types package.
+	AutoReopenOnClose bool `protobuf:"varint,5,opt,name=auto_reopen_on_close,json=autoReopenOnClose,proto3" json:"auto_reopen_on_close,omitempty" yaml:"auto_reopen_on_close"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,8 +92,164 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxRelativeTimeout() time.Duration {
+	if m != nil {
+		return m.MaxRelativeTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetSendQueueReleaseRate() uint64 {
+	if m != nil {
+		return m.SendQueueReleaseRate
+	}
+	return 0
+}
+
+func (m *Params) GetSendQueueMaxInFlight() uint64 {
+	if m != nil {
+		return m.SendQueueMaxInFlight
+	}
+	return 0
+}
+
+func (m *Params) GetAutoReopenOnClose() bool {
+	if m != nil {
+		return m.AutoReopenOnClose
+	}
+	return false
+}
+
+// ReconcileActiveChannelsProposal is a governance proposal to reconcile the active channels of the interchain
+// accounts controller submodule with the state of their channels. Active channels whose channel is missing or no
+// longer open are removed, such that interchain accounts may be reopened and no packets are sent over them.
+type ReconcileActiveChannelsProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *ReconcileActiveChannelsProposal) Reset()         { *m = ReconcileActiveChannelsProposal{} }
+func (m *ReconcileActiveChannelsProposal) String() string { return proto.CompactTextString(m) }
+func (*ReconcileActiveChannelsProposal) ProtoMessage()    {}
+func (*ReconcileActiveChannelsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *ReconcileActiveChannelsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileActiveChannelsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileActiveChannelsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconcileActiveChannelsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileActiveChannelsProposal.Merge(m, src)
+}
+func (m *ReconcileActiveChannelsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileActiveChannelsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileActiveChannelsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileActiveChannelsProposal proto.InternalMessageInfo
+
+// Unbonding defines an undelegation sent to the host chain by an interchain account. The completion time of the
+// unbonding is returned by the host chain in the acknowledgement of the packet carrying the undelegation.
+type Unbonding struct {
+	// channel identifier over which the undelegation was sent
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// sequence of the packet carrying the undelegation
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// validator address on the host chain
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// undelegated amount
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// completion time of the unbonding, unset until the packet is acknowledged
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *Unbonding) Reset()         { *m = Unbonding{} }
+func (m *Unbonding) String() string { return proto.CompactTextString(m) }
+func (*Unbonding) ProtoMessage()    {}
+func (*Unbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{2}
+}
+func (m *Unbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Unbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Unbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Unbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unbonding.Merge(m, src)
+}
+func (m *Unbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Unbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unbonding proto.InternalMessageInfo
+
+func (m *Unbonding) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *Unbonding) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Unbonding) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *Unbonding) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Unbonding) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*ReconcileActiveChannelsProposal)(nil), "ibc.applications.interchain_accounts.controller.v1.ReconcileActiveChannelsProposal")
+	proto.RegisterType((*Unbonding)(nil), "ibc.applications.interchain_accounts.controller.v1.Unbonding")
 }
 
 func init() {
@@ -80,24 +257,51 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x45, 0x9b, 0xa5, 0x42, 0xd9, 0x88, 0x18, 0x68, 0x25, 0x0c, 0xca, 0xc4, 0x92, 0x3c, 0xb5,
-	0x1d, 0x90, 0x18, 0x8b, 0xd8, 0x18, 0x2a, 0x06, 0x06, 0x96, 0xca, 0x7e, 0x35, 0xae, 0x91, 0xe3,
-	0x17, 0xc5, 0x4e, 0xa4, 0xfc, 0x05, 0x9f, 0xc5, 0xd8, 0x91, 0x09, 0xa1, 0xe4, 0x0f, 0xf8, 0x02,
-	0xd4, 0x64, 0x48, 0x24, 0xba, 0x5d, 0x1f, 0xf9, 0x1d, 0xe9, 0xde, 0xf0, 0x41, 0x0b, 0x04, 0x9e,
-	0xe7, 0x46, 0x23, 0xf7, 0x9a, 0xac, 0x03, 0x6d, 0xbd, 0x2c, 0x70, 0xcf, 0xb5, 0xdd, 0x72, 0x44,
-	0x2a, 0xad, 0x77, 0x80, 0x64, 0x7d, 0x41, 0xc6, 0xc8, 0x02, 0xaa, 0xc5, 0xe8, 0x95, 0xe6, 0x05,
-	0x79, 0x8a, 0x96, 0x5a, 0x60, 0x3a, 0x96, 0xa4, 0x27, 0x24, 0xe9, 0xe8, 0xac, 0x5a, 0xcc, 0x67,
-	0x8a, 0x48, 0x19, 0x09, 0x9d, 0x41, 0x94, 0x6f, 0xc0, 0x6d, 0xdd, 0xeb, 0xe6, 0x17, 0x8a, 0x14,
-	0x75, 0x11, 0x8e, 0xa9, 0xa7, 0xf1, 0x4b, 0x38, 0xdd, 0xf0, 0x82, 0x67, 0x2e, 0x7a, 0x0a, 0xa3,
-	0xc1, 0xb5, 0x95, 0x96, 0x0b, 0x23, 0x77, 0x97, 0xc1, 0x4d, 0x70, 0x7b, 0xb6, 0xbe, 0xfa, 0xfd,
-	0xbe, 0x9e, 0xd5, 0x3c, 0x33, 0xf7, 0xf1, 0xff, 0x3f, 0xf1, 0xf3, 0xf9, 0x00, 0x1f, 0x7b, 0xb6,
-	0x7e, 0xff, 0x6c, 0x58, 0x70, 0x68, 0x58, 0xf0, 0xd3, 0xb0, 0xe0, 0xa3, 0x65, 0x93, 0x43, 0xcb,
-	0x26, 0x5f, 0x2d, 0x9b, 0xbc, 0x6e, 0x94, 0xf6, 0xfb, 0x52, 0xa4, 0x48, 0x19, 0x20, 0xb9, 0x8c,
-	0x1c, 0x68, 0x81, 0x89, 0x22, 0xa8, 0x56, 0x90, 0xd1, 0xae, 0x34, 0xd2, 0x1d, 0xb7, 0x73, 0xb0,
-	0xbc, 0x4b, 0x86, 0xc6, 0xc9, 0xa9, 0xd9, 0x7c, 0x9d, 0x4b, 0x27, 0xa6, 0x5d, 0x95, 0xd5, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x19, 0x5d, 0x5d, 0x76, 0x01, 0x00, 0x00,
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xc1, 0x6e, 0xe3, 0x36,
+	0x10, 0xb5, 0x12, 0x27, 0x88, 0x19, 0xa0, 0xad, 0x05, 0xb7, 0x55, 0xdc, 0x56, 0x32, 0xd4, 0x43,
+	0x73, 0x89, 0x84, 0x38, 0x05, 0x02, 0xf4, 0x16, 0xb9, 0x2d, 0x10, 0xa0, 0x45, 0x5d, 0x21, 0x3d,
+	0xa4, 0x3d, 0x08, 0x14, 0x35, 0x91, 0x59, 0x50, 0xa4, 0x22, 0x52, 0x46, 0xf2, 0x17, 0x39, 0xee,
+	0x27, 0x05, 0x7b, 0xca, 0x69, 0xb1, 0x27, 0xef, 0x22, 0xf9, 0x03, 0x7f, 0xc1, 0x82, 0x92, 0x1c,
+	0x3b, 0xb1, 0xf7, 0x46, 0xbe, 0x99, 0x79, 0x1c, 0x72, 0xde, 0x23, 0x1a, 0xd1, 0x98, 0xf8, 0x38,
+	0xcf, 0x19, 0x25, 0x58, 0x51, 0xc1, 0xa5, 0x4f, 0xb9, 0x82, 0x82, 0x4c, 0x30, 0xe5, 0x11, 0x26,
+	0x44, 0x94, 0x5c, 0x49, 0x9f, 0x08, 0xae, 0x0a, 0xc1, 0x18, 0x14, 0xfe, 0xf4, 0x78, 0x65, 0xe7,
+	0xe5, 0x85, 0x50, 0xc2, 0x1c, 0xd2, 0x98, 0x78, 0xab, 0x24, 0xde, 0x06, 0x12, 0x6f, 0xa5, 0x6c,
+	0x7a, 0xdc, 0x3f, 0x48, 0x85, 0x48, 0x19, 0xf8, 0x15, 0x43, 0x5c, 0x5e, 0xf9, 0x98, 0xdf, 0xd6,
+	0x74, 0xfd, 0x5e, 0x2a, 0x52, 0x51, 0x2d, 0x7d, 0xbd, 0x6a, 0x50, 0xfb, 0x75, 0x41, 0x52, 0x16,
+	0xd5, 0x69, 0x4d, 0xdc, 0x79, 0x1d, 0x57, 0x34, 0x03, 0xa9, 0x70, 0x96, 0x2f, 0x08, 0x88, 0x90,
+	0x99, 0x90, 0x7e, 0x8c, 0x25, 0xf8, 0xd3, 0xe3, 0x18, 0x14, 0xd6, 0x77, 0xa1, 0x0d, 0x81, 0xfb,
+	0x6e, 0x1b, 0xed, 0x8e, 0x71, 0x81, 0x33, 0x69, 0xfe, 0x81, 0xcc, 0x65, 0xb7, 0x11, 0x70, 0x1c,
+	0x33, 0x48, 0x2c, 0x63, 0x60, 0x1c, 0xee, 0x05, 0x3f, 0xcc, 0x67, 0xce, 0xc1, 0x2d, 0xce, 0xd8,
+	0x2f, 0xee, 0x7a, 0x8e, 0x1b, 0x76, 0x97, 0xe0, 0x6f, 0x35, 0x66, 0x2a, 0xd4, 0xcb, 0xf0, 0x4d,
+	0x54, 0x00, 0xc3, 0x8a, 0x4e, 0x21, 0xd2, 0x8d, 0x89, 0x52, 0x59, 0x5b, 0x03, 0xe3, 0x70, 0x7f,
+	0x78, 0xe0, 0xd5, 0x8d, 0x7b, 0x8b, 0xc6, 0xbd, 0x5f, 0x9b, 0x8b, 0x05, 0x3f, 0xdd, 0xcf, 0x9c,
+	0xd6, 0x7c, 0xe6, 0x7c, 0x57, 0x1f, 0xb7, 0x89, 0xc4, 0x7d, 0xf3, 0xc1, 0x31, 0x42, 0x33, 0xc3,
+	0x37, 0x61, 0x13, 0xb9, 0xa8, 0x03, 0xe6, 0x25, 0xfa, 0x56, 0x02, 0x4f, 0xa2, 0xeb, 0x12, 0x4a,
+	0xd0, 0x75, 0x80, 0x25, 0x44, 0x05, 0x56, 0x60, 0x6d, 0x0f, 0x8c, 0xc3, 0x76, 0xe0, 0xce, 0x67,
+	0x8e, 0x5d, 0x33, 0x7f, 0x26, 0xd1, 0x0d, 0x7b, 0x3a, 0xf2, 0xb7, 0x0e, 0x84, 0x35, 0x1e, 0x62,
+	0x05, 0xe6, 0x7f, 0xc8, 0x5a, 0xa9, 0xd0, 0x6d, 0x51, 0x1e, 0x5d, 0x31, 0x9a, 0x4e, 0x94, 0xd5,
+	0xae, 0xb8, 0x7f, 0x9c, 0xcf, 0x1c, 0x67, 0x8d, 0xfb, 0x45, 0xe6, 0x2a, 0xf9, 0x9f, 0xf8, 0xe6,
+	0x9c, 0xff, 0x5e, 0xc1, 0xe6, 0x18, 0xf5, 0x70, 0xa9, 0x44, 0x54, 0x80, 0xc8, 0x81, 0x47, 0x82,
+	0x47, 0x84, 0x09, 0x09, 0xd6, 0x4e, 0xf5, 0xfa, 0xce, 0xf2, 0x39, 0x36, 0x65, 0xb9, 0x61, 0x57,
+	0xc3, 0x61, 0x85, 0xfe, 0xc5, 0x47, 0x15, 0x76, 0x89, 0x9c, 0x10, 0x88, 0xe0, 0x84, 0x32, 0x38,
+	0x23, 0xfa, 0x8d, 0x46, 0x13, 0xcc, 0x39, 0x30, 0x39, 0x2e, 0x44, 0x2e, 0x24, 0x66, 0x66, 0x0f,
+	0xed, 0x28, 0xaa, 0x18, 0x54, 0x33, 0xee, 0x84, 0xf5, 0xc6, 0x1c, 0xa0, 0xfd, 0x04, 0x24, 0x29,
+	0x68, 0xae, 0xc7, 0x51, 0xcd, 0xab, 0x13, 0xae, 0x42, 0xee, 0xdb, 0x2d, 0xd4, 0xf9, 0x87, 0xc7,
+	0x82, 0x27, 0x94, 0xa7, 0xe6, 0xcf, 0x08, 0x91, 0x9a, 0x39, 0xa2, 0xb5, 0x5c, 0x3a, 0xc1, 0xd7,
+	0xf3, 0x99, 0xd3, 0x6d, 0xe4, 0xf2, 0x1c, 0x73, 0xc3, 0x4e, 0xb3, 0x39, 0x4f, 0xcc, 0x3e, 0xda,
+	0x93, 0x70, 0x5d, 0x02, 0x27, 0x50, 0x1d, 0xd1, 0x0e, 0x9f, 0xf7, 0xe6, 0x39, 0xea, 0x4e, 0x31,
+	0xa3, 0x09, 0x56, 0xa2, 0x88, 0x70, 0x92, 0x14, 0x20, 0x65, 0x35, 0xbe, 0x4e, 0xf0, 0xfd, 0x7c,
+	0xe6, 0x58, 0x35, 0xf1, 0x5a, 0x8a, 0x1b, 0x7e, 0xf5, 0x8c, 0x9d, 0xd5, 0x90, 0x79, 0x8a, 0x76,
+	0x71, 0xa6, 0x9d, 0x68, 0xb5, 0x1b, 0xdd, 0xd5, 0x7e, 0xf0, 0xb4, 0x1f, 0xbc, 0xc6, 0x0f, 0xde,
+	0x48, 0x50, 0x1e, 0xb4, 0xb5, 0xee, 0xc2, 0x26, 0xdd, 0x4c, 0xd1, 0x97, 0x44, 0x64, 0x39, 0x03,
+	0x7d, 0xe3, 0x4a, 0x77, 0xd5, 0x2c, 0xf6, 0x87, 0xfd, 0x35, 0xe5, 0x5e, 0x2c, 0x2c, 0x17, 0xb8,
+	0x8d, 0x74, 0xbf, 0x59, 0x38, 0xe5, 0x05, 0x81, 0x7b, 0xa7, 0x55, 0xfb, 0xc5, 0x12, 0xd5, 0x85,
+	0xc1, 0xff, 0xf7, 0x8f, 0xb6, 0xf1, 0xf0, 0x68, 0x1b, 0x1f, 0x1f, 0x6d, 0xe3, 0xee, 0xc9, 0x6e,
+	0x3d, 0x3c, 0xd9, 0xad, 0xf7, 0x4f, 0x76, 0xeb, 0xdf, 0x71, 0x4a, 0xd5, 0xa4, 0x8c, 0x3d, 0x22,
+	0x32, 0xbf, 0x71, 0x31, 0x8d, 0xc9, 0x51, 0x2a, 0xfc, 0xe9, 0x89, 0x9f, 0x89, 0xa4, 0x64, 0x20,
+	0xf5, 0x2f, 0x26, 0xfd, 0xe1, 0xe9, 0xd1, 0xf2, 0xef, 0x39, 0xda, 0xf4, 0x81, 0xa9, 0xdb, 0x1c,
+	0x64, 0xbc, 0x5b, 0xf5, 0x7c, 0xf2, 0x69, 0x00, 0x92, 0xdb, 0x51, 0x6a, 0x00, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -120,6 +324,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoReopenOnClose {
+		i--
+		if m.AutoReopenOnClose {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SendQueueMaxInFlight != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.SendQueueMaxInFlight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SendQueueReleaseRate != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.SendQueueReleaseRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxRelativeTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxRelativeTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintController(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	if m.ControllerEnabled {
 		i--
 		if m.ControllerEnabled {
@@ -133,6 +365,103 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReconcileActiveChannelsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileActiveChannelsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileActiveChannelsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Unbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintController(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintController(dAtA []byte, offset int, v uint64) int {
 	offset -= sovController(v)
 	base := offset
@@ -153,6 +482,58 @@ func (m *Params) Size() (n int) {
 	if m.ControllerEnabled {
 		n += 2
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxRelativeTimeout)
+	n += 1 + l + sovController(uint64(l))
+	if m.SendQueueReleaseRate != 0 {
+		n += 1 + sovController(uint64(m.SendQueueReleaseRate))
+	}
+	if m.SendQueueMaxInFlight != 0 {
+		n += 1 + sovController(uint64(m.SendQueueMaxInFlight))
+	}
+	if m.AutoReopenOnClose {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReconcileActiveChannelsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *Unbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovController(uint64(m.Sequence))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovController(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovController(uint64(l))
 	return n
 }
 
@@ -211,6 +592,410 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelativeTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxRelativeTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendQueueReleaseRate", wireType)
+			}
+			m.SendQueueReleaseRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendQueueReleaseRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendQueueMaxInFlight", wireType)
+			}
+			m.SendQueueMaxInFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendQueueMaxInFlight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoReopenOnClose", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoReopenOnClose = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconcileActiveChannelsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconcileActiveChannelsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconcileActiveChannelsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Unbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
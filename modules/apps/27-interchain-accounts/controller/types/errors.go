@@ -7,4 +7,10 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrInvalidLabel                = sdkerrors.Register(SubModuleName, 3, "invalid interchain account label")
+	ErrLabelAlreadyInUse           = sdkerrors.Register(SubModuleName, 4, "interchain account label is already in use")
+	ErrHostParamsNotFound          = sdkerrors.Register(SubModuleName, 5, "host chain parameters not found")
+	ErrInvalidRelativeTimeout      = sdkerrors.Register(SubModuleName, 6, "invalid relative timeout")
+	ErrSendQueueDisabled           = sdkerrors.Register(SubModuleName, 7, "send queue is disabled")
+	ErrCompletionTimeNotFound      = sdkerrors.Register(SubModuleName, 8, "unbonding completion time not found")
 )
@@ -0,0 +1,18 @@
+package types
+
+// Interchain accounts controller events
+const (
+	EventTypeAutoReopen             = "ics27_auto_reopen"
+	EventTypeReconcileActiveChannel = "ics27_reconcile_active_channel"
+	EventTypePacketPriority         = "ics27_packet_priority"
+	EventTypeUnbonding              = "ics27_unbonding"
+
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyAttempt        = "attempt"
+	AttributeKeyReopenSuccess  = "success"
+	AttributeKeyReopenError    = "error"
+	AttributeKeySequence       = "packet_sequence"
+	AttributeKeyPriority       = "priority"
+	AttributeKeyCompletionTime = "completion_time"
+)
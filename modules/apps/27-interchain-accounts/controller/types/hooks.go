@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ControllerHooks defines the event hooks which may be registered on the interchain accounts controller keeper
+type ControllerHooks interface {
+	// OnInterchainAccountCreated is called once the channel handshake of an interchain account completes successfully
+	// on the controller chain and the interchain account may be used
+	OnInterchainAccountCreated(ctx sdk.Context, portID, channelID, address string)
+}
+
+var _ ControllerHooks = MultiControllerHooks{}
+
+// MultiControllerHooks combines multiple ControllerHooks, all hook functions are run in array sequence
+type MultiControllerHooks []ControllerHooks
+
+// NewMultiControllerHooks creates a new MultiControllerHooks instance from the provided hooks
+func NewMultiControllerHooks(hooks ...ControllerHooks) MultiControllerHooks {
+	return hooks
+}
+
+// OnInterchainAccountCreated implements the ControllerHooks interface
+func (h MultiControllerHooks) OnInterchainAccountCreated(ctx sdk.Context, portID, channelID, address string) {
+	for i := range h {
+		h[i].OnInterchainAccountCreated(ctx, portID, channelID, address)
+	}
+}
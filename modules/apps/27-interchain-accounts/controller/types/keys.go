@@ -1,9 +1,134 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
 
 	// StoreKey is the store key string for the interchain accounts controller module
 	StoreKey = SubModuleName
+
+	// RouterKey is the message route for the interchain accounts controller module
+	RouterKey = SubModuleName
+
+	// UnassignedConnectionID defines the connection identifier used to group the controller ports without an active
+	// channel by the Query/InterchainAccountPortsByConnection gRPC method
+	UnassignedConnectionID = "unassigned"
+)
+
+var (
+	// LabelKeyPrefix defines the key prefix used to store the labels of interchain accounts
+	LabelKeyPrefix = "label"
+
+	// HostParamsKeyPrefix defines the key prefix used to store the cached host chain parameters of a connection
+	HostParamsKeyPrefix = "hostParams"
+
+	// SendQueueKeyPrefix defines the key prefix used to store the packets held by the send queue of a controller port
+	SendQueueKeyPrefix = "sendQueue"
+
+	// SendQueueIndexKeyPrefix defines the key prefix used to store the next send queue index of a controller port
+	SendQueueIndexKeyPrefix = "sendQueueIndex"
+
+	// ReopenAttemptsKeyPrefix defines the key prefix used to store the number of consecutive automatic reopen attempts
+	// made for the channel of a controller port
+	ReopenAttemptsKeyPrefix = "reopenAttempts"
+
+	// PendingReopenKeyPrefix defines the key prefix used to store the automatic reopen attempts scheduled for a block height
+	PendingReopenKeyPrefix = "pendingReopen"
+
+	// UnbondingKeyPrefix defines the key prefix used to store the undelegations sent by interchain accounts
+	UnbondingKeyPrefix = "unbonding"
+
+	// UnbondingQueueKeyPrefix defines the key prefix used to index the acknowledged undelegations by completion time
+	UnbondingQueueKeyPrefix = "unbondingQueue"
+
+	// LastActivityKeyPrefix defines the key prefix used to store the block height of the last packet activity on the
+	// active channel of a controller port
+	LastActivityKeyPrefix = "lastActivity"
 )
+
+// KeyLabel creates and returns a new key used for interchain account label store operations
+func KeyLabel(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", LabelKeyPrefix, portID))
+}
+
+// KeyHostParams creates and returns a new key used for cached host chain parameters store operations
+func KeyHostParams(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", HostParamsKeyPrefix, connectionID))
+}
+
+// KeySendQueuePrefix creates and returns a new key prefix used for send queue store operations of a controller port
+func KeySendQueuePrefix(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", SendQueueKeyPrefix, portID))
+}
+
+// KeySendQueuePacket creates and returns a new key used for send queue store operations. The queue index is encoded
+// in big endian such that queued packets are iterated in the order in which they were enqueued
+func KeySendQueuePacket(portID string, index uint64) []byte {
+	return append(KeySendQueuePrefix(portID), sdk.Uint64ToBigEndian(index)...)
+}
+
+// KeySendQueueIndex creates and returns a new key used for next send queue index store operations
+func KeySendQueueIndex(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", SendQueueIndexKeyPrefix, portID))
+}
+
+// KeyReopenAttempts creates and returns a new key used for automatic reopen attempts store operations
+func KeyReopenAttempts(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ReopenAttemptsKeyPrefix, portID))
+}
+
+// KeyPendingReopenPrefix creates and returns the key prefix used to iterate over the scheduled automatic reopen attempts
+func KeyPendingReopenPrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", PendingReopenKeyPrefix))
+}
+
+// KeyPendingReopenHeight creates and returns the key prefix of the automatic reopen attempts scheduled for the provided
+// block height. The height is encoded in big endian such that scheduled attempts are iterated in order of height
+func KeyPendingReopenHeight(height uint64) []byte {
+	return append(KeyPendingReopenPrefix(), sdk.Uint64ToBigEndian(height)...)
+}
+
+// KeyPendingReopen creates and returns a new key used for scheduled automatic reopen attempt store operations
+func KeyPendingReopen(height uint64, portID string) []byte {
+	return append(KeyPendingReopenHeight(height), []byte(fmt.Sprintf("/%s", portID))...)
+}
+
+// KeyUnbondingPrefix creates and returns the key prefix used to iterate over the undelegations of a controller port
+func KeyUnbondingPrefix(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", UnbondingKeyPrefix, portID))
+}
+
+// KeyUnbonding creates and returns a new key used for undelegation store operations. The packet sequence is encoded
+// in big endian such that the undelegations sent over a channel are iterated in order of sequence
+func KeyUnbonding(portID, channelID string, sequence uint64) []byte {
+	return append(KeyUnbondingPrefix(portID), append([]byte(fmt.Sprintf("%s/", channelID)), sdk.Uint64ToBigEndian(sequence)...)...)
+}
+
+// KeyUnbondingQueuePrefix creates and returns the key prefix used to iterate over the acknowledged undelegations in
+// order of completion time
+func KeyUnbondingQueuePrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", UnbondingQueueKeyPrefix))
+}
+
+// KeyUnbondingQueueTime creates and returns the key prefix of the acknowledged undelegations completing at the provided
+// time. The time is encoded in a sortable format such that undelegations are iterated in order of completion time
+func KeyUnbondingQueueTime(completionTime time.Time) []byte {
+	return append(KeyUnbondingQueuePrefix(), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// KeyUnbondingQueue creates and returns a new key used for undelegation completion queue store operations
+func KeyUnbondingQueue(completionTime time.Time, portID, channelID string, sequence uint64) []byte {
+	return append(KeyUnbondingQueueTime(completionTime), append([]byte("/"), KeyUnbonding(portID, channelID, sequence)...)...)
+}
+
+// KeyLastActivity creates and returns a new key used for last packet activity store operations
+func KeyLastActivity(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", LastActivityKeyPrefix, portID))
+}
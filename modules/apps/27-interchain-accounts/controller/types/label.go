@@ -0,0 +1,28 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaximumLabelLength defines the maximum length in bytes of an interchain account label
+const MaximumLabelLength = 64
+
+// ValidateLabel performs basic validation of the provided interchain account label. A label must be non-empty,
+// must not exceed MaximumLabelLength and must not contain leading or trailing whitespace.
+func ValidateLabel(label string) error {
+	if strings.TrimSpace(label) == "" {
+		return sdkerrors.Wrap(ErrInvalidLabel, "label cannot be blank")
+	}
+
+	if len(label) > MaximumLabelLength {
+		return sdkerrors.Wrapf(ErrInvalidLabel, "label length %d exceeds the maximum of %d", len(label), MaximumLabelLength)
+	}
+
+	if strings.TrimSpace(label) != label {
+		return sdkerrors.Wrap(ErrInvalidLabel, "label cannot contain leading or trailing whitespace")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgSetInterchainAccountLabel = "set_interchain_account_label"
+)
+
+var _ sdk.Msg = &MsgSetInterchainAccountLabel{}
+
+// NewMsgSetInterchainAccountLabel creates a new MsgSetInterchainAccountLabel instance
+func NewMsgSetInterchainAccountLabel(owner, connectionID, label string) *MsgSetInterchainAccountLabel {
+	return &MsgSetInterchainAccountLabel{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		Label:        label,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgSetInterchainAccountLabel) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSetInterchainAccountLabel) Type() string {
+	return TypeMsgSetInterchainAccountLabel
+}
+
+// ValidateBasic performs a basic check of the MsgSetInterchainAccountLabel fields. An empty label is permitted
+// and clears the label currently assigned to the interchain account.
+func (msg MsgSetInterchainAccountLabel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return err
+	}
+
+	if msg.Label != "" {
+		return ValidateLabel(msg.Label)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSetInterchainAccountLabel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg. The owner of the interchain account is the signer.
+func (msg MsgSetInterchainAccountLabel) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
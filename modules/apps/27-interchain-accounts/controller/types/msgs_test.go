@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+const validOwner = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+func TestMsgSetInterchainAccountLabelValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetInterchainAccountLabel
+		expPass bool
+	}{
+		{"success", types.NewMsgSetInterchainAccountLabel(validOwner, ibctesting.FirstConnectionID, "treasury"), true},
+		{"success - empty label", types.NewMsgSetInterchainAccountLabel(validOwner, ibctesting.FirstConnectionID, ""), true},
+		{"invalid owner address", types.NewMsgSetInterchainAccountLabel("invalid", ibctesting.FirstConnectionID, "treasury"), false},
+		{"invalid connection identifier", types.NewMsgSetInterchainAccountLabel(validOwner, "invalid|connection", "treasury"), false},
+		{"blank label", types.NewMsgSetInterchainAccountLabel(validOwner, ibctesting.FirstConnectionID, "   "), false},
+		{"label with trailing whitespace", types.NewMsgSetInterchainAccountLabel(validOwner, ibctesting.FirstConnectionID, "treasury "), false},
+		{"label exceeds maximum length", types.NewMsgSetInterchainAccountLabel(validOwner, ibctesting.FirstConnectionID, strings.Repeat("a", types.MaximumLabelLength+1)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSetInterchainAccountLabelGetSigners(t *testing.T) {
+	msg := types.NewMsgSetInterchainAccountLabel(validOwner, ibctesting.FirstConnectionID, "treasury")
+	require.Equal(t, validOwner, msg.GetSigners()[0].String())
+}
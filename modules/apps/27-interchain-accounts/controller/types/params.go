@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -9,11 +10,27 @@ import (
 const (
 	// DefaultControllerEnabled is the default value for the controller param (set to true)
 	DefaultControllerEnabled = true
+	// DefaultMaxRelativeTimeout is the default value for the max relative timeout param (set to 24 hours)
+	DefaultMaxRelativeTimeout = time.Hour * 24
+	// DefaultSendQueueReleaseRate is the default value for the send queue release rate param (set to 0, disabling the send queue)
+	DefaultSendQueueReleaseRate = 0
+	// DefaultSendQueueMaxInFlight is the default value for the send queue max in flight param (set to 0)
+	DefaultSendQueueMaxInFlight = 0
+	// DefaultAutoReopenOnClose is the default value for the auto reopen on close param (set to false)
+	DefaultAutoReopenOnClose = false
 )
 
 var (
 	// KeyControllerEnabled is the store key for ControllerEnabled Params
 	KeyControllerEnabled = []byte("ControllerEnabled")
+	// KeyMaxRelativeTimeout is the store key for MaxRelativeTimeout Params
+	KeyMaxRelativeTimeout = []byte("MaxRelativeTimeout")
+	// KeySendQueueReleaseRate is the store key for SendQueueReleaseRate Params
+	KeySendQueueReleaseRate = []byte("SendQueueReleaseRate")
+	// KeySendQueueMaxInFlight is the store key for SendQueueMaxInFlight Params
+	KeySendQueueMaxInFlight = []byte("SendQueueMaxInFlight")
+	// KeyAutoReopenOnClose is the store key for AutoReopenOnClose Params
+	KeyAutoReopenOnClose = []byte("AutoReopenOnClose")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -22,15 +39,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the controller submodule
-func NewParams(enableController bool) Params {
+func NewParams(enableController bool, maxRelativeTimeout time.Duration, sendQueueReleaseRate, sendQueueMaxInFlight uint64, autoReopenOnClose bool) Params {
 	return Params{
-		ControllerEnabled: enableController,
+		ControllerEnabled:    enableController,
+		MaxRelativeTimeout:   maxRelativeTimeout,
+		SendQueueReleaseRate: sendQueueReleaseRate,
+		SendQueueMaxInFlight: sendQueueMaxInFlight,
+		AutoReopenOnClose:    autoReopenOnClose,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the controller submodule
 func DefaultParams() Params {
-	return NewParams(DefaultControllerEnabled)
+	return NewParams(DefaultControllerEnabled, DefaultMaxRelativeTimeout, DefaultSendQueueReleaseRate, DefaultSendQueueMaxInFlight, DefaultAutoReopenOnClose)
 }
 
 // Validate validates all controller submodule parameters
@@ -39,6 +60,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxRelativeTimeout(p.MaxRelativeTimeout); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.SendQueueReleaseRate); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.SendQueueMaxInFlight); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.AutoReopenOnClose); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -46,6 +83,10 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyControllerEnabled, p.ControllerEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyMaxRelativeTimeout, p.MaxRelativeTimeout, validateMaxRelativeTimeout),
+		paramtypes.NewParamSetPair(KeySendQueueReleaseRate, p.SendQueueReleaseRate, validateUint64),
+		paramtypes.NewParamSetPair(KeySendQueueMaxInFlight, p.SendQueueMaxInFlight, validateUint64),
+		paramtypes.NewParamSetPair(KeyAutoReopenOnClose, p.AutoReopenOnClose, validateEnabled),
 	}
 }
 
@@ -57,3 +98,25 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxRelativeTimeout(i interface{}) error {
+	timeout, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if timeout < 0 {
+		return fmt.Errorf("max relative timeout cannot be negative: %s", timeout)
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
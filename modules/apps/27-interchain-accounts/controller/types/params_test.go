@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -10,5 +11,9 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false).Validate())
+	require.NoError(t, types.NewParams(false, types.DefaultMaxRelativeTimeout, 0, 0, false).Validate())
+	require.NoError(t, types.NewParams(true, 0, 0, 0, false).Validate())
+	require.NoError(t, types.NewParams(true, types.DefaultMaxRelativeTimeout, 5, 10, false).Validate())
+	require.NoError(t, types.NewParams(true, types.DefaultMaxRelativeTimeout, 0, 0, true).Validate())
+	require.Error(t, types.NewParams(true, -time.Second, 0, 0, false).Validate())
 }
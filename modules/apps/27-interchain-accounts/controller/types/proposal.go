@@ -0,0 +1,43 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeReconcileActiveChannels defines the type for a ReconcileActiveChannelsProposal
+	ProposalTypeReconcileActiveChannels = "ReconcileActiveChannels"
+)
+
+var _ govtypes.Content = &ReconcileActiveChannelsProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeReconcileActiveChannels)
+}
+
+// NewReconcileActiveChannelsProposal creates a new interchain accounts controller active channel reconciliation proposal.
+func NewReconcileActiveChannelsProposal(title, description string) govtypes.Content {
+	return &ReconcileActiveChannelsProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// GetTitle returns the title of an active channel reconciliation proposal.
+func (rcp *ReconcileActiveChannelsProposal) GetTitle() string { return rcp.Title }
+
+// GetDescription returns the description of an active channel reconciliation proposal.
+func (rcp *ReconcileActiveChannelsProposal) GetDescription() string { return rcp.Description }
+
+// ProposalRoute returns the routing key of an active channel reconciliation proposal.
+func (rcp *ReconcileActiveChannelsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an active channel reconciliation proposal.
+func (rcp *ReconcileActiveChannelsProposal) ProposalType() string {
+	return ProposalTypeReconcileActiveChannels
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (rcp *ReconcileActiveChannelsProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(rcp)
+}
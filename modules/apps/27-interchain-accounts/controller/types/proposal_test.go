@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+)
+
+func TestReconcileActiveChannelsProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *types.ReconcileActiveChannelsProposal
+		expPass  bool
+	}{
+		{"success", &types.ReconcileActiveChannelsProposal{Title: "title", Description: "description"}, true},
+		{"empty title", &types.ReconcileActiveChannelsProposal{Title: "", Description: "description"}, false},
+		{"empty description", &types.ReconcileActiveChannelsProposal{Title: "title", Description: ""}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,220 +114,6648 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
+// QueryInterchainAccountClientStatusRequest is the request type for the Query/InterchainAccountClientStatus RPC method.
+type QueryInterchainAccountClientStatusRequest struct {
+	// owner address of the interchain account
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier on which the interchain account was registered
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/controller/v1/query.proto", fileDescriptor_df0d8b259d72854e)
+func (m *QueryInterchainAccountClientStatusRequest) Reset() {
+	*m = QueryInterchainAccountClientStatusRequest{}
+}
+func (m *QueryInterchainAccountClientStatusRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInterchainAccountClientStatusRequest) ProtoMessage() {}
+func (*QueryInterchainAccountClientStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{2}
+}
+func (m *QueryInterchainAccountClientStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountClientStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountClientStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountClientStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountClientStatusRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountClientStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountClientStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountClientStatusRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_df0d8b259d72854e = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x03, 0x31,
-	0x1c, 0xc5, 0x1b, 0xc1, 0x0e, 0xe7, 0x76, 0x76, 0x90, 0x22, 0x87, 0x74, 0x72, 0x69, 0xfe, 0xf4,
-	0x2a, 0x08, 0x1d, 0x1c, 0x14, 0x74, 0xad, 0x1d, 0x5d, 0x24, 0x17, 0xc3, 0x35, 0x72, 0x97, 0x7f,
-	0x9a, 0xe4, 0x0a, 0x5d, 0xfd, 0x04, 0x82, 0x5f, 0xca, 0xb1, 0x20, 0x82, 0x9b, 0xd2, 0xfa, 0x41,
-	0xe4, 0x2e, 0x07, 0xad, 0xd8, 0x41, 0xeb, 0x16, 0xf2, 0xe7, 0xfd, 0xde, 0x7b, 0xbc, 0xe0, 0x4c,
-	0x26, 0x1c, 0x98, 0xd6, 0x99, 0xe4, 0xcc, 0x49, 0x54, 0x16, 0xa4, 0x72, 0xc2, 0xf0, 0x31, 0x93,
-	0xea, 0x96, 0x71, 0x8e, 0x85, 0x72, 0x16, 0x38, 0x2a, 0x67, 0x30, 0xcb, 0x84, 0x81, 0x69, 0x0f,
-	0x26, 0x85, 0x30, 0x33, 0xaa, 0x0d, 0x3a, 0x0c, 0x63, 0x99, 0x70, 0xba, 0xae, 0xa7, 0x1b, 0xf4,
-	0x74, 0xa5, 0xa7, 0xd3, 0x5e, 0xbb, 0x95, 0x62, 0x8a, 0x95, 0x1c, 0xca, 0x97, 0x27, 0xb5, 0x2f,
-	0xb6, 0x48, 0xb2, 0xc6, 0xf5, 0x90, 0xc3, 0x14, 0x31, 0xcd, 0x04, 0x30, 0x2d, 0x81, 0x29, 0x85,
-	0xae, 0x0e, 0x55, 0x5d, 0x3b, 0xad, 0x20, 0xbc, 0x2e, 0xb3, 0x0f, 0x99, 0x61, 0xb9, 0x1d, 0x89,
-	0x49, 0x21, 0xac, 0xeb, 0xc8, 0x60, 0xff, 0xdb, 0xaf, 0xd5, 0xa8, 0xac, 0x08, 0x47, 0x41, 0x53,
-	0x57, 0x3f, 0x07, 0xe4, 0x88, 0x1c, 0xef, 0xc5, 0x03, 0xfa, 0xf7, 0xaa, 0xb4, 0x66, 0xd6, 0xa4,
-	0xf8, 0x9d, 0x04, 0xbb, 0x95, 0x57, 0xf8, 0x4a, 0x82, 0xa6, 0x3f, 0x86, 0x97, 0xdb, 0x80, 0x7f,
-	0xf6, 0x68, 0x5f, 0xfd, 0x9b, 0xe3, 0x9b, 0x77, 0x06, 0x0f, 0x2f, 0x9f, 0x4f, 0x3b, 0x27, 0x61,
-	0x0c, 0xf5, 0x24, 0xbf, 0x99, 0xc2, 0x37, 0x3c, 0xbf, 0x7f, 0x5e, 0x44, 0x64, 0xbe, 0x88, 0xc8,
-	0xc7, 0x22, 0x22, 0x8f, 0xcb, 0xa8, 0x31, 0x5f, 0x46, 0x8d, 0xb7, 0x65, 0xd4, 0xb8, 0x19, 0xa6,
-	0xd2, 0x8d, 0x8b, 0x84, 0x72, 0xcc, 0x81, 0xa3, 0xcd, 0xd1, 0x96, 0xf8, 0x6e, 0x8a, 0x30, 0xed,
-	0x43, 0x8e, 0x77, 0x45, 0x26, 0xac, 0x37, 0x8b, 0x4f, 0xbb, 0x2b, 0xbf, 0xee, 0x26, 0x3f, 0x37,
-	0xd3, 0xc2, 0x26, 0xcd, 0x6a, 0xd5, 0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x0c, 0xad,
-	0x99, 0xc4, 0x02, 0x00, 0x00,
+var xxx_messageInfo_QueryInterchainAccountClientStatusRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountClientStatusRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryInterchainAccountClientStatusRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryInterchainAccountClientStatusResponse is the response type for the Query/InterchainAccountClientStatus RPC
+// method.
+type QueryInterchainAccountClientStatusResponse struct {
+	// client identifier of the light client backing the connection
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// client type of the light client backing the connection
+	ClientType string `protobuf:"bytes,2,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty" yaml:"client_type"`
+	// status of the light client backing the connection
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// latest height of the light client backing the connection
+	LatestHeight types.Height `protobuf:"bytes,4,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height" yaml:"latest_height"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the ICA controller submodule.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryInterchainAccountClientStatusResponse) Reset() {
+	*m = QueryInterchainAccountClientStatusResponse{}
+}
+func (m *QueryInterchainAccountClientStatusResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInterchainAccountClientStatusResponse) ProtoMessage() {}
+func (*QueryInterchainAccountClientStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{3}
+}
+func (m *QueryInterchainAccountClientStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountClientStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountClientStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountClientStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountClientStatusResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountClientStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountClientStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountClientStatusResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryInterchainAccountClientStatusResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountClientStatusResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryInterchainAccountClientStatusResponse) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInterchainAccountClientStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the ICA controller submodule.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryInterchainAccountClientStatusResponse) GetLatestHeight() types.Height {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return types.Height{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryInterchainAccountRequest is the request type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountRequest struct {
+	// owner address of the interchain account
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier on which the interchain account was registered
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryInterchainAccountRequest) Reset()         { *m = QueryInterchainAccountRequest{} }
+func (m *QueryInterchainAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{4}
+}
+func (m *QueryInterchainAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryInterchainAccountRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryInterchainAccountRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return ""
+}
+
+// QueryInterchainAccountResponse is the response type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountResponse struct {
+	// interchain account address on the host chain
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryInterchainAccountResponse) Reset()         { *m = QueryInterchainAccountResponse{} }
+func (m *QueryInterchainAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{5}
+}
+func (m *QueryInterchainAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/Params",
+}
+func (m *QueryInterchainAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+// QueryInterchainAccountPortsRequest is the request type for the Query/InterchainAccountPorts RPC method.
+type QueryInterchainAccountPortsRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountPortsRequest) Reset()         { *m = QueryInterchainAccountPortsRequest{} }
+func (m *QueryInterchainAccountPortsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountPortsRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountPortsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{6}
+}
+func (m *QueryInterchainAccountPortsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountPortsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountPortsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryInterchainAccountPortsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountPortsRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountPortsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountPortsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountPortsRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/interchain_accounts/controller/v1/query.proto",
+var xxx_messageInfo_QueryInterchainAccountPortsRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountPortsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryInterchainAccountPortsResponse is the response type for the Query/InterchainAccountPorts RPC method.
+type QueryInterchainAccountPortsResponse struct {
+	// list of ports bound by the controller submodule
+	Ports []InterchainAccountPort `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountPortsResponse) Reset()         { *m = QueryInterchainAccountPortsResponse{} }
+func (m *QueryInterchainAccountPortsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountPortsResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountPortsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{7}
+}
+func (m *QueryInterchainAccountPortsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountPortsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountPortsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryInterchainAccountPortsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountPortsResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountPortsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountPortsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountPortsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryInterchainAccountPortsResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountPortsResponse) GetPorts() []InterchainAccountPort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryInterchainAccountPortsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// InterchainAccountPort defines a port bound by the controller submodule together with its active channel
+// and the associated interchain account address.
+type InterchainAccountPort struct {
+	// controller port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// active channel identifier, empty if the port has no active channel
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// active is true if the port has an active channel
+	Active bool `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// interchain account address, empty if no interchain account is registered for the port
+	AccountAddress string `protobuf:"bytes,4,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty" yaml:"account_address"`
+	// human-readable label of the interchain account, empty if no label is set
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *InterchainAccountPort) Reset()         { *m = InterchainAccountPort{} }
+func (m *InterchainAccountPort) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountPort) ProtoMessage()    {}
+func (*InterchainAccountPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{8}
+}
+func (m *InterchainAccountPort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountPort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *InterchainAccountPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountPort.Merge(m, src)
+}
+func (m *InterchainAccountPort) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountPort.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_InterchainAccountPort proto.InternalMessageInfo
+
+func (m *InterchainAccountPort) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *InterchainAccountPort) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InterchainAccountPort) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *InterchainAccountPort) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *InterchainAccountPort) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// QueryInterchainAccountConnectionRequest is the request type for the Query/InterchainAccountConnection RPC method.
+type QueryInterchainAccountConnectionRequest struct {
+	// controller port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+}
+
+func (m *QueryInterchainAccountConnectionRequest) Reset() {
+	*m = QueryInterchainAccountConnectionRequest{}
+}
+func (m *QueryInterchainAccountConnectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountConnectionRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountConnectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{9}
+}
+func (m *QueryInterchainAccountConnectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountConnectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountConnectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountConnectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountConnectionRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountConnectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountConnectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountConnectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountConnectionRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountConnectionRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryInterchainAccountConnectionResponse is the response type for the Query/InterchainAccountConnection RPC method.
+type QueryInterchainAccountConnectionResponse struct {
+	// connection identifier of the active channel
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *QueryInterchainAccountConnectionResponse) Reset() {
+	*m = QueryInterchainAccountConnectionResponse{}
+}
+func (m *QueryInterchainAccountConnectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountConnectionResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{10}
+}
+func (m *QueryInterchainAccountConnectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountConnectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountConnectionResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountConnectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountConnectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountConnectionResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountConnectionResponse) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryInterchainAccountCounterpartyRequest is the request type for the Query/InterchainAccountCounterparty RPC
+// method.
+type QueryInterchainAccountCounterpartyRequest struct {
+	// controller port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+}
+
+func (m *QueryInterchainAccountCounterpartyRequest) Reset() {
+	*m = QueryInterchainAccountCounterpartyRequest{}
+}
+func (m *QueryInterchainAccountCounterpartyRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInterchainAccountCounterpartyRequest) ProtoMessage() {}
+func (*QueryInterchainAccountCounterpartyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{11}
+}
+func (m *QueryInterchainAccountCounterpartyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountCounterpartyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountCounterpartyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountCounterpartyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountCounterpartyRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountCounterpartyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountCounterpartyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountCounterpartyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountCounterpartyRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountCounterpartyRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryInterchainAccountCounterpartyResponse is the response type for the Query/InterchainAccountCounterparty RPC
+// method.
+type QueryInterchainAccountCounterpartyResponse struct {
+	// active channel identifier on the controller chain
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// counterparty port identifier on the host chain
+	CounterpartyPortId string `protobuf:"bytes,2,opt,name=counterparty_port_id,json=counterpartyPortId,proto3" json:"counterparty_port_id,omitempty" yaml:"counterparty_port_id"`
+	// counterparty channel identifier on the host chain
+	CounterpartyChannelId string `protobuf:"bytes,3,opt,name=counterparty_channel_id,json=counterpartyChannelId,proto3" json:"counterparty_channel_id,omitempty" yaml:"counterparty_channel_id"`
+}
+
+func (m *QueryInterchainAccountCounterpartyResponse) Reset() {
+	*m = QueryInterchainAccountCounterpartyResponse{}
+}
+func (m *QueryInterchainAccountCounterpartyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInterchainAccountCounterpartyResponse) ProtoMessage() {}
+func (*QueryInterchainAccountCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{12}
+}
+func (m *QueryInterchainAccountCounterpartyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountCounterpartyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountCounterpartyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountCounterpartyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountCounterpartyResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountCounterpartyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountCounterpartyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountCounterpartyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountCounterpartyResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountCounterpartyResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountCounterpartyResponse) GetCounterpartyPortId() string {
+	if m != nil {
+		return m.CounterpartyPortId
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountCounterpartyResponse) GetCounterpartyChannelId() string {
+	if m != nil {
+		return m.CounterpartyChannelId
+	}
+	return ""
+}
+
+// QuerySendQueueDepthRequest is the request type for the Query/SendQueueDepth RPC method.
+type QuerySendQueueDepthRequest struct {
+	// controller port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+}
+
+func (m *QuerySendQueueDepthRequest) Reset()         { *m = QuerySendQueueDepthRequest{} }
+func (m *QuerySendQueueDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySendQueueDepthRequest) ProtoMessage()    {}
+func (*QuerySendQueueDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{13}
+}
+func (m *QuerySendQueueDepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendQueueDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendQueueDepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendQueueDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendQueueDepthRequest.Merge(m, src)
+}
+func (m *QuerySendQueueDepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendQueueDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendQueueDepthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendQueueDepthRequest proto.InternalMessageInfo
+
+func (m *QuerySendQueueDepthRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QuerySendQueueDepthResponse is the response type for the Query/SendQueueDepth RPC method.
+type QuerySendQueueDepthResponse struct {
+	// number of packets held by the send queue
+	Depth uint64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *QuerySendQueueDepthResponse) Reset()         { *m = QuerySendQueueDepthResponse{} }
+func (m *QuerySendQueueDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySendQueueDepthResponse) ProtoMessage()    {}
+func (*QuerySendQueueDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{14}
+}
+func (m *QuerySendQueueDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendQueueDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendQueueDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendQueueDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendQueueDepthResponse.Merge(m, src)
+}
+func (m *QuerySendQueueDepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendQueueDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendQueueDepthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendQueueDepthResponse proto.InternalMessageInfo
+
+func (m *QuerySendQueueDepthResponse) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+// QueryPacketTimeoutRequest is the request type for the Query/PacketTimeout RPC method.
+type QueryPacketTimeoutRequest struct {
+	// controller port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryPacketTimeoutRequest) Reset()         { *m = QueryPacketTimeoutRequest{} }
+func (m *QueryPacketTimeoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketTimeoutRequest) ProtoMessage()    {}
+func (*QueryPacketTimeoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{15}
+}
+func (m *QueryPacketTimeoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketTimeoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketTimeoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketTimeoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketTimeoutRequest.Merge(m, src)
+}
+func (m *QueryPacketTimeoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketTimeoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketTimeoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketTimeoutRequest proto.InternalMessageInfo
+
+func (m *QueryPacketTimeoutRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPacketTimeoutRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryPacketTimeoutResponse is the response type for the Query/PacketTimeout RPC method.
+type QueryPacketTimeoutResponse struct {
+	// block height after which the packet times out
+	TimeoutHeight types.Height `protobuf:"bytes,1,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// block timestamp (in nanoseconds) after which the packet times out
+	TimeoutTimestamp uint64 `protobuf:"varint,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *QueryPacketTimeoutResponse) Reset()         { *m = QueryPacketTimeoutResponse{} }
+func (m *QueryPacketTimeoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketTimeoutResponse) ProtoMessage()    {}
+func (*QueryPacketTimeoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{16}
+}
+func (m *QueryPacketTimeoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketTimeoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketTimeoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketTimeoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketTimeoutResponse.Merge(m, src)
+}
+func (m *QueryPacketTimeoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketTimeoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketTimeoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketTimeoutResponse proto.InternalMessageInfo
+
+func (m *QueryPacketTimeoutResponse) GetTimeoutHeight() types.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types.Height{}
+}
+
+func (m *QueryPacketTimeoutResponse) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+// QueryInterchainAccountPortsByConnectionRequest is the request type for the Query/InterchainAccountPortsByConnection
+// RPC method.
+type QueryInterchainAccountPortsByConnectionRequest struct {
+}
+
+func (m *QueryInterchainAccountPortsByConnectionRequest) Reset() {
+	*m = QueryInterchainAccountPortsByConnectionRequest{}
+}
+func (m *QueryInterchainAccountPortsByConnectionRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInterchainAccountPortsByConnectionRequest) ProtoMessage() {}
+func (*QueryInterchainAccountPortsByConnectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{17}
+}
+func (m *QueryInterchainAccountPortsByConnectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountPortsByConnectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountPortsByConnectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountPortsByConnectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountPortsByConnectionRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountPortsByConnectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountPortsByConnectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountPortsByConnectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountPortsByConnectionRequest proto.InternalMessageInfo
+
+// QueryInterchainAccountPortsByConnectionResponse is the response type for the Query/InterchainAccountPortsByConnection
+// RPC method.
+type QueryInterchainAccountPortsByConnectionResponse struct {
+	// list of connections and the ports bound by the controller submodule whose active channel runs on them, ordered by
+	// connection identifier with the unassigned group last
+	Connections []ConnectionPorts `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections"`
+}
+
+func (m *QueryInterchainAccountPortsByConnectionResponse) Reset() {
+	*m = QueryInterchainAccountPortsByConnectionResponse{}
+}
+func (m *QueryInterchainAccountPortsByConnectionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInterchainAccountPortsByConnectionResponse) ProtoMessage() {}
+func (*QueryInterchainAccountPortsByConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{18}
+}
+func (m *QueryInterchainAccountPortsByConnectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountPortsByConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountPortsByConnectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountPortsByConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountPortsByConnectionResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountPortsByConnectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountPortsByConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountPortsByConnectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountPortsByConnectionResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountPortsByConnectionResponse) GetConnections() []ConnectionPorts {
+	if m != nil {
+		return m.Connections
+	}
+	return nil
+}
+
+// ConnectionPorts defines the ports bound by the controller submodule whose active channel runs on a connection.
+type ConnectionPorts struct {
+	// connection identifier, "unassigned" for the group of ports without an active channel
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// controller port identifiers
+	PortIds []string `protobuf:"bytes,2,rep,name=port_ids,json=portIds,proto3" json:"port_ids,omitempty" yaml:"port_ids"`
+}
+
+func (m *ConnectionPorts) Reset()         { *m = ConnectionPorts{} }
+func (m *ConnectionPorts) String() string { return proto.CompactTextString(m) }
+func (*ConnectionPorts) ProtoMessage()    {}
+func (*ConnectionPorts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{19}
+}
+func (m *ConnectionPorts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionPorts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionPorts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionPorts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionPorts.Merge(m, src)
+}
+func (m *ConnectionPorts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionPorts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionPorts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionPorts proto.InternalMessageInfo
+
+func (m *ConnectionPorts) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionPorts) GetPortIds() []string {
+	if m != nil {
+		return m.PortIds
+	}
+	return nil
+}
+
+// QueryPendingPacketsRequest is the request type for the Query/PendingPackets RPC method.
+type QueryPendingPacketsRequest struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+}
+
+func (m *QueryPendingPacketsRequest) Reset()         { *m = QueryPendingPacketsRequest{} }
+func (m *QueryPendingPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{20}
+}
+func (m *QueryPendingPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryPendingPacketsResponse is the response type for the Query/PendingPackets RPC method.
+type QueryPendingPacketsResponse struct {
+	// active channel of the port over which the pending packets were sent
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// pending packets ordered by sequence
+	Packets []PendingPacket `protobuf:"bytes,2,rep,name=packets,proto3" json:"packets"`
+	// highest priority of the pending packets
+	MaxPriority uint32 `protobuf:"varint,3,opt,name=max_priority,json=maxPriority,proto3" json:"max_priority,omitempty" yaml:"max_priority"`
+}
+
+func (m *QueryPendingPacketsResponse) Reset()         { *m = QueryPendingPacketsResponse{} }
+func (m *QueryPendingPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsResponse) ProtoMessage()    {}
+func (*QueryPendingPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{21}
+}
+func (m *QueryPendingPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPendingPacketsResponse) GetPackets() []PendingPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryPendingPacketsResponse) GetMaxPriority() uint32 {
+	if m != nil {
+		return m.MaxPriority
+	}
+	return 0
+}
+
+// PendingPacket defines a packet sent over the active channel of an interchain account which has not yet been
+// acknowledged
+type PendingPacket struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// advisory priority of the packet, 0 if unspecified or if the packet data was not retained
+	Priority uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *PendingPacket) Reset()         { *m = PendingPacket{} }
+func (m *PendingPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingPacket) ProtoMessage()    {}
+func (*PendingPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{22}
+}
+func (m *PendingPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPacket.Merge(m, src)
+}
+func (m *PendingPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPacket proto.InternalMessageInfo
+
+func (m *PendingPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingPacket) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// QueryPendingUnbondingsRequest is the request type for the Query/PendingUnbondings RPC method.
+type QueryPendingUnbondingsRequest struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+}
+
+func (m *QueryPendingUnbondingsRequest) Reset()         { *m = QueryPendingUnbondingsRequest{} }
+func (m *QueryPendingUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingUnbondingsRequest) ProtoMessage()    {}
+func (*QueryPendingUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{23}
+}
+func (m *QueryPendingUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryPendingUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingUnbondingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingUnbondingsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingUnbondingsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryPendingUnbondingsResponse is the response type for the Query/PendingUnbondings RPC method.
+type QueryPendingUnbondingsResponse struct {
+	// pending unbondings ordered by channel identifier and packet sequence
+	Unbondings []Unbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
+}
+
+func (m *QueryPendingUnbondingsResponse) Reset()         { *m = QueryPendingUnbondingsResponse{} }
+func (m *QueryPendingUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingUnbondingsResponse) ProtoMessage()    {}
+func (*QueryPendingUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{24}
+}
+func (m *QueryPendingUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingUnbondingsResponse.Merge(m, src)
+}
+func (m *QueryPendingUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingUnbondingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingUnbondingsResponse) GetUnbondings() []Unbonding {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+// QueryStaleInterchainAccountsRequest is the request type for the Query/StaleInterchainAccounts RPC method.
+type QueryStaleInterchainAccountsRequest struct {
+	// number of blocks without activity after which an interchain account is considered stale
+	InactiveBlocks uint64 `protobuf:"varint,1,opt,name=inactive_blocks,json=inactiveBlocks,proto3" json:"inactive_blocks,omitempty" yaml:"inactive_blocks"`
+}
+
+func (m *QueryStaleInterchainAccountsRequest) Reset()         { *m = QueryStaleInterchainAccountsRequest{} }
+func (m *QueryStaleInterchainAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStaleInterchainAccountsRequest) ProtoMessage()    {}
+func (*QueryStaleInterchainAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{25}
+}
+func (m *QueryStaleInterchainAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleInterchainAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleInterchainAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStaleInterchainAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleInterchainAccountsRequest.Merge(m, src)
+}
+func (m *QueryStaleInterchainAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleInterchainAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleInterchainAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStaleInterchainAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryStaleInterchainAccountsRequest) GetInactiveBlocks() uint64 {
+	if m != nil {
+		return m.InactiveBlocks
+	}
+	return 0
+}
+
+// QueryStaleInterchainAccountsResponse is the response type for the Query/StaleInterchainAccounts RPC method.
+type QueryStaleInterchainAccountsResponse struct {
+	// stale interchain accounts ordered by port identifier
+	Accounts []StaleInterchainAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+}
+
+func (m *QueryStaleInterchainAccountsResponse) Reset()         { *m = QueryStaleInterchainAccountsResponse{} }
+func (m *QueryStaleInterchainAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStaleInterchainAccountsResponse) ProtoMessage()    {}
+func (*QueryStaleInterchainAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{26}
+}
+func (m *QueryStaleInterchainAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleInterchainAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleInterchainAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStaleInterchainAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleInterchainAccountsResponse.Merge(m, src)
+}
+func (m *QueryStaleInterchainAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleInterchainAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleInterchainAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStaleInterchainAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryStaleInterchainAccountsResponse) GetAccounts() []StaleInterchainAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// StaleInterchainAccount defines an interchain account whose active channel has had no recent packet activity
+type StaleInterchainAccount struct {
+	// controller port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// active channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// interchain account address
+	AccountAddress string `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty" yaml:"account_address"`
+	// block height of the last activity on the active channel, 0 if no activity was recorded
+	LastActivityHeight uint64 `protobuf:"varint,4,opt,name=last_activity_height,json=lastActivityHeight,proto3" json:"last_activity_height,omitempty" yaml:"last_activity_height"`
+}
+
+func (m *StaleInterchainAccount) Reset()         { *m = StaleInterchainAccount{} }
+func (m *StaleInterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*StaleInterchainAccount) ProtoMessage()    {}
+func (*StaleInterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{27}
+}
+func (m *StaleInterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StaleInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StaleInterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StaleInterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleInterchainAccount.Merge(m, src)
+}
+func (m *StaleInterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *StaleInterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleInterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleInterchainAccount proto.InternalMessageInfo
+
+func (m *StaleInterchainAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *StaleInterchainAccount) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *StaleInterchainAccount) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *StaleInterchainAccount) GetLastActivityHeight() uint64 {
+	if m != nil {
+		return m.LastActivityHeight
+	}
+	return 0
+}
+
+// QueryNextChannelIDRequest is the request type for the Query/NextChannelID RPC method.
+type QueryNextChannelIDRequest struct {
+	// owner address of the interchain account
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier on which the interchain account is to be registered
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *QueryNextChannelIDRequest) Reset()         { *m = QueryNextChannelIDRequest{} }
+func (m *QueryNextChannelIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextChannelIDRequest) ProtoMessage()    {}
+func (*QueryNextChannelIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{28}
+}
+func (m *QueryNextChannelIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextChannelIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextChannelIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextChannelIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextChannelIDRequest.Merge(m, src)
+}
+func (m *QueryNextChannelIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextChannelIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextChannelIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextChannelIDRequest proto.InternalMessageInfo
+
+func (m *QueryNextChannelIDRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryNextChannelIDRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryNextChannelIDResponse is the response type for the Query/NextChannelID RPC method.
+type QueryNextChannelIDResponse struct {
+	// controller port identifier of the interchain account
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel identifier to be allocated to the next channel, subject to channels opened in the meantime
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *QueryNextChannelIDResponse) Reset()         { *m = QueryNextChannelIDResponse{} }
+func (m *QueryNextChannelIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextChannelIDResponse) ProtoMessage()    {}
+func (*QueryNextChannelIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{29}
+}
+func (m *QueryNextChannelIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextChannelIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextChannelIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextChannelIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextChannelIDResponse.Merge(m, src)
+}
+func (m *QueryNextChannelIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextChannelIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextChannelIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextChannelIDResponse proto.InternalMessageInfo
+
+func (m *QueryNextChannelIDResponse) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryNextChannelIDResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryInterchainAccountClientStatusRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountClientStatusRequest")
+	proto.RegisterType((*QueryInterchainAccountClientStatusResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountClientStatusResponse")
+	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest")
+	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountResponse")
+	proto.RegisterType((*QueryInterchainAccountPortsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountPortsRequest")
+	proto.RegisterType((*QueryInterchainAccountPortsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountPortsResponse")
+	proto.RegisterType((*InterchainAccountPort)(nil), "ibc.applications.interchain_accounts.controller.v1.InterchainAccountPort")
+	proto.RegisterType((*QueryInterchainAccountConnectionRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountConnectionRequest")
+	proto.RegisterType((*QueryInterchainAccountConnectionResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountConnectionResponse")
+	proto.RegisterType((*QueryInterchainAccountCounterpartyRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountCounterpartyRequest")
+	proto.RegisterType((*QueryInterchainAccountCounterpartyResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountCounterpartyResponse")
+	proto.RegisterType((*QuerySendQueueDepthRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QuerySendQueueDepthRequest")
+	proto.RegisterType((*QuerySendQueueDepthResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QuerySendQueueDepthResponse")
+	proto.RegisterType((*QueryPacketTimeoutRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryPacketTimeoutRequest")
+	proto.RegisterType((*QueryPacketTimeoutResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryPacketTimeoutResponse")
+	proto.RegisterType((*QueryInterchainAccountPortsByConnectionRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountPortsByConnectionRequest")
+	proto.RegisterType((*QueryInterchainAccountPortsByConnectionResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountPortsByConnectionResponse")
+	proto.RegisterType((*ConnectionPorts)(nil), "ibc.applications.interchain_accounts.controller.v1.ConnectionPorts")
+	proto.RegisterType((*QueryPendingPacketsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryPendingPacketsRequest")
+	proto.RegisterType((*QueryPendingPacketsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryPendingPacketsResponse")
+	proto.RegisterType((*PendingPacket)(nil), "ibc.applications.interchain_accounts.controller.v1.PendingPacket")
+	proto.RegisterType((*QueryPendingUnbondingsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryPendingUnbondingsRequest")
+	proto.RegisterType((*QueryPendingUnbondingsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryPendingUnbondingsResponse")
+	proto.RegisterType((*QueryStaleInterchainAccountsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryStaleInterchainAccountsRequest")
+	proto.RegisterType((*QueryStaleInterchainAccountsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryStaleInterchainAccountsResponse")
+	proto.RegisterType((*StaleInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.StaleInterchainAccount")
+	proto.RegisterType((*QueryNextChannelIDRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryNextChannelIDRequest")
+	proto.RegisterType((*QueryNextChannelIDResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryNextChannelIDResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/controller/v1/query.proto", fileDescriptor_df0d8b259d72854e)
+}
+
+var fileDescriptor_df0d8b259d72854e = []byte{
+	// 1800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x19, 0xdb, 0x71, 0x9e, 0x23, 0x67, 0x33, 0x51, 0x1c, 0x95, 0x49, 0xa4, 0x60, 0x5a,
+	0x74, 0xdd, 0x2d, 0x42, 0xd6, 0xf6, 0x02, 0x8b, 0x1a, 0xd8, 0x16, 0x96, 0xb7, 0x71, 0xb4, 0xdd,
+	0x78, 0x65, 0xda, 0x75, 0x83, 0x6d, 0x37, 0x2a, 0x45, 0xcd, 0xca, 0xdc, 0x50, 0x24, 0x23, 0x52,
+	0xae, 0x05, 0x23, 0x68, 0x51, 0xf4, 0xe3, 0xd6, 0xaf, 0xed, 0xa9, 0x05, 0xf6, 0xdc, 0x63, 0x4f,
+	0xfd, 0x1b, 0xb6, 0x40, 0x0f, 0x0b, 0x14, 0x0b, 0xf4, 0x24, 0xb4, 0x49, 0xaf, 0xbd, 0xe8, 0x2f,
+	0x28, 0x38, 0xf3, 0x28, 0x91, 0x16, 0xfd, 0xa1, 0xaf, 0x5e, 0x6c, 0xce, 0xcc, 0x9b, 0xdf, 0x7b,
+	0xef, 0x37, 0xef, 0x3d, 0xce, 0xa3, 0xe0, 0x5b, 0x56, 0xd5, 0xd4, 0x0c, 0xcf, 0xb3, 0x2d, 0xd3,
+	0x08, 0x2c, 0xd7, 0xf1, 0x35, 0xcb, 0x09, 0x58, 0xd3, 0x3c, 0x30, 0x2c, 0xa7, 0x62, 0x98, 0xa6,
+	0xdb, 0x72, 0x02, 0x5f, 0x33, 0x5d, 0x27, 0x68, 0xba, 0xb6, 0xcd, 0x9a, 0xda, 0xe1, 0x8a, 0xf6,
+	0xbc, 0xc5, 0x9a, 0x6d, 0xd5, 0x6b, 0xba, 0x81, 0x4b, 0x56, 0xad, 0xaa, 0xa9, 0xc6, 0xf7, 0xab,
+	0x29, 0xfb, 0xd5, 0xfe, 0x7e, 0xf5, 0x70, 0x45, 0xc9, 0xd6, 0xdd, 0xba, 0xcb, 0xb7, 0x6b, 0xe1,
+	0x93, 0x40, 0x52, 0xde, 0x30, 0x5d, 0xbf, 0xe1, 0xfa, 0x5a, 0xd5, 0xf0, 0x99, 0x50, 0xa1, 0x1d,
+	0xae, 0x54, 0x59, 0x60, 0xac, 0x68, 0x9e, 0x51, 0xb7, 0x1c, 0x0e, 0x8f, 0xb2, 0x9b, 0x23, 0x58,
+	0xdd, 0x1f, 0x21, 0x48, 0x21, 0x04, 0x31, 0xdd, 0x26, 0xd3, 0x4c, 0xdb, 0x62, 0x4e, 0xc0, 0x85,
+	0xf8, 0x13, 0x0a, 0xdc, 0xad, 0xbb, 0x6e, 0xdd, 0x66, 0x9a, 0xe1, 0x59, 0x9a, 0xe1, 0x38, 0x6e,
+	0x80, 0x1e, 0xf2, 0x55, 0x9a, 0x05, 0xb2, 0x13, 0x5a, 0x59, 0x36, 0x9a, 0x46, 0xc3, 0xd7, 0xd9,
+	0xf3, 0x16, 0xf3, 0x03, 0x6a, 0xc1, 0xcd, 0xc4, 0xac, 0xef, 0xb9, 0x8e, 0xcf, 0x88, 0x0e, 0x73,
+	0x1e, 0x9f, 0xc9, 0x49, 0xf7, 0xa5, 0xe5, 0x85, 0xd5, 0x75, 0x75, 0x78, 0xde, 0x54, 0xc4, 0x44,
+	0x24, 0xfa, 0x53, 0x09, 0xbe, 0xc6, 0x75, 0x95, 0x7a, 0x3b, 0x37, 0xc4, 0xc6, 0x4d, 0xee, 0xc5,
+	0x6e, 0x60, 0x04, 0xad, 0xc8, 0x30, 0x92, 0x85, 0x59, 0xf7, 0xc7, 0x0e, 0x6b, 0x72, 0x03, 0xae,
+	0xea, 0x62, 0x40, 0xde, 0x86, 0x8c, 0xe9, 0x3a, 0x0e, 0x33, 0x43, 0x1b, 0x2a, 0x56, 0x2d, 0x27,
+	0x87, 0xab, 0xc5, 0x5c, 0xb7, 0x53, 0xc8, 0xb6, 0x8d, 0x86, 0xbd, 0x4e, 0x13, 0xcb, 0x54, 0xbf,
+	0xd6, 0x1f, 0x97, 0x6a, 0xf4, 0x37, 0x32, 0xbc, 0x71, 0x11, 0x13, 0x90, 0x85, 0x15, 0xb8, 0x2a,
+	0x08, 0x0e, 0x35, 0x71, 0x3b, 0x8a, 0xd9, 0x6e, 0xa7, 0xf0, 0x1a, 0x6a, 0x8a, 0x96, 0xa8, 0x3e,
+	0x2f, 0x9e, 0x4b, 0x35, 0xf2, 0x16, 0x2c, 0xe0, 0x7c, 0xd0, 0xf6, 0x18, 0x9a, 0xb7, 0xd4, 0xed,
+	0x14, 0x48, 0x62, 0x53, 0xb8, 0x48, 0x75, 0x10, 0xa3, 0xbd, 0xb6, 0xc7, 0xc8, 0x12, 0xcc, 0xf9,
+	0x5c, 0x7b, 0xee, 0x32, 0x77, 0x18, 0x47, 0xe4, 0x43, 0xc8, 0xd8, 0x46, 0xc0, 0xfc, 0xa0, 0x72,
+	0xc0, 0xac, 0xfa, 0x41, 0x90, 0x9b, 0xe1, 0x07, 0xa2, 0xf0, 0x03, 0x09, 0xa3, 0x41, 0xc5, 0x18,
+	0x38, 0x5c, 0x51, 0x1f, 0x71, 0x89, 0xe2, 0xdd, 0xcf, 0x3a, 0x85, 0x4b, 0x7d, 0x46, 0x12, 0xdb,
+	0xa9, 0x7e, 0x4d, 0x8c, 0x85, 0x2c, 0x0d, 0xe0, 0x5e, 0x3a, 0x21, 0x53, 0x3d, 0x87, 0x75, 0xc8,
+	0x9f, 0xa6, 0x15, 0xa9, 0xcf, 0xc1, 0x15, 0xa3, 0x56, 0x6b, 0x32, 0xdf, 0x47, 0xc5, 0xd1, 0x90,
+	0xda, 0x40, 0xd3, 0xf7, 0x96, 0xdd, 0x66, 0xd0, 0x0b, 0x9f, 0x87, 0x00, 0xfd, 0x2c, 0xc4, 0x20,
+	0xfe, 0xaa, 0x2a, 0x52, 0x56, 0x0d, 0x53, 0x56, 0x15, 0x55, 0x01, 0x53, 0x56, 0x2d, 0x1b, 0x75,
+	0x86, 0x7b, 0xf5, 0xd8, 0x4e, 0xfa, 0x85, 0x04, 0x5f, 0x3e, 0x53, 0x1d, 0xda, 0xcb, 0x60, 0xd6,
+	0x0b, 0x27, 0x72, 0xd2, 0xfd, 0xcb, 0xcb, 0x0b, 0xab, 0xa5, 0x51, 0xf2, 0x25, 0x55, 0x45, 0x71,
+	0x26, 0x3c, 0x4d, 0x5d, 0xa0, 0x93, 0xad, 0x84, 0x5b, 0x32, 0x77, 0xeb, 0xf5, 0x73, 0xdd, 0x12,
+	0x36, 0x26, 0xfc, 0xfa, 0xaf, 0x04, 0xb7, 0x52, 0xf5, 0x91, 0xaf, 0xc3, 0x95, 0x50, 0x57, 0x3f,
+	0xe4, 0x49, 0xb7, 0x53, 0x58, 0x14, 0x87, 0x8a, 0x0b, 0x54, 0x9f, 0x0b, 0x9f, 0x4a, 0x35, 0xf2,
+	0x26, 0x80, 0x79, 0x60, 0x38, 0x0e, 0xb3, 0xfb, 0x41, 0x70, 0xab, 0xdb, 0x29, 0xdc, 0x10, 0xf2,
+	0xfd, 0x35, 0xaa, 0x5f, 0xc5, 0x41, 0xa9, 0x16, 0xc6, 0xba, 0x61, 0x06, 0xd6, 0x21, 0xe3, 0xb1,
+	0x3e, 0xaf, 0xe3, 0x88, 0x6c, 0xc2, 0x75, 0xa4, 0xa6, 0x12, 0x1d, 0xfe, 0x0c, 0x87, 0x54, 0xba,
+	0x9d, 0xc2, 0x92, 0x80, 0x3c, 0x21, 0x40, 0xf5, 0x45, 0x9c, 0xd9, 0x10, 0x13, 0x61, 0xc0, 0xda,
+	0x46, 0x95, 0xd9, 0xb9, 0x59, 0x11, 0xb0, 0x7c, 0x40, 0xf7, 0xe1, 0xf5, 0x53, 0x12, 0xbf, 0x17,
+	0x97, 0x51, 0xe8, 0x0c, 0x43, 0x00, 0xb5, 0x60, 0xf9, 0x7c, 0x5c, 0x8c, 0x91, 0x81, 0xa4, 0x91,
+	0x86, 0x4a, 0x9a, 0x27, 0xa7, 0x96, 0xcf, 0xf0, 0x0f, 0x6b, 0x7a, 0x46, 0x33, 0x68, 0x8f, 0xe4,
+	0xc4, 0xef, 0x4e, 0x2f, 0x8b, 0x09, 0x68, 0xf4, 0x23, 0x79, 0xe8, 0xd2, 0x05, 0x0f, 0x7d, 0x07,
+	0xb2, 0x66, 0x0c, 0xad, 0x12, 0x99, 0x27, 0x82, 0xa6, 0xd0, 0xed, 0x14, 0xee, 0x44, 0x24, 0x0c,
+	0x4a, 0x51, 0x9d, 0xc4, 0xa7, 0xcb, 0x22, 0xfa, 0x3e, 0x80, 0xdb, 0x09, 0xe1, 0x98, 0x55, 0xbc,
+	0x88, 0x16, 0x69, 0xb7, 0x53, 0xc8, 0xa7, 0xa0, 0xc6, 0x4d, 0xbc, 0x15, 0x5f, 0xd9, 0x8c, 0xcc,
+	0xa5, 0x25, 0x50, 0x38, 0x25, 0xbb, 0xcc, 0xa9, 0xed, 0xb4, 0x58, 0x8b, 0xbd, 0xc3, 0xbc, 0xe0,
+	0x60, 0x24, 0x7a, 0xd7, 0xe0, 0x4e, 0x2a, 0x14, 0xd2, 0x99, 0x85, 0xd9, 0x5a, 0x38, 0xc1, 0x91,
+	0x66, 0x74, 0x31, 0xa0, 0x35, 0xf8, 0x12, 0xbe, 0x98, 0xcd, 0x67, 0x2c, 0xd8, 0xb3, 0x1a, 0xcc,
+	0x6d, 0x05, 0xa3, 0xa8, 0x27, 0x0a, 0xcc, 0xfb, 0xe1, 0x3e, 0xc7, 0x14, 0xef, 0xa3, 0x19, 0xbd,
+	0x37, 0xa6, 0x7f, 0x93, 0x40, 0x49, 0x53, 0x83, 0xa6, 0xfd, 0x08, 0x16, 0x03, 0x31, 0x15, 0xbd,
+	0x7d, 0xa4, 0x73, 0xdf, 0x3e, 0xf7, 0xf0, 0xed, 0x73, 0x4b, 0x98, 0x93, 0xdc, 0x4f, 0xf5, 0x0c,
+	0x4e, 0x08, 0x69, 0x52, 0x82, 0x1b, 0x91, 0x44, 0xf8, 0xdf, 0x0f, 0x8c, 0x86, 0x27, 0xac, 0x2c,
+	0xde, 0xed, 0x76, 0x0a, 0xb9, 0x24, 0x48, 0x4f, 0x84, 0xea, 0xaf, 0xe1, 0xdc, 0x5e, 0x6f, 0xea,
+	0x1b, 0xa0, 0x9e, 0x51, 0xa9, 0x8b, 0xed, 0x81, 0x4c, 0xa7, 0x9f, 0x4a, 0xa0, 0x5d, 0x78, 0x0b,
+	0x52, 0xf2, 0x0c, 0x16, 0xfa, 0x59, 0x19, 0x95, 0xfb, 0xcd, 0x51, 0xca, 0x7d, 0x1f, 0x5c, 0x68,
+	0x13, 0x85, 0x3e, 0x8e, 0x1e, 0x5e, 0x99, 0xae, 0x9f, 0x10, 0x1b, 0xb3, 0x8a, 0x10, 0x15, 0xe6,
+	0x31, 0x42, 0xfc, 0x9c, 0x7c, 0xff, 0xf2, 0xf2, 0xd5, 0xe2, 0xcd, 0x6e, 0xa7, 0x70, 0x3d, 0x11,
+	0x3b, 0x3e, 0xd5, 0xaf, 0x88, 0xe0, 0xf1, 0x7b, 0x79, 0x50, 0x66, 0x4e, 0xcd, 0x72, 0xea, 0x22,
+	0x4e, 0xfc, 0x91, 0xf2, 0xa0, 0x2b, 0xc1, 0x9d, 0x54, 0xac, 0xb1, 0xea, 0x8a, 0x01, 0x57, 0x3c,
+	0x01, 0xc4, 0xfd, 0x59, 0x58, 0xdd, 0x18, 0xe9, 0xae, 0x1a, 0x37, 0x09, 0x8f, 0x22, 0xc2, 0x25,
+	0xeb, 0x70, 0xad, 0x61, 0x1c, 0x55, 0xbc, 0xa6, 0xe5, 0x36, 0xad, 0xa0, 0xcd, 0x8b, 0x4b, 0xa6,
+	0x78, 0xbb, 0xdb, 0x29, 0xdc, 0x14, 0xa6, 0xc5, 0x57, 0xa9, 0xbe, 0xd0, 0x30, 0x8e, 0xca, 0xd1,
+	0x68, 0x0b, 0x32, 0x09, 0xec, 0x44, 0x3a, 0x4a, 0xc9, 0x74, 0x0c, 0xd7, 0x7a, 0x4a, 0xc2, 0x24,
+	0xc8, 0xe8, 0xbd, 0x31, 0x7d, 0x0f, 0x6f, 0x6a, 0x88, 0xf6, 0x3d, 0xa7, 0xea, 0xf2, 0x87, 0xd1,
+	0xce, 0xe2, 0x17, 0x12, 0xe4, 0x4f, 0x83, 0xc3, 0xe3, 0x30, 0x01, 0x5a, 0xbd, 0x59, 0x0c, 0xf4,
+	0xb7, 0x47, 0xe1, 0xb6, 0x87, 0x8d, 0xbc, 0xc6, 0x60, 0xe9, 0xc7, 0x78, 0xbd, 0xda, 0x0d, 0x0c,
+	0x9b, 0x0d, 0xa4, 0x61, 0xcf, 0xb7, 0x4d, 0xb8, 0x6e, 0x39, 0xe2, 0x96, 0x50, 0xa9, 0xda, 0xae,
+	0xf9, 0x4c, 0x5c, 0x0b, 0x67, 0xe2, 0x37, 0x83, 0x13, 0x02, 0x54, 0x5f, 0x8c, 0x66, 0x8a, 0x62,
+	0xe2, 0x0f, 0x12, 0x7c, 0xe5, 0x6c, 0x65, 0xe8, 0xb9, 0x0d, 0xf3, 0x91, 0x2b, 0xe8, 0xf7, 0xbb,
+	0xa3, 0xf8, 0x9d, 0xae, 0x06, 0x49, 0xe8, 0x69, 0xa0, 0x9f, 0xc8, 0xb0, 0x94, 0x2e, 0xfa, 0xff,
+	0xb8, 0x8b, 0xa5, 0xdc, 0xb9, 0x2e, 0x0f, 0x7d, 0xe7, 0xda, 0x81, 0xac, 0x6d, 0xf8, 0x41, 0x85,
+	0xd3, 0x6d, 0x05, 0xed, 0x78, 0xaf, 0x32, 0x13, 0x7f, 0xb7, 0xa7, 0x49, 0x51, 0x9d, 0x84, 0xd3,
+	0x1b, 0x38, 0x8b, 0x8d, 0x89, 0x87, 0xef, 0xbf, 0x6d, 0x76, 0x14, 0x44, 0x6f, 0xe5, 0x77, 0xa6,
+	0xda, 0x94, 0xfc, 0x04, 0x94, 0x34, 0x8d, 0x18, 0x13, 0xd3, 0x3f, 0x8a, 0xd5, 0x7f, 0xdf, 0x85,
+	0x59, 0x6e, 0x01, 0xf9, 0x42, 0x82, 0x39, 0xd1, 0x3d, 0x93, 0x87, 0xa3, 0x44, 0xde, 0x60, 0xa3,
+	0xaf, 0x6c, 0x8d, 0x8d, 0x23, 0x88, 0xa0, 0xeb, 0x3f, 0xfb, 0xc7, 0x7f, 0x3e, 0x91, 0xdf, 0x24,
+	0xab, 0x1a, 0x7e, 0xd4, 0xb8, 0xc8, 0xc7, 0x0c, 0xf1, 0x09, 0x80, 0xfc, 0x5d, 0x86, 0x7b, 0x67,
+	0xb6, 0xde, 0xe4, 0xc3, 0x91, 0xcd, 0xbc, 0xc8, 0x57, 0x05, 0xe5, 0xe9, 0xb4, 0xe0, 0x91, 0x1c,
+	0x9b, 0x93, 0xf3, 0x11, 0xa9, 0x0d, 0x43, 0x0e, 0x8f, 0x5e, 0x5f, 0x3b, 0xe6, 0xff, 0x5f, 0x68,
+	0xb1, 0x0b, 0x80, 0x76, 0x9c, 0x88, 0xd8, 0x17, 0xf8, 0xbd, 0xa7, 0x82, 0xdf, 0x06, 0xfe, 0x28,
+	0xc3, 0x8d, 0xc1, 0xa2, 0xb1, 0x33, 0x39, 0x1f, 0x23, 0xda, 0xf4, 0x49, 0x42, 0x22, 0x55, 0x4f,
+	0x39, 0x55, 0x4f, 0xc8, 0xfe, 0x74, 0xa8, 0x22, 0x3f, 0x97, 0x61, 0x29, 0xfd, 0x5e, 0x47, 0xf6,
+	0x27, 0xe7, 0x4e, 0xfc, 0xa3, 0x83, 0xf2, 0xfd, 0x89, 0xe3, 0x22, 0x57, 0xdf, 0xe4, 0x5c, 0xad,
+	0x91, 0x95, 0xa1, 0x72, 0x8e, 0xfb, 0xfa, 0x67, 0x19, 0xee, 0x9c, 0xd1, 0x9c, 0x92, 0x1f, 0x4c,
+	0x30, 0x23, 0x4e, 0x5e, 0xb0, 0x95, 0x1f, 0x4e, 0x07, 0x1c, 0x59, 0xd9, 0xe6, 0xac, 0x3c, 0x22,
+	0x0f, 0x87, 0x66, 0x45, 0x3b, 0xc6, 0x92, 0x1d, 0x0f, 0x21, 0xf2, 0x97, 0xd4, 0xea, 0x14, 0xeb,
+	0x0e, 0x27, 0x5a, 0x9d, 0x06, 0x9b, 0x76, 0xe5, 0xe9, 0xb4, 0xe0, 0x91, 0xb0, 0x32, 0x27, 0xec,
+	0x5d, 0xf2, 0x68, 0x3c, 0xc2, 0x62, 0x84, 0xfc, 0x4a, 0x86, 0xc5, 0x64, 0x5b, 0x4b, 0xb6, 0x47,
+	0x76, 0x22, 0xb5, 0xd5, 0x56, 0xde, 0x9f, 0x18, 0x1e, 0xb2, 0xb0, 0xc7, 0x59, 0xd8, 0x26, 0xef,
+	0x8d, 0xc3, 0x82, 0xcf, 0x9c, 0x5a, 0xe5, 0x79, 0x08, 0x5e, 0xe1, 0xfd, 0x3a, 0xf9, 0xb5, 0x0c,
+	0x99, 0x44, 0x13, 0x4d, 0x1e, 0x8f, 0xf1, 0xc6, 0x1d, 0xec, 0xf9, 0x95, 0xed, 0x49, 0xc1, 0x8d,
+	0x53, 0x7f, 0x4f, 0xd2, 0x80, 0x1d, 0x92, 0x76, 0x1c, 0xb5, 0x30, 0x2f, 0x34, 0xec, 0xcb, 0xc9,
+	0x5f, 0x65, 0xa0, 0xe7, 0xf7, 0xd5, 0xa4, 0x3a, 0xe1, 0x9a, 0x99, 0xd2, 0xe7, 0x2b, 0xe6, 0x54,
+	0x75, 0x20, 0x9f, 0x5b, 0x9c, 0xcf, 0x0d, 0xf2, 0xed, 0xa1, 0xf9, 0xac, 0x54, 0xdb, 0x95, 0x58,
+	0x19, 0xfa, 0xa5, 0x0c, 0x8b, 0xc9, 0x0e, 0x79, 0x8c, 0x9c, 0x4a, 0x6d, 0xdb, 0x95, 0xf7, 0x27,
+	0x86, 0x87, 0xce, 0xef, 0x72, 0xe7, 0x1f, 0x93, 0xef, 0x8e, 0x15, 0x4c, 0x02, 0xbb, 0x12, 0xb5,
+	0xdd, 0xbf, 0x97, 0xe1, 0xc6, 0x40, 0x7b, 0x3a, 0xc6, 0xf5, 0xe6, 0xb4, 0xce, 0x59, 0xd1, 0x27,
+	0x09, 0x89, 0x8c, 0xec, 0x73, 0x46, 0xca, 0x64, 0x7b, 0x12, 0x8c, 0xf4, 0x1b, 0x66, 0xf2, 0x27,
+	0x19, 0x6e, 0x9f, 0xd2, 0xbf, 0x92, 0xd1, 0xef, 0x1f, 0x67, 0xb7, 0xdf, 0xca, 0x93, 0xc9, 0x03,
+	0x23, 0x4d, 0x8f, 0x39, 0x4d, 0x5b, 0xe4, 0x3b, 0xc3, 0xd0, 0xe4, 0x87, 0xa0, 0x95, 0x14, 0x39,
+	0xf2, 0xa9, 0x0c, 0x99, 0x44, 0xff, 0x36, 0x46, 0x15, 0x4e, 0xeb, 0x3c, 0x95, 0xed, 0x49, 0xc1,
+	0xa1, 0xff, 0x0e, 0xf7, 0xff, 0x80, 0x7c, 0x34, 0xa5, 0x86, 0xc1, 0x61, 0x47, 0x41, 0xec, 0x73,
+	0x77, 0xf1, 0xe3, 0xcf, 0x5e, 0xe6, 0xa5, 0xcf, 0x5f, 0xe6, 0xa5, 0x7f, 0xbd, 0xcc, 0x4b, 0xbf,
+	0x7d, 0x95, 0xbf, 0xf4, 0xf9, 0xab, 0xfc, 0xa5, 0x7f, 0xbe, 0xca, 0x5f, 0xfa, 0xa0, 0x5c, 0xb7,
+	0x82, 0x83, 0x56, 0x55, 0x35, 0xdd, 0x86, 0x86, 0x3f, 0x6d, 0x5b, 0x55, 0xf3, 0x41, 0xdd, 0xd5,
+	0x0e, 0xd7, 0xb4, 0x86, 0x5b, 0x6b, 0xd9, 0xcc, 0x17, 0x06, 0xae, 0xbe, 0xf5, 0xa0, 0x6f, 0xe3,
+	0x83, 0x34, 0x1b, 0xc3, 0x9f, 0x37, 0xfd, 0xea, 0x1c, 0xff, 0xe1, 0x79, 0xed, 0x7f, 0x03, 0x00,
+	0x09, 0xe4, 0xcf, 0xa0, 0xb4, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the ICA controller submodule. The parameters in effect at a past block may be
+	// queried by setting the x-cosmos-block-height gRPC metadata header to the block height. Historical queries
+	// are only served for heights whose state has not been pruned by the queried node, such that nodes serving
+	// historical parameters must be configured with a pruning strategy retaining the heights of interest.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InterchainAccountClientStatus queries the status of the light client backing the connection
+	// of an interchain account.
+	InterchainAccountClientStatus(ctx context.Context, in *QueryInterchainAccountClientStatusRequest, opts ...grpc.CallOption) (*QueryInterchainAccountClientStatusResponse, error)
+	// InterchainAccount queries the address of the interchain account registered by the provided owner on the provided
+	// connection.
+	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
+	// InterchainAccountPorts queries all ports bound by the ICA controller submodule together with their
+	// active channel and interchain account address.
+	InterchainAccountPorts(ctx context.Context, in *QueryInterchainAccountPortsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountPortsResponse, error)
+	// InterchainAccountConnection queries the connection on which the active channel of the provided port runs.
+	InterchainAccountConnection(ctx context.Context, in *QueryInterchainAccountConnectionRequest, opts ...grpc.CallOption) (*QueryInterchainAccountConnectionResponse, error)
+	// InterchainAccountCounterparty queries the host chain port and channel identifiers of the active channel of the
+	// provided port.
+	InterchainAccountCounterparty(ctx context.Context, in *QueryInterchainAccountCounterpartyRequest, opts ...grpc.CallOption) (*QueryInterchainAccountCounterpartyResponse, error)
+	// SendQueueDepth queries the number of packets held by the send queue of the provided port.
+	SendQueueDepth(ctx context.Context, in *QuerySendQueueDepthRequest, opts ...grpc.CallOption) (*QuerySendQueueDepthResponse, error)
+	// PacketTimeout queries the timeout height and timestamp of an outstanding packet sent over the active channel of
+	// the provided port. Packet timeouts are only retained if packet data retention is enabled by the channel
+	// parameters of the controller chain.
+	PacketTimeout(ctx context.Context, in *QueryPacketTimeoutRequest, opts ...grpc.CallOption) (*QueryPacketTimeoutResponse, error)
+	// InterchainAccountPortsByConnection queries all ports bound by the ICA controller submodule grouped by the
+	// connection on which their active channel runs. Ports without an active channel are grouped as unassigned.
+	InterchainAccountPortsByConnection(ctx context.Context, in *QueryInterchainAccountPortsByConnectionRequest, opts ...grpc.CallOption) (*QueryInterchainAccountPortsByConnectionResponse, error)
+	// PendingPackets queries the packets sent over the active channel of the provided port which have not yet been
+	// acknowledged, alongside the advisory priority of each packet. Packet priorities are only reported if packet data
+	// retention is enabled by the channel parameters of the controller chain.
+	PendingPackets(ctx context.Context, in *QueryPendingPacketsRequest, opts ...grpc.CallOption) (*QueryPendingPacketsResponse, error)
+	// PendingUnbondings queries the undelegations sent by the interchain account of a controller port which have not
+	// yet completed
+	PendingUnbondings(ctx context.Context, in *QueryPendingUnbondingsRequest, opts ...grpc.CallOption) (*QueryPendingUnbondingsResponse, error)
+	// StaleInterchainAccounts queries the interchain accounts whose active channel has had no packet activity for more
+	// than the provided number of blocks. Sending a packet, receiving its acknowledgement and opening the channel count
+	// as activity.
+	StaleInterchainAccounts(ctx context.Context, in *QueryStaleInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryStaleInterchainAccountsResponse, error)
+	// NextChannelID queries the controller port identifier of the provided owner and connection, along with the channel
+	// identifier the channel keeper would allocate to the next channel opened on the controller chain. Channel
+	// identifiers are allocated sequentially across all ports, any channel handshake initiated before the registration
+	// of the interchain account, including concurrent registrations in the same block, shifts the allocated identifier.
+	NextChannelID(ctx context.Context, in *QueryNextChannelIDRequest, opts ...grpc.CallOption) (*QueryNextChannelIDResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccountClientStatus(ctx context.Context, in *QueryInterchainAccountClientStatusRequest, opts ...grpc.CallOption) (*QueryInterchainAccountClientStatusResponse, error) {
+	out := new(QueryInterchainAccountClientStatusResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountClientStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error) {
+	out := new(QueryInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccountPorts(ctx context.Context, in *QueryInterchainAccountPortsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountPortsResponse, error) {
+	out := new(QueryInterchainAccountPortsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccountConnection(ctx context.Context, in *QueryInterchainAccountConnectionRequest, opts ...grpc.CallOption) (*QueryInterchainAccountConnectionResponse, error) {
+	out := new(QueryInterchainAccountConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccountCounterparty(ctx context.Context, in *QueryInterchainAccountCounterpartyRequest, opts ...grpc.CallOption) (*QueryInterchainAccountCounterpartyResponse, error) {
+	out := new(QueryInterchainAccountCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SendQueueDepth(ctx context.Context, in *QuerySendQueueDepthRequest, opts ...grpc.CallOption) (*QuerySendQueueDepthResponse, error) {
+	out := new(QuerySendQueueDepthResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/SendQueueDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketTimeout(ctx context.Context, in *QueryPacketTimeoutRequest, opts ...grpc.CallOption) (*QueryPacketTimeoutResponse, error) {
+	out := new(QueryPacketTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/PacketTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccountPortsByConnection(ctx context.Context, in *QueryInterchainAccountPortsByConnectionRequest, opts ...grpc.CallOption) (*QueryInterchainAccountPortsByConnectionResponse, error) {
+	out := new(QueryInterchainAccountPortsByConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountPortsByConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPackets(ctx context.Context, in *QueryPendingPacketsRequest, opts ...grpc.CallOption) (*QueryPendingPacketsResponse, error) {
+	out := new(QueryPendingPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/PendingPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingUnbondings(ctx context.Context, in *QueryPendingUnbondingsRequest, opts ...grpc.CallOption) (*QueryPendingUnbondingsResponse, error) {
+	out := new(QueryPendingUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/PendingUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StaleInterchainAccounts(ctx context.Context, in *QueryStaleInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryStaleInterchainAccountsResponse, error) {
+	out := new(QueryStaleInterchainAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/StaleInterchainAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextChannelID(ctx context.Context, in *QueryNextChannelIDRequest, opts ...grpc.CallOption) (*QueryNextChannelIDResponse, error) {
+	out := new(QueryNextChannelIDResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/NextChannelID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the ICA controller submodule. The parameters in effect at a past block may be
+	// queried by setting the x-cosmos-block-height gRPC metadata header to the block height. Historical queries
+	// are only served for heights whose state has not been pruned by the queried node, such that nodes serving
+	// historical parameters must be configured with a pruning strategy retaining the heights of interest.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InterchainAccountClientStatus queries the status of the light client backing the connection
+	// of an interchain account.
+	InterchainAccountClientStatus(context.Context, *QueryInterchainAccountClientStatusRequest) (*QueryInterchainAccountClientStatusResponse, error)
+	// InterchainAccount queries the address of the interchain account registered by the provided owner on the provided
+	// connection.
+	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
+	// InterchainAccountPorts queries all ports bound by the ICA controller submodule together with their
+	// active channel and interchain account address.
+	InterchainAccountPorts(context.Context, *QueryInterchainAccountPortsRequest) (*QueryInterchainAccountPortsResponse, error)
+	// InterchainAccountConnection queries the connection on which the active channel of the provided port runs.
+	InterchainAccountConnection(context.Context, *QueryInterchainAccountConnectionRequest) (*QueryInterchainAccountConnectionResponse, error)
+	// InterchainAccountCounterparty queries the host chain port and channel identifiers of the active channel of the
+	// provided port.
+	InterchainAccountCounterparty(context.Context, *QueryInterchainAccountCounterpartyRequest) (*QueryInterchainAccountCounterpartyResponse, error)
+	// SendQueueDepth queries the number of packets held by the send queue of the provided port.
+	SendQueueDepth(context.Context, *QuerySendQueueDepthRequest) (*QuerySendQueueDepthResponse, error)
+	// PacketTimeout queries the timeout height and timestamp of an outstanding packet sent over the active channel of
+	// the provided port. Packet timeouts are only retained if packet data retention is enabled by the channel
+	// parameters of the controller chain.
+	PacketTimeout(context.Context, *QueryPacketTimeoutRequest) (*QueryPacketTimeoutResponse, error)
+	// InterchainAccountPortsByConnection queries all ports bound by the ICA controller submodule grouped by the
+	// connection on which their active channel runs. Ports without an active channel are grouped as unassigned.
+	InterchainAccountPortsByConnection(context.Context, *QueryInterchainAccountPortsByConnectionRequest) (*QueryInterchainAccountPortsByConnectionResponse, error)
+	// PendingPackets queries the packets sent over the active channel of the provided port which have not yet been
+	// acknowledged, alongside the advisory priority of each packet. Packet priorities are only reported if packet data
+	// retention is enabled by the channel parameters of the controller chain.
+	PendingPackets(context.Context, *QueryPendingPacketsRequest) (*QueryPendingPacketsResponse, error)
+	// PendingUnbondings queries the undelegations sent by the interchain account of a controller port which have not
+	// yet completed
+	PendingUnbondings(context.Context, *QueryPendingUnbondingsRequest) (*QueryPendingUnbondingsResponse, error)
+	// StaleInterchainAccounts queries the interchain accounts whose active channel has had no packet activity for more
+	// than the provided number of blocks. Sending a packet, receiving its acknowledgement and opening the channel count
+	// as activity.
+	StaleInterchainAccounts(context.Context, *QueryStaleInterchainAccountsRequest) (*QueryStaleInterchainAccountsResponse, error)
+	// NextChannelID queries the controller port identifier of the provided owner and connection, along with the channel
+	// identifier the channel keeper would allocate to the next channel opened on the controller chain. Channel
+	// identifiers are allocated sequentially across all ports, any channel handshake initiated before the registration
+	// of the interchain account, including concurrent registrations in the same block, shifts the allocated identifier.
+	NextChannelID(context.Context, *QueryNextChannelIDRequest) (*QueryNextChannelIDResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountClientStatus(ctx context.Context, req *QueryInterchainAccountClientStatusRequest) (*QueryInterchainAccountClientStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountClientStatus not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountPorts(ctx context.Context, req *QueryInterchainAccountPortsRequest) (*QueryInterchainAccountPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountPorts not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountConnection(ctx context.Context, req *QueryInterchainAccountConnectionRequest) (*QueryInterchainAccountConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountConnection not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountCounterparty(ctx context.Context, req *QueryInterchainAccountCounterpartyRequest) (*QueryInterchainAccountCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountCounterparty not implemented")
+}
+func (*UnimplementedQueryServer) SendQueueDepth(ctx context.Context, req *QuerySendQueueDepthRequest) (*QuerySendQueueDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendQueueDepth not implemented")
+}
+func (*UnimplementedQueryServer) PacketTimeout(ctx context.Context, req *QueryPacketTimeoutRequest) (*QueryPacketTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketTimeout not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountPortsByConnection(ctx context.Context, req *QueryInterchainAccountPortsByConnectionRequest) (*QueryInterchainAccountPortsByConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountPortsByConnection not implemented")
+}
+func (*UnimplementedQueryServer) PendingPackets(ctx context.Context, req *QueryPendingPacketsRequest) (*QueryPendingPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPackets not implemented")
+}
+func (*UnimplementedQueryServer) PendingUnbondings(ctx context.Context, req *QueryPendingUnbondingsRequest) (*QueryPendingUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) StaleInterchainAccounts(ctx context.Context, req *QueryStaleInterchainAccountsRequest) (*QueryStaleInterchainAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaleInterchainAccounts not implemented")
+}
+func (*UnimplementedQueryServer) NextChannelID(ctx context.Context, req *QueryNextChannelIDRequest) (*QueryNextChannelIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextChannelID not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccountClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountClientStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountClientStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountClientStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountClientStatus(ctx, req.(*QueryInterchainAccountClientStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccount(ctx, req.(*QueryInterchainAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccountPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountPorts(ctx, req.(*QueryInterchainAccountPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccountConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountConnection(ctx, req.(*QueryInterchainAccountConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccountCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountCounterparty(ctx, req.(*QueryInterchainAccountCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SendQueueDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendQueueDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendQueueDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/SendQueueDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendQueueDepth(ctx, req.(*QuerySendQueueDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketTimeoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/PacketTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketTimeout(ctx, req.(*QueryPacketTimeoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccountPortsByConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountPortsByConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountPortsByConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountPortsByConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountPortsByConnection(ctx, req.(*QueryInterchainAccountPortsByConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/PendingPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPackets(ctx, req.(*QueryPendingPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/PendingUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUnbondings(ctx, req.(*QueryPendingUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StaleInterchainAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStaleInterchainAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StaleInterchainAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/StaleInterchainAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StaleInterchainAccounts(ctx, req.(*QueryStaleInterchainAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextChannelID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextChannelIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextChannelID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/NextChannelID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextChannelID(ctx, req.(*QueryNextChannelIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "InterchainAccountClientStatus",
+			Handler:    _Query_InterchainAccountClientStatus_Handler,
+		},
+		{
+			MethodName: "InterchainAccount",
+			Handler:    _Query_InterchainAccount_Handler,
+		},
+		{
+			MethodName: "InterchainAccountPorts",
+			Handler:    _Query_InterchainAccountPorts_Handler,
+		},
+		{
+			MethodName: "InterchainAccountConnection",
+			Handler:    _Query_InterchainAccountConnection_Handler,
+		},
+		{
+			MethodName: "InterchainAccountCounterparty",
+			Handler:    _Query_InterchainAccountCounterparty_Handler,
+		},
+		{
+			MethodName: "SendQueueDepth",
+			Handler:    _Query_SendQueueDepth_Handler,
+		},
+		{
+			MethodName: "PacketTimeout",
+			Handler:    _Query_PacketTimeout_Handler,
+		},
+		{
+			MethodName: "InterchainAccountPortsByConnection",
+			Handler:    _Query_InterchainAccountPortsByConnection_Handler,
+		},
+		{
+			MethodName: "PendingPackets",
+			Handler:    _Query_PendingPackets_Handler,
+		},
+		{
+			MethodName: "PendingUnbondings",
+			Handler:    _Query_PendingUnbondings_Handler,
+		},
+		{
+			MethodName: "StaleInterchainAccounts",
+			Handler:    _Query_StaleInterchainAccounts_Handler,
+		},
+		{
+			MethodName: "NextChannelID",
+			Handler:    _Query_NextChannelID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/controller/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountClientStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountClientStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountClientStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountClientStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountClientStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountClientStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountPortsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountPortsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountPortsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountPortsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountPortsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountPortsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ports) > 0 {
+		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainAccountPort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountPort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountPort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountConnectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountConnectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountConnectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountConnectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountCounterpartyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountCounterpartyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountCounterpartyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountCounterpartyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountCounterpartyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountCounterpartyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyChannelId) > 0 {
+		i -= len(m.CounterpartyChannelId)
+		copy(dAtA[i:], m.CounterpartyChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyPortId) > 0 {
+		i -= len(m.CounterpartyPortId)
+		copy(dAtA[i:], m.CounterpartyPortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendQueueDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendQueueDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendQueueDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendQueueDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendQueueDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendQueueDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketTimeoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketTimeoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketTimeoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketTimeoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketTimeoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketTimeoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountPortsByConnectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountPortsByConnectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountPortsByConnectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountPortsByConnectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountPortsByConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountPortsByConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Connections) > 0 {
+		for iNdEx := len(m.Connections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Connections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionPorts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionPorts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionPorts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortIds) > 0 {
+		for iNdEx := len(m.PortIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PortIds[iNdEx])
+			copy(dAtA[i:], m.PortIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PortIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPriority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPriority))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleInterchainAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleInterchainAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleInterchainAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InactiveBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InactiveBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleInterchainAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleInterchainAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleInterchainAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StaleInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StaleInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StaleInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastActivityHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastActivityHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextChannelIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextChannelIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextChannelIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextChannelIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextChannelIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextChannelIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountClientStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountClientStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.LatestHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInterchainAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountPortsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountPortsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *InterchainAccountPort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountConnectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountCounterpartyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountCounterpartyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyPortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySendQueueDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySendQueueDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryPacketTimeoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountPortsByConnectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInterchainAccountPortsByConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Connections) > 0 {
+		for _, e := range m.Connections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectionPorts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PortIds) > 0 {
+		for _, s := range m.PortIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxPriority != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPriority))
+	}
+	return n
+}
+
+func (m *PendingPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQuery(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *QueryPendingUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStaleInterchainAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InactiveBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.InactiveBlocks))
+	}
+	return n
+}
+
+func (m *QueryStaleInterchainAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StaleInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastActivityHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastActivityHeight))
+	}
+	return n
+}
+
+func (m *QueryNextChannelIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextChannelIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountClientStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountClientStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountClientStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountClientStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountClientStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountClientStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountPortsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountPortsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountPortsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountPortsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountPortsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountPortsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, InterchainAccountPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainAccountPort) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountPort: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountPort: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountConnectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountConnectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountConnectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountConnectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountConnectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountConnectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountCounterpartyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountCounterpartyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountCounterpartyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountCounterpartyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountCounterpartyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySendQueueDepthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendQueueDepthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendQueueDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySendQueueDepthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendQueueDepthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendQueueDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketTimeoutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketTimeoutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketTimeoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketTimeoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketTimeoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountPortsByConnectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountPortsByConnectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountPortsByConnectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountPortsByConnectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountPortsByConnectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountPortsByConnectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connections = append(m.Connections, ConnectionPorts{})
+			if err := m.Connections[len(m.Connections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionPorts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionPorts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionPorts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortIds = append(m.PortIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, PendingPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriority", wireType)
+			}
+			m.MaxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaleInterchainAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaleInterchainAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaleInterchainAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveBlocks", wireType)
+			}
+			m.InactiveBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactiveBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaleInterchainAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaleInterchainAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaleInterchainAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, StaleInterchainAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StaleInterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StaleInterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StaleInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityHeight", wireType)
+			}
+			m.LastActivityHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActivityHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextChannelIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -348,12 +6778,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextChannelIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextChannelIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -375,7 +6869,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextChannelIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -398,17 +6892,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextChannelIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextChannelIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -418,27 +6912,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
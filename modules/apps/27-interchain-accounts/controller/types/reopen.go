@@ -0,0 +1,21 @@
+package types
+
+const (
+	// MaxAutoReopenAttempts defines the maximum number of consecutive attempts made to automatically reopen the
+	// channel of an interchain account closed due to a packet timeout. Further attempts are abandoned once exceeded
+	MaxAutoReopenAttempts = 5
+
+	// AutoReopenBaseBackoff defines the number of blocks awaited before retrying a failed automatic reopen attempt.
+	// The backoff is doubled with each consecutive failed attempt
+	AutoReopenBaseBackoff = 10
+)
+
+// AutoReopenBackoff returns the number of blocks awaited before retrying the automatic reopen of a channel following
+// the provided number of consecutive failed attempts
+func AutoReopenBackoff(attempts uint64) uint64 {
+	if attempts == 0 {
+		return 0
+	}
+
+	return AutoReopenBaseBackoff << (attempts - 1)
+}
@@ -0,0 +1,16 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+)
+
+func TestAutoReopenBackoff(t *testing.T) {
+	require.Equal(t, uint64(0), types.AutoReopenBackoff(0))
+	require.Equal(t, uint64(types.AutoReopenBaseBackoff), types.AutoReopenBackoff(1))
+	require.Equal(t, uint64(2*types.AutoReopenBaseBackoff), types.AutoReopenBackoff(2))
+	require.Equal(t, uint64(16*types.AutoReopenBaseBackoff), types.AutoReopenBackoff(types.MaxAutoReopenAttempts))
+}
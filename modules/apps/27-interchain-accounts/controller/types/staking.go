@@ -0,0 +1,152 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ValidatorRewards defines the staking rewards accrued by an interchain account from a validator on the host chain
+// which are to be delegated back to that validator
+type ValidatorRewards struct {
+	ValidatorAddress string
+	Amount           sdk.Coin
+}
+
+// NewValidatorRewards creates a new ValidatorRewards instance
+func NewValidatorRewards(validatorAddress string, amount sdk.Coin) ValidatorRewards {
+	return ValidatorRewards{
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+	}
+}
+
+// NewCompoundRewardsMsgs constructs the messages compounding the staking rewards of the provided delegator. A
+// MsgWithdrawDelegatorReward is constructed for every validator, followed by a MsgDelegate of the rewards amount to
+// each validator. Validators with zero rewards are only withdrawn from, as there is nothing to delegate.
+//
+// The messages are intended to be sent in a single interchain account transaction, which the host chain executes
+// atomically: if any message fails, for example because the delegated amount exceeds the withdrawn rewards and the
+// balance of the interchain account, none of the withdrawals or delegations are applied.
+func NewCompoundRewardsMsgs(delegatorAddress string, rewards []ValidatorRewards) ([]sdk.Msg, error) {
+	if len(rewards) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least one validator must be provided")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(delegatorAddress); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %s: %v", delegatorAddress, err)
+	}
+
+	seen := make(map[string]bool)
+	withdrawMsgs := make([]sdk.Msg, 0, len(rewards))
+	delegateMsgs := make([]sdk.Msg, 0, len(rewards))
+	for _, reward := range rewards {
+		if seen[reward.ValidatorAddress] {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate validator address %s", reward.ValidatorAddress)
+		}
+		seen[reward.ValidatorAddress] = true
+
+		if _, err := sdk.ValAddressFromBech32(reward.ValidatorAddress); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s: %v", reward.ValidatorAddress, err)
+		}
+
+		withdrawMsg := &disttypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: delegatorAddress,
+			ValidatorAddress: reward.ValidatorAddress,
+		}
+
+		if err := withdrawMsg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		withdrawMsgs = append(withdrawMsgs, withdrawMsg)
+
+		if !reward.Amount.IsValid() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid rewards amount %s for validator %s", reward.Amount, reward.ValidatorAddress)
+		}
+
+		// skip the delegation when there are no rewards to withdraw
+		if reward.Amount.IsZero() {
+			continue
+		}
+
+		delegateMsg := &stakingtypes.MsgDelegate{
+			DelegatorAddress: delegatorAddress,
+			ValidatorAddress: reward.ValidatorAddress,
+			Amount:           reward.Amount,
+		}
+
+		if err := delegateMsg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		delegateMsgs = append(delegateMsgs, delegateMsg)
+	}
+
+	// rewards must be withdrawn before they can be delegated
+	return append(withdrawMsgs, delegateMsgs...), nil
+}
+
+// NewSetWithdrawAddressMsg constructs the message setting the address to which the staking rewards of the provided
+// delegator are withdrawn. Both addresses are accounts on the host chain, the withdraw address must therefore use the
+// same bech32 prefix as the delegator address, which may differ from the bech32 prefix of the controller chain.
+func NewSetWithdrawAddressMsg(delegatorAddress, withdrawAddress string) (sdk.Msg, error) {
+	delegatorPrefix, err := decodeAccAddress(delegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %s: %v", delegatorAddress, err)
+	}
+
+	withdrawPrefix, err := decodeAccAddress(withdrawAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdraw address %s: %v", withdrawAddress, err)
+	}
+
+	if withdrawPrefix != delegatorPrefix {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "withdraw address %s must use the bech32 prefix %s of the host chain", withdrawAddress, delegatorPrefix)
+	}
+
+	return &disttypes.MsgSetWithdrawAddress{
+		DelegatorAddress: delegatorAddress,
+		WithdrawAddress:  withdrawAddress,
+	}, nil
+}
+
+// NewUndelegateMsg constructs the message undelegating the provided amount of the provided delegator from the provided
+// validator. Both addresses belong to the host chain and are therefore only required to be valid bech32 addresses, as
+// the bech32 prefixes of the host chain may differ from those of the controller chain.
+func NewUndelegateMsg(delegatorAddress, validatorAddress string, amount sdk.Coin) (sdk.Msg, error) {
+	if _, err := decodeAccAddress(delegatorAddress); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %s: %v", delegatorAddress, err)
+	}
+
+	if _, err := decodeAccAddress(validatorAddress); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s: %v", validatorAddress, err)
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid undelegation amount %s", amount)
+	}
+
+	return &stakingtypes.MsgUndelegate{
+		DelegatorAddress: delegatorAddress,
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+	}, nil
+}
+
+// decodeAccAddress decodes the provided bech32 account address without requiring the bech32 prefix of the controller
+// chain and returns its bech32 prefix
+func decodeAccAddress(address string) (string, error) {
+	prefix, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return "", err
+	}
+
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return "", err
+	}
+
+	return prefix, nil
+}
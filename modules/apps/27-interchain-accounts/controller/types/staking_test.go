@@ -0,0 +1,150 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+)
+
+func TestNewCompoundRewardsMsgs(t *testing.T) {
+	var (
+		validatorA = sdk.ValAddress([]byte("validatorA__________")).String()
+		validatorB = sdk.ValAddress([]byte("validatorB__________")).String()
+		rewards    = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+		noRewards  = sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
+	)
+
+	testCases := []struct {
+		name      string
+		delegator string
+		rewards   []types.ValidatorRewards
+		expMsgs   []sdk.Msg
+		expPass   bool
+	}{
+		{
+			"success",
+			validOwner,
+			[]types.ValidatorRewards{types.NewValidatorRewards(validatorA, rewards), types.NewValidatorRewards(validatorB, rewards)},
+			[]sdk.Msg{
+				&disttypes.MsgWithdrawDelegatorReward{DelegatorAddress: validOwner, ValidatorAddress: validatorA},
+				&disttypes.MsgWithdrawDelegatorReward{DelegatorAddress: validOwner, ValidatorAddress: validatorB},
+				&stakingtypes.MsgDelegate{DelegatorAddress: validOwner, ValidatorAddress: validatorA, Amount: rewards},
+				&stakingtypes.MsgDelegate{DelegatorAddress: validOwner, ValidatorAddress: validatorB, Amount: rewards},
+			},
+			true,
+		},
+		{
+			"success - delegation skipped for validator without rewards",
+			validOwner,
+			[]types.ValidatorRewards{types.NewValidatorRewards(validatorA, noRewards), types.NewValidatorRewards(validatorB, rewards)},
+			[]sdk.Msg{
+				&disttypes.MsgWithdrawDelegatorReward{DelegatorAddress: validOwner, ValidatorAddress: validatorA},
+				&disttypes.MsgWithdrawDelegatorReward{DelegatorAddress: validOwner, ValidatorAddress: validatorB},
+				&stakingtypes.MsgDelegate{DelegatorAddress: validOwner, ValidatorAddress: validatorB, Amount: rewards},
+			},
+			true,
+		},
+		{
+			"success - no rewards to delegate",
+			validOwner,
+			[]types.ValidatorRewards{types.NewValidatorRewards(validatorA, noRewards)},
+			[]sdk.Msg{
+				&disttypes.MsgWithdrawDelegatorReward{DelegatorAddress: validOwner, ValidatorAddress: validatorA},
+			},
+			true,
+		},
+		{"no validators provided", validOwner, nil, nil, false},
+		{"invalid delegator address", "invalid", []types.ValidatorRewards{types.NewValidatorRewards(validatorA, rewards)}, nil, false},
+		{"invalid validator address", validOwner, []types.ValidatorRewards{types.NewValidatorRewards("invalid", rewards)}, nil, false},
+		{"invalid validator address without rewards", validOwner, []types.ValidatorRewards{types.NewValidatorRewards(validOwner, noRewards)}, nil, false},
+		{"duplicate validator address", validOwner, []types.ValidatorRewards{types.NewValidatorRewards(validatorA, rewards), types.NewValidatorRewards(validatorA, rewards)}, nil, false},
+		{"invalid rewards amount", validOwner, []types.ValidatorRewards{types.NewValidatorRewards(validatorA, sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)})}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		msgs, err := types.NewCompoundRewardsMsgs(tc.delegator, tc.rewards)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expMsgs, msgs, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestNewSetWithdrawAddressMsg(t *testing.T) {
+	withdrawAddress := sdk.AccAddress([]byte("withdrawAddress_____")).String()
+
+	hostWithdrawAddress, err := bech32.ConvertAndEncode("osmo", []byte("withdrawAddress_____"))
+	require.NoError(t, err)
+
+	hostDelegator, err := bech32.ConvertAndEncode("osmo", []byte("delegator___________"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		delegator string
+		withdraw  string
+		expPass   bool
+	}{
+		{"success", validOwner, withdrawAddress, true},
+		{"success - host chain bech32 prefix", hostDelegator, hostWithdrawAddress, true},
+		{"invalid delegator address", "invalid", withdrawAddress, false},
+		{"invalid withdraw address", validOwner, "invalid", false},
+		{"empty withdraw address", validOwner, "", false},
+		{"withdraw address bech32 prefix differs from the host chain", hostDelegator, withdrawAddress, false},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewSetWithdrawAddressMsg(tc.delegator, tc.withdraw)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, &disttypes.MsgSetWithdrawAddress{DelegatorAddress: tc.delegator, WithdrawAddress: tc.withdraw}, msg, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestNewUndelegateMsg(t *testing.T) {
+	validator := sdk.ValAddress([]byte("validator___________")).String()
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	hostDelegator, err := bech32.ConvertAndEncode("osmo", []byte("delegator___________"))
+	require.NoError(t, err)
+
+	hostValidator, err := bech32.ConvertAndEncode("osmovaloper", []byte("validator___________"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		delegator string
+		validator string
+		amount    sdk.Coin
+		expPass   bool
+	}{
+		{"success", validOwner, validator, amount, true},
+		{"success - host chain bech32 prefix", hostDelegator, hostValidator, amount, true},
+		{"invalid delegator address", "invalid", validator, amount, false},
+		{"invalid validator address", validOwner, "invalid", amount, false},
+		{"zero amount", validOwner, validator, sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), false},
+		{"invalid amount denom", validOwner, validator, sdk.Coin{Denom: "1", Amount: sdk.NewInt(100)}, false},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewUndelegateMsg(tc.delegator, tc.validator, tc.amount)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, &stakingtypes.MsgUndelegate{DelegatorAddress: tc.delegator, ValidatorAddress: tc.validator, Amount: tc.amount}, msg, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/controller/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetInterchainAccountLabel defines a msg to set or clear the label of an interchain account.
+// Labels are local metadata of the controller chain and are never sent to the host chain.
+type MsgSetInterchainAccountLabel struct {
+	// owner address of the interchain account
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier on which the interchain account was registered
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// label to assign to the interchain account, an empty label clears the existing label
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *MsgSetInterchainAccountLabel) Reset()         { *m = MsgSetInterchainAccountLabel{} }
+func (m *MsgSetInterchainAccountLabel) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInterchainAccountLabel) ProtoMessage()    {}
+func (*MsgSetInterchainAccountLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{0}
+}
+func (m *MsgSetInterchainAccountLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInterchainAccountLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInterchainAccountLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetInterchainAccountLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInterchainAccountLabel.Merge(m, src)
+}
+func (m *MsgSetInterchainAccountLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInterchainAccountLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInterchainAccountLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInterchainAccountLabel proto.InternalMessageInfo
+
+// MsgSetInterchainAccountLabelResponse defines the response type for the Msg/SetInterchainAccountLabel RPC method.
+type MsgSetInterchainAccountLabelResponse struct {
+}
+
+func (m *MsgSetInterchainAccountLabelResponse) Reset()         { *m = MsgSetInterchainAccountLabelResponse{} }
+func (m *MsgSetInterchainAccountLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInterchainAccountLabelResponse) ProtoMessage()    {}
+func (*MsgSetInterchainAccountLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{1}
+}
+func (m *MsgSetInterchainAccountLabelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInterchainAccountLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInterchainAccountLabelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetInterchainAccountLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInterchainAccountLabelResponse.Merge(m, src)
+}
+func (m *MsgSetInterchainAccountLabelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInterchainAccountLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInterchainAccountLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInterchainAccountLabelResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetInterchainAccountLabel)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSetInterchainAccountLabel")
+	proto.RegisterType((*MsgSetInterchainAccountLabelResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSetInterchainAccountLabelResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/controller/v1/tx.proto", fileDescriptor_7def041328c84a30)
+}
+
+var fileDescriptor_7def041328c84a30 = []byte{
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x4a, 0x33, 0x41,
+	0x14, 0x85, 0x77, 0xfe, 0xf0, 0x8b, 0x0e, 0xda, 0x2c, 0x29, 0xd6, 0x20, 0x1b, 0x59, 0x44, 0x6c,
+	0x32, 0x43, 0x92, 0x42, 0x88, 0x58, 0x98, 0x2e, 0x60, 0x20, 0xc4, 0x46, 0x6c, 0xc2, 0xee, 0x64,
+	0xd8, 0x8c, 0xcc, 0xce, 0x5d, 0x76, 0x26, 0xd1, 0xbc, 0x81, 0xa5, 0xe0, 0x0b, 0xe4, 0x6d, 0xb4,
+	0x33, 0xa5, 0x95, 0x48, 0xd2, 0x58, 0xfb, 0x04, 0xb2, 0x59, 0x74, 0x15, 0x62, 0x40, 0xb0, 0x9b,
+	0xc3, 0xe5, 0x7c, 0xf7, 0x9e, 0xe1, 0xe0, 0x23, 0x11, 0x30, 0xea, 0xc7, 0xb1, 0x14, 0xcc, 0x37,
+	0x02, 0x94, 0xa6, 0x42, 0x19, 0x9e, 0xb0, 0x81, 0x2f, 0x54, 0xcf, 0x67, 0x0c, 0x86, 0xca, 0x68,
+	0xca, 0x40, 0x99, 0x04, 0xa4, 0xe4, 0x09, 0x1d, 0x55, 0xa9, 0xb9, 0x26, 0x71, 0x02, 0x06, 0xec,
+	0x9a, 0x08, 0x18, 0xf9, 0x6a, 0x26, 0x4b, 0xcc, 0x24, 0x37, 0x93, 0x51, 0xb5, 0x54, 0x0c, 0x21,
+	0x84, 0x85, 0x9d, 0xa6, 0xaf, 0x8c, 0xe4, 0xdd, 0x21, 0xbc, 0xd3, 0xd6, 0xe1, 0x19, 0x37, 0xad,
+	0x4f, 0xc2, 0x49, 0x06, 0x38, 0xf5, 0x03, 0x2e, 0xed, 0x22, 0xfe, 0x0f, 0x57, 0x8a, 0x27, 0x0e,
+	0xda, 0x45, 0x07, 0x1b, 0xdd, 0x4c, 0xd8, 0xc7, 0x78, 0x8b, 0x81, 0x52, 0x9c, 0xa5, 0xdb, 0x7b,
+	0xa2, 0xef, 0xfc, 0x4b, 0xa7, 0x4d, 0xe7, 0xed, 0xb9, 0x5c, 0x1c, 0xfb, 0x91, 0x6c, 0x78, 0xdf,
+	0xc6, 0x5e, 0x77, 0x33, 0xd7, 0xad, 0x7e, 0x0a, 0x95, 0x29, 0xdd, 0x29, 0x64, 0xd0, 0x85, 0x68,
+	0xac, 0xdf, 0x4c, 0xca, 0xd6, 0xeb, 0xa4, 0x6c, 0x79, 0xfb, 0x78, 0x6f, 0xd5, 0x51, 0x5d, 0xae,
+	0x63, 0x50, 0x9a, 0xd7, 0x1e, 0x11, 0x2e, 0xb4, 0x75, 0x68, 0xdf, 0x23, 0xbc, 0xfd, 0x73, 0x84,
+	0x0e, 0xf9, 0xfd, 0x77, 0x91, 0x55, 0xfb, 0x4b, 0xe7, 0x7f, 0x4d, 0xfc, 0x48, 0xd4, 0xbc, 0x7c,
+	0x98, 0xb9, 0x68, 0x3a, 0x73, 0xd1, 0xcb, 0xcc, 0x45, 0xb7, 0x73, 0xd7, 0x9a, 0xce, 0x5d, 0xeb,
+	0x69, 0xee, 0x5a, 0x17, 0x9d, 0x50, 0x98, 0xc1, 0x30, 0x20, 0x0c, 0x22, 0xca, 0x40, 0x47, 0xa0,
+	0xa9, 0x08, 0x58, 0x25, 0x04, 0x3a, 0xaa, 0xd3, 0x08, 0xfa, 0x43, 0xc9, 0x75, 0x5a, 0x28, 0x4d,
+	0x6b, 0x87, 0x95, 0xfc, 0x9a, 0xca, 0xb2, 0x2e, 0x99, 0x71, 0xcc, 0x75, 0xb0, 0xb6, 0xa8, 0x40,
+	0xfd, 0x7d, 0x00, 0xc3, 0x65, 0x33, 0xc3, 0x8b, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetInterchainAccountLabel defines a rpc handler method for MsgSetInterchainAccountLabel.
+	SetInterchainAccountLabel(ctx context.Context, in *MsgSetInterchainAccountLabel, opts ...grpc.CallOption) (*MsgSetInterchainAccountLabelResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetInterchainAccountLabel(ctx context.Context, in *MsgSetInterchainAccountLabel, opts ...grpc.CallOption) (*MsgSetInterchainAccountLabelResponse, error) {
+	out := new(MsgSetInterchainAccountLabelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/SetInterchainAccountLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetInterchainAccountLabel defines a rpc handler method for MsgSetInterchainAccountLabel.
+	SetInterchainAccountLabel(context.Context, *MsgSetInterchainAccountLabel) (*MsgSetInterchainAccountLabelResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetInterchainAccountLabel(ctx context.Context, req *MsgSetInterchainAccountLabel) (*MsgSetInterchainAccountLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterchainAccountLabel not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetInterchainAccountLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInterchainAccountLabel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInterchainAccountLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/SetInterchainAccountLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInterchainAccountLabel(ctx, req.(*MsgSetInterchainAccountLabel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetInterchainAccountLabel",
+			Handler:    _Msg_SetInterchainAccountLabel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/controller/v1/tx.proto",
+}
+
+func (m *MsgSetInterchainAccountLabel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInterchainAccountLabel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInterchainAccountLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInterchainAccountLabelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInterchainAccountLabelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInterchainAccountLabelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetInterchainAccountLabel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetInterchainAccountLabelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetInterchainAccountLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetInterchainAccountLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetInterchainAccountLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetInterchainAccountLabelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetInterchainAccountLabelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetInterchainAccountLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewUnbonding creates a new Unbonding instance awaiting the acknowledgement of the packet carrying the undelegation
+func NewUnbonding(channelID string, sequence uint64, validatorAddress string, amount sdk.Coin) Unbonding {
+	return Unbonding{
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+	}
+}
+
+// IsAcknowledged returns true if the completion time of the unbonding was returned by the host chain
+func (u Unbonding) IsAcknowledged() bool {
+	return !u.CompletionTime.IsZero()
+}
@@ -15,6 +15,9 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdInterchainAccountsByConnection(),
+		GetCmdAllInterchainAccounts(),
+		GetCmdHostCapabilities(),
 	)
 
 	return queryCmd
@@ -14,9 +14,11 @@ import (
 // GetCmdParams returns the command handler for the host submodule parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "params",
-		Short:   "Query the current interchain-accounts host submodule parameters",
-		Long:    "Query the current interchain-accounts host submodule parameters",
+		Use:   "params",
+		Short: "Query the current interchain-accounts host submodule parameters",
+		Long: `Query the current interchain-accounts host submodule parameters.
+The parameters in effect at a past block may be queried using the --height flag, provided the queried node
+has not pruned the state at that height.`,
 		Args:    cobra.NoArgs,
 		Example: fmt.Sprintf("%s query interchain-accounts host params", version.AppName),
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -26,7 +28,11 @@ func GetCmdParams() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, _ := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
 			return clientCtx.PrintProto(res.Params)
 		},
 	}
@@ -35,3 +41,111 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdInterchainAccountsByConnection returns the command handler for querying the interchain accounts registered over a host connection.
+func GetCmdInterchainAccountsByConnection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts-by-connection [connection-id]",
+		Short:   "Query the interchain accounts registered over a host connection",
+		Long:    "Query the interchain accounts registered over a host connection",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-accounts-by-connection connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInterchainAccountsByConnectionRequest{
+				ConnectionId: args[0],
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.InterchainAccountsByConnection(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdAllInterchainAccounts returns the command handler for querying all interchain accounts registered on the host chain.
+func GetCmdAllInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query all interchain accounts registered on the host chain",
+		Long:    "Query all interchain accounts registered on the host chain, regardless of their connection or owner",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-accounts", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllInterchainAccountsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllInterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdHostCapabilities returns the command handler for querying the interchain accounts features supported by the host chain.
+func GetCmdHostCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "capabilities",
+		Short:   "Query the interchain accounts features supported by the host chain",
+		Long:    "Query the ICS27 versions, channel version formats, encoding formats, transaction types and packet data types supported by the host chain",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host capabilities", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HostCapabilities(cmd.Context(), &types.QueryHostCapabilitiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Capabilities)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
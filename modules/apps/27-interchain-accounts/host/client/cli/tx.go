@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+const (
+	flagReadOnly = "read-only"
+)
+
+// NewCmdSubmitLinkInterchainAccountProposal implements a command handler for submitting an interchain accounts
+// host account linking proposal transaction.
+func NewCmdSubmitLinkInterchainAccountProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "link-interchain-account [connection-id] [controller-port-id] [account-address]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to link an existing interchain account to a controller port",
+		Long: "Submit a proposal to link an existing interchain account to a controller port along with an initial deposit.\n" +
+			"Channels subsequently opened by the controller port over the host connection control the linked interchain account.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal link-interchain-account connection-0 icacontroller-cosmos1... cosmos1... --title=<title> --description=<description> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewLinkInterchainAccountProposal(title, description, args[0], args[1], args[2])
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitProvisionInterchainAccountProposal implements a command handler for submitting an interchain accounts
+// host account provisioning proposal transaction.
+func NewCmdSubmitProvisionInterchainAccountProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provision-interchain-account [controller-port-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to provision the interchain account of a controller port",
+		Long: "Submit a proposal to provision the interchain account of a controller port along with an initial deposit.\n" +
+			"Channels may be opened for the controller port even if interchain account creation is disabled.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal provision-interchain-account icacontroller-cosmos1... --read-only --title=<title> --description=<description> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			readOnly, err := cmd.Flags().GetBool(flagReadOnly)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewProvisionInterchainAccountProposal(title, description, args[0], readOnly)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(flagReadOnly, false, "restrict the interchain account to the query only messages")
+
+	return cmd
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/client/cli"
+)
+
+var (
+	LinkInterchainAccountProposalHandler      = govclient.NewProposalHandler(cli.NewCmdSubmitLinkInterchainAccountProposal, emptyRestHandler)
+	ProvisionInterchainAccountProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitProvisionInterchainAccountProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-ica-host",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC proposals")
+		},
+	}
+}
@@ -109,14 +109,13 @@ func (im IBCModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled.Error())
 	}
 
-	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
-
-	if err := im.keeper.OnRecvPacket(ctx, packet); err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err.Error())
+	result, err := im.keeper.OnRecvPacket(ctx, packet)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
 	}
 
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
-	return ack
+	return channeltypes.NewResultAcknowledgement(result)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
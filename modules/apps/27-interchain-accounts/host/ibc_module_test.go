@@ -1,15 +1,20 @@
 package host_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto"
 
+	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
+	hostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -139,7 +144,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, false, nil, true, 0, false, nil, 0, 0))
 			}, false,
 		},
 		{
@@ -260,7 +265,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, false, nil, true, 0, false, nil, 0, 0))
 			}, false,
 		},
 		{
@@ -399,7 +404,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, false, nil, true, 0, false, nil, 0, 0))
 			}, false,
 		},
 		{
@@ -443,7 +448,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
 				Amount:      amount,
 			}
-			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg})
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 			suite.Require().NoError(err)
 
 			icaPacketData := icatypes.InterchainAccountPacketData{
@@ -452,7 +457,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 			}
 			packetData = icaPacketData.GetBytes()
 
-			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 			// malleate packetData for test cases
@@ -587,8 +592,16 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 
 func (suite *InterchainAccountsTestSuite) TestNegotiateAppVersion() {
 	var (
+		path            *ibctesting.Path
+		order           channeltypes.Order
+		metadata        icatypes.ICAMetadata
 		proposedVersion string
+		expVersion      string
 	)
+
+	supportedVersions := icatypes.SupportedVersions
+	defer func() { icatypes.SupportedVersions = supportedVersions }()
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -597,11 +610,177 @@ func (suite *InterchainAccountsTestSuite) TestNegotiateAppVersion() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: newer proposed version negotiated down", func() {
+				proposedVersion = "ics27-2"
+			}, true,
+		},
+		{
+			"success: newer ICAMetadata version negotiated down", func() {
+				metadata.Version = "ics27-2"
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Version = icatypes.VersionPrefix
+				metadata.Address = TestAccAddress.String()
+				expVersion = icatypes.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"success: highest supported version not exceeding the proposed version", func() {
+				icatypes.SupportedVersions = []string{icatypes.VersionPrefix, "ics27-2", "ics27-4"}
+				proposedVersion = "ics27-3"
+				expVersion = icatypes.NewAppVersion("ics27-2", TestAccAddress.String())
+			}, true,
+		},
+		{
+			"success: ICAMetadata with highest supported version not exceeding the proposed version", func() {
+				icatypes.SupportedVersions = []string{icatypes.VersionPrefix, "ics27-2", "ics27-4"}
+				metadata.Version = "ics27-3"
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Version = "ics27-2"
+				metadata.Address = TestAccAddress.String()
+				expVersion = icatypes.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"no supported version overlaps with the proposed version", func() {
+				icatypes.SupportedVersions = []string{"ics27-2"}
+			}, false,
+		},
+		{
+			"ICAMetadata without supported version overlapping with the proposed version", func() {
+				icatypes.SupportedVersions = []string{"ics27-2"}
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"invalid proposed version number", func() {
+				proposedVersion = "ics27-0"
+			}, false,
+		},
+		{
+			"invalid ICAMetadata version", func() {
+				metadata.Version = "ics20-1"
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"success: ICAMetadata", func() {
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				expVersion = icatypes.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"success: ICAMetadata without encoding selects default encoding", func() {
+				metadata.Encoding = ""
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				metadata.Encoding = icatypes.EncodingProtobuf
+				expVersion = icatypes.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"success: ICAMetadata with proto3json encoding", func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				expVersion = icatypes.EncodeICAMetadata(metadata)
+			}, true,
+		},
 		{
 			"invalid proposed version", func() {
 				proposedVersion = "invalid version"
 			}, false,
 		},
+		{
+			"invalid ICAMetadata encoding", func() {
+				proposedVersion = "{invalid metadata"
+			}, false,
+		},
+		{
+			"unsupported encoding format", func() {
+				metadata.Encoding = "amino"
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"unsupported transaction type", func() {
+				metadata.TxType = "invalid-tx-type"
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"invalid host connection ID", func() {
+				metadata.HostConnectionId = "connection-10"
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"invalid controller connection ID", func() {
+				metadata.ControllerConnectionId = "connection-10"
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"proposed ICAMetadata contains account address", func() {
+				metadata.Address = TestAccAddress.String()
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"unsupported channel ordering: UNORDERED", func() {
+				order = channeltypes.UNORDERED
+			}, false,
+		},
+		{
+			"unsupported channel ordering: NONE", func() {
+				order = channeltypes.NONE
+			}, false,
+		},
+		{
+			"success: max gas budget assigned by host", func() {
+				suite.setGasBudgetParams(0, 500000)
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				metadata.GasBudget = 500000
+				expVersion = icatypes.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"success: proposed gas budget within bounds", func() {
+				suite.setGasBudgetParams(100000, 500000)
+				metadata.GasBudget = 200000
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				expVersion = icatypes.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"proposed gas budget exceeds max gas budget", func() {
+				suite.setGasBudgetParams(0, 500000)
+				metadata.GasBudget = 600000
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"proposed gas budget is less than min gas budget", func() {
+				suite.setGasBudgetParams(100000, 0)
+				metadata.GasBudget = 50000
+				proposedVersion = icatypes.EncodeICAMetadata(metadata)
+			}, false,
+		},
+		{
+			"legacy version format cannot carry a gas budget", func() {
+				suite.setGasBudgetParams(0, 500000)
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -609,7 +788,7 @@ func (suite *InterchainAccountsTestSuite) TestNegotiateAppVersion() {
 
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
-			path := NewICAPath(suite.chainA, suite.chainB)
+			path = NewICAPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
 
 			module, _, err := suite.chainA.GetSimApp().GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), icatypes.PortID)
@@ -626,15 +805,19 @@ func (suite *InterchainAccountsTestSuite) TestNegotiateAppVersion() {
 				ChannelId: path.EndpointB.ChannelID,
 			}
 
+			// chainA acts as the host chain, the controller chain is the counterparty of its connection
+			icatypes.SupportedVersions = supportedVersions
+			order = channeltypes.ORDERED
+			metadata = icatypes.NewDefaultICAMetadata(path.EndpointB.ConnectionID, path.EndpointA.ConnectionID)
 			proposedVersion = icatypes.VersionPrefix
+			expVersion = TestVersion
 
 			tc.malleate()
 
-			version, err := cbs.NegotiateAppVersion(suite.chainA.GetContext(), channeltypes.ORDERED, path.EndpointA.ConnectionID, icatypes.PortID, *counterparty, proposedVersion)
+			version, err := cbs.NegotiateAppVersion(suite.chainA.GetContext(), order, path.EndpointA.ConnectionID, icatypes.PortID, *counterparty, proposedVersion)
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().NoError(icatypes.ValidateVersion(version))
-				suite.Require().Equal(TestVersion, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Empty(version)
@@ -642,3 +825,91 @@ func (suite *InterchainAccountsTestSuite) TestNegotiateAppVersion() {
 		})
 	}
 }
+
+// setGasBudgetParams sets the min and max gas budget params of the host submodule on chainA
+func (suite *InterchainAccountsTestSuite) setGasBudgetParams(minGasBudget, maxGasBudget uint64) {
+	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
+	params.MinGasBudget = minGasBudget
+	params.MaxGasBudget = maxGasBudget
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), params)
+}
+
+// panicMsgServer wraps the bank MsgServer, panicking after a MsgSend has been successfully executed
+type panicMsgServer struct {
+	banktypes.MsgServer
+}
+
+// Send executes the MsgSend and deliberately panics afterwards
+func (s panicMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	if _, err := s.MsgServer.Send(goCtx, msg); err != nil {
+		return nil, err
+	}
+
+	panic("mock panic during message execution")
+}
+
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketPanic() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	simApp := suite.chainB.GetSimApp()
+
+	// send 100stake to interchain account wallet
+	amount, _ := sdk.ParseCoinsNormalized("100stake")
+	interchainAccountAddr, _ := simApp.ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+	bankMsg := &banktypes.MsgSend{FromAddress: suite.chainB.SenderAccount.GetAddress().String(), ToAddress: interchainAccountAddr, Amount: amount}
+
+	_, err = suite.chainB.SendMsgs(bankMsg)
+	suite.Require().NoError(err)
+
+	// build packet data
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      amount,
+	}
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+	simApp.ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// create a host keeper using a msg router which routes MsgSend to a panicking handler
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(simApp.InterfaceRegistry())
+	banktypes.RegisterMsgServer(msgRouter, panicMsgServer{bankkeeper.NewMsgServerImpl(simApp.BankKeeper)})
+
+	hostKeeper := hostkeeper.NewKeeper(
+		simApp.AppCodec(), simApp.GetKey(types.StoreKey), simApp.GetSubspace(types.SubModuleName),
+		simApp.IBCKeeper.ChannelKeeper, simApp.IBCKeeper.ChannelKeeper, &simApp.IBCKeeper.PortKeeper,
+		simApp.IBCKeeper.ConnectionKeeper, simApp.AccountKeeper, simApp.ScopedICAHostKeeper, msgRouter,
+	)
+	hostModule := icahost.NewIBCModule(hostKeeper)
+
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+	var ack exported.Acknowledgement
+	suite.Require().NotPanics(func() {
+		ack = hostModule.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
+	})
+	suite.Require().False(ack.Success())
+
+	// assert state transitions executed prior to the panic have been discarded
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	balance := simApp.BankKeeper.GetAllBalances(suite.chainB.GetContext(), icaAddr)
+	suite.Require().Equal(amount, balance)
+
+	// assert the chain continues to produce blocks
+	suite.coordinator.CommitBlock(suite.chainB)
+}
@@ -2,14 +2,18 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 )
 
 // RegisterInterchainAccount attempts to create a new account using the provided address and stores it in state keyed by the provided port identifier
+// If readOnly is true the account is registered in read-only mode, restricting the messages it may execute to the query only messages
 // If an account for the provided address already exists this function returns early (no-op)
-func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, accAddr sdk.AccAddress, controllerPortID string) {
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, accAddr sdk.AccAddress, controllerPortID string, readOnly bool) {
 	if acc := k.accountKeeper.GetAccount(ctx, accAddr); acc != nil {
 		return
 	}
@@ -23,4 +27,118 @@ func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, accAddr sdk.AccAddres
 	k.accountKeeper.SetAccount(ctx, interchainAccount)
 
 	k.SetInterchainAccountAddress(ctx, controllerPortID, interchainAccount.Address)
+
+	if readOnly {
+		k.SetReadOnlyInterchainAccount(ctx, interchainAccount.Address)
+	}
+}
+
+// ProvisionInterchainAccount creates the interchain account of the provided controller port identifier ahead of the channel
+// handshake and returns its address. It is invoked by the handler of a ProvisionInterchainAccountProposal and allows channels
+// to be opened for the provided controller port if account creation is disabled.
+// The address of a pre-provisioned account may additionally be linked to other controller ports, see LinkInterchainAccount.
+func (k Keeper) ProvisionInterchainAccount(ctx sdk.Context, controllerPortID string, readOnly bool) (string, error) {
+	if _, err := icatypes.ParseHostConnSequence(controllerPortID); err != nil {
+		return "", sdkerrors.Wrapf(err, "expected format %s, got %s", icatypes.ControllerPortFormat, controllerPortID)
+	}
+
+	if _, err := icatypes.ParseControllerPortOwner(controllerPortID); err != nil {
+		return "", sdkerrors.Wrapf(err, "expected format %s, got %s", icatypes.ControllerPortFormat, controllerPortID)
+	}
+
+	if addr, found := k.GetInterchainAccountAddress(ctx, controllerPortID); found {
+		return "", sdkerrors.Wrapf(icatypes.ErrInterchainAccountAlreadySet, "interchain account %s is already set for controller port %s", addr, controllerPortID)
+	}
+
+	accAddr := k.addressGenerator(k.accountKeeper.GetModuleAddress(icatypes.ModuleName), controllerPortID)
+	if acc := k.accountKeeper.GetAccount(ctx, accAddr); acc != nil {
+		return "", sdkerrors.Wrapf(icatypes.ErrAccountAlreadyExist, "account %s already exists", accAddr)
+	}
+
+	k.RegisterInterchainAccount(ctx, accAddr, controllerPortID, readOnly)
+
+	return accAddr.String(), nil
+}
+
+// ProvisionInterchainAccountProposal creates the interchain account of a controller port as specified in the proposal
+func (k Keeper) ProvisionInterchainAccountProposal(ctx sdk.Context, p *types.ProvisionInterchainAccountProposal) error {
+	accAddr, err := k.ProvisionInterchainAccount(ctx, p.PortId, p.ReadOnly)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("interchain account provisioned", "address", accAddr, "port-id", p.PortId, "read-only", p.ReadOnly)
+
+	return nil
+}
+
+// LinkInterchainAccount links the existing interchain account of the provided address to the provided controller port identifier
+// on the given host connection. Channels subsequently opened by the controller port over the connection control the linked interchain
+// account. It is intended to be invoked by an administrative flow of the host chain, such as a governance proposal handler, and
+// requires account reuse to be enabled.
+func (k Keeper) LinkInterchainAccount(ctx sdk.Context, connectionID, controllerPortID, accountAddress string) error {
+	if !k.IsAccountReuseAllowed(ctx) {
+		return sdkerrors.Wrap(types.ErrAccountReuseDisabled, "interchain accounts may not be linked to additional controller ports")
+	}
+
+	connSeq, err := icatypes.ParseHostConnSequence(controllerPortID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "expected format %s, got %s", icatypes.ControllerPortFormat, controllerPortID)
+	}
+
+	if _, err := icatypes.ParseControllerPortOwner(controllerPortID); err != nil {
+		return sdkerrors.Wrapf(err, "expected format %s, got %s", icatypes.ControllerPortFormat, controllerPortID)
+	}
+
+	if expConnectionID := connectiontypes.FormatConnectionIdentifier(connSeq); expConnectionID != connectionID {
+		return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "expected controller port %s to open channels over connection %s, got %s", controllerPortID, connectionID, expConnectionID)
+	}
+
+	if _, found := k.connectionKeeper.GetConnection(ctx, connectionID); !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if addr, found := k.GetInterchainAccountAddress(ctx, controllerPortID); found {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountAlreadySet, "interchain account %s is already set for controller port %s", addr, controllerPortID)
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(accountAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "invalid interchain account address %s: %s", accountAddress, err)
+	}
+
+	if _, ok := k.accountKeeper.GetAccount(ctx, accAddr).(*icatypes.InterchainAccount); !ok {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "no interchain account exists for address %s", accountAddress)
+	}
+
+	k.SetInterchainAccountAddress(ctx, controllerPortID, accountAddress)
+
+	return nil
+}
+
+// LinkInterchainAccountProposal links an existing interchain account to a controller port as specified in the proposal
+func (k Keeper) LinkInterchainAccountProposal(ctx sdk.Context, p *types.LinkInterchainAccountProposal) error {
+	if err := k.LinkInterchainAccount(ctx, p.ConnectionId, p.PortId, p.AccountAddress); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("interchain account linked", "address", p.AccountAddress, "connection-id", p.ConnectionId, "port-id", p.PortId)
+
+	return nil
+}
+
+// GetLinkedInterchainAccountAddress returns the address of the existing interchain account linked to the provided controller port
+// identifier, see LinkInterchainAccount. Controller ports which are not linked are associated with the interchain account generated
+// from the controller port identifier.
+func (k Keeper) GetLinkedInterchainAccountAddress(ctx sdk.Context, controllerPortID string) (string, bool) {
+	addr, found := k.GetInterchainAccountAddress(ctx, controllerPortID)
+	if !found {
+		return "", false
+	}
+
+	if addr == k.addressGenerator(k.accountKeeper.GetModuleAddress(icatypes.ModuleName), controllerPortID).String() {
+		return "", false
+	}
+
+	return addr, true
 }
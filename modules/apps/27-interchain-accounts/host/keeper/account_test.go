@@ -2,8 +2,15 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -31,3 +38,505 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
 	interchainAccount := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), icaAddr)
 	suite.Require().Equal(interchainAccount.GetAddress().String(), storedAddr)
 }
+
+func (suite *KeeperTestSuite) TestProvisionInterchainAccount() {
+	var controllerPortID string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid controller port identifier", func() {
+				controllerPortID = "invalid-port"
+			}, false,
+		},
+		{
+			"interchain account is already set for controller port", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), controllerPortID, TestAccAddress.String())
+			}, false,
+		},
+		{
+			"account already exists", func() {
+				acc := suite.chainB.GetSimApp().AccountKeeper.NewAccountWithAddress(suite.chainB.GetContext(), TestAccAddress)
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), acc)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			controllerPortID = TestPortID
+
+			tc.malleate()
+
+			accAddr, err := suite.chainB.GetSimApp().ICAHostKeeper.ProvisionInterchainAccount(suite.chainB.GetContext(), controllerPortID, true)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestAccAddress.String(), accAddr)
+
+				storedAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), controllerPortID)
+				suite.Require().True(found)
+				suite.Require().Equal(accAddr, storedAddr)
+
+				suite.Require().NotNil(suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), TestAccAddress))
+				suite.Require().True(suite.chainB.GetSimApp().ICAHostKeeper.IsReadOnlyInterchainAccount(suite.chainB.GetContext(), accAddr))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Empty(accAddr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProvisionInterchainAccountProposal() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	// account creation is disabled such that channels may only be opened for pre-provisioned interchain accounts
+	params := types.NewParams(true, nil, false, nil, false, 0, false, nil, 0, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	proposal := types.NewProvisionInterchainAccountProposal(ibctesting.Title, ibctesting.Description, TestPortID, false)
+	suite.Require().NoError(proposal.ValidateBasic())
+
+	handler := icahost.NewProposalHandler(suite.chainB.GetSimApp().ICAHostKeeper)
+	err := handler(suite.chainB.GetContext(), proposal)
+	suite.Require().NoError(err)
+
+	accAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(TestAccAddress.String(), accAddr)
+	suite.Require().False(suite.chainB.GetSimApp().ICAHostKeeper.IsReadOnlyInterchainAccount(suite.chainB.GetContext(), accAddr))
+
+	// provisioning the interchain account again fails as it is already set for the controller port
+	err = handler(suite.chainB.GetContext(), proposal)
+	suite.Require().ErrorIs(err, icatypes.ErrInterchainAccountAlreadySet)
+
+	err = SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestLinkInterchainAccount() {
+	var (
+		path             *ibctesting.Path
+		connectionID     string
+		controllerPortID string
+		accAddr          string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"account reuse is disabled", func() {
+				params := types.NewParams(true, nil, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false,
+		},
+		{
+			"invalid controller port identifier", func() {
+				controllerPortID = "invalid-port-id"
+			}, false,
+		},
+		{
+			"controller port does not open channels over the connection", func() {
+				connectionID = ibctesting.FirstConnectionID
+			}, false,
+		},
+		{
+			"connection not found", func() {
+				connectionID = "connection-100"
+
+				var err error
+				controllerPortID, err = icatypes.GeneratePortID(TestOwnerAddress, path.EndpointA.ConnectionID, connectionID)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"controller port is already associated with an interchain account", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), controllerPortID, accAddr)
+			}, false,
+		},
+		{
+			"invalid account address", func() {
+				accAddr = "invalid-address"
+			}, false,
+		},
+		{
+			"account is not an interchain account", func() {
+				accAddr = suite.chainB.SenderAccount.GetAddress().String()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := types.NewParams(true, nil, true, nil, true, 0, false, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			// create a second connection to the same controller chain
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			connectionID = path.EndpointB.ConnectionID
+			controllerPortID, err = icatypes.GeneratePortID(TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+			suite.Require().NoError(err)
+
+			accAddr = TestAccAddress.String()
+
+			tc.malleate()
+
+			err = suite.chainB.GetSimApp().ICAHostKeeper.LinkInterchainAccount(suite.chainB.GetContext(), connectionID, controllerPortID, accAddr)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				linkedAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetLinkedInterchainAccountAddress(suite.chainB.GetContext(), controllerPortID)
+				suite.Require().True(found)
+				suite.Require().Equal(TestAccAddress.String(), linkedAddr)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestLinkInterchainAccountProposal() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	params := types.NewParams(true, nil, true, nil, true, 0, false, nil, 0, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	secondPath := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(secondPath)
+
+	controllerPortID, err := icatypes.GeneratePortID(TestOwnerAddress, secondPath.EndpointA.ConnectionID, secondPath.EndpointB.ConnectionID)
+	suite.Require().NoError(err)
+
+	proposal := types.NewLinkInterchainAccountProposal(ibctesting.Title, ibctesting.Description, secondPath.EndpointB.ConnectionID, controllerPortID, TestAccAddress.String())
+	suite.Require().NoError(proposal.ValidateBasic())
+
+	handler := icahost.NewProposalHandler(suite.chainB.GetSimApp().ICAHostKeeper)
+	err = handler(suite.chainB.GetContext(), proposal)
+	suite.Require().NoError(err)
+
+	linkedAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetLinkedInterchainAccountAddress(suite.chainB.GetContext(), controllerPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(TestAccAddress.String(), linkedAddr)
+
+	// linking the controller port again fails as it is already associated with an interchain account
+	err = handler(suite.chainB.GetContext(), proposal)
+	suite.Require().ErrorIs(err, icatypes.ErrInterchainAccountAlreadySet)
+}
+
+func (suite *KeeperTestSuite) TestGetLinkedInterchainAccountAddress() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	// controller ports which are not associated with an interchain account are not linked
+	_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetLinkedInterchainAccountAddress(suite.chainB.GetContext(), TestPortID)
+	suite.Require().False(found)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// controller ports associated with the interchain account generated from the controller port are not linked
+	_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetLinkedInterchainAccountAddress(suite.chainB.GetContext(), TestPortID)
+	suite.Require().False(found)
+}
+
+// linkInterchainAccount links the interchain account of the provided address to the controller port of the owner on the provided path
+func (suite *KeeperTestSuite) linkInterchainAccount(path *ibctesting.Path, owner, accAddr string) {
+	controllerPortID, err := icatypes.GeneratePortID(owner, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	suite.Require().NoError(err)
+
+	err = suite.chainB.GetSimApp().ICAHostKeeper.LinkInterchainAccount(suite.chainB.GetContext(), path.EndpointB.ConnectionID, controllerPortID, accAddr)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestInterchainAccountReuse() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, true, nil, true, 0, false, nil, 0, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// open an additional channel for the same owner over a second connection to the same controller chain
+	secondPath := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(secondPath)
+
+	suite.linkInterchainAccount(secondPath, TestOwnerAddress, TestAccAddress.String())
+
+	err = SetupICAPath(secondPath, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.Require().NotEqual(path.EndpointA.ChannelConfig.PortID, secondPath.EndpointA.ChannelConfig.PortID)
+
+	accAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), secondPath.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(TestAccAddress.String(), accAddr)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+	// execute a transaction for the shared interchain account via the additional channel
+	msg := &banktypes.MsgSend{
+		FromAddress: accAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	packet := channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		1,
+		secondPath.EndpointA.ChannelConfig.PortID,
+		secondPath.EndpointA.ChannelID,
+		secondPath.EndpointB.ChannelConfig.PortID,
+		secondPath.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestUnlinkedInterchainAccountNotReused() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	params := types.NewParams(true, nil, true, nil, true, 0, false, nil, 0, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// a controller port of the same owner over a second connection which has not been linked attempts to claim the existing account
+	secondPath := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(secondPath)
+
+	err = InitInterchainAccount(secondPath.EndpointA, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	cacheCtx, _ := suite.chainB.GetContext().CacheContext()
+	err = suite.chanOpenTry(cacheCtx, secondPath, TestVersion)
+	suite.Require().ErrorIs(err, icatypes.ErrInvalidVersion)
+
+	// the controller port is only able to open a channel for the interchain account generated from its port identifier
+	expAccAddr := icatypes.GenerateAddress(suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(icatypes.ModuleName), secondPath.EndpointA.ChannelConfig.PortID)
+	suite.Require().NotEqual(TestAccAddress, expAccAddr)
+
+	err = suite.chanOpenTry(suite.chainB.GetContext(), secondPath, icatypes.NewAppVersion(icatypes.VersionPrefix, expAccAddr.String()))
+	suite.Require().NoError(err)
+
+	accAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), secondPath.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(expAccAddr.String(), accAddr)
+}
+
+func (suite *KeeperTestSuite) TestLinkedInterchainAccountReuseDisabled() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	params := types.NewParams(true, nil, true, nil, true, 0, false, nil, 0, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	secondPath := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(secondPath)
+
+	suite.linkInterchainAccount(secondPath, TestOwnerAddress, TestAccAddress.String())
+
+	err = InitInterchainAccount(secondPath.EndpointA, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// disabling account reuse prevents linked controller ports from opening channels
+	params.AllowAccountReuse = false
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	err = suite.chanOpenTry(suite.chainB.GetContext(), secondPath, TestVersion)
+	suite.Require().ErrorIs(err, types.ErrAccountReuseDisabled)
+}
+
+// chanOpenTry invokes the host OnChanOpenTry callback for the channel initialised on the controller chain using the provided version
+func (suite *KeeperTestSuite) chanOpenTry(ctx sdk.Context, path *ibctesting.Path, version string) error {
+	channelSequence := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(ctx)
+	channelID := channeltypes.FormatChannelIdentifier(channelSequence)
+
+	chanCap, err := suite.chainB.App.GetScopedIBCKeeper().NewCapability(ctx, host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, channelID))
+	suite.Require().NoError(err)
+
+	counterparty := channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	channel := channeltypes.NewChannel(channeltypes.TRYOPEN, channeltypes.ORDERED, counterparty, []string{path.EndpointB.ConnectionID}, version)
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannel(ctx, path.EndpointB.ChannelConfig.PortID, channelID, channel)
+
+	return suite.chainB.GetSimApp().ICAHostKeeper.OnChanOpenTry(ctx, channel.Ordering, channel.ConnectionHops,
+		path.EndpointB.ChannelConfig.PortID, channelID, chanCap, counterparty, version, icatypes.VersionPrefix,
+	)
+}
+
+func (suite *KeeperTestSuite) TestPreProvisionedInterchainAccountReuse() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	params := types.NewParams(true, nil, true, nil, false, 0, false, nil, 0, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	controllerPortID, err := icatypes.GeneratePortID(TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	suite.Require().NoError(err)
+
+	accAddr, err := suite.chainB.GetSimApp().ICAHostKeeper.ProvisionInterchainAccount(suite.chainB.GetContext(), controllerPortID, false)
+	suite.Require().NoError(err)
+
+	err = SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// the pre-provisioned interchain account is reused by a linked controller port over an additional connection
+	secondPath := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(secondPath)
+
+	suite.linkInterchainAccount(secondPath, TestOwnerAddress, accAddr)
+
+	err = SetupICAPath(secondPath, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	reusedAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), secondPath.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(accAddr, reusedAddr)
+}
+
+func (suite *KeeperTestSuite) TestInterchainAccountReuseExecutionOrder() {
+	testCases := []struct {
+		name  string
+		order []int
+	}{
+		{
+			"packet over the first channel is delivered first", []int{0, 1},
+		},
+		{
+			"packet over the second channel is delivered first", []int{1, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, true, nil, true, 0, false, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			secondPath := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(secondPath)
+
+			suite.linkInterchainAccount(secondPath, TestOwnerAddress, TestAccAddress.String())
+
+			err = SetupICAPath(secondPath, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// the shared interchain account is only able to fund one of the two transactions
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150))))
+
+			var packets []channeltypes.Packet
+			for _, p := range []*ibctesting.Path{path, secondPath} {
+				msg := &banktypes.MsgSend{
+					FromAddress: TestAccAddress.String(),
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packets = append(packets, channeltypes.NewPacket(
+					icaPacketData.GetBytes(),
+					1,
+					p.EndpointA.ChannelConfig.PortID,
+					p.EndpointA.ChannelID,
+					p.EndpointB.ChannelConfig.PortID,
+					p.EndpointB.ChannelID,
+					clienttypes.NewHeight(0, 100),
+					0,
+				))
+			}
+
+			// both packets are delivered within the same block and executed sequentially in the order of delivery,
+			// such that only the packet delivered first is funded and the second observes its state changes
+			ctx := suite.chainB.GetContext()
+			recipientBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packets[tc.order[0]])
+			suite.Require().NoError(err)
+
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packets[tc.order[1]])
+			suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, TestAccAddress, sdk.DefaultBondDenom)
+			suite.Require().Equal(sdk.NewInt(50), balance.Amount)
+
+			expRecipientBalance := recipientBalance.AddAmount(sdk.NewInt(100))
+			suite.Require().Equal(expRecipientBalance, suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+		})
+	}
+}
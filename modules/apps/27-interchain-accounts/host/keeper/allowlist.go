@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// allowListCache holds the AllowList compiled from the most recently read raw AllowMessages parameter.
+// The cache is keyed by the raw parameter bytes rather than invalidated on parameter updates, ensuring
+// parameter updates within the same block, as well as reverted updates, are always observed.
+type allowListCache struct {
+	mu          sync.Mutex
+	legacyAmino *codec.LegacyAmino
+	raw         []byte
+	allowList   *types.AllowList
+}
+
+// WithAllowListCache enables caching of the compiled AllowMessages parameter. The parameter is still read
+// from the paramstore for every packet, consuming the same amount of gas, but is only decoded and compiled
+// when its raw value differs from the cached value.
+func WithAllowListCache() Option {
+	return func(k *Keeper) {
+		k.allowListCache = &allowListCache{
+			legacyAmino: codec.NewLegacyAmino(),
+		}
+	}
+}
+
+// getAllowList returns the compiled AllowMessages parameter, using the allow list cache if enabled
+func (k Keeper) getAllowList(ctx sdk.Context) *types.AllowList {
+	if k.allowListCache == nil {
+		return types.NewAllowList(k.GetAllowMessages(ctx))
+	}
+
+	raw := k.paramSpace.GetRaw(ctx, types.KeyAllowMessages)
+
+	k.allowListCache.mu.Lock()
+	defer k.allowListCache.mu.Unlock()
+
+	if k.allowListCache.allowList != nil && bytes.Equal(k.allowListCache.raw, raw) {
+		return k.allowListCache.allowList
+	}
+
+	var allowMsgs []string
+	if err := k.allowListCache.legacyAmino.UnmarshalJSON(raw, &allowMsgs); err != nil {
+		panic(err)
+	}
+
+	k.allowListCache.raw = append([]byte(nil), raw...)
+	k.allowListCache.allowList = types.NewAllowList(allowMsgs)
+
+	return k.allowListCache.allowList
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// negotiateGasBudget returns the gas budget to be negotiated for a channel given the gas budget proposed by the
+// controller chain. The max gas budget param is assigned if the controller chain did not propose a gas budget.
+// An error is returned if the resulting gas budget is not within the bounds set by the gas budget params
+func (k Keeper) negotiateGasBudget(ctx sdk.Context, proposedGasBudget uint64) (uint64, error) {
+	gasBudget := proposedGasBudget
+	if gasBudget == 0 {
+		gasBudget = k.GetMaxGasBudget(ctx)
+	}
+
+	if err := k.validateGasBudget(ctx, gasBudget); err != nil {
+		return 0, err
+	}
+
+	return gasBudget, nil
+}
+
+// validateGasBudget asserts the provided gas budget is within the bounds set by the gas budget params. A gas budget
+// of 0, leaving the execution of interchain account transactions unbounded, is only valid if the max gas budget
+// param is not set
+func (k Keeper) validateGasBudget(ctx sdk.Context, gasBudget uint64) error {
+	maxGasBudget := k.GetMaxGasBudget(ctx)
+	if gasBudget == 0 {
+		if maxGasBudget != 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidGasBudget, "channel must have a gas budget not exceeding %d", maxGasBudget)
+		}
+
+		return nil
+	}
+
+	if minGasBudget := k.GetMinGasBudget(ctx); gasBudget < minGasBudget {
+		return sdkerrors.Wrapf(types.ErrInvalidGasBudget, "gas budget %d is less than the minimum %d", gasBudget, minGasBudget)
+	}
+
+	if maxGasBudget != 0 && gasBudget > maxGasBudget {
+		return sdkerrors.Wrapf(types.ErrInvalidGasBudget, "gas budget %d exceeds the maximum %d", gasBudget, maxGasBudget)
+	}
+
+	return nil
+}
+
+// getGasBudget returns the gas budget negotiated for the provided channel. Channels using the legacy version format,
+// which predates gas budget negotiation, are not bounded by a gas budget.
+func (k Keeper) getGasBudget(ctx sdk.Context, portID, channelID string) (uint64, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID %s channel ID %s", portID, channelID)
+	}
+
+	if !icatypes.IsICAMetadataVersion(channel.Version) {
+		return 0, nil
+	}
+
+	metadata, err := icatypes.ParseICAMetadata(channel.Version)
+	if err != nil {
+		return 0, err
+	}
+
+	return metadata.GasBudget, nil
+}
+
+// executeMsgsWithinGasBudget executes the provided msgs with the provided execution function using a gas meter limited
+// to the provided gas budget.
+// Exceeding the gas budget results in an error rather than an out of gas panic, such that the packet is acknowledged
+// with an error. The gas consumed by the msgs is charged to the gas meter of the provided context, out of gas panics
+// raised by it are propagated as they are handled by the transaction processing the packet.
+func (k Keeper) executeMsgsWithinGasBudget(
+	ctx sdk.Context, msgs []sdk.Msg, gasBudget uint64,
+	executeMsgs func(sdk.Context, []sdk.Msg) ([]abci.Event, error),
+) (events []abci.Event, err error) {
+	gasMeter := sdk.NewGasMeter(gasBudget)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+
+			events, err = nil, sdkerrors.Wrapf(types.ErrGasBudgetExceeded, "gas budget %d", gasBudget)
+		}
+
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain account transaction")
+	}()
+
+	return executeMsgs(ctx.WithGasMeter(gasMeter), msgs)
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketGasBudget() {
+	var (
+		path      *ibctesting.Path
+		gasBudget uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: channel without gas budget",
+			func() {},
+			nil,
+		},
+		{
+			"success: transaction executed within gas budget",
+			func() {
+				gasBudget = 1000000
+			},
+			nil,
+		},
+		{
+			"transaction exceeds gas budget",
+			func() {
+				gasBudget = 1000
+			},
+			types.ErrGasBudgetExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			gasBudget = 0
+
+			tc.malleate() // malleate mutates test data
+
+			setHostChannelGasBudget(path, gasBudget)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			recipient := suite.chainB.SenderAccount.GetAddress()
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext().WithGasMeter(sdk.NewInfiniteGasMeter())
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(balance.AddAmount(sdk.NewInt(100)), suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(balance, suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom))
+
+				// the gas consumed up to the gas budget is charged to the transaction processing the packet
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), gasBudget)
+			}
+		})
+	}
+}
+
+// setHostChannelGasBudget overwrites the host channel version with ICAMetadata negotiating the provided gas budget
+func setHostChannelGasBudget(path *ibctesting.Path, gasBudget uint64) {
+	metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	metadata.Address = TestAccAddress.String()
+	metadata.GasBudget = gasBudget
+
+	channel := path.EndpointB.GetChannel()
+	channel.Version = icatypes.EncodeICAMetadata(metadata)
+	path.EndpointB.SetChannel(channel)
+}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
@@ -26,6 +29,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.HostGenesisState
 		keeper.SetInterchainAccountAddress(ctx, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, accAddr := range state.ReadOnlyAccounts {
+		keeper.SetReadOnlyInterchainAccount(ctx, accAddr)
+	}
+
+	for _, accountAuthorizations := range state.AccountAuthorizations {
+		keeper.SetAccountAuthorizations(ctx, accountAuthorizations.Address, accountAuthorizations.Authorizations)
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
@@ -36,5 +47,48 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) icatypes.HostGenesisState {
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.PortID,
 		keeper.GetParams(ctx),
+		keeper.GetAllReadOnlyInterchainAccounts(ctx),
+		keeper.GetAllAccountAuthorizations(ctx),
 	)
 }
+
+// RebuildGenesisFromState reconstructs the interchain accounts host genesis state by iterating the live store
+// and validates its consistency with the state of the port, channel and auth modules. The host port must be bound,
+// each active channel must exist and be OPEN and each interchain account must exist in the auth module.
+// For a well-formed chain the reconstructed genesis state is identical to the exported genesis state.
+func (k Keeper) RebuildGenesisFromState(ctx sdk.Context) (icatypes.HostGenesisState, error) {
+	if !k.IsBound(ctx, icatypes.PortID) {
+		return icatypes.HostGenesisState{}, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "port %s is not bound", icatypes.PortID)
+	}
+
+	activeChannels := k.GetAllActiveChannels(ctx)
+	for _, ch := range activeChannels {
+		channel, found := k.channelKeeper.GetChannel(ctx, ch.PortId, ch.ChannelId)
+		if !found {
+			return icatypes.HostGenesisState{}, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", ch.PortId, ch.ChannelId)
+		}
+
+		if channel.State != channeltypes.OPEN {
+			return icatypes.HostGenesisState{}, sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "active channel %s on port %s is in state %s, expected %s", ch.ChannelId, ch.PortId, channel.State, channeltypes.OPEN)
+		}
+	}
+
+	interchainAccounts := k.GetAllInterchainAccounts(ctx)
+	for _, acc := range interchainAccounts {
+		accAddr, err := sdk.AccAddressFromBech32(acc.AccountAddress)
+		if err != nil {
+			return icatypes.HostGenesisState{}, sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "interchain account address %s for port %s: %s", acc.AccountAddress, acc.PortId, err)
+		}
+
+		if _, ok := k.accountKeeper.GetAccount(ctx, accAddr).(icatypes.InterchainAccountI); !ok {
+			return icatypes.HostGenesisState{}, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "interchain account %s for port %s does not exist", acc.AccountAddress, acc.PortId)
+		}
+	}
+
+	genesisState := icatypes.NewHostGenesisState(activeChannels, interchainAccounts, icatypes.PortID, k.GetParams(ctx), k.GetAllReadOnlyInterchainAccounts(ctx), k.GetAllAccountAuthorizations(ctx))
+	if err := genesisState.Validate(); err != nil {
+		return icatypes.HostGenesisState{}, err
+	}
+
+	return genesisState, nil
+}
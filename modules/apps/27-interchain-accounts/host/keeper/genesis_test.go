@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -23,7 +26,14 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				AccountAddress: TestAccAddress.String(),
 			},
 		},
-		Port: icatypes.PortID,
+		Port:             icatypes.PortID,
+		ReadOnlyAccounts: []string{TestAccAddress.String()},
+		AccountAuthorizations: []types.AccountAuthorizations{
+			{
+				Address:        TestAccAddress.String(),
+				Authorizations: []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})}},
+			},
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -36,7 +46,19 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(TestAccAddress.String(), accountAdrr)
 
-	expParams := types.NewParams(false, nil)
+	suite.Require().True(suite.chainA.GetSimApp().ICAHostKeeper.IsReadOnlyInterchainAccount(suite.chainA.GetContext(), TestAccAddress.String()))
+	suite.Require().Equal(genesisState.AccountAuthorizations[0].Authorizations, suite.chainA.GetSimApp().ICAHostKeeper.GetAccountAuthorizations(suite.chainA.GetContext(), TestAccAddress.String()))
+
+	res, err := suite.chainA.GetSimApp().ICAHostKeeper.InterchainAccountsByConnection(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		&types.QueryInterchainAccountsByConnectionRequest{ConnectionId: ibctesting.FirstConnectionID},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.InterchainAccountRecord{
+		{PortId: TestPortID, Address: TestAccAddress.String(), Owner: TestOwnerAddress},
+	}, res.InterchainAccounts)
+
+	expParams := types.NewParams(false, nil, false, nil, false, 0, false, nil, 0, 0)
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
@@ -63,3 +85,71 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
+
+func (suite *KeeperTestSuite) TestRebuildGenesisFromState() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: read-only interchain account", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetReadOnlyInterchainAccount(suite.chainB.GetContext(), TestAccAddress.String())
+			}, true,
+		},
+		{
+			"success: interchain account restricted by message authorizations", func() {
+				authorizations := []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})}}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), TestAccAddress.String(), authorizations)
+			}, true,
+		},
+		{
+			"active channel not found", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, "channel-100")
+			}, false,
+		},
+		{
+			"active channel is not OPEN", func() {
+				err := path.EndpointB.SetChannelClosed()
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"interchain account not found in auth", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), "icacontroller-unknown", TestOwnerAddress)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			genesisState, err := suite.chainB.GetSimApp().ICAHostKeeper.RebuildGenesisFromState(suite.chainB.GetContext())
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				expGenesisState := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
+				suite.Require().Equal(expGenesisState, genesisState)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -2,8 +2,16 @@ package keeper
 
 import (
 	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -17,3 +25,92 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccountsByConnection implements the Query/InterchainAccountsByConnection gRPC method
+func (q Keeper) InterchainAccountsByConnection(c context.Context, req *types.QueryInterchainAccountsByConnectionRequest) (*types.QueryInterchainAccountsByConnectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var accounts []types.InterchainAccountRecord
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyConnectionAccountPrefix(req.ConnectionId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID := string(key)
+
+		owner, err := icatypes.ParseControllerPortOwner(portID)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, types.InterchainAccountRecord{
+			PortId:  portID,
+			Address: string(value),
+			Owner:   owner,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsByConnectionResponse{
+		InterchainAccounts: accounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// AllInterchainAccounts implements the Query/AllInterchainAccounts gRPC method
+func (q Keeper) AllInterchainAccounts(c context.Context, req *types.QueryAllInterchainAccountsRequest) (*types.QueryAllInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var accounts []types.InterchainAccountRecord
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.OwnerKeyPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID := string(key)
+
+		owner, err := icatypes.ParseControllerPortOwner(portID)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, types.InterchainAccountRecord{
+			PortId:  portID,
+			Address: string(value),
+			Owner:   owner,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllInterchainAccountsResponse{
+		InterchainAccounts: accounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// HostCapabilities implements the Query/HostCapabilities gRPC method
+func (q Keeper) HostCapabilities(c context.Context, _ *types.QueryHostCapabilitiesRequest) (*types.QueryHostCapabilitiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryHostCapabilitiesResponse{
+		Capabilities: q.GetHostCapabilities(ctx),
+	}, nil
+}
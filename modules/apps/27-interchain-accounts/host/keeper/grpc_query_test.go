@@ -1,9 +1,16 @@
 package keeper_test
 
 import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +19,277 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryParamsAtHeight() {
+	// params in effect at a past block
+	expParams := types.DefaultParams()
+	expParams.HostEnabled = false
+	expParams.AllowMessages = []string{"/cosmos.bank.v1beta1.MsgSend"}
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	suite.coordinator.CommitBlock(suite.chainA)
+	historicalHeight := suite.chainA.App.LastBlockHeight()
+
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	req := &types.QueryParamsRequest{}
+	bz, err := req.Marshal()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		height    int64
+		expParams types.Params
+	}{
+		{"latest height", 0, types.DefaultParams()},
+		{"historical height", historicalHeight, expParams},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// the gRPC gateway and gRPC server route queries setting the x-cosmos-block-height header through ABCI
+			resp := suite.chainA.App.Query(abci.RequestQuery{
+				Path:   "/ibc.applications.interchain_accounts.host.v1.Query/Params",
+				Data:   bz,
+				Height: tc.height,
+			})
+			suite.Require().True(resp.IsOK(), resp.Log)
+
+			var res types.QueryParamsResponse
+			suite.Require().NoError(res.Unmarshal(resp.Value))
+			suite.Require().Equal(tc.expParams, *res.Params)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryHostCapabilities() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	res, err := suite.chainA.GetSimApp().ICAHostKeeper.HostCapabilities(ctx, &types.QueryHostCapabilitiesRequest{})
+	suite.Require().NoError(err)
+
+	expCapabilities := types.HostCapabilities{
+		HostEnabled:          true,
+		Versions:             []string{icatypes.VersionPrefix},
+		VersionFormats:       []string{types.VersionFormatLegacy, types.VersionFormatICAMetadata},
+		Encodings:            []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON},
+		TxTypes:              []string{icatypes.TxTypeSDKMultiMsg, icatypes.TxTypeSDKQueryOnly},
+		PacketTypes:          []string{"TYPE_EXECUTE_TX", "TYPE_EXECUTE_TX_WITH_EVENTS"},
+		AllowAccountCreation: true,
+		Compressions:         []string{icatypes.CompressionGzip},
+	}
+	suite.Require().Equal(expCapabilities, res.Capabilities)
+
+	// capabilities reflect the host submodule parameters
+	params := types.DefaultParams()
+	params.HostEnabled = false
+	params.AllowAccountCreation = false
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	res, err = suite.chainA.GetSimApp().ICAHostKeeper.HostCapabilities(ctx, &types.QueryHostCapabilitiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Capabilities.HostEnabled)
+	suite.Require().False(res.Capabilities.AllowAccountCreation)
+	suite.Require().True(res.Capabilities.SupportsPacketType(icatypes.EXECUTE_TX.String()))
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountsByConnection() {
+	var (
+		req         *types.QueryInterchainAccountsByConnectionRequest
+		expAccounts []types.InterchainAccountRecord
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				// interchain account registered over a different host connection
+				otherPortID, err := icatypes.GeneratePortID(TestOwnerAddress, ibctesting.FirstConnectionID, "connection-1")
+				suite.Require().NoError(err)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), otherPortID, TestAccAddress.String())
+
+				req = &types.QueryInterchainAccountsByConnectionRequest{
+					ConnectionId: ibctesting.FirstConnectionID,
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryInterchainAccountsByConnectionRequest{
+					ConnectionId: ibctesting.FirstConnectionID,
+				}
+			},
+			true,
+		},
+		{
+			"no interchain accounts registered over connection",
+			func() {
+				expAccounts = nil
+
+				req = &types.QueryInterchainAccountsByConnectionRequest{
+					ConnectionId: "connection-10",
+				}
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req = &types.QueryInterchainAccountsByConnectionRequest{
+					ConnectionId: "",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			portID := path.EndpointA.ChannelConfig.PortID
+			address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), portID)
+			suite.Require().True(found)
+
+			expAccounts = []types.InterchainAccountRecord{
+				{PortId: portID, Address: address, Owner: TestOwnerAddress},
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccountsByConnection(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAccounts, res.InterchainAccounts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAllInterchainAccounts() {
+	var (
+		req         *types.QueryAllInterchainAccountsRequest
+		expAccounts []types.InterchainAccountRecord
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				// interchain account of a different owner registered over a different host connection
+				otherOwner := suite.chainA.SenderAccount.GetAddress().String()
+				otherPortID, err := icatypes.GeneratePortID(otherOwner, ibctesting.FirstConnectionID, "connection-1")
+				suite.Require().NoError(err)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), otherPortID, TestAccAddress.String())
+
+				expAccounts = append(expAccounts, types.InterchainAccountRecord{PortId: otherPortID, Address: TestAccAddress.String(), Owner: otherOwner})
+
+				req = &types.QueryAllInterchainAccountsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryAllInterchainAccountsRequest{}
+			},
+			true,
+		},
+		{
+			"paginated",
+			func() {
+				otherPortID, err := icatypes.GeneratePortID(TestOwnerAddress, ibctesting.FirstConnectionID, "connection-1")
+				suite.Require().NoError(err)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), otherPortID, TestAccAddress.String())
+
+				// only the first interchain account in key order is returned
+				if otherPortID < expAccounts[0].PortId {
+					expAccounts = []types.InterchainAccountRecord{{PortId: otherPortID, Address: TestAccAddress.String(), Owner: TestOwnerAddress}}
+				}
+
+				req = &types.QueryAllInterchainAccountsRequest{
+					Pagination: &query.PageRequest{
+						Limit: 1,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			portID := path.EndpointA.ChannelConfig.PortID
+			address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), portID)
+			suite.Require().True(found)
+
+			expAccounts = []types.InterchainAccountRecord{
+				{PortId: portID, Address: address, Owner: TestOwnerAddress},
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.AllInterchainAccounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expAccounts, res.InterchainAccounts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
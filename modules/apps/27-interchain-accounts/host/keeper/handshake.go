@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -14,6 +17,10 @@ import (
 
 // OnChanOpenTry performs basic validation of the ICA channel
 // and registers a new interchain account (if it doesn't exist).
+// If account reuse is enabled, the counterparty port identifier is associated
+// with an existing interchain account of the same owner where applicable.
+// If account creation is disabled, the handshake is rejected unless an interchain account
+// has been pre-provisioned for the counterparty port identifier.
 func (k Keeper) OnChanOpenTry(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -25,8 +32,8 @@ func (k Keeper) OnChanOpenTry(
 	version,
 	counterpartyVersion string,
 ) error {
-	if order != channeltypes.ORDERED {
-		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if err := icatypes.ValidateChannelOrdering(order); err != nil {
+		return err
 	}
 
 	if portID != icatypes.PortID {
@@ -47,33 +54,52 @@ func (k Keeper) OnChanOpenTry(
 		return sdkerrors.Wrapf(err, "failed to validate controller port %s", counterparty.PortId)
 	}
 
-	if err := icatypes.ValidateVersion(version); err != nil {
+	parsedAddr, txType, err := k.parseVersions(ctx, connectionHops[0], version, counterpartyVersion)
+	if err != nil {
 		return sdkerrors.Wrap(err, "version validation failed")
 	}
 
-	if counterpartyVersion != icatypes.VersionPrefix {
-		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "expected %s, got %s", icatypes.VersionPrefix, version)
-	}
-
 	// On the host chain the capability may only be claimed during the OnChanOpenTry
 	// The capability being claimed in OpenInit is for a controller chain (the port is different)
 	if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
 		return sdkerrors.Wrapf(err, "failed to claim capability for channel %s on port %s", channelID, portID)
 	}
 
-	// Check to ensure that the version string contains the expected address generated from the Counterparty portID
-	accAddr := icatypes.GenerateAddress(k.accountKeeper.GetModuleAddress(icatypes.ModuleName), counterparty.PortId)
-	parsedAddr, err := icatypes.ParseAddressFromVersion(version)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "expected format <app-version%saccount-address>, got %s", icatypes.Delimiter, version)
+	// Controller ports linked to an existing interchain account control the linked account if account reuse is enabled
+	if linkedAddr, found := k.GetLinkedInterchainAccountAddress(ctx, counterparty.PortId); found {
+		if !k.IsAccountReuseAllowed(ctx) {
+			return sdkerrors.Wrapf(types.ErrAccountReuseDisabled, "controller port %s is linked to interchain account %s", counterparty.PortId, linkedAddr)
+		}
+
+		if parsedAddr != linkedAddr {
+			return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "version contains invalid account address: expected %s, got %s", linkedAddr, parsedAddr)
+		}
+
+		linkedAccAddr, err := sdk.AccAddressFromBech32(linkedAddr)
+		if err != nil {
+			return err
+		}
+
+		return k.validateAccountTxType(ctx, linkedAccAddr, txType)
 	}
 
+	// Check to ensure that the version string contains the expected address generated from the Counterparty portID
+	accAddr := k.addressGenerator(k.accountKeeper.GetModuleAddress(icatypes.ModuleName), counterparty.PortId)
 	if parsedAddr != accAddr.String() {
 		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "version contains invalid account address: expected %s, got %s", parsedAddr, accAddr)
 	}
 
+	if err := k.validateAccountTxType(ctx, accAddr, txType); err != nil {
+		return err
+	}
+
+	// Only pre-provisioned interchain accounts may be used if account creation is disabled
+	if _, found := k.GetInterchainAccountAddress(ctx, counterparty.PortId); !found && !k.IsAccountCreationAllowed(ctx) {
+		return sdkerrors.Wrapf(types.ErrAccountCreationDisabled, "no interchain account has been pre-provisioned for controller port %s", counterparty.PortId)
+	}
+
 	// Register interchain account if it does not already exist
-	k.RegisterInterchainAccount(ctx, accAddr, counterparty.PortId)
+	k.RegisterInterchainAccount(ctx, accAddr, counterparty.PortId, txType == icatypes.TxTypeSDKQueryOnly)
 
 	return nil
 }
@@ -102,6 +128,75 @@ func (k Keeper) OnChanCloseConfirm(
 	return nil
 }
 
+// parseVersions validates the host and counterparty channel versions and returns the interchain account address
+// and transaction type contained in the host channel version. If the counterparty version is encoded as ICAMetadata
+// the host version must contain the negotiated ICAMetadata, otherwise the legacy version format is expected.
+// The negotiated version may be lower than the counterparty version and the negotiated gas budget must be within the
+// bounds set by the gas budget params. Channels using the legacy version format use the multi message transaction type
+// and are not bounded by a gas budget.
+func (k Keeper) parseVersions(ctx sdk.Context, connectionID, version, counterpartyVersion string) (string, string, error) {
+	if !icatypes.IsICAMetadataVersion(counterpartyVersion) {
+		if err := icatypes.ValidateVersion(version); err != nil {
+			return "", "", err
+		}
+
+		if err := icatypes.ValidateNegotiatedVersion(counterpartyVersion, strings.Split(version, icatypes.Delimiter)[0]); err != nil {
+			return "", "", err
+		}
+
+		parsedAddr, err := icatypes.ParseAddressFromVersion(version)
+		if err != nil {
+			return "", "", sdkerrors.Wrapf(err, "expected format <app-version%saccount-address>, got %s", icatypes.Delimiter, version)
+		}
+
+		if err := k.validateGasBudget(ctx, 0); err != nil {
+			return "", "", err
+		}
+
+		return parsedAddr, icatypes.TxTypeSDKMultiMsg, nil
+	}
+
+	counterpartyMetadata, err := icatypes.ParseICAMetadata(counterpartyVersion)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := k.validateMetadata(ctx, connectionID, counterpartyMetadata); err != nil {
+		return "", "", err
+	}
+
+	metadata, err := icatypes.ParseICAMetadata(version)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := icatypes.ValidateNegotiatedICAMetadata(counterpartyMetadata, metadata); err != nil {
+		return "", "", err
+	}
+
+	if err := k.validateGasBudget(ctx, metadata.GasBudget); err != nil {
+		return "", "", err
+	}
+
+	return metadata.Address, metadata.TxType, nil
+}
+
+// validateAccountTxType asserts the provided transaction type matches the mode of the interchain account registered
+// at the provided address. The mode of an interchain account is fixed upon registration, a read-only interchain account
+// may only be controlled using the query only transaction type and vice versa. It is a no-op if no account exists.
+func (k Keeper) validateAccountTxType(ctx sdk.Context, accAddr sdk.AccAddress, txType string) error {
+	if acc := k.accountKeeper.GetAccount(ctx, accAddr); acc == nil {
+		return nil
+	}
+
+	readOnly := k.IsReadOnlyInterchainAccount(ctx, accAddr.String())
+	if readOnly != (txType == icatypes.TxTypeSDKQueryOnly) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "transaction type %s does not match the mode of interchain account %s (read-only: %t)", txType, accAddr, readOnly)
+	}
+
+	return nil
+}
+
 // validateControllerPortParams asserts the provided connection sequence and counterparty connection sequence
 // match that of the associated connection stored in state
 func (k Keeper) validateControllerPortParams(ctx sdk.Context, channelID, portID string, connectionSeq, counterpartyConnectionSeq uint64) error {
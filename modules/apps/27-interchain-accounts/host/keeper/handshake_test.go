@@ -3,6 +3,7 @@ package keeper_test
 import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	hostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -104,6 +105,186 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			false,
 		},
+		{
+			"success: ICAMetadata version",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"success: ICAMetadata version with encoding selected by host",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Encoding = ""
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				metadata.Encoding = icatypes.EncodingProtobuf
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"success: ICAMetadata version with query only transaction type",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.TxType = icatypes.TxTypeSDKQueryOnly
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"success: newer counterparty version negotiated down",
+			func() {
+				counterpartyVersion = "ics27-2"
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"success: newer ICAMetadata counterparty version negotiated down",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Version = "ics27-2"
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Version = icatypes.VersionPrefix
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"ICAMetadata version not negotiated down",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Version = "ics27-2"
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"success: ICAMetadata version with gas budget selected by host",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.MaxGasBudget = 500000
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				metadata.GasBudget = 500000
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"ICAMetadata version with gas budget exceeding max gas budget",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.MaxGasBudget = 500000
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.GasBudget = 600000
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"query only transaction type for existing interchain account",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.RegisterInterchainAccount(suite.chainB.GetContext(), TestAccAddress, path.EndpointA.ChannelConfig.PortID, false)
+
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.TxType = icatypes.TxTypeSDKQueryOnly
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"multi message transaction type for existing read-only interchain account",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.RegisterInterchainAccount(suite.chainB.GetContext(), TestAccAddress, path.EndpointA.ChannelConfig.PortID, true)
+
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"legacy version for ICAMetadata counterparty version",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"ICAMetadata version for legacy counterparty version",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"ICAMetadata counterparty version with host connection mismatch",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, "connection-10")
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestAccAddress.String()
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"ICAMetadata version with invalid account address",
+			func() {
+				metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				counterpartyVersion = icatypes.EncodeICAMetadata(metadata)
+
+				metadata.Address = TestOwnerAddress
+				channel.Version = icatypes.EncodeICAMetadata(metadata)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
 		{
 			"capability already claimed",
 			func() {
@@ -113,6 +294,50 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			false,
 		},
+		{
+			"success: custom address generator",
+			func() {
+				hostkeeper.WithAddressGenerator(customAddressGenerator)(&suite.chainB.GetSimApp().ICAHostKeeper)
+
+				moduleAccAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(icatypes.ModuleName)
+				channel.Version = icatypes.NewAppVersion(icatypes.VersionPrefix, customAddressGenerator(moduleAccAddr, channel.Counterparty.PortId).String())
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"address generator mismatch",
+			func() {
+				hostkeeper.WithAddressGenerator(customAddressGenerator)(&suite.chainB.GetSimApp().ICAHostKeeper)
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"success: account creation disabled with pre-provisioned interchain account",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.AllowAccountCreation = false
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				_, err := suite.chainB.GetSimApp().ICAHostKeeper.ProvisionInterchainAccount(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, false)
+				suite.Require().NoError(err)
+
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"account creation disabled without pre-provisioned interchain account",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.AllowAccountCreation = false
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				path.EndpointB.SetChannel(*channel)
+			},
+			false,
+		},
 		{
 			"invalid account address",
 			func() {
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// GetIdempotentExecution returns the execution of the transaction carrying the provided idempotency key by the
+// provided interchain account address
+func (k Keeper) GetIdempotentExecution(ctx sdk.Context, address, idempotencyKey string) (types.IdempotentExecution, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyIdempotentExecution(address, idempotencyKey))
+	if bz == nil {
+		return types.IdempotentExecution{}, false
+	}
+
+	var execution types.IdempotentExecution
+	k.cdc.MustUnmarshal(bz, &execution)
+
+	return execution, true
+}
+
+// SetIdempotentExecution stores the execution of the transaction carrying the provided idempotency key by the provided
+// interchain account address and indexes it by the height at which it was executed
+func (k Keeper) SetIdempotentExecution(ctx sdk.Context, address, idempotencyKey string, execution types.IdempotentExecution) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyIdempotentExecution(address, idempotencyKey), k.cdc.MustMarshal(&execution))
+	store.Set(types.KeyIdempotentExecutionHeight(execution.Height, address, idempotencyKey), types.KeyIdempotentExecution(address, idempotencyKey))
+}
+
+// PruneIdempotentExecutions deletes the executions of transactions carrying an idempotency key which were executed more
+// than the number of blocks set by the idempotency key retention param ago. All executions are pruned if idempotency
+// keys are disabled
+func (k Keeper) PruneIdempotentExecutions(ctx sdk.Context) {
+	retention := k.GetIdempotencyKeyRetention(ctx)
+	height := uint64(ctx.BlockHeight())
+
+	// executions at or below the prune height have exceeded the retention window
+	pruneHeight := height
+	if retention != 0 {
+		if height <= retention {
+			return
+		}
+
+		pruneHeight = height - retention
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyIdempotentExecutionHeightPrefix(0), types.KeyIdempotentExecutionHeightPrefix(pruneHeight+1))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key(), iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getIdempotentResult returns the result of the original execution of the transaction carrying the provided idempotency
+// key by the interchain account associated with the provided controller port. False is returned if no idempotency key is
+// provided, idempotency keys are disabled or the transaction has not been executed within the retention window
+func (k Keeper) getIdempotentResult(ctx sdk.Context, portID, idempotencyKey string) ([]byte, bool) {
+	if idempotencyKey == "" || k.GetIdempotencyKeyRetention(ctx) == 0 {
+		return nil, false
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return nil, false
+	}
+
+	execution, found := k.GetIdempotentExecution(ctx, address, idempotencyKey)
+	if !found {
+		return nil, false
+	}
+
+	return execution.Result, true
+}
+
+// setIdempotentResult records the result of the execution of the transaction carrying the provided idempotency key by
+// the interchain account associated with the provided controller port. No execution is recorded if no idempotency key
+// is provided or idempotency keys are disabled
+func (k Keeper) setIdempotentResult(ctx sdk.Context, portID, idempotencyKey string, result []byte) {
+	if idempotencyKey == "" || k.GetIdempotencyKeyRetention(ctx) == 0 {
+		return
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return
+	}
+
+	k.SetIdempotentExecution(ctx, address, idempotencyKey, types.IdempotentExecution{
+		Result: result,
+		Height: uint64(ctx.BlockHeight()),
+	})
+}
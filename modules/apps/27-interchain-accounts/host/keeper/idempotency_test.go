@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketIdempotencyKey() {
+	var (
+		retention      uint64
+		idempotencyKey string
+		expExecutions  int64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"transaction with a retained idempotency key is executed once",
+			func() {
+				expExecutions = 1
+			},
+		},
+		{
+			"transaction without an idempotency key is executed each time",
+			func() {
+				idempotencyKey = ""
+				expExecutions = 2
+			},
+		},
+		{
+			"idempotency keys are ignored when disabled",
+			func() {
+				retention = 0
+				expExecutions = 2
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			retention = 10
+			idempotencyKey = "transfer-1"
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			recipient := suite.chainB.SenderAccount.GetAddress()
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, retention, false, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type:           icatypes.EXECUTE_TX_WITH_EVENTS,
+				Data:           data,
+				IdempotencyKey: idempotencyKey,
+			}
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+
+			var results [][]byte
+			for sequence := uint64(1); sequence <= 2; sequence++ {
+				packet := channeltypes.NewPacket(
+					icaPacketData.GetBytes(),
+					sequence,
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.NewHeight(0, 100),
+					0,
+				)
+
+				result, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+				suite.Require().NoError(err)
+
+				results = append(results, result)
+			}
+
+			expBalance := balance.AddAmount(sdk.NewInt(100 * expExecutions))
+			suite.Require().Equal(expBalance, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom))
+
+			execution, found := suite.chainB.GetSimApp().ICAHostKeeper.GetIdempotentExecution(suite.chainB.GetContext(), interchainAccountAddr, idempotencyKey)
+			if expExecutions == 1 {
+				// the duplicate transaction results in the result of the original execution
+				suite.Require().True(found)
+				suite.Require().Equal(results[0], execution.Result)
+				suite.Require().Equal(results[0], results[1])
+			} else {
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneIdempotentExecutions() {
+	testCases := []struct {
+		msg       string
+		retention uint64
+		expFound  bool
+	}{
+		{
+			"execution within the retention window is retained",
+			10,
+			true,
+		},
+		{
+			"execution beyond the retention window is pruned",
+			5,
+			false,
+		},
+		{
+			"all executions are pruned when idempotency keys are disabled",
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainB.GetContext()
+			address := TestAccAddress.String()
+			height := uint64(ctx.BlockHeight())
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetIdempotentExecution(ctx, address, "key", types.IdempotentExecution{
+				Result: []byte{byte(1)},
+				Height: height,
+			})
+
+			params := types.DefaultParams()
+			params.IdempotencyKeyRetention = tc.retention
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(ctx, params)
+
+			ctx = ctx.WithBlockHeight(int64(height + 5))
+			suite.chainB.GetSimApp().ICAHostKeeper.PruneIdempotentExecutions(ctx)
+
+			_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetIdempotentExecution(ctx, address, "key")
+			suite.Require().Equal(tc.expFound, found)
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
@@ -22,23 +23,70 @@ import (
 // Keeper defines the IBC interchain accounts host keeper
 type Keeper struct {
 	storeKey   sdk.StoreKey
-	cdc        codec.BinaryCodec
+	cdc        codec.Codec
 	paramSpace paramtypes.Subspace
 
-	channelKeeper icatypes.ChannelKeeper
-	portKeeper    icatypes.PortKeeper
-	accountKeeper icatypes.AccountKeeper
+	ics4Wrapper      icatypes.ICS4Wrapper
+	channelKeeper    icatypes.ChannelKeeper
+	portKeeper       icatypes.PortKeeper
+	connectionKeeper icatypes.ConnectionKeeper
+	accountKeeper    icatypes.AccountKeeper
 
 	scopedKeeper capabilitykeeper.ScopedKeeper
 
-	msgRouter *baseapp.MsgServiceRouter
+	msgRouter   *baseapp.MsgServiceRouter
+	queryRouter *baseapp.GRPCQueryRouter
+
+	addressGenerator     icatypes.AddressGenerator
+	signerAuthorizer     types.SignerAuthorizer
+	encryptedMemoHandler types.EncryptedMemoHandler
+
+	allowListCache *allowListCache
+}
+
+// Option defines a functional option used to configure the interchain accounts host Keeper
+type Option func(*Keeper)
+
+// WithAddressGenerator overrides the function used to derive interchain account addresses.
+// It defaults to icatypes.GenerateAddress. Controller chains which validate the derived account
+// addresses must be configured with the same AddressGenerator.
+func WithAddressGenerator(generator icatypes.AddressGenerator) Option {
+	return func(k *Keeper) {
+		k.addressGenerator = generator
+	}
+}
+
+// WithSignerAuthorizer overrides the function used to determine whether a message executed on behalf of an
+// interchain account may require signers other than the interchain account. It defaults to types.RejectAdditionalSigners.
+func WithSignerAuthorizer(authorizer types.SignerAuthorizer) Option {
+	return func(k *Keeper) {
+		k.signerAuthorizer = authorizer
+	}
+}
+
+// WithQueryRouter sets the router used to execute the queries carried by interchain accounts packets. Packets carrying
+// a query are rejected if no query router is set, regardless of the allow queries param.
+func WithQueryRouter(queryRouter *baseapp.GRPCQueryRouter) Option {
+	return func(k *Keeper) {
+		k.queryRouter = queryRouter
+	}
+}
+
+// WithEncryptedMemoHandler sets the handler to which the encrypted memos carried by interchain accounts packets are
+// passed prior to executing their transaction. Packets carrying an encrypted memo are rejected if no handler is set.
+func WithEncryptedMemoHandler(handler types.EncryptedMemoHandler) Option {
+	return func(k *Keeper) {
+		k.encryptedMemoHandler = handler
+	}
 }
 
 // NewKeeper creates a new interchain accounts host Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
+	cdc codec.Codec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ics4Wrapper icatypes.ICS4Wrapper, channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
+	connectionKeeper icatypes.ConnectionKeeper,
 	accountKeeper icatypes.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+	opts ...Option,
 ) Keeper {
 
 	// ensure ibc interchain accounts module account is set
@@ -51,16 +99,26 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
-		accountKeeper: accountKeeper,
-		scopedKeeper:  scopedKeeper,
-		msgRouter:     msgRouter,
+	k := Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		ics4Wrapper:      ics4Wrapper,
+		channelKeeper:    channelKeeper,
+		portKeeper:       portKeeper,
+		connectionKeeper: connectionKeeper,
+		accountKeeper:    accountKeeper,
+		scopedKeeper:     scopedKeeper,
+		msgRouter:        msgRouter,
+		addressGenerator: icatypes.GenerateAddress,
+		signerAuthorizer: types.RejectAdditionalSigners,
 	}
+
+	for _, opt := range opts {
+		opt(&k)
+	}
+
+	return k
 }
 
 // Logger returns the application logger, scoped to the associated module
@@ -176,12 +234,99 @@ func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []icatypes.RegisteredI
 }
 
 // SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated portID
+// The address is additionally indexed by the host connection identifier encoded in the controller portID
 func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, portID string, address string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID), []byte(address))
+
+	if connSeq, err := icatypes.ParseHostConnSequence(portID); err == nil {
+		connectionID := connectiontypes.FormatConnectionIdentifier(connSeq)
+		store.Set(types.KeyConnectionAccount(connectionID, portID), []byte(address))
+	}
+}
+
+// IsReadOnlyInterchainAccount returns true if the provided interchain account address was registered in read-only mode
+func (k Keeper) IsReadOnlyInterchainAccount(ctx sdk.Context, address string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyReadOnlyAccount(address))
 }
 
-// NegotiateAppVersion handles application version negotation for the IBC interchain accounts module
+// GetAllReadOnlyInterchainAccounts returns the addresses of all interchain accounts registered in read-only mode
+func (k Keeper) GetAllReadOnlyInterchainAccounts(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.ReadOnlyAccountKeyPrefix))
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		addresses = append(addresses, keySplit[1])
+	}
+
+	return addresses
+}
+
+// SetReadOnlyInterchainAccount marks the provided interchain account address as read-only
+func (k Keeper) SetReadOnlyInterchainAccount(ctx sdk.Context, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyReadOnlyAccount(address), []byte{0x01})
+}
+
+// GetAccountAuthorizations returns the message authorizations restricting the provided interchain account address.
+// No authorizations are returned if the interchain account is only restricted by the host submodule parameters
+func (k Keeper) GetAccountAuthorizations(ctx sdk.Context, address string) []types.MessageAuthorization {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAccountAuthorizations(address))
+	if bz == nil {
+		return nil
+	}
+
+	var accountAuthorizations types.AccountAuthorizations
+	k.cdc.MustUnmarshal(bz, &accountAuthorizations)
+
+	return accountAuthorizations.Authorizations
+}
+
+// GetAllAccountAuthorizations returns the message authorizations of all interchain accounts restricted by them
+func (k Keeper) GetAllAccountAuthorizations(ctx sdk.Context) []types.AccountAuthorizations {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.AccountAuthorizationsKeyPrefix)))
+	defer iterator.Close()
+
+	var accountAuthorizations []types.AccountAuthorizations
+	for ; iterator.Valid(); iterator.Next() {
+		var authorizations types.AccountAuthorizations
+		k.cdc.MustUnmarshal(iterator.Value(), &authorizations)
+
+		accountAuthorizations = append(accountAuthorizations, authorizations)
+	}
+
+	return accountAuthorizations
+}
+
+// SetAccountAuthorizations stores the message authorizations restricting the provided interchain account address.
+// Providing no authorizations removes the existing authorizations of the interchain account
+func (k Keeper) SetAccountAuthorizations(ctx sdk.Context, address string, authorizations []types.MessageAuthorization) {
+	store := ctx.KVStore(k.storeKey)
+	if len(authorizations) == 0 {
+		store.Delete(types.KeyAccountAuthorizations(address))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&types.AccountAuthorizations{
+		Address:        address,
+		Authorizations: authorizations,
+	})
+	store.Set(types.KeyAccountAuthorizations(address), bz)
+}
+
+// NegotiateAppVersion handles application version negotation for the IBC interchain accounts module.
+// The proposed version is negotiated down to the highest supported version which does not exceed it, the
+// handshake is only rejected if no such version exists. Proposed versions encoded as ICAMetadata are validated
+// against the provided connection, the encoding format and gas budget are negotiated and the interchain account
+// address is populated, see negotiateGasBudget. The legacy version format is negotiated as <app-version>.<account-address>. The requested channel ordering must be supported by
+// interchain accounts channels, ensuring misconfigured orderings fail prior to the channel handshake.
 func (k Keeper) NegotiateAppVersion(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -190,12 +335,102 @@ func (k Keeper) NegotiateAppVersion(
 	counterparty channeltypes.Counterparty,
 	proposedVersion string,
 ) (string, error) {
-	if proposedVersion != icatypes.VersionPrefix {
-		return "", sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "failed to negotiate app version: expected %s, got %s", icatypes.VersionPrefix, proposedVersion)
+	if err := icatypes.ValidateChannelOrdering(order); err != nil {
+		return "", sdkerrors.Wrap(err, "failed to negotiate app version")
 	}
 
-	moduleAccAddr := k.accountKeeper.GetModuleAddress(icatypes.ModuleName)
-	accAddr := icatypes.GenerateAddress(moduleAccAddr, counterparty.PortId)
+	accAddr, found := k.GetLinkedInterchainAccountAddress(ctx, counterparty.PortId)
+	if !found {
+		moduleAccAddr := k.accountKeeper.GetModuleAddress(icatypes.ModuleName)
+		accAddr = k.addressGenerator(moduleAccAddr, counterparty.PortId).String()
+	}
 
-	return icatypes.NewAppVersion(icatypes.VersionPrefix, accAddr.String()), nil
+	if icatypes.IsICAMetadataVersion(proposedVersion) {
+		metadata, err := icatypes.ParseICAMetadata(proposedVersion)
+		if err != nil {
+			return "", sdkerrors.Wrap(err, "failed to negotiate app version")
+		}
+
+		if err := k.validateMetadata(ctx, connectionID, metadata); err != nil {
+			return "", sdkerrors.Wrap(err, "failed to negotiate app version")
+		}
+
+		if metadata.Address != "" {
+			return "", sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "failed to negotiate app version: proposed metadata must not contain an account address, got %s", metadata.Address)
+		}
+
+		// newer controller chains may propose a version which is negotiated down to the highest supported version
+		version, err := icatypes.NegotiateVersion(metadata.Version)
+		if err != nil {
+			return "", sdkerrors.Wrap(err, "failed to negotiate app version")
+		}
+
+		metadata.Version = version
+
+		// the host chain selects the default encoding format if the controller chain did not propose one
+		if metadata.Encoding == "" {
+			metadata.Encoding = icatypes.EncodingProtobuf
+		}
+
+		gasBudget, err := k.negotiateGasBudget(ctx, metadata.GasBudget)
+		if err != nil {
+			return "", sdkerrors.Wrap(err, "failed to negotiate app version")
+		}
+
+		metadata.GasBudget = gasBudget
+
+		metadata.Address = accAddr
+
+		return icatypes.EncodeICAMetadata(metadata), nil
+	}
+
+	version, err := icatypes.NegotiateVersion(proposedVersion)
+	if err != nil {
+		return "", sdkerrors.Wrap(err, "failed to negotiate app version")
+	}
+
+	// the legacy version format cannot carry a gas budget
+	if err := k.validateGasBudget(ctx, 0); err != nil {
+		return "", sdkerrors.Wrap(err, "failed to negotiate app version")
+	}
+
+	return icatypes.NewAppVersion(version, accAddr), nil
+}
+
+// validateMetadata performs basic validation of the provided ICAMetadata and asserts the connection identifiers
+// match the provided host connection and its counterparty
+func (k Keeper) validateMetadata(ctx sdk.Context, connectionID string, metadata icatypes.ICAMetadata) error {
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if metadata.HostConnectionId != connectionID {
+		return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "expected host connection ID %s, got %s", connectionID, metadata.HostConnectionId)
+	}
+
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if metadata.ControllerConnectionId != connection.GetCounterparty().GetConnectionID() {
+		return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "expected controller connection ID %s, got %s", connection.GetCounterparty().GetConnectionID(), metadata.ControllerConnectionId)
+	}
+
+	return nil
+}
+
+// GetHostCapabilities returns the interchain accounts features supported by the host submodule given its current parameters.
+// The packet data types are those executed by OnRecvPacket.
+func (k Keeper) GetHostCapabilities(ctx sdk.Context) types.HostCapabilities {
+	return types.HostCapabilities{
+		HostEnabled:          k.IsHostEnabled(ctx),
+		Versions:             append([]string(nil), icatypes.SupportedVersions...),
+		VersionFormats:       append([]string(nil), types.SupportedVersionFormats...),
+		Encodings:            append([]string(nil), icatypes.SupportedEncodings...),
+		TxTypes:              append([]string(nil), icatypes.SupportedTxTypes...),
+		PacketTypes:          []string{icatypes.EXECUTE_TX.String(), icatypes.EXECUTE_TX_WITH_EVENTS.String()},
+		AllowAccountCreation: k.IsAccountCreationAllowed(ctx),
+		Compressions:         append([]string(nil), icatypes.SupportedCompressions...),
+	}
 }
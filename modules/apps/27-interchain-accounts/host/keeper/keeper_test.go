@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +26,11 @@ var (
 	TestVersion = icatypes.NewAppVersion(icatypes.VersionPrefix, TestAccAddress.String())
 )
 
+// customAddressGenerator defines an interchain account address generator which differs from icatypes.GenerateAddress
+func customAddressGenerator(moduleAccAddr sdk.AccAddress, portID string) sdk.AccAddress {
+	return icatypes.GenerateAddress(moduleAccAddr, fmt.Sprintf("custom/%s", portID))
+}
+
 type KeeperTestSuite struct {
 	suite.Suite
 
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+// handleEncryptedMemo passes the provided encrypted memo, carried by a packet controlling the interchain account of the
+// provided portID, to the encrypted memo handler. Packets without an encrypted memo are not affected. An error is
+// returned if the encrypted memo exceeds MaxEncryptedMemoLength, if no encrypted memo handler is set or if the handler
+// rejects the encrypted memo.
+func (k Keeper) handleEncryptedMemo(ctx sdk.Context, portID string, encryptedMemo []byte) error {
+	if len(encryptedMemo) == 0 {
+		return nil
+	}
+
+	if len(encryptedMemo) > icatypes.MaxEncryptedMemoLength {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidOutgoingData, "packet data encrypted memo cannot be greater than %d bytes", icatypes.MaxEncryptedMemoLength)
+	}
+
+	if k.encryptedMemoHandler == nil {
+		return sdkerrors.Wrap(types.ErrEncryptedMemoRejected, "encrypted memos are not supported by the host chain")
+	}
+
+	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, portID)
+	if !found {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.encryptedMemoHandler(ctx, accAddr, encryptedMemo); err != nil {
+		return sdkerrors.Wrap(types.ErrEncryptedMemoRejected, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"bytes"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// This migration
+// - indexes the interchain accounts registered prior to the connection account index by host connection
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	_, err := m.keeper.MigrateConnectionAccounts(ctx, false)
+	return err
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration
+// - sets the host submodule parameters introduced since version 2 to their defaults
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := types.DefaultParams()
+
+	m.setParamIfNotExists(ctx, types.KeyIdempotencyKeyRetention, params.IdempotencyKeyRetention)
+	m.setParamIfNotExists(ctx, types.KeyAllowAccountReuse, params.AllowAccountReuse)
+	m.setParamIfNotExists(ctx, types.KeyAllowAccountCreation, params.AllowAccountCreation)
+	m.setParamIfNotExists(ctx, types.KeyQueryOnlyMessages, params.QueryOnlyMessages)
+	m.setParamIfNotExists(ctx, types.KeyStrictDecoding, params.StrictDecoding)
+	m.setParamIfNotExists(ctx, types.KeyAllowQueries, params.AllowQueries)
+	m.setParamIfNotExists(ctx, types.KeyMinGasBudget, params.MinGasBudget)
+	m.setParamIfNotExists(ctx, types.KeyMaxGasBudget, params.MaxGasBudget)
+
+	return nil
+}
+
+// setParamIfNotExists sets the host submodule parameter stored under the provided key to the provided value
+// unless it is already set, such that parameters updated by governance are preserved.
+func (m Migrator) setParamIfNotExists(ctx sdk.Context, key []byte, value interface{}) {
+	if !m.keeper.paramSpace.Has(ctx, key) {
+		m.keeper.paramSpace.Set(ctx, key, value)
+	}
+}
+
+// MigrateConnectionAccounts writes the connection account index entry of each interchain account whose entry is
+// missing or stale, preserving the association between the controller port and the interchain account address.
+// The number of migrated interchain accounts is returned. If dryRun is true the store is left untouched and the
+// number of interchain accounts which would be migrated is returned. Otherwise an error is returned if the
+// connection account index does not hold exactly one entry per interchain account once migrated.
+func (k Keeper) MigrateConnectionAccounts(ctx sdk.Context, dryRun bool) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	var (
+		keys, values [][]byte
+		expected     uint64
+	)
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.OwnerKeyPrefix+"/"))
+	for ; iterator.Valid(); iterator.Next() {
+		portID := strings.TrimPrefix(string(iterator.Key()), icatypes.OwnerKeyPrefix+"/")
+
+		// interchain accounts registered over ports not encoding the host connection are not indexed
+		connSeq, err := icatypes.ParseHostConnSequence(portID)
+		if err != nil {
+			continue
+		}
+
+		expected++
+
+		key := types.KeyConnectionAccount(connectiontypes.FormatConnectionIdentifier(connSeq), portID)
+		if bytes.Equal(store.Get(key), iterator.Value()) {
+			continue
+		}
+
+		keys = append(keys, key)
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	if dryRun {
+		return uint64(len(keys)), nil
+	}
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+
+	var indexed uint64
+	indexIterator := sdk.KVStorePrefixIterator(store, []byte(types.ConnectionAccountKeyPrefix+"/"))
+	for ; indexIterator.Valid(); indexIterator.Next() {
+		indexed++
+	}
+	indexIterator.Close()
+
+	if indexed != expected {
+		return 0, sdkerrors.Wrapf(types.ErrStoreMigrationFailed, "expected %d connection account entries, got %d", expected, indexed)
+	}
+
+	k.Logger(ctx).Info("migrated connection account index", "migrated", len(keys), "total", indexed)
+
+	return uint64(len(keys)), nil
+}
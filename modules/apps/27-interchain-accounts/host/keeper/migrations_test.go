@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	hostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestMigrateConnectionAccounts() {
+	var (
+		portID      string
+		indexKey    []byte
+		expMigrated uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: connection account index up to date",
+			func() {
+				expMigrated = 0
+			},
+			true,
+		},
+		{
+			"success: interchain account registered prior to the connection account index",
+			func() {
+				suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(types.StoreKey)).Delete(indexKey)
+			},
+			true,
+		},
+		{
+			"success: stale connection account index entry",
+			func() {
+				suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(types.StoreKey)).Set(indexKey, []byte(suite.chainB.SenderAccount.GetAddress().String()))
+			},
+			true,
+		},
+		{
+			"connection account index entry without interchain account",
+			func() {
+				otherPortID, err := icatypes.GeneratePortID(TestOwnerAddress, ibctesting.FirstConnectionID, "connection-1")
+				suite.Require().NoError(err)
+
+				expMigrated = 0
+				suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(types.StoreKey)).Set(types.KeyConnectionAccount("connection-1", otherPortID), []byte(TestAccAddress.String()))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			indexKey = types.KeyConnectionAccount(path.EndpointB.ConnectionID, portID)
+			expMigrated = 1
+
+			address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), portID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(types.StoreKey))
+			indexValue := store.Get(indexKey)
+
+			// the store is left untouched in dry-run mode
+			migrated, err := suite.chainB.GetSimApp().ICAHostKeeper.MigrateConnectionAccounts(suite.chainB.GetContext(), true)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expMigrated, migrated)
+			suite.Require().Equal(indexValue, store.Get(indexKey))
+
+			err = hostkeeper.NewMigrator(&suite.chainB.GetSimApp().ICAHostKeeper).Migrate1to2(suite.chainB.GetContext())
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]byte(address), store.Get(indexKey))
+
+				// the migration is idempotent
+				migrated, err = suite.chainB.GetSimApp().ICAHostKeeper.MigrateConnectionAccounts(suite.chainB.GetContext(), false)
+				suite.Require().NoError(err)
+				suite.Require().Zero(migrated)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrStoreMigrationFailed)
+			}
+		})
+	}
+}
+
+// migratedParamKeys holds the store keys of the host submodule parameters introduced since version 2
+var migratedParamKeys = [][]byte{
+	types.KeyIdempotencyKeyRetention,
+	types.KeyAllowAccountReuse,
+	types.KeyAllowAccountCreation,
+	types.KeyQueryOnlyMessages,
+	types.KeyStrictDecoding,
+	types.KeyAllowQueries,
+	types.KeyMinGasBudget,
+	types.KeyMaxGasBudget,
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	var expParams types.Params
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: parameters missing from the store are set to their defaults",
+			func() {
+				paramStore := prefix.NewStore(suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+				for _, key := range migratedParamKeys {
+					paramStore.Delete(key)
+					suite.Require().False(suite.chainB.GetSimApp().GetSubspace(types.SubModuleName).Has(suite.chainB.GetContext(), key))
+				}
+			},
+		},
+		{
+			"success: parameters set in the store are preserved",
+			func() {
+				expParams.IdempotencyKeyRetention = 100
+				expParams.AllowAccountReuse = true
+				expParams.AllowAccountCreation = false
+				expParams.QueryOnlyMessages = []string{"/cosmos.bank.v1beta1.MsgSend"}
+				expParams.StrictDecoding = true
+				expParams.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/Balance"}
+				expParams.MinGasBudget = 100000
+				expParams.MaxGasBudget = 1000000
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), expParams)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			expParams = types.DefaultParams()
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), expParams)
+
+			tc.malleate()
+
+			err := hostkeeper.NewMigrator(&suite.chainB.GetSimApp().ICAHostKeeper).Migrate2to3(suite.chainB.GetContext())
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(expParams, suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext()))
+		})
+	}
+}
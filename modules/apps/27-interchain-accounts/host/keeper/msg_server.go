@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer implements the interchain accounts host Msg service. It wraps the Keeper as the
+// Keeper exposes a SetAccountAuthorizations store method under the same name.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the interchain accounts host Msg service for the provided Keeper
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SetAccountAuthorizations defines a rpc handler method for MsgSetAccountAuthorizations. The signer must be an
+// interchain account, such that the msg may only be executed through an interchain account transaction. The updated
+// authorizations may only narrow the existing authorizations of the interchain account, see NarrowsAuthorizations.
+func (k msgServer) SetAccountAuthorizations(goCtx context.Context, msg *types.MsgSetAccountAuthorizations) (*types.MsgSetAccountAuthorizationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := k.accountKeeper.GetAccount(ctx, accAddr).(*icatypes.InterchainAccount); !ok {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "signer %s is not an interchain account", msg.Signer)
+	}
+
+	if !types.NarrowsAuthorizations(k.GetAccountAuthorizations(ctx, msg.Signer), msg.Authorizations) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorizations of interchain account %s may only be narrowed", msg.Signer)
+	}
+
+	k.Keeper.SetAccountAuthorizations(ctx, msg.Signer, msg.Authorizations)
+
+	return &types.MsgSetAccountAuthorizationsResponse{}, nil
+}
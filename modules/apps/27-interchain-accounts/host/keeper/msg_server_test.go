@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	hostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+func (suite *KeeperTestSuite) TestSetAccountAuthorizations() {
+	var (
+		msg                   *types.MsgSetAccountAuthorizations
+		interchainAccountAddr string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: authorizations narrowed", func() {}, nil,
+		},
+		{
+			"success: unrestricted interchain account restricted", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr, nil)
+
+				msg.Authorizations = []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}}
+			}, nil,
+		},
+		{
+			"success: unrestricted interchain account remains unrestricted", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr, nil)
+
+				msg.Authorizations = nil
+			}, nil,
+		},
+		{
+			"authorizations removed", func() {
+				msg.Authorizations = nil
+			}, sdkerrors.ErrUnauthorized,
+		},
+		{
+			"message type not authorized", func() {
+				msg.Authorizations = append(msg.Authorizations, types.MessageAuthorization{TypeUrl: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+			}, sdkerrors.ErrUnauthorized,
+		},
+		{
+			"allowed addresses widened", func() {
+				msg.Authorizations = []types.MessageAuthorization{{
+					TypeUrl:          sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+					AllowedAddresses: []string{suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String()},
+				}}
+			}, sdkerrors.ErrUnauthorized,
+		},
+		{
+			"address constraint removed", func() {
+				msg.Authorizations = []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}}
+			}, sdkerrors.ErrUnauthorized,
+		},
+		{
+			"signer is not an interchain account", func() {
+				msg.Signer = suite.chainB.SenderAccount.GetAddress().String()
+			}, icatypes.ErrInterchainAccountNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var found bool
+			interchainAccountAddr, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			existingAuthorizations := []types.MessageAuthorization{
+				{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), AllowedAddresses: []string{suite.chainA.SenderAccount.GetAddress().String()}},
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr, existingAuthorizations)
+
+			// existing authorizations are replaced by narrower authorizations
+			msg = types.NewMsgSetAccountAuthorizations(interchainAccountAddr, []types.MessageAuthorization{
+				{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), AllowedAddresses: []string{suite.chainB.SenderAccount.GetAddress().String()}},
+			})
+
+			tc.malleate()
+
+			msgServer := hostkeeper.NewMsgServerImpl(suite.chainB.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.SetAccountAuthorizations(sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				expAuthorizations := msg.Authorizations
+				if len(expAuthorizations) == 0 {
+					expAuthorizations = nil
+				}
+				suite.Require().Equal(expAuthorizations, suite.chainB.GetSimApp().ICAHostKeeper.GetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
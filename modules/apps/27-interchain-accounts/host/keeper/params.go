@@ -21,9 +21,71 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// IsAccountReuseAllowed retrieves the allow account reuse boolean from the paramstore.
+// True is returned if existing interchain accounts may be reused by controller ports of the same owner.
+func (k Keeper) IsAccountReuseAllowed(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyAllowAccountReuse, &res)
+	return res
+}
+
+// GetQueryOnlyMessages retrieves the msg types which read-only interchain accounts may execute from the paramstore
+func (k Keeper) GetQueryOnlyMessages(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyQueryOnlyMessages, &res)
+	return res
+}
+
+// IsAccountCreationAllowed retrieves the allow account creation boolean from the paramstore.
+// True is returned if new interchain accounts may be created during the channel handshake.
+func (k Keeper) IsAccountCreationAllowed(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyAllowAccountCreation, &res)
+	return res
+}
+
+// GetIdempotencyKeyRetention retrieves the idempotency key retention from the paramstore.
+// The idempotency keys of executed interchain account transactions are retained for the returned number of
+// blocks, a value of 0 disables idempotency keys.
+func (k Keeper) GetIdempotencyKeyRetention(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyIdempotencyKeyRetention, &res)
+	return res
+}
+
+// IsStrictDecodingEnabled retrieves the strict decoding boolean from the paramstore.
+// True is returned if interchain account transactions carrying unknown fields are rejected.
+func (k Keeper) IsStrictDecodingEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyStrictDecoding, &res)
+	return res
+}
+
+// GetAllowQueries retrieves the host enabled gRPC query method names from the paramstore
+func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyAllowQueries, &res)
+	return res
+}
+
+// GetMinGasBudget retrieves the min gas budget from the paramstore
+func (k Keeper) GetMinGasBudget(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMinGasBudget, &res)
+	return res
+}
+
+// GetMaxGasBudget retrieves the max gas budget from the paramstore.
+// Gas budgets negotiated for channels are not bounded if 0 is returned.
+func (k Keeper) GetMaxGasBudget(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxGasBudget, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx), k.IsAccountReuseAllowed(ctx), k.GetQueryOnlyMessages(ctx), k.IsAccountCreationAllowed(ctx), k.GetIdempotencyKeyRetention(ctx), k.IsStrictDecodingEnabled(ctx), k.GetAllowQueries(ctx), k.GetMinGasBudget(ctx), k.GetMaxGasBudget(ctx))
 }
 
 // SetParams sets the total set of the host submodule parameters.
@@ -10,6 +10,7 @@ func (suite *KeeperTestSuite) TestParams() {
 
 	expParams.HostEnabled = false
 	expParams.AllowMessages = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+	expParams.AllowAccountCreation = false
 	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
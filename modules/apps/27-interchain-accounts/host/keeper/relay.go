@@ -3,57 +3,173 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // AuthenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
+// from state using the provided controller port identifier. Each msg must require the signature of the interchain
+// account, any additional signer must be permitted by the configured SignerAuthorizer. Read-only interchain accounts
+// may only execute the query only messages. Interchain accounts restricted by message authorizations may only execute
+// the authorized messages, including MsgSetAccountAuthorizations
 func (k Keeper) AuthenticateTx(ctx sdk.Context, msgs []sdk.Msg, portID string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, portID)
 	if !found {
 		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetAllowMessages(ctx)
-	for _, msg := range msgs {
-		if !types.ContainsMsgType(allowMsgs, msg) {
+	accAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	if err != nil {
+		return err
+	}
+
+	allowList := k.getAllowList(ctx)
+	readOnly := k.IsReadOnlyInterchainAccount(ctx, interchainAccountAddr)
+	authorizations := k.GetAccountAuthorizations(ctx, interchainAccountAddr)
+	for i, msg := range msgs {
+		if !allowList.Contains(sdk.MsgTypeURL(msg)) {
 			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
 		}
 
+		if readOnly && !types.ContainsMsgType(k.GetQueryOnlyMessages(ctx), msg) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed for read-only interchain account %s: %s", interchainAccountAddr, sdk.MsgTypeURL(msg))
+		}
+
+		if len(authorizations) > 0 && !types.IsMsgAuthorized(authorizations, msg) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %d (%s) not authorized for interchain account %s", i, sdk.MsgTypeURL(msg), interchainAccountAddr)
+		}
+
+		var hasInterchainAccount bool
 		for _, signer := range msg.GetSigners() {
-			if interchainAccountAddr != signer.String() {
-				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String())
+			if accAddr.Equals(signer) {
+				hasInterchainAccount = true
+				continue
+			}
+
+			if !k.signerAuthorizer(ctx, accAddr, msg, signer) {
+				return sdkerrors.Wrapf(types.ErrUnauthorizedSigner, "message %d (%s) requires signer %s, expected %s", i, sdk.MsgTypeURL(msg), signer, interchainAccountAddr)
 			}
 		}
+
+		if !hasInterchainAccount {
+			return sdkerrors.Wrapf(types.ErrUnauthorizedSigner, "message %d (%s) does not require interchain account %s as a signer", i, sdk.MsgTypeURL(msg), interchainAccountAddr)
+		}
 	}
 
 	return nil
 }
 
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) error {
+// executeTx authenticates and atomically executes the provided msgs. Channels which negotiated a gas budget execute the
+// msgs within the gas budget, see executeMsgsWithinGasBudget. If continue on error is enabled the msgs are executed
+// independently, see executeMsgsIndependently, returning the result of each msg.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg, continueOnError bool) ([]abci.Event, []icatypes.MsgResult, error) {
 	if err := k.AuthenticateTx(ctx, msgs, sourcePort); err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	gasBudget, err := k.getGasBudget(ctx, destPort, destChannel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executeMsgs := k.executeMsgs
+
+	var msgResults []icatypes.MsgResult
+	if continueOnError {
+		executeMsgs = func(ctx sdk.Context, msgs []sdk.Msg) ([]abci.Event, error) {
+			var events []abci.Event
+			events, msgResults = k.executeMsgsIndependently(ctx, msgs)
+			return events, nil
+		}
 	}
 
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
+
+	var events []abci.Event
+	if gasBudget != 0 {
+		events, err = k.executeMsgsWithinGasBudget(cacheCtx, msgs, gasBudget, executeMsgs)
+	} else {
+		events, err = executeMsgs(cacheCtx, msgs)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	writeCache()
+
+	return events, msgResults, nil
+}
+
+// executeMsgsIndependently executes each of the provided msgs in its own cached context, committing the state
+// transitions of successful msgs regardless of the failure of other msgs. The returned results contain the result
+// of the msg at the same index, the returned events contain the events emitted by the successful msgs.
+// NOTE: the execution is not atomic, a failed msg does not revert the state transitions of the msgs executed before it
+// and the msgs executed after it observe the state as if the failed msg had not been included. Out of gas panics,
+// including exceeding the gas budget of the channel, are propagated and fail the execution of all msgs.
+func (k Keeper) executeMsgsIndependently(ctx sdk.Context, msgs []sdk.Msg) ([]abci.Event, []icatypes.MsgResult) {
+	var (
+		events  []abci.Event
+		results []icatypes.MsgResult
+	)
+
+	for i, msg := range msgs {
+		// CacheContext returns a new context with the multi-store branched into a cached storage object
+		// writeCache is called only if the msg succeeds
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		msgEvents, err := k.executeMsgs(cacheCtx, []sdk.Msg{msg})
+		if err != nil {
+			k.Logger(ctx).Info("interchain account message failed with continue on error enabled", "index", i, "msg-type", sdk.MsgTypeURL(msg), "error", err.Error())
+			results = append(results, icatypes.NewMsgResult(err))
+			continue
+		}
+
+		writeCache()
+
+		events = append(events, msgEvents...)
+		results = append(results, icatypes.NewMsgResult(nil))
+	}
+
+	return events, results
+}
+
+// executeMsgs validates and executes the provided msgs in order, returning the events emitted by the executed msgs.
+// A panic raised during message execution is recovered and returned as an error, resulting in the cached state
+// transitions being discarded by the caller. Out of gas panics are propagated as they are handled by the transaction
+// processing the packet.
+func (k Keeper) executeMsgs(ctx sdk.Context, msgs []sdk.Msg) (events []abci.Event, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			k.Logger(ctx).Error("recovered from panic during interchain account message execution", "panic", r)
+			events, err = nil, sdkerrors.Wrapf(types.ErrMsgExecutionPanic, "%v", r)
+		}
+	}()
+
 	for _, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
-			return err
+			return nil, err
 		}
 
-		if _, err := k.executeMsg(cacheCtx, msg); err != nil {
-			return err
+		res, err := k.executeMsg(ctx, msg)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	writeCache()
+		events = append(events, res.Events...)
+	}
 
-	return nil
+	return events, nil
 }
 
 // Attempts to get the message handler from the router and if found will then execute the message
@@ -66,28 +182,187 @@ func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 	return handler(ctx, msg)
 }
 
-// OnRecvPacket handles a given interchain accounts packet on a destination host chain
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+// executeQuery executes the provided query, returning the protobuf encoded query response. The query must be allowed
+// by the allow queries param and its response may not exceed MaxQueryResponseLength.
+func (k Keeper) executeQuery(ctx sdk.Context, query icatypes.QueryRequest) ([]byte, error) {
+	if k.queryRouter == nil || !types.NewAllowList(k.GetAllowQueries(ctx)).Contains(query.Path) {
+		return nil, sdkerrors.Wrapf(types.ErrQueryNotAllowed, "query path %s", query.Path)
+	}
+
+	handler := k.queryRouter.Route(query.Path)
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized query path %s", query.Path)
+	}
+
+	res, err := handler(ctx, abci.RequestQuery{
+		Path: query.Path,
+		Data: query.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Value) > icatypes.MaxQueryResponseLength {
+		return nil, sdkerrors.Wrapf(types.ErrQueryResponseTooLarge, "query response length %d exceeds %d", len(res.Value), icatypes.MaxQueryResponseLength)
+	}
+
+	return res.Value, nil
+}
+
+// OnRecvPacket handles a given interchain accounts packet on a destination host chain. The returned bytes are
+// the result to be included in a successful acknowledgement. Packets of type EXECUTE_TX_WITH_EVENTS result in the
+// JSON encoded TxEvents emitted by the executed messages, bounded by MaxTxEventsLength. Packets carrying an idempotency
+// key already executed by the interchain account within the retention window are not executed again, resulting in the
+// result of the original execution. If strict decoding is enabled, protobuf encoded transactions carrying fields
+// unknown to the host chain are rejected.
+// Packets are executed sequentially in the order in which they are delivered within a block. Packets sent over different
+// channels controlling the same reused interchain account therefore observe the state changes of all packets delivered
+// before them, regardless of the channel over which they were sent.
+// Packets carrying a query execute the query once the transaction has been executed, resulting in the JSON encoded
+// TxQueryResult containing the result of the transaction alongside the query response. A query which is not allowed or
+// fails results in the transaction being rejected.
+// Packets of type EXECUTE_TX with continue on error enabled execute each message independently, resulting in the JSON
+// encoded TxPartialResult containing the result of each message. The packet is acknowledged successfully even if all
+// of its messages failed, controllers must inspect the result of each message. Authentication failures or exceeding
+// the gas budget of the channel still fail the entire packet.
+// Packets carrying an encrypted memo pass it to the encrypted memo handler prior to executing the transaction, without
+// the memo being interpreted by the host. The packet is rejected if no handler is set or the handler returns an error.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 
 	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
-		return sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
+		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
 	}
 
 	switch data.Type {
-	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
+	case icatypes.EXECUTE_TX, icatypes.EXECUTE_TX_WITH_EVENTS:
+		// transactions carrying an idempotency key are executed at most once within the retention window
+		if result, found := k.getIdempotentResult(ctx, packet.SourcePort, data.IdempotencyKey); found {
+			k.Logger(ctx).Info("interchain account transaction already executed", "port-id", packet.SourcePort, "idempotency-key", data.IdempotencyKey)
+			return result, nil
+		}
+
+		encoding, compression, err := k.getEncoding(ctx, packet.DestinationPort, packet.DestinationChannel)
+		if err != nil {
+			return nil, err
+		}
+
+		deserializeCosmosTx := icatypes.DeserializeCosmosTx
+		if k.IsStrictDecodingEnabled(ctx) {
+			deserializeCosmosTx = icatypes.DeserializeCosmosTxStrict
+		}
+
+		msgs, err := deserializeCosmosTx(k.cdc, data.Data, encoding, compression)
+		if err != nil {
+			return nil, err
+		}
+
+		if data.ContinueOnError && data.Type != icatypes.EXECUTE_TX {
+			return nil, sdkerrors.Wrapf(icatypes.ErrInvalidOutgoingData, "continue on error is not supported for packet data type %s", data.Type)
+		}
+
+		if err := k.handleEncryptedMemo(ctx, packet.SourcePort, data.EncryptedMemo); err != nil {
+			return nil, err
+		}
+
+		events, msgResults, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs, data.ContinueOnError)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if err = k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs); err != nil {
-			return err
+		result := []byte{byte(1)}
+		switch {
+		case data.Type == icatypes.EXECUTE_TX_WITH_EVENTS:
+			result = icatypes.NewTxEvents(events, icatypes.MaxTxEventsLength).GetBytes()
+		case data.ContinueOnError:
+			result = icatypes.NewTxPartialResult(msgResults).GetBytes()
 		}
 
-		return nil
+		if data.Query != nil {
+			response, err := k.executeQuery(ctx, *data.Query)
+			if err != nil {
+				return nil, err
+			}
+
+			result = icatypes.NewTxQueryResult(result, response).GetBytes()
+		}
+
+		k.setIdempotentResult(ctx, packet.SourcePort, data.IdempotencyKey, result)
+
+		return result, nil
 	default:
-		return icatypes.ErrUnknownDataType
+		return nil, icatypes.ErrUnknownDataType
+	}
+}
+
+// getEncoding returns the encoding and compression formats negotiated for the provided channel. Channels using
+// the legacy version format, which predates encoding negotiation, use the protobuf encoding format without compression.
+func (k Keeper) getEncoding(ctx sdk.Context, portID, channelID string) (string, string, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID %s channel ID %s", portID, channelID)
+	}
+
+	if !icatypes.IsICAMetadataVersion(channel.Version) {
+		return icatypes.EncodingProtobuf, "", nil
+	}
+
+	metadata, err := icatypes.ParseICAMetadata(channel.Version)
+	if err != nil {
+		return "", "", err
+	}
+
+	return metadata.Encoding, metadata.Compression, nil
+}
+
+// WriteAcknowledgements writes the acknowledgement of each of the provided packets received by the host.
+// Each acknowledgement is validated and written independently through the ICS4Wrapper, exactly as if
+// WriteAcknowledgement had been invoked for each packet individually. A failure to write one acknowledgement
+// does not affect the others. The returned slice contains the result for the packet at the same index,
+// where a nil entry indicates the acknowledgement was written successfully.
+func (k Keeper) WriteAcknowledgements(ctx sdk.Context, packetAcks []types.PacketAcknowledgement) []error {
+	results := make([]error, len(packetAcks))
+	for i, packetAck := range packetAcks {
+		// CacheContext returns a new context with the multi-store branched into a cached storage object
+		// writeCache is called only if the acknowledgement is written successfully
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.writeAcknowledgement(cacheCtx, packetAck); err != nil {
+			results[i] = err
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	return results
+}
+
+// writeAcknowledgement validates the provided packet and acknowledgement and writes the acknowledgement
+// using the host channel capability
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, packetAck types.PacketAcknowledgement) error {
+	packet := packetAck.Packet
+	if packet == nil {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packet cannot be nil")
+	}
+
+	if err := packet.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if packet.GetDestPort() != icatypes.PortID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "expected %s, got %s", icatypes.PortID, packet.GetDestPort())
 	}
+
+	if packetAck.Acknowledgement == nil {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidAcknowledgement, "acknowledgement cannot be nil")
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel()))
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "failed to find capability for port %s, channel %s", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, packetAck.Acknowledgement.Acknowledgement())
 }
@@ -3,18 +3,23 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	hostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -41,7 +46,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -51,11 +56,226 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
 		},
+		{
+			"interchain account successfully executes MsgSetAccountAuthorizations",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				authorizations := []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), AllowedAddresses: []string{suite.chainB.SenderAccount.GetAddress().String()}}}
+				msg := types.NewMsgSetAccountAuthorizations(interchainAccountAddr, authorizations)
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"restricted interchain account fails to widen its authorizations",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				authorizations := []types.MessageAuthorization{
+					{TypeUrl: sdk.MsgTypeURL(&types.MsgSetAccountAuthorizations{})},
+					{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), AllowedAddresses: []string{suite.chainB.SenderAccount.GetAddress().String()}},
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr, authorizations)
+
+				// the interchain account attempts to lift the recipient constraint of bank sends
+				msg := types.NewMsgSetAccountAuthorizations(interchainAccountAddr, []types.MessageAuthorization{
+					{TypeUrl: sdk.MsgTypeURL(&types.MsgSetAccountAuthorizations{})},
+					{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				})
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg), sdk.MsgTypeURL(&banktypes.MsgSend{})}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend with proto3json encoding",
+			func() {
+				setHostChannelEncoding(path, icatypes.EncodingProto3JSON)
+
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProto3JSON, "")
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend with negotiated protobuf encoding",
+			func() {
+				setHostChannelEncoding(path, icatypes.EncodingProtobuf)
+
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend with negotiated gzip compression",
+			func() {
+				setHostChannelCompression(path, icatypes.CompressionGzip)
+
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, icatypes.CompressionGzip)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"gzip compressed packet data on uncompressed channel",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, icatypes.CompressionGzip)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"decompressed packet data exceeds maximum length",
+			func() {
+				setHostChannelCompression(path, icatypes.CompressionGzip)
+
+				data, err := icatypes.CompressData(make([]byte, icatypes.MaxPacketDataLength+1), icatypes.CompressionGzip)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			false,
+		},
+		{
+			"proto3json encoded packet data on protobuf encoded channel",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProto3JSON, "")
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
 		{
 			"interchain account successfully executes stakingtypes.MsgDelegate",
 			func() {
@@ -69,7 +289,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -79,7 +299,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -103,7 +323,38 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgDelegate, msgUndelegate})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgDelegate, msgUndelegate}, icatypes.EncodingProtobuf, "")
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"interchain account successfully compounds staking rewards",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				suite.delegateFromICAWallet(interchainAccountAddr, validatorAddr, sdk.NewInt(5000))
+
+				rewards := []controllertypes.ValidatorRewards{
+					controllertypes.NewValidatorRewards(validatorAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				msgs, err := controllertypes.NewCompoundRewardsMsgs(interchainAccountAddr, rewards)
+				suite.Require().NoError(err)
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -113,11 +364,42 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(&disttypes.MsgWithdrawDelegatorReward{}), sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
 		},
+		{
+			"compounding staking rewards fails atomically when the delegation exceeds the withdrawn rewards",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				suite.delegateFromICAWallet(interchainAccountAddr, validatorAddr, sdk.NewInt(5000))
+
+				rewards := []controllertypes.ValidatorRewards{
+					controllertypes.NewValidatorRewards(validatorAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))),
+				}
+
+				msgs, err := controllertypes.NewCompoundRewardsMsgs(interchainAccountAddr, rewards)
+				suite.Require().NoError(err)
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf, "")
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(&disttypes.MsgWithdrawDelegatorReward{}), sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
 		{
 			"interchain account successfully executes govtypes.MsgSubmitProposal",
 			func() {
@@ -138,7 +420,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Proposer:       interchainAccountAddr,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -148,7 +430,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -177,7 +459,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Option:     govtypes.OptionYes,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -187,7 +469,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -203,7 +485,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Depositor: interchainAccountAddr,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -213,7 +495,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -229,7 +511,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					WithdrawAddress:  suite.chainB.SenderAccount.GetAddress().String(),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -239,7 +521,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -266,7 +548,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					TimeoutTimestamp: uint64(0),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -276,7 +558,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -305,7 +587,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{
 			"invalid packet type - UNSPECIFIED",
 			func() {
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -322,7 +604,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			func() {
 				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -343,7 +625,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -364,7 +646,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -374,7 +656,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			false,
@@ -422,7 +704,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				0,
 			)
 
-			err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -433,17 +715,1081 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
-func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
-	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, portID)
-	suite.Require().True(found)
+func (suite *KeeperTestSuite) TestOnRecvPacketResult() {
+	var packetType icatypes.Type
 
-	msgBankSend := &banktypes.MsgSend{
-		FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
-		ToAddress:   interchainAccountAddr,
-		Amount:      amount,
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expEvents bool
+	}{
+		{
+			"execute tx returns the default result",
+			func() {
+				packetType = icatypes.EXECUTE_TX
+			},
+			false,
+		},
+		{
+			"execute tx with events returns the emitted events",
+			func() {
+				packetType = icatypes.EXECUTE_TX_WITH_EVENTS
+			},
+			true,
+		},
 	}
 
-	res, err := suite.chainB.SendMsgs(msgBankSend)
-	suite.Require().NotEmpty(res)
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+			suite.Require().NoError(err)
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate() // malleate mutates test data
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: packetType,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			result, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			suite.Require().NoError(err)
+
+			if !tc.expEvents {
+				suite.Require().Equal([]byte{byte(1)}, result)
+				return
+			}
+
+			ack, err := icatypes.ParseAcknowledgement(channeltypes.NewResultAcknowledgement(result).Acknowledgement())
+			suite.Require().NoError(err)
+
+			txEvents, err := ack.GetTxEvents()
+			suite.Require().NoError(err)
+			suite.Require().False(txEvents.Truncated)
+
+			var hasTransfer bool
+			for _, event := range txEvents.Events {
+				if event.Type != banktypes.EventTypeTransfer {
+					continue
+				}
+
+				hasTransfer = true
+				suite.Require().Contains(event.Attributes, icatypes.EventAttribute{Key: banktypes.AttributeKeyRecipient, Value: suite.chainB.SenderAccount.GetAddress().String()})
+			}
+
+			suite.Require().True(hasTransfer)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketStrictDecoding() {
+	// protobuf encoding of a varint field with a field number unknown to CosmosTx and MsgSend
+	unknownField := []byte{0xa0, 0x06, 0x01}
+
+	var (
+		strictDecoding bool
+		msgBz          []byte
+		txBz           []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: transaction without unknown fields",
+			func() {},
+			nil,
+		},
+		{
+			"success: unknown transaction field is discarded when strict decoding is disabled",
+			func() {
+				strictDecoding = false
+				txBz = append(txBz, unknownField...)
+			},
+			nil,
+		},
+		{
+			"success: unknown message field is discarded when strict decoding is disabled",
+			func() {
+				strictDecoding = false
+				msgBz = append(msgBz, unknownField...)
+			},
+			nil,
+		},
+		{
+			"unknown transaction field is rejected",
+			func() {
+				txBz = append(txBz, unknownField...)
+			},
+			icatypes.ErrUnknownFields,
+		},
+		{
+			"unknown message field is rejected",
+			func() {
+				msgBz = append(msgBz, unknownField...)
+			},
+			icatypes.ErrUnknownFields,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			strictDecoding = true
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			recipient := suite.chainB.SenderAccount.GetAddress()
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			cdc := suite.chainA.GetSimApp().AppCodec()
+			msgBz, err = cdc.Marshal(msg)
+			suite.Require().NoError(err)
+
+			txBz = nil
+			tc.malleate() // malleate mutates test data
+
+			cosmosTx := &icatypes.CosmosTx{
+				Messages: []*codectypes.Any{
+					{
+						TypeUrl: sdk.MsgTypeURL(msg),
+						Value:   msgBz,
+					},
+				},
+			}
+
+			bz, err := cdc.Marshal(cosmosTx)
+			suite.Require().NoError(err)
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, strictDecoding, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: append(bz, txBz...),
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(balance.AddAmount(sdk.NewInt(100)), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketQuery() {
+	var (
+		interchainAccountAddr string
+		validatorAddr         sdk.ValAddress
+		packetType            icatypes.Type
+		allowQueries          []string
+		query                 *icatypes.QueryRequest
+		expResponse           codec.ProtoMarshaler
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		expErr   error
+	}{
+		{
+			"success: delegate then read balance",
+			func() {
+				query = icatypes.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", suite.chainB.GetSimApp().AppCodec().MustMarshal(&banktypes.QueryBalanceRequest{
+					Address: interchainAccountAddr,
+					Denom:   sdk.DefaultBondDenom,
+				}))
+
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))
+				expResponse = &banktypes.QueryBalanceResponse{Balance: &coin}
+			},
+			true,
+			nil,
+		},
+		{
+			"success: delegate then read delegation",
+			func() {
+				query = icatypes.NewQueryRequest("/cosmos.staking.v1beta1.Query/Delegation", suite.chainB.GetSimApp().AppCodec().MustMarshal(&stakingtypes.QueryDelegationRequest{
+					DelegatorAddr: interchainAccountAddr,
+					ValidatorAddr: validatorAddr.String(),
+				}))
+
+				delegatorAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+				suite.Require().NoError(err)
+
+				validator, found := suite.chainB.GetSimApp().StakingKeeper.GetValidator(suite.chainB.GetContext(), validatorAddr)
+				suite.Require().True(found)
+
+				shares, err := validator.SharesFromTokens(sdk.NewInt(5000))
+				suite.Require().NoError(err)
+
+				expResponse = &stakingtypes.QueryDelegationResponse{
+					DelegationResponse: &stakingtypes.DelegationResponse{
+						Delegation: stakingtypes.NewDelegation(delegatorAddr, validatorAddr, shares),
+						Balance:    sdk.NewCoin(sdk.DefaultBondDenom, validator.TokensFromShares(shares).TruncateInt()),
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"success: query result includes the transaction events",
+			func() {
+				packetType = icatypes.EXECUTE_TX_WITH_EVENTS
+			},
+			true,
+			nil,
+		},
+		{
+			"success: query allowed by wildcard",
+			func() {
+				allowQueries = []string{"/cosmos.bank.v1beta1.Query/*"}
+			},
+			true,
+			nil,
+		},
+		{
+			"query is not allowed",
+			func() {
+				allowQueries = []string{"/cosmos.bank.v1beta1.Query/AllBalances"}
+			},
+			false,
+			types.ErrQueryNotAllowed,
+		},
+		{
+			"queries are disabled",
+			func() {
+				allowQueries = nil
+			},
+			false,
+			types.ErrQueryNotAllowed,
+		},
+		{
+			"unknown query path",
+			func() {
+				allowQueries = []string{types.Wildcard}
+				query.Path = "/cosmos.bank.v1beta1.Query/Unknown"
+			},
+			false,
+			sdkerrors.ErrUnknownRequest,
+		},
+		{
+			"query fails",
+			func() {
+				query.Data = []byte("invalid")
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			var found bool
+			interchainAccountAddr, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			validatorAddr = (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+			msg := &stakingtypes.MsgDelegate{
+				DelegatorAddress: interchainAccountAddr,
+				ValidatorAddress: validatorAddr.String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+			suite.Require().NoError(err)
+
+			packetType = icatypes.EXECUTE_TX
+			allowQueries = []string{"/cosmos.bank.v1beta1.Query/Balance", "/cosmos.staking.v1beta1.Query/Delegation"}
+			query = icatypes.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", suite.chainB.GetSimApp().AppCodec().MustMarshal(&banktypes.QueryBalanceRequest{
+				Address: interchainAccountAddr,
+				Denom:   sdk.DefaultBondDenom,
+			}))
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))
+			expResponse = &banktypes.QueryBalanceResponse{Balance: &coin}
+
+			tc.malleate() // malleate mutates test data
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, allowQueries, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type:  packetType,
+				Data:  data,
+				Query: query,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			result, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				if tc.expErr != nil {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			ack, err := icatypes.ParseAcknowledgement(channeltypes.NewResultAcknowledgement(result).Acknowledgement())
+			suite.Require().NoError(err)
+
+			txQueryResult, err := ack.GetTxQueryResult()
+			suite.Require().NoError(err)
+
+			// the query response reflects the state resulting from the executed transaction
+			suite.Require().Equal(suite.chainB.GetSimApp().AppCodec().MustMarshal(expResponse), txQueryResult.Response)
+
+			if packetType == icatypes.EXECUTE_TX {
+				suite.Require().Equal([]byte{byte(1)}, txQueryResult.Result)
+			} else {
+				txEvents, err := icatypes.AcknowledgementResult{Success: true, Result: txQueryResult.Result}.GetTxEvents()
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(txEvents.Events)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketContinueOnError() {
+	var (
+		amounts    []int64
+		packetType icatypes.Type
+		gasBudget  uint64
+		expResults []icatypes.MsgResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: all msgs succeed",
+			func() {},
+			nil,
+		},
+		{
+			"success: failed msg does not revert successful msgs",
+			func() {
+				amounts = []int64{100, 100000, 100}
+				expResults = []icatypes.MsgResult{
+					icatypes.NewMsgResult(nil),
+					icatypes.NewMsgResult(sdkerrors.ErrInsufficientFunds),
+					icatypes.NewMsgResult(nil),
+				}
+			},
+			nil,
+		},
+		{
+			"success: all msgs fail",
+			func() {
+				amounts = []int64{100000, 100000}
+				expResults = []icatypes.MsgResult{
+					icatypes.NewMsgResult(sdkerrors.ErrInsufficientFunds),
+					icatypes.NewMsgResult(sdkerrors.ErrInsufficientFunds),
+				}
+			},
+			nil,
+		},
+		{
+			"continue on error not supported for packet data type",
+			func() {
+				packetType = icatypes.EXECUTE_TX_WITH_EVENTS
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+		{
+			"gas budget exceeded fails all msgs",
+			func() {
+				gasBudget = 1000
+			},
+			types.ErrGasBudgetExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			amounts = []int64{100, 100}
+			packetType = icatypes.EXECUTE_TX
+			gasBudget = 0
+			expResults = []icatypes.MsgResult{icatypes.NewMsgResult(nil), icatypes.NewMsgResult(nil)}
+
+			tc.malleate() // malleate mutates test data
+
+			setHostChannelGasBudget(path, gasBudget)
+
+			recipient := suite.chainB.SenderAccount.GetAddress()
+
+			var (
+				msgs      []sdk.Msg
+				expAmount int64
+			)
+			for i, amount := range amounts {
+				msgs = append(msgs, &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   recipient.String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))),
+				})
+
+				if expResults[i].Success {
+					expAmount += amount
+				}
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, false, nil, true, 0, false, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf, "")
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type:            packetType,
+				Data:            data,
+				ContinueOnError: true,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+
+			result, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(balance, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom))
+
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			ack, err := icatypes.ParseAcknowledgement(channeltypes.NewResultAcknowledgement(result).Acknowledgement())
+			suite.Require().NoError(err)
+
+			txPartialResult, err := ack.GetTxPartialResult()
+			suite.Require().NoError(err)
+			suite.Require().Len(txPartialResult.Results, len(expResults))
+
+			for i, expResult := range expResults {
+				suite.Require().Equal(expResult.Success, txPartialResult.Results[i].Success)
+				suite.Require().Contains(txPartialResult.Results[i].Error, expResult.Error)
+			}
+
+			// the state transitions of the successful msgs are committed
+			suite.Require().Equal(balance.AddAmount(sdk.NewInt(expAmount)), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketEncryptedMemo() {
+	var (
+		encryptedMemo []byte
+		handledMemo   []byte
+		handledAddr   sdk.AccAddress
+	)
+
+	setEncryptedMemoHandler := func(handlerErr error) {
+		hostkeeper.WithEncryptedMemoHandler(func(_ sdk.Context, interchainAccountAddr sdk.AccAddress, memo []byte) error {
+			handledAddr, handledMemo = interchainAccountAddr, memo
+			return handlerErr
+		})(&suite.chainB.GetSimApp().ICAHostKeeper)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: encrypted memo passed to handler",
+			func() {
+				setEncryptedMemoHandler(nil)
+			},
+			nil,
+		},
+		{
+			"success: no encrypted memo without handler",
+			func() {
+				encryptedMemo = nil
+			},
+			nil,
+		},
+		{
+			"encrypted memo without handler",
+			func() {},
+			types.ErrEncryptedMemoRejected,
+		},
+		{
+			"encrypted memo rejected by handler",
+			func() {
+				setEncryptedMemoHandler(sdkerrors.ErrUnauthorized)
+			},
+			types.ErrEncryptedMemoRejected,
+		},
+		{
+			"encrypted memo too large",
+			func() {
+				encryptedMemo = make([]byte, icatypes.MaxEncryptedMemoLength+1)
+				setEncryptedMemoHandler(nil)
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			encryptedMemo = []byte("opaque encrypted memo")
+			handledMemo, handledAddr = nil, nil
+
+			tc.malleate() // malleate mutates test data
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, false, nil, true, 0, false, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			recipient := suite.chainB.SenderAccount.GetAddress()
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type:          icatypes.EXECUTE_TX,
+				Data:          data,
+				EncryptedMemo: encryptedMemo,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(balance, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom))
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(balance.AddAmount(sdk.NewInt(100)), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom))
+
+			// the encrypted memo is passed to the handler as is, without being interpreted by the host
+			suite.Require().Equal(encryptedMemo, handledMemo)
+			if len(encryptedMemo) != 0 {
+				suite.Require().Equal(interchainAccountAddr, handledAddr.String())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAuthenticateTx() {
+	var (
+		path *ibctesting.Path
+		msgs []sdk.Msg
+	)
+
+	newMultiSend := func(signers ...sdk.AccAddress) *banktypes.MsgMultiSend {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+		msg := &banktypes.MsgMultiSend{}
+		for _, signer := range signers {
+			msg.Inputs = append(msg.Inputs, banktypes.NewInput(signer, coins))
+			msg.Outputs = append(msg.Outputs, banktypes.NewOutput(suite.chainB.SenderAccount.GetAddress(), coins))
+		}
+
+		return msg
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func(interchainAccountAddr sdk.AccAddress)
+		expErr   error
+	}{
+		{
+			"success: interchain account is the sole signer",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr)}
+			},
+			nil,
+		},
+		{
+			"success: additional signer permitted by signer authorizer",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr, suite.chainB.SenderAccount.GetAddress())}
+
+				hostkeeper.WithSignerAuthorizer(func(_ sdk.Context, _ sdk.AccAddress, _ sdk.Msg, signer sdk.AccAddress) bool {
+					return signer.Equals(suite.chainB.SenderAccount.GetAddress())
+				})(&suite.chainB.GetSimApp().ICAHostKeeper)
+			},
+			nil,
+		},
+		{
+			"additional signer rejected by default",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr, suite.chainB.SenderAccount.GetAddress())}
+			},
+			types.ErrUnauthorizedSigner,
+		},
+		{
+			"additional signer not permitted by signer authorizer",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr, suite.chainA.SenderAccount.GetAddress())}
+
+				hostkeeper.WithSignerAuthorizer(func(_ sdk.Context, _ sdk.AccAddress, _ sdk.Msg, signer sdk.AccAddress) bool {
+					return signer.Equals(suite.chainB.SenderAccount.GetAddress())
+				})(&suite.chainB.GetSimApp().ICAHostKeeper)
+			},
+			types.ErrUnauthorizedSigner,
+		},
+		{
+			"interchain account is not a signer",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr), newMultiSend(suite.chainB.SenderAccount.GetAddress())}
+
+				hostkeeper.WithSignerAuthorizer(func(_ sdk.Context, _ sdk.AccAddress, _ sdk.Msg, _ sdk.AccAddress) bool {
+					return true
+				})(&suite.chainB.GetSimApp().ICAHostKeeper)
+			},
+			types.ErrUnauthorizedSigner,
+		},
+		{
+			"success: read-only interchain account executes query only message",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr)}
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetReadOnlyInterchainAccount(suite.chainB.GetContext(), interchainAccountAddr.String())
+
+				msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+				params := types.NewParams(true, []string{msgTypeURL}, false, []string{msgTypeURL}, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"read-only interchain account executes message which is not query only",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr)}
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetReadOnlyInterchainAccount(suite.chainB.GetContext(), interchainAccountAddr.String())
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"read-only interchain account executes query only message which is not allowed",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr)}
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetReadOnlyInterchainAccount(suite.chainB.GetContext(), interchainAccountAddr.String())
+
+				params := types.NewParams(true, nil, false, []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"success: message authorized for interchain account",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr)}
+
+				authorizations := []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), AllowedAddresses: []string{suite.chainB.SenderAccount.GetAddress().String()}}}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr.String(), authorizations)
+			},
+			nil,
+		},
+		{
+			"success: message authorizations updated by authorized interchain account",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{types.NewMsgSetAccountAuthorizations(interchainAccountAddr.String(), nil)}
+
+				authorizations := []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&types.MsgSetAccountAuthorizations{})}}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr.String(), authorizations)
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(&types.MsgSetAccountAuthorizations{})}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"message authorizations not updatable by interchain account restricted to other messages",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{types.NewMsgSetAccountAuthorizations(interchainAccountAddr.String(), nil)}
+
+				authorizations := []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr.String(), authorizations)
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(&types.MsgSetAccountAuthorizations{})}, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"message sent to address not allowed by interchain account authorization",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr)}
+
+				authorizations := []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), AllowedAddresses: []string{suite.chainA.SenderAccount.GetAddress().String()}}}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr.String(), authorizations)
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"message type not authorized for interchain account",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr)}
+
+				authorizations := []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})}}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr.String(), authorizations)
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"message authorized for interchain account but not allowed by host parameters",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{newMultiSend(interchainAccountAddr)}
+
+				authorizations := []types.MessageAuthorization{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAuthorizations(suite.chainB.GetContext(), interchainAccountAddr.String(), authorizations)
+
+				params := types.NewParams(true, nil, false, nil, true, 0, false, nil, 0, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"message type not allowed",
+			func(interchainAccountAddr sdk.AccAddress) {
+				msgs = []sdk.Msg{&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr.String(),
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}}
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			accAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, false, nil, true, 0, false, nil, 0, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate(accAddr) // malleate mutates test data
+
+			err = suite.chainB.GetSimApp().ICAHostKeeper.AuthenticateTx(suite.chainB.GetContext(), msgs, path.EndpointA.ChannelConfig.PortID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAuthenticateTxAllowListCache() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	hostkeeper.WithAllowListCache()(&suite.chainB.GetSimApp().ICAHostKeeper)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msgs := []sdk.Msg{&banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}}
+
+	authenticate := func(ctx sdk.Context) error {
+		return suite.chainB.GetSimApp().ICAHostKeeper.AuthenticateTx(ctx, msgs, path.EndpointA.ChannelConfig.PortID)
+	}
+
+	ctx := suite.chainB.GetContext()
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(ctx, types.NewParams(true, []string{"/cosmos.bank.v1beta1.*"}, false, nil, true, 0, false, nil, 0, 0))
+	suite.Require().NoError(authenticate(ctx))
+
+	// parameter updates within the same block are observed
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(ctx, types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, false, nil, true, 0, false, nil, 0, 0))
+	suite.Require().ErrorIs(authenticate(ctx), sdkerrors.ErrUnauthorized)
+
+	// parameter updates which are discarded are not observed
+	cacheCtx, _ := ctx.CacheContext()
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(cacheCtx, types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, false, nil, true, 0, false, nil, 0, 0))
+	suite.Require().NoError(authenticate(cacheCtx))
+	suite.Require().ErrorIs(authenticate(ctx), sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestWriteAcknowledgements() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	newPacket := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.NewPacket(
+			[]byte("packet data"),
+			sequence,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			clienttypes.NewHeight(0, 100),
+			0,
+		)
+	}
+
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	errorAck := channeltypes.NewErrorAcknowledgement("error")
+
+	invalidPortPacket := newPacket(3)
+	invalidPortPacket.DestinationPort = ibctesting.MockPort
+
+	invalidChannelPacket := newPacket(4)
+	invalidChannelPacket.DestinationChannel = ibctesting.InvalidID
+
+	packetAcks := []types.PacketAcknowledgement{
+		types.NewPacketAcknowledgement(newPacket(1), successAck),
+		types.NewPacketAcknowledgement(newPacket(2), errorAck),
+		types.NewPacketAcknowledgement(invalidPortPacket, successAck),
+		types.NewPacketAcknowledgement(invalidChannelPacket, successAck),
+		types.NewPacketAcknowledgement(newPacket(5), nil),
+		types.NewPacketAcknowledgement(nil, successAck),
+		types.NewPacketAcknowledgement(newPacket(0), successAck),
+		types.NewPacketAcknowledgement(newPacket(1), successAck), // acknowledgement already written
+	}
+
+	results := suite.chainB.GetSimApp().ICAHostKeeper.WriteAcknowledgements(suite.chainB.GetContext(), packetAcks)
+	suite.Require().Len(results, len(packetAcks))
+
+	suite.Require().NoError(results[0])
+	suite.Require().NoError(results[1])
+	suite.Require().ErrorIs(results[2], porttypes.ErrInvalidPort)
+	suite.Require().ErrorIs(results[3], channeltypes.ErrChannelCapabilityNotFound)
+	suite.Require().ErrorIs(results[4], channeltypes.ErrInvalidAcknowledgement)
+	suite.Require().ErrorIs(results[5], channeltypes.ErrInvalidPacket)
+	suite.Require().Error(results[6])
+	suite.Require().ErrorIs(results[7], channeltypes.ErrAcknowledgementExists)
+
+	// the written acknowledgements are equivalent to those written individually
+	for _, packetAck := range packetAcks[:2] {
+		ackCommitment, found := suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packetAck.Packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Equal(channeltypes.CommitAcknowledgement(packetAck.Acknowledgement.Acknowledgement()), ackCommitment)
+	}
+
+	suite.Require().False(suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 5))
+}
+
+// setHostChannelEncoding overwrites the host channel version with ICAMetadata negotiating the provided encoding format
+func setHostChannelEncoding(path *ibctesting.Path, encoding string) {
+	metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	metadata.Address = TestAccAddress.String()
+	metadata.Encoding = encoding
+
+	channel := path.EndpointB.GetChannel()
+	channel.Version = icatypes.EncodeICAMetadata(metadata)
+	path.EndpointB.SetChannel(channel)
+}
+
+// setHostChannelCompression overwrites the host channel version with ICAMetadata negotiating the protobuf encoding
+// format and the provided compression format
+func setHostChannelCompression(path *ibctesting.Path, compression string) {
+	metadata := icatypes.NewDefaultICAMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	metadata.Address = TestAccAddress.String()
+	metadata.Compression = compression
+
+	channel := path.EndpointB.GetChannel()
+	channel.Version = icatypes.EncodeICAMetadata(metadata)
+	path.EndpointB.SetChannel(channel)
+}
+
+func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, portID)
+	suite.Require().True(found)
+
+	msgBankSend := &banktypes.MsgSend{
+		FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
+		ToAddress:   interchainAccountAddr,
+		Amount:      amount,
+	}
+
+	res, err := suite.chainB.SendMsgs(msgBankSend)
+	suite.Require().NotEmpty(res)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) delegateFromICAWallet(interchainAccountAddr string, validatorAddr sdk.ValAddress, amount sdk.Int) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	validator, found := suite.chainB.GetSimApp().StakingKeeper.GetValidator(suite.chainB.GetContext(), validatorAddr)
+	suite.Require().True(found)
+
+	_, err = suite.chainB.GetSimApp().StakingKeeper.Delegate(suite.chainB.GetContext(), delegatorAddr, amount, stakingtypes.Unbonded, validator, true)
 	suite.Require().NoError(err)
 }
@@ -0,0 +1,26 @@
+package host
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// NewProposalHandler defines the interchain accounts host proposal handler
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.LinkInterchainAccountProposal:
+			return k.LinkInterchainAccountProposal(ctx, c)
+
+		case *types.ProvisionInterchainAccountProposal:
+			return k.ProvisionInterchainAccountProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchain accounts host proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// PacketAcknowledgement pairs a packet received by the host with the acknowledgement to be written for it
+type PacketAcknowledgement struct {
+	Packet          ibcexported.PacketI
+	Acknowledgement ibcexported.Acknowledgement
+}
+
+// NewPacketAcknowledgement creates and returns a new PacketAcknowledgement
+func NewPacketAcknowledgement(packet ibcexported.PacketI, ack ibcexported.Acknowledgement) PacketAcknowledgement {
+	return PacketAcknowledgement{
+		Packet:          packet,
+		Acknowledgement: ack,
+	}
+}
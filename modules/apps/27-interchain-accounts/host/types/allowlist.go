@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strings"
+)
+
+// Wildcard defines the suffix of an allow list entry matching every message type URL with the preceding prefix.
+// An entry consisting of only the Wildcard matches every message type URL.
+const Wildcard = "*"
+
+// AllowList defines a compiled representation of a list of allowed message type URLs. Exact entries are matched
+// using a set while wildcard entries are matched by prefix.
+type AllowList struct {
+	exact    map[string]struct{}
+	prefixes []string
+}
+
+// NewAllowList compiles the provided list of allowed message type URLs into an AllowList
+func NewAllowList(allowMsgs []string) *AllowList {
+	allowList := &AllowList{
+		exact: make(map[string]struct{}, len(allowMsgs)),
+	}
+
+	for _, typeURL := range allowMsgs {
+		if strings.HasSuffix(typeURL, Wildcard) {
+			allowList.prefixes = append(allowList.prefixes, strings.TrimSuffix(typeURL, Wildcard))
+			continue
+		}
+
+		allowList.exact[typeURL] = struct{}{}
+	}
+
+	return allowList
+}
+
+// Contains returns true if the provided message type URL is allowed, otherwise false
+func (al *AllowList) Contains(typeURL string) bool {
+	if _, ok := al.exact[typeURL]; ok {
+		return true
+	}
+
+	for _, prefix := range al.prefixes {
+		if strings.HasPrefix(typeURL, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestAllowListContains(t *testing.T) {
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name      string
+		allowMsgs []string
+		expPass   bool
+	}{
+		{"exact match", []string{"/cosmos.staking.v1beta1.MsgDelegate", msgSend}, true},
+		{"wildcard prefix match", []string{"/cosmos.bank.v1beta1.*"}, true},
+		{"wildcard matches all", []string{types.Wildcard}, true},
+		{"empty allow list", nil, false},
+		{"no exact match", []string{"/cosmos.bank.v1beta1.MsgMultiSend"}, false},
+		{"no wildcard prefix match", []string{"/cosmos.staking.v1beta1.*"}, false},
+		{"prefix without wildcard", []string{"/cosmos.bank.v1beta1."}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPass, types.NewAllowList(tc.allowMsgs).Contains(msgSend))
+			require.Equal(t, tc.expPass, types.ContainsMsgType(tc.allowMsgs, &banktypes.MsgSend{}))
+		})
+	}
+}
+
+// largeAllowList returns an allow list of n exact entries followed by the MsgSend type URL
+func largeAllowList(n int) []string {
+	allowMsgs := make([]string, 0, n+1)
+	for i := 0; i < n; i++ {
+		allowMsgs = append(allowMsgs, fmt.Sprintf("/ibc.test.v1.Msg%d", i))
+	}
+
+	return append(allowMsgs, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+}
+
+func BenchmarkContainsMsgType(b *testing.B) {
+	allowMsgs := largeAllowList(1000)
+	msg := &banktypes.MsgSend{}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !types.ContainsMsgType(allowMsgs, msg) {
+			b.Fatal("expected message type to be allowed")
+		}
+	}
+}
+
+func BenchmarkAllowListContains(b *testing.B) {
+	allowList := types.NewAllowList(largeAllowList(1000))
+	typeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !allowList.Contains(typeURL) {
+			b.Fatal("expected message type to be allowed")
+		}
+	}
+}
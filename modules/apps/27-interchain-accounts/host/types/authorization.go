@@ -0,0 +1,196 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// constrainedAddressValidators maps the typeURLs of the sdk messages with a known constrained address argument
+// to the validation function of the address
+var constrainedAddressValidators = map[string]func(string) error{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}):                     validateAccAddress,
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):                validateAccAddress,
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):              validateValAddress,
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):            validateValAddress,
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):       validateValAddress,
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}): validateValAddress,
+	sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}):      validateAccAddress,
+}
+
+func validateAccAddress(address string) error {
+	_, err := sdk.AccAddressFromBech32(address)
+	return err
+}
+
+func validateValAddress(address string) error {
+	_, err := sdk.ValAddressFromBech32(address)
+	return err
+}
+
+// ConstrainedAddresses returns the addresses passed as the constrained address argument of the provided sdk message,
+// i.e. the recipients of bank sends, the validator of staking and reward withdrawal messages and the withdraw address
+// of distribution messages. False is returned if the sdk message type has no known constrained address argument.
+func ConstrainedAddresses(msg sdk.Msg) ([]string, bool) {
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		return []string{msg.ToAddress}, true
+	case *banktypes.MsgMultiSend:
+		addresses := make([]string, len(msg.Outputs))
+		for i, output := range msg.Outputs {
+			addresses[i] = output.Address
+		}
+		return addresses, true
+	case *stakingtypes.MsgDelegate:
+		return []string{msg.ValidatorAddress}, true
+	case *stakingtypes.MsgUndelegate:
+		return []string{msg.ValidatorAddress}, true
+	case *stakingtypes.MsgBeginRedelegate:
+		return []string{msg.ValidatorDstAddress}, true
+	case *distrtypes.MsgWithdrawDelegatorReward:
+		return []string{msg.ValidatorAddress}, true
+	case *distrtypes.MsgSetWithdrawAddress:
+		return []string{msg.WithdrawAddress}, true
+	default:
+		return nil, false
+	}
+}
+
+// ValidateBasic performs basic validation of the MessageAuthorization
+func (a MessageAuthorization) ValidateBasic() error {
+	if strings.TrimSpace(a.TypeUrl) == "" {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "message type URL cannot be empty")
+	}
+
+	if len(a.AllowedAddresses) == 0 {
+		return nil
+	}
+
+	validateAddress, ok := constrainedAddressValidators[a.TypeUrl]
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidAuthorization, "message type %s does not support address constraints", a.TypeUrl)
+	}
+
+	seen := make(map[string]bool)
+	for _, address := range a.AllowedAddresses {
+		if err := validateAddress(address); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAuthorization, "invalid allowed address %s for message type %s: %s", address, a.TypeUrl, err)
+		}
+
+		if seen[address] {
+			return sdkerrors.Wrapf(ErrInvalidAuthorization, "duplicate allowed address %s for message type %s", address, a.TypeUrl)
+		}
+
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// Authorizes returns true if the sdk message is of the authorized type and each of its constrained addresses
+// is allowed by the MessageAuthorization
+func (a MessageAuthorization) Authorizes(msg sdk.Msg) bool {
+	if a.TypeUrl != sdk.MsgTypeURL(msg) {
+		return false
+	}
+
+	if len(a.AllowedAddresses) == 0 {
+		return true
+	}
+
+	addresses, ok := ConstrainedAddresses(msg)
+	if !ok {
+		return false
+	}
+
+	for _, address := range addresses {
+		if !contains(a.AllowedAddresses, address) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateAuthorizations performs basic validation of the provided message authorizations. At most one
+// authorization may be defined per sdk message type.
+func ValidateAuthorizations(authorizations []MessageAuthorization) error {
+	seen := make(map[string]bool)
+	for _, authorization := range authorizations {
+		if err := authorization.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if seen[authorization.TypeUrl] {
+			return sdkerrors.Wrapf(ErrInvalidAuthorization, "duplicate authorization for message type %s", authorization.TypeUrl)
+		}
+
+		seen[authorization.TypeUrl] = true
+	}
+
+	return nil
+}
+
+// IsMsgAuthorized returns true if the sdk message is authorized by any of the provided message authorizations
+func IsMsgAuthorized(authorizations []MessageAuthorization, msg sdk.Msg) bool {
+	for _, authorization := range authorizations {
+		if authorization.Authorizes(msg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NarrowsAuthorizations returns true if the updated message authorizations authorize no sdk message which is not
+// authorized by the current message authorizations. An empty list of current authorizations authorizes every sdk
+// message, while an empty list of updated authorizations is only narrowing if the current list is empty as well.
+func NarrowsAuthorizations(current, updated []MessageAuthorization) bool {
+	if len(current) == 0 {
+		return true
+	}
+
+	if len(updated) == 0 {
+		return false
+	}
+
+	for _, authorization := range updated {
+		if !isNarrowedBy(current, authorization) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isNarrowedBy returns true if the provided message authorization authorizes a subset of the sdk messages authorized by
+// the current authorization of the same sdk message type
+func isNarrowedBy(current []MessageAuthorization, authorization MessageAuthorization) bool {
+	for _, currentAuthorization := range current {
+		if currentAuthorization.TypeUrl != authorization.TypeUrl {
+			continue
+		}
+
+		if len(currentAuthorization.AllowedAddresses) == 0 {
+			return true
+		}
+
+		if len(authorization.AllowedAddresses) == 0 {
+			return false
+		}
+
+		for _, address := range authorization.AllowedAddresses {
+			if !contains(currentAuthorization.AllowedAddresses, address) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
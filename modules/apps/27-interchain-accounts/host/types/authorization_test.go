@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+var (
+	accAddr         = sdk.AccAddress([]byte("interchain-account"))
+	recipientAddr   = sdk.AccAddress([]byte("recipient"))
+	accAddress      = accAddr.String()
+	recipient       = recipientAddr.String()
+	validator       = sdk.ValAddress([]byte("validator")).String()
+	otherValidator  = sdk.ValAddress([]byte("other-validator")).String()
+	msgSendTypeURL  = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	delegateTypeURL = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+)
+
+func TestMessageAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authorization types.MessageAuthorization
+		expPass       bool
+	}{
+		{"success: unconstrained", types.MessageAuthorization{TypeUrl: msgSendTypeURL}, true},
+		{"success: unconstrained message type without constrained address", types.MessageAuthorization{TypeUrl: sdk.MsgTypeURL(&govtypes.MsgVote{})}, true},
+		{"success: allowed recipients", types.MessageAuthorization{TypeUrl: msgSendTypeURL, AllowedAddresses: []string{recipient}}, true},
+		{"success: allowed validators", types.MessageAuthorization{TypeUrl: delegateTypeURL, AllowedAddresses: []string{validator, otherValidator}}, true},
+		{"empty type URL", types.MessageAuthorization{TypeUrl: " "}, false},
+		{"message type without constrained address", types.MessageAuthorization{TypeUrl: sdk.MsgTypeURL(&govtypes.MsgVote{}), AllowedAddresses: []string{recipient}}, false},
+		{"account address allowed for validator constraint", types.MessageAuthorization{TypeUrl: delegateTypeURL, AllowedAddresses: []string{recipient}}, false},
+		{"validator address allowed for recipient constraint", types.MessageAuthorization{TypeUrl: msgSendTypeURL, AllowedAddresses: []string{validator}}, false},
+		{"duplicate allowed address", types.MessageAuthorization{TypeUrl: msgSendTypeURL, AllowedAddresses: []string{recipient, recipient}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidAuthorization)
+			}
+		})
+	}
+}
+
+func TestValidateAuthorizations(t *testing.T) {
+	require.NoError(t, types.ValidateAuthorizations(nil))
+	require.NoError(t, types.ValidateAuthorizations([]types.MessageAuthorization{{TypeUrl: msgSendTypeURL}, {TypeUrl: delegateTypeURL}}))
+
+	err := types.ValidateAuthorizations([]types.MessageAuthorization{{TypeUrl: msgSendTypeURL}, {TypeUrl: msgSendTypeURL, AllowedAddresses: []string{recipient}}})
+	require.ErrorIs(t, err, types.ErrInvalidAuthorization)
+
+	err = types.ValidateAuthorizations([]types.MessageAuthorization{{TypeUrl: ""}})
+	require.ErrorIs(t, err, types.ErrInvalidAuthorization)
+}
+
+func TestIsMsgAuthorized(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	authorizations := []types.MessageAuthorization{
+		{TypeUrl: msgSendTypeURL, AllowedAddresses: []string{recipient}},
+		{TypeUrl: delegateTypeURL, AllowedAddresses: []string{validator}},
+		{TypeUrl: sdk.MsgTypeURL(&govtypes.MsgVote{})},
+	}
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{"send to allowed recipient", banktypes.NewMsgSend(accAddr, recipientAddr, coins), true},
+		{"delegate to allowed validator", &stakingtypes.MsgDelegate{DelegatorAddress: accAddress, ValidatorAddress: validator}, true},
+		{"unconstrained message type", &govtypes.MsgVote{Voter: accAddress, ProposalId: 1}, true},
+		{"send to other recipient", banktypes.NewMsgSend(accAddr, sdk.AccAddress([]byte("other-recipient")), coins), false},
+		{"delegate to other validator", &stakingtypes.MsgDelegate{DelegatorAddress: accAddress, ValidatorAddress: otherValidator}, false},
+		{"multi send with unauthorized output", banktypes.NewMsgMultiSend(
+			[]banktypes.Input{banktypes.NewInput(accAddr, coins.Add(coins...))},
+			[]banktypes.Output{banktypes.NewOutput(recipientAddr, coins), banktypes.NewOutput(sdk.AccAddress([]byte("other-recipient")), coins)},
+		), false},
+		{"message type not authorized", &stakingtypes.MsgUndelegate{DelegatorAddress: accAddress, ValidatorAddress: validator}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPass, types.IsMsgAuthorized(authorizations, tc.msg))
+		})
+	}
+}
+
+func TestNarrowsAuthorizations(t *testing.T) {
+	current := []types.MessageAuthorization{
+		{TypeUrl: msgSendTypeURL},
+		{TypeUrl: delegateTypeURL, AllowedAddresses: []string{validator, otherValidator}},
+	}
+
+	testCases := []struct {
+		name      string
+		current   []types.MessageAuthorization
+		updated   []types.MessageAuthorization
+		expNarrow bool
+	}{
+		{"unrestricted account restricted", nil, []types.MessageAuthorization{{TypeUrl: msgSendTypeURL}}, true},
+		{"unrestricted account remains unrestricted", nil, nil, true},
+		{"unchanged", current, current, true},
+		{"message type removed", current, []types.MessageAuthorization{{TypeUrl: msgSendTypeURL}}, true},
+		{"address constraint added", current, []types.MessageAuthorization{{TypeUrl: msgSendTypeURL, AllowedAddresses: []string{recipient}}}, true},
+		{"allowed address removed", current, []types.MessageAuthorization{{TypeUrl: delegateTypeURL, AllowedAddresses: []string{validator}}}, true},
+		{"authorizations removed", current, nil, false},
+		{"message type added", current, append(current, types.MessageAuthorization{TypeUrl: sdk.MsgTypeURL(&govtypes.MsgVote{})}), false},
+		{"address constraint removed", current, []types.MessageAuthorization{{TypeUrl: delegateTypeURL}}, false},
+		{"allowed address added", current, []types.MessageAuthorization{{TypeUrl: delegateTypeURL, AllowedAddresses: []string{validator, sdk.ValAddress([]byte("new-validator")).String()}}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expNarrow, types.NarrowsAuthorizations(tc.current, tc.updated))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+const (
+	// VersionFormatLegacy defines the channel version format <app-version>.<account-address>
+	VersionFormatLegacy = "legacy"
+
+	// VersionFormatICAMetadata defines the channel version format of JSON encoded ICAMetadata
+	VersionFormatICAMetadata = "ics27_metadata"
+)
+
+// SupportedVersionFormats defines the channel version formats which the host submodule is able to negotiate
+var SupportedVersionFormats = []string{VersionFormatLegacy, VersionFormatICAMetadata}
+
+// SupportsVersion returns true if the provided ICS27 protocol version is supported by the host chain
+func (hc HostCapabilities) SupportsVersion(version string) bool {
+	return contains(hc.Versions, version)
+}
+
+// SupportsVersionFormat returns true if the provided channel version format is supported by the host chain
+func (hc HostCapabilities) SupportsVersionFormat(format string) bool {
+	return contains(hc.VersionFormats, format)
+}
+
+// SupportsEncoding returns true if the provided encoding format is supported by the host chain
+func (hc HostCapabilities) SupportsEncoding(encoding string) bool {
+	return contains(hc.Encodings, encoding)
+}
+
+// SupportsTxType returns true if the provided transaction type is supported by the host chain
+func (hc HostCapabilities) SupportsTxType(txType string) bool {
+	return contains(hc.TxTypes, txType)
+}
+
+// SupportsPacketType returns true if the packet data type with the provided name is supported by the host chain
+func (hc HostCapabilities) SupportsPacketType(packetType string) bool {
+	return contains(hc.PacketTypes, packetType)
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -6,5 +6,16 @@ import (
 
 // ICA Host sentinel errors
 var (
-	ErrHostSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrHostSubModuleDisabled   = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrMsgExecutionPanic       = sdkerrors.Register(SubModuleName, 4, "panic during interchain account message execution")
+	ErrUnauthorizedSigner      = sdkerrors.Register(SubModuleName, 5, "message requires a signer the interchain account cannot provide")
+	ErrAccountCreationDisabled = sdkerrors.Register(SubModuleName, 6, "interchain account creation is disabled")
+	ErrInvalidAuthorization    = sdkerrors.Register(SubModuleName, 7, "invalid interchain account message authorization")
+	ErrStoreMigrationFailed    = sdkerrors.Register(SubModuleName, 8, "interchain account store migration failed")
+	ErrQueryNotAllowed         = sdkerrors.Register(SubModuleName, 9, "query is not allowed")
+	ErrQueryResponseTooLarge   = sdkerrors.Register(SubModuleName, 10, "query response exceeds the maximum length")
+	ErrInvalidGasBudget        = sdkerrors.Register(SubModuleName, 11, "invalid gas budget")
+	ErrGasBudgetExceeded       = sdkerrors.Register(SubModuleName, 12, "interchain account transaction exceeded the gas budget")
+	ErrEncryptedMemoRejected   = sdkerrors.Register(SubModuleName, 13, "encrypted memo rejected")
+	ErrAccountReuseDisabled    = sdkerrors.Register(SubModuleName, 14, "interchain account reuse is disabled")
 )
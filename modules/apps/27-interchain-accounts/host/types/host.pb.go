@@ -29,8 +29,45 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// host_enabled enables or disables the host submodule.
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
-	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain. Entries ending with
+	// "*" allow every sdk message typeURL with the preceding prefix.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// allow_account_reuse enables or disables the reuse of existing interchain accounts by additional controller ports.
+	// An interchain account is only reused by a controller port it has been explicitly linked to on a given connection
+	// through governance, see LinkInterchainAccountProposal. Transactions sent over the channels controlling a reused
+	// account are executed in the order in which their packets are delivered to the host chain.
+	AllowAccountReuse bool `protobuf:"varint,3,opt,name=allow_account_reuse,json=allowAccountReuse,proto3" json:"allow_account_reuse,omitempty" yaml:"allow_account_reuse"`
+	// query_only_messages defines the subset of allow_messages which do not mutate state on the host chain.
+	// Read-only interchain accounts may only execute the sdk message typeURLs present in both lists.
+	QueryOnlyMessages []string `protobuf:"bytes,4,rep,name=query_only_messages,json=queryOnlyMessages,proto3" json:"query_only_messages,omitempty" yaml:"query_only_messages"`
+	// allow_account_creation enables or disables the creation of new interchain accounts during the channel handshake.
+	// If disabled, a channel may only be opened by a controller port for which an interchain account has been
+	// pre-provisioned on the host chain, or which has been linked to an existing interchain account.
+	AllowAccountCreation bool `protobuf:"varint,5,opt,name=allow_account_creation,json=allowAccountCreation,proto3" json:"allow_account_creation,omitempty" yaml:"allow_account_creation"`
+	// idempotency_key_retention defines the number of blocks for which the idempotency keys of successfully executed
+	// interchain account transactions are retained. Packets carrying a retained idempotency key are not executed again
+	// and are acknowledged with the result of the original execution. Idempotency keys are ignored if set to 0.
+	IdempotencyKeyRetention uint64 `protobuf:"varint,6,opt,name=idempotency_key_retention,json=idempotencyKeyRetention,proto3" json:"idempotency_key_retention,omitempty" yaml:"idempotency_key_retention"`
+	// strict_decoding enables or disables the rejection of protobuf encoded interchain account transactions carrying
+	// fields unknown to the host chain, including within the contained sdk messages. Packets carrying unknown fields are
+	// acknowledged with an error. JSON encoded packet data and transactions always reject unknown fields.
+	// NOTE: enabling strict decoding trades forward compatibility for safety. Controllers built against newer versions
+	// of an sdk message which set fields the host chain does not know of will have their transactions rejected instead
+	// of executed without the unknown fields.
+	StrictDecoding bool `protobuf:"varint,7,opt,name=strict_decoding,json=strictDecoding,proto3" json:"strict_decoding,omitempty" yaml:"strict_decoding"`
+	// allow_queries defines a list of fully qualified gRPC query method names which packets may request to be executed
+	// after their transaction. Entries ending with "*" allow every method name with the preceding prefix. Queries are
+	// disabled if empty.
+	// NOTE: queries are executed as part of the state machine, only queries which are deterministic and bounded in
+	// their gas consumption should be allowed, e.g. "/cosmos.bank.v1beta1.Query/Balance".
+	AllowQueries []string `protobuf:"bytes,8,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// min_gas_budget defines the minimum gas budget which may be negotiated for a channel. Channels without a gas budget
+	// are not subject to the minimum.
+	MinGasBudget uint64 `protobuf:"varint,9,opt,name=min_gas_budget,json=minGasBudget,proto3" json:"min_gas_budget,omitempty" yaml:"min_gas_budget"`
+	// max_gas_budget defines the maximum gas budget which may be negotiated for a channel. Channels negotiated while
+	// the maximum is set must have a gas budget, the maximum is assigned if the controller chain did not propose one.
+	// Gas budgets are not bounded if set to 0.
+	MaxGasBudget uint64 `protobuf:"varint,10,opt,name=max_gas_budget,json=maxGasBudget,proto3" json:"max_gas_budget,omitempty" yaml:"max_gas_budget"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,8 +117,449 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetAllowAccountReuse() bool {
+	if m != nil {
+		return m.AllowAccountReuse
+	}
+	return false
+}
+
+func (m *Params) GetQueryOnlyMessages() []string {
+	if m != nil {
+		return m.QueryOnlyMessages
+	}
+	return nil
+}
+
+func (m *Params) GetAllowAccountCreation() bool {
+	if m != nil {
+		return m.AllowAccountCreation
+	}
+	return false
+}
+
+func (m *Params) GetIdempotencyKeyRetention() uint64 {
+	if m != nil {
+		return m.IdempotencyKeyRetention
+	}
+	return 0
+}
+
+func (m *Params) GetStrictDecoding() bool {
+	if m != nil {
+		return m.StrictDecoding
+	}
+	return false
+}
+
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *Params) GetMinGasBudget() uint64 {
+	if m != nil {
+		return m.MinGasBudget
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasBudget() uint64 {
+	if m != nil {
+		return m.MaxGasBudget
+	}
+	return 0
+}
+
+// IdempotentExecution records the successful execution of an interchain account transaction carrying an idempotency
+// key.
+type IdempotentExecution struct {
+	// result included in the successful acknowledgement of the original execution
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// block height at which the transaction was executed
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *IdempotentExecution) Reset()         { *m = IdempotentExecution{} }
+func (m *IdempotentExecution) String() string { return proto.CompactTextString(m) }
+func (*IdempotentExecution) ProtoMessage()    {}
+func (*IdempotentExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *IdempotentExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdempotentExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdempotentExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdempotentExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdempotentExecution.Merge(m, src)
+}
+func (m *IdempotentExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdempotentExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdempotentExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdempotentExecution proto.InternalMessageInfo
+
+func (m *IdempotentExecution) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *IdempotentExecution) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// HostCapabilities describes the interchain accounts features supported by a host chain. Controller chains may use
+// the host capabilities to select a compatible channel version prior to registering an interchain account.
+type HostCapabilities struct {
+	// host_enabled is true if the host submodule is enabled.
+	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
+	// versions defines the supported ICS27 protocol versions.
+	Versions []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	// version_formats defines the supported channel version formats. The "legacy" format defines channel versions
+	// in the format <app-version>.<account-address>. The "ics27_metadata" format defines channel versions encoded as
+	// JSON ICAMetadata, containing the version, controller_connection_id, host_connection_id, address, encoding and
+	// tx_type fields.
+	VersionFormats []string `protobuf:"bytes,3,rep,name=version_formats,json=versionFormats,proto3" json:"version_formats,omitempty" yaml:"version_formats"`
+	// encodings defines the supported encoding formats of interchain account transactions. Channels using the legacy
+	// version format always use the proto3 encoding format.
+	Encodings []string `protobuf:"bytes,4,rep,name=encodings,proto3" json:"encodings,omitempty"`
+	// tx_types defines the supported transaction types of interchain accounts. Channels using the legacy version
+	// format always use the sdk_multi_msg transaction type.
+	TxTypes []string `protobuf:"bytes,5,rep,name=tx_types,json=txTypes,proto3" json:"tx_types,omitempty" yaml:"tx_types"`
+	// packet_types defines the names of the supported interchain account packet data types, e.g. TYPE_EXECUTE_TX.
+	PacketTypes []string `protobuf:"bytes,6,rep,name=packet_types,json=packetTypes,proto3" json:"packet_types,omitempty" yaml:"packet_types"`
+	// allow_account_creation is true if new interchain accounts may be created during the channel handshake.
+	AllowAccountCreation bool `protobuf:"varint,7,opt,name=allow_account_creation,json=allowAccountCreation,proto3" json:"allow_account_creation,omitempty" yaml:"allow_account_creation"`
+	// compressions defines the supported compression formats of interchain account transactions. Channels using the
+	// legacy version format never compress interchain account transactions.
+	Compressions []string `protobuf:"bytes,8,rep,name=compressions,proto3" json:"compressions,omitempty"`
+}
+
+func (m *HostCapabilities) Reset()         { *m = HostCapabilities{} }
+func (m *HostCapabilities) String() string { return proto.CompactTextString(m) }
+func (*HostCapabilities) ProtoMessage()    {}
+func (*HostCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *HostCapabilities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostCapabilities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostCapabilities.Merge(m, src)
+}
+func (m *HostCapabilities) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostCapabilities proto.InternalMessageInfo
+
+func (m *HostCapabilities) GetHostEnabled() bool {
+	if m != nil {
+		return m.HostEnabled
+	}
+	return false
+}
+
+func (m *HostCapabilities) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *HostCapabilities) GetVersionFormats() []string {
+	if m != nil {
+		return m.VersionFormats
+	}
+	return nil
+}
+
+func (m *HostCapabilities) GetEncodings() []string {
+	if m != nil {
+		return m.Encodings
+	}
+	return nil
+}
+
+func (m *HostCapabilities) GetTxTypes() []string {
+	if m != nil {
+		return m.TxTypes
+	}
+	return nil
+}
+
+func (m *HostCapabilities) GetPacketTypes() []string {
+	if m != nil {
+		return m.PacketTypes
+	}
+	return nil
+}
+
+func (m *HostCapabilities) GetAllowAccountCreation() bool {
+	if m != nil {
+		return m.AllowAccountCreation
+	}
+	return false
+}
+
+func (m *HostCapabilities) GetCompressions() []string {
+	if m != nil {
+		return m.Compressions
+	}
+	return nil
+}
+
+// MessageAuthorization authorizes an interchain account to execute sdk messages of a given type. The authorization
+// may constrain the address passed as the recipient, validator or withdraw address argument of the message.
+type MessageAuthorization struct {
+	// type_url of the authorized sdk message.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	// allowed_addresses defines the addresses which may be passed as the constrained address argument of the sdk
+	// message, e.g. the recipient of a bank send or the validator of a delegation. Every address is allowed if empty.
+	// Address constraints may only be defined for sdk message types with a known constrained address argument.
+	AllowedAddresses []string `protobuf:"bytes,2,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty" yaml:"allowed_addresses"`
+}
+
+func (m *MessageAuthorization) Reset()         { *m = MessageAuthorization{} }
+func (m *MessageAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MessageAuthorization) ProtoMessage()    {}
+func (*MessageAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{3}
+}
+func (m *MessageAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageAuthorization.Merge(m, src)
+}
+func (m *MessageAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageAuthorization proto.InternalMessageInfo
+
+func (m *MessageAuthorization) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MessageAuthorization) GetAllowedAddresses() []string {
+	if m != nil {
+		return m.AllowedAddresses
+	}
+	return nil
+}
+
+// AccountAuthorizations contains a pairing of an interchain account address and the message authorizations
+// restricting the sdk messages it may execute.
+type AccountAuthorizations struct {
+	Address        string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Authorizations []MessageAuthorization `protobuf:"bytes,2,rep,name=authorizations,proto3" json:"authorizations"`
+}
+
+func (m *AccountAuthorizations) Reset()         { *m = AccountAuthorizations{} }
+func (m *AccountAuthorizations) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthorizations) ProtoMessage()    {}
+func (*AccountAuthorizations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{4}
+}
+func (m *AccountAuthorizations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthorizations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthorizations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthorizations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthorizations.Merge(m, src)
+}
+func (m *AccountAuthorizations) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthorizations) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthorizations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthorizations proto.InternalMessageInfo
+
+func (m *AccountAuthorizations) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountAuthorizations) GetAuthorizations() []MessageAuthorization {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+// LinkInterchainAccountProposal is a governance proposal linking an existing interchain account to a controller port
+// on a host connection. A channel opened by the controller port over the connection controls the linked interchain
+// account rather than a newly created one. Linking requires account reuse to be enabled.
+type LinkInterchainAccountProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// host connection identifier over which the controller port opens its channel
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// controller port identifier to be linked to the interchain account
+	PortId string `protobuf:"bytes,4,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// address of the existing interchain account
+	AccountAddress string `protobuf:"bytes,5,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty" yaml:"account_address"`
+}
+
+func (m *LinkInterchainAccountProposal) Reset()         { *m = LinkInterchainAccountProposal{} }
+func (m *LinkInterchainAccountProposal) String() string { return proto.CompactTextString(m) }
+func (*LinkInterchainAccountProposal) ProtoMessage()    {}
+func (*LinkInterchainAccountProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{5}
+}
+func (m *LinkInterchainAccountProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkInterchainAccountProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkInterchainAccountProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkInterchainAccountProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkInterchainAccountProposal.Merge(m, src)
+}
+func (m *LinkInterchainAccountProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkInterchainAccountProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkInterchainAccountProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkInterchainAccountProposal proto.InternalMessageInfo
+
+// ProvisionInterchainAccountProposal is a governance proposal creating the interchain account of a controller port
+// ahead of the channel handshake. Channels may be opened for the controller port even if account creation is disabled.
+type ProvisionInterchainAccountProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// controller port identifier for which the interchain account is created
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// read_only restricts the interchain account to the query only messages
+	ReadOnly bool `protobuf:"varint,4,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty" yaml:"read_only"`
+}
+
+func (m *ProvisionInterchainAccountProposal) Reset()         { *m = ProvisionInterchainAccountProposal{} }
+func (m *ProvisionInterchainAccountProposal) String() string { return proto.CompactTextString(m) }
+func (*ProvisionInterchainAccountProposal) ProtoMessage()    {}
+func (*ProvisionInterchainAccountProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{6}
+}
+func (m *ProvisionInterchainAccountProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvisionInterchainAccountProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvisionInterchainAccountProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvisionInterchainAccountProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionInterchainAccountProposal.Merge(m, src)
+}
+func (m *ProvisionInterchainAccountProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvisionInterchainAccountProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionInterchainAccountProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvisionInterchainAccountProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*IdempotentExecution)(nil), "ibc.applications.interchain_accounts.host.v1.IdempotentExecution")
+	proto.RegisterType((*HostCapabilities)(nil), "ibc.applications.interchain_accounts.host.v1.HostCapabilities")
+	proto.RegisterType((*MessageAuthorization)(nil), "ibc.applications.interchain_accounts.host.v1.MessageAuthorization")
+	proto.RegisterType((*AccountAuthorizations)(nil), "ibc.applications.interchain_accounts.host.v1.AccountAuthorizations")
+	proto.RegisterType((*LinkInterchainAccountProposal)(nil), "ibc.applications.interchain_accounts.host.v1.LinkInterchainAccountProposal")
+	proto.RegisterType((*ProvisionInterchainAccountProposal)(nil), "ibc.applications.interchain_accounts.host.v1.ProvisionInterchainAccountProposal")
 }
 
 func init() {
@@ -89,27 +567,70 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x6a, 0x32, 0x41,
-	0x14, 0xc5, 0xdd, 0xef, 0x03, 0x49, 0x36, 0x7f, 0x0a, 0x93, 0x10, 0xb5, 0x58, 0x65, 0x2b, 0x8b,
-	0xb8, 0x17, 0x63, 0x21, 0x58, 0x05, 0x21, 0x4d, 0x20, 0x10, 0x2c, 0xd3, 0xc8, 0xcc, 0x38, 0x19,
-	0x07, 0x66, 0xe7, 0x2e, 0xde, 0x59, 0x83, 0x2f, 0x90, 0x3a, 0x8f, 0x95, 0xd2, 0x32, 0x95, 0x04,
-	0x7d, 0x03, 0x9f, 0x20, 0xec, 0x6c, 0x20, 0x0a, 0xa9, 0xe6, 0x9e, 0x73, 0xf8, 0x1d, 0x98, 0x13,
-	0x0e, 0x34, 0x17, 0xc0, 0xb2, 0xcc, 0x68, 0xc1, 0x9c, 0x46, 0x4b, 0xa0, 0xad, 0x93, 0x73, 0x31,
-	0x63, 0xda, 0x4e, 0x98, 0x10, 0x98, 0x5b, 0x47, 0x30, 0x43, 0x72, 0xb0, 0xe8, 0xf9, 0x37, 0xc9,
-	0xe6, 0xe8, 0xb0, 0x76, 0xa3, 0xb9, 0x48, 0xf6, 0xc1, 0xe4, 0x0f, 0x30, 0xf1, 0xc0, 0xa2, 0xd7,
-	0x6c, 0x28, 0x44, 0x65, 0x24, 0x78, 0x96, 0xe7, 0x2f, 0xc0, 0xec, 0xb2, 0x2c, 0x6a, 0x5e, 0x2a,
-	0x54, 0xe8, 0x4f, 0x28, 0xae, 0xd2, 0x8d, 0xdf, 0x82, 0xb0, 0xfa, 0xc4, 0xe6, 0x2c, 0xa5, 0xda,
-	0x30, 0x3c, 0x2d, 0x6a, 0x26, 0xd2, 0x32, 0x6e, 0xe4, 0xb4, 0x1e, 0xb4, 0x83, 0xce, 0xd1, 0xe8,
-	0x7a, 0xb7, 0x6e, 0x5d, 0x2c, 0x59, 0x6a, 0x86, 0xf1, 0x7e, 0x1a, 0x8f, 0x4f, 0x0a, 0x79, 0x5f,
-	0xaa, 0xda, 0x5d, 0x78, 0xce, 0x8c, 0xc1, 0xd7, 0x49, 0x2a, 0x89, 0x98, 0x92, 0x54, 0xff, 0xd7,
-	0xfe, 0xdf, 0x39, 0x1e, 0x35, 0x76, 0xeb, 0xd6, 0x55, 0x49, 0x1f, 0xe6, 0xf1, 0xf8, 0xcc, 0x1b,
-	0x8f, 0x3f, 0x7a, 0x34, 0xfd, 0xd8, 0x44, 0xc1, 0x6a, 0x13, 0x05, 0x5f, 0x9b, 0x28, 0x78, 0xdf,
-	0x46, 0x95, 0xd5, 0x36, 0xaa, 0x7c, 0x6e, 0xa3, 0xca, 0xf3, 0x83, 0xd2, 0x6e, 0x96, 0xf3, 0x44,
-	0x60, 0x0a, 0x02, 0x29, 0x45, 0x02, 0xcd, 0x45, 0x57, 0x21, 0x2c, 0xfa, 0x90, 0xe2, 0x34, 0x37,
-	0x92, 0x8a, 0x69, 0x09, 0x6e, 0x07, 0xdd, 0xdf, 0x71, 0xba, 0x87, 0xab, 0xba, 0x65, 0x26, 0x89,
-	0x57, 0xfd, 0xaf, 0xfb, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x1e, 0x18, 0xa5, 0x8f, 0x01,
-	0x00, 0x00,
+	// 1000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xce, 0xe6, 0x63, 0xb3, 0x99, 0x2c, 0xdb, 0xd4, 0xd9, 0xa6, 0x4e, 0xd4, 0xae, 0x97, 0x11,
+	0x87, 0x48, 0x90, 0xb5, 0xd2, 0x1e, 0x2a, 0x55, 0x42, 0x90, 0x0d, 0x01, 0xc2, 0x67, 0x18, 0x81,
+	0x90, 0xb8, 0x98, 0x59, 0x7b, 0xea, 0x1d, 0xc5, 0xf6, 0x18, 0xcf, 0x38, 0xac, 0xf9, 0x05, 0x1c,
+	0xe1, 0x37, 0xf0, 0x1f, 0x38, 0xf1, 0x03, 0x7a, 0xec, 0x91, 0x93, 0x85, 0x92, 0x03, 0x77, 0xff,
+	0x02, 0x34, 0x1f, 0xbb, 0xb1, 0x43, 0x2a, 0x84, 0xda, 0x93, 0xe7, 0x7d, 0xde, 0xf7, 0x7d, 0xfc,
+	0x7e, 0x8e, 0x0d, 0x9e, 0xd0, 0x89, 0xef, 0xe2, 0x34, 0x8d, 0xa8, 0x8f, 0x05, 0x65, 0x09, 0x77,
+	0x69, 0x22, 0x48, 0xe6, 0x4f, 0x31, 0x4d, 0x3c, 0xec, 0xfb, 0x2c, 0x4f, 0x04, 0x77, 0xa7, 0x8c,
+	0x0b, 0xf7, 0xe2, 0x50, 0x3d, 0x47, 0x69, 0xc6, 0x04, 0xb3, 0xde, 0xa1, 0x13, 0x7f, 0x54, 0x77,
+	0x1c, 0xdd, 0xe2, 0x38, 0x52, 0x0e, 0x17, 0x87, 0x7b, 0xbb, 0x21, 0x63, 0x61, 0x44, 0x5c, 0xe5,
+	0x3b, 0xc9, 0x9f, 0xb9, 0x38, 0x29, 0x34, 0xd1, 0x5e, 0x3f, 0x64, 0x21, 0x53, 0x47, 0x57, 0x9e,
+	0x34, 0x0a, 0xff, 0x5e, 0x03, 0xed, 0x33, 0x9c, 0xe1, 0x98, 0x5b, 0x4f, 0x41, 0x57, 0xd2, 0x78,
+	0x24, 0xc1, 0x93, 0x88, 0x04, 0x76, 0x6b, 0xd8, 0xda, 0xef, 0x8c, 0xef, 0x57, 0xa5, 0xb3, 0x5d,
+	0xe0, 0x38, 0x7a, 0x0a, 0xeb, 0x5a, 0x88, 0x36, 0xa5, 0x78, 0xa2, 0x25, 0xeb, 0x7d, 0xd0, 0xc3,
+	0x51, 0xc4, 0x7e, 0xf4, 0x62, 0xc2, 0x39, 0x0e, 0x09, 0xb7, 0x97, 0x87, 0x2b, 0xfb, 0x1b, 0xe3,
+	0xdd, 0xaa, 0x74, 0xee, 0x69, 0xef, 0xa6, 0x1e, 0xa2, 0x37, 0x14, 0xf0, 0xb9, 0x91, 0xad, 0x2f,
+	0xc0, 0xb6, 0xb6, 0x30, 0x39, 0x79, 0x19, 0xc9, 0x39, 0xb1, 0x57, 0x54, 0x10, 0x83, 0xaa, 0x74,
+	0xf6, 0xea, 0x34, 0x0d, 0x23, 0x88, 0xee, 0x2a, 0xf4, 0x48, 0x83, 0x48, 0x62, 0x92, 0xef, 0x87,
+	0x9c, 0x64, 0x85, 0xc7, 0x92, 0xa8, 0xb8, 0x0e, 0x6b, 0x55, 0x85, 0x55, 0xe3, 0xbb, 0xc5, 0x08,
+	0xa2, 0xbb, 0x0a, 0xfd, 0x32, 0x89, 0x8a, 0x45, 0x7c, 0xdf, 0x82, 0x9d, 0xe6, 0xab, 0xfd, 0x8c,
+	0xa8, 0x86, 0xd8, 0x6b, 0x2a, 0xc4, 0x37, 0xab, 0xd2, 0x79, 0x78, 0x5b, 0x88, 0x73, 0x3b, 0x88,
+	0xfa, 0xf5, 0x28, 0x8f, 0x0d, 0x6c, 0x7d, 0x0f, 0x76, 0x69, 0x40, 0xe2, 0x94, 0x09, 0x92, 0xf8,
+	0x85, 0x77, 0x4e, 0x0a, 0x2f, 0x23, 0x82, 0x24, 0x8a, 0xbb, 0x3d, 0x6c, 0xed, 0xaf, 0x8e, 0xdf,
+	0xaa, 0x4a, 0x67, 0xa8, 0xb9, 0x5f, 0x6a, 0x0a, 0xd1, 0xfd, 0x9a, 0xee, 0x53, 0x52, 0xa0, 0xb9,
+	0xc6, 0x3a, 0x06, 0x77, 0xb8, 0xc8, 0xa8, 0x2f, 0xbc, 0x80, 0xf8, 0x2c, 0xa0, 0x49, 0x68, 0xaf,
+	0xab, 0x98, 0xf7, 0xaa, 0xd2, 0xd9, 0xd1, 0xbc, 0x37, 0x0c, 0x20, 0xea, 0x69, 0xe4, 0x03, 0x03,
+	0x58, 0xef, 0x02, 0xdd, 0x30, 0x4f, 0x96, 0x86, 0x12, 0x6e, 0x77, 0x54, 0x25, 0xed, 0xaa, 0x74,
+	0xfa, 0xf5, 0xb4, 0x8d, 0x1a, 0xa2, 0xae, 0x92, 0xbf, 0xd2, 0xa2, 0xf5, 0x1e, 0xe8, 0xc5, 0x34,
+	0xf1, 0x42, 0xcc, 0xbd, 0x49, 0x1e, 0x84, 0x44, 0xd8, 0x1b, 0x2a, 0xb5, 0xda, 0x80, 0x34, 0xf5,
+	0x10, 0x75, 0x63, 0x9a, 0x7c, 0x84, 0xf9, 0x58, 0x89, 0x8a, 0x00, 0xcf, 0xea, 0x04, 0xe0, 0x5f,
+	0x04, 0x78, 0x76, 0x83, 0x00, 0xcf, 0x16, 0x04, 0xf0, 0x04, 0x6c, 0x9f, 0xce, 0x0b, 0x24, 0x4e,
+	0x66, 0xc4, 0xcf, 0x55, 0x71, 0x76, 0x40, 0x3b, 0x23, 0x3c, 0x8f, 0x84, 0x9a, 0xf7, 0x2e, 0x32,
+	0x92, 0xc4, 0xa7, 0x84, 0x86, 0x53, 0x61, 0x2f, 0xcb, 0xf7, 0x20, 0x23, 0xc1, 0xdf, 0x57, 0xc0,
+	0xd6, 0xc7, 0x8c, 0x8b, 0x63, 0x9c, 0xe2, 0x09, 0x8d, 0xa8, 0xa0, 0xe4, 0xd5, 0x56, 0x67, 0x0f,
+	0x74, 0x2e, 0x48, 0xc6, 0xe5, 0x6a, 0xeb, 0xa5, 0x41, 0x0b, 0x59, 0x76, 0xce, 0x9c, 0xbd, 0x67,
+	0x2c, 0x8b, 0xb1, 0xe0, 0xf6, 0x8a, 0x2a, 0x7b, 0xad, 0x73, 0x37, 0x0c, 0x20, 0xea, 0x19, 0xe4,
+	0x43, 0x0d, 0x58, 0x0f, 0xc0, 0x06, 0x49, 0x74, 0x17, 0xcd, 0xfc, 0xa3, 0x6b, 0xc0, 0x1a, 0x81,
+	0x8e, 0x98, 0x79, 0xa2, 0x48, 0x09, 0xb7, 0xd7, 0x14, 0xf7, 0x76, 0x55, 0x3a, 0x77, 0x34, 0xf7,
+	0x5c, 0x03, 0xd1, 0xba, 0x98, 0x7d, 0x5d, 0xa4, 0x3a, 0xd5, 0x14, 0xfb, 0xe7, 0x44, 0x18, 0x9f,
+	0xb6, 0xf2, 0xa9, 0xa5, 0x5a, 0xd7, 0x42, 0xb4, 0xa9, 0x45, 0xed, 0xfb, 0xf2, 0x1d, 0x5a, 0x7f,
+	0xb5, 0x1d, 0x82, 0xa0, 0xeb, 0xb3, 0x38, 0xcd, 0x08, 0xd7, 0x75, 0x54, 0xb3, 0x89, 0x1a, 0x18,
+	0xfc, 0xb5, 0x05, 0xfa, 0x66, 0x9b, 0x8f, 0x72, 0x31, 0x65, 0x19, 0xfd, 0x49, 0x3b, 0xcb, 0x0a,
+	0x14, 0x29, 0xf1, 0xf2, 0x2c, 0x52, 0x8d, 0x6b, 0x56, 0xc0, 0x68, 0x64, 0x05, 0x8a, 0x94, 0x7c,
+	0x93, 0x45, 0xd6, 0x29, 0xd0, 0xd7, 0x0d, 0x09, 0x3c, 0x1c, 0x04, 0x92, 0x7f, 0x71, 0xdd, 0x3d,
+	0xa8, 0x4a, 0xc7, 0xae, 0x25, 0x50, 0x37, 0x81, 0x68, 0xcb, 0x60, 0x47, 0x0b, 0xe8, 0xb7, 0x16,
+	0xb8, 0x67, 0x72, 0x69, 0xc4, 0xc4, 0x2d, 0x1b, 0xac, 0x1b, 0x4f, 0x1d, 0x13, 0x9a, 0x8b, 0x56,
+	0x0a, 0x7a, 0xb8, 0x61, 0xab, 0xde, 0xbd, 0xf9, 0x68, 0x3c, 0xfa, 0x3f, 0x5f, 0x8a, 0xd1, 0x6d,
+	0xa5, 0x18, 0xaf, 0x3e, 0x2f, 0x9d, 0x25, 0x74, 0x83, 0x1f, 0xfe, 0xbc, 0x0c, 0x1e, 0x7e, 0x46,
+	0x93, 0xf3, 0xd3, 0x05, 0x9d, 0x89, 0xf9, 0x2c, 0x63, 0x29, 0xe3, 0x38, 0xb2, 0xfa, 0x60, 0x4d,
+	0x50, 0x11, 0x11, 0x13, 0xab, 0x16, 0xac, 0x21, 0xd8, 0x0c, 0x08, 0xf7, 0x33, 0x9a, 0xaa, 0x1e,
+	0x2f, 0x2b, 0x5d, 0x1d, 0x92, 0x97, 0x8a, 0xcf, 0x92, 0x84, 0xf8, 0x52, 0xf2, 0x68, 0xa0, 0xae,
+	0xfb, 0xc6, 0xa5, 0xd2, 0x50, 0x43, 0xd4, 0xbd, 0x96, 0x4f, 0x03, 0xeb, 0x6d, 0xb0, 0x9e, 0xb2,
+	0x4c, 0x48, 0xc7, 0x55, 0xe5, 0x68, 0x55, 0xa5, 0xd3, 0x33, 0x63, 0xa8, 0x15, 0x10, 0xb5, 0xe5,
+	0xe9, 0x34, 0x90, 0xbb, 0x34, 0x1f, 0xa7, 0x79, 0x65, 0xd7, 0x86, 0xad, 0xe6, 0x2e, 0xdd, 0x30,
+	0x80, 0xa8, 0x67, 0x10, 0xd3, 0x32, 0xf8, 0x47, 0x0b, 0xc0, 0xb3, 0x8c, 0x5d, 0x50, 0x39, 0x53,
+	0xaf, 0xbf, 0x1e, 0xb5, 0x84, 0x56, 0xfe, 0x33, 0xa1, 0x43, 0xb0, 0x91, 0x11, 0x1c, 0xa8, 0x6f,
+	0x97, 0xca, 0xbf, 0x33, 0xee, 0x57, 0xa5, 0xb3, 0xa5, 0xcd, 0x17, 0x2a, 0x88, 0x3a, 0xf2, 0x2c,
+	0x3f, 0x66, 0xe3, 0xe0, 0xf9, 0xe5, 0xa0, 0xf5, 0xe2, 0x72, 0xd0, 0xfa, 0xeb, 0x72, 0xd0, 0xfa,
+	0xe5, 0x6a, 0xb0, 0xf4, 0xe2, 0x6a, 0xb0, 0xf4, 0xe7, 0xd5, 0x60, 0xe9, 0xbb, 0x4f, 0x42, 0x2a,
+	0xa6, 0xf9, 0x64, 0xe4, 0xb3, 0xd8, 0xf5, 0x19, 0x8f, 0x19, 0x77, 0xe9, 0xc4, 0x3f, 0x08, 0x99,
+	0x7b, 0xf1, 0xd8, 0x8d, 0x59, 0x90, 0x47, 0x84, 0xcb, 0xff, 0x17, 0xee, 0x3e, 0x7a, 0x72, 0x70,
+	0x3d, 0x57, 0x07, 0xcd, 0x5f, 0x17, 0xb5, 0xf4, 0x93, 0xb6, 0xfa, 0xb5, 0x78, 0xfc, 0xcf, 0x00,
+	0x41, 0x5a, 0x1d, 0xcd, 0xf4, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -132,64 +653,1750 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.AllowMessages) > 0 {
-		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowMessages[iNdEx])
-			copy(dAtA[i:], m.AllowMessages[iNdEx])
-			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+	if m.MaxGasBudget != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGasBudget))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinGasBudget != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MinGasBudget))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x42
 		}
 	}
-	if m.HostEnabled {
+	if m.StrictDecoding {
 		i--
-		if m.HostEnabled {
+		if m.StrictDecoding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IdempotencyKeyRetention != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.IdempotencyKeyRetention))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AllowAccountCreation {
+		i--
+		if m.AllowAccountCreation {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.QueryOnlyMessages) > 0 {
+		for iNdEx := len(m.QueryOnlyMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryOnlyMessages[iNdEx])
+			copy(dAtA[i:], m.QueryOnlyMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.QueryOnlyMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AllowAccountReuse {
+		i--
+		if m.AllowAccountReuse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdempotentExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdempotentExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdempotentExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostCapabilities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Compressions) > 0 {
+		for iNdEx := len(m.Compressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Compressions[iNdEx])
+			copy(dAtA[i:], m.Compressions[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.Compressions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.AllowAccountCreation {
+		i--
+		if m.AllowAccountCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PacketTypes) > 0 {
+		for iNdEx := len(m.PacketTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PacketTypes[iNdEx])
+			copy(dAtA[i:], m.PacketTypes[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.PacketTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TxTypes) > 0 {
+		for iNdEx := len(m.TxTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxTypes[iNdEx])
+			copy(dAtA[i:], m.TxTypes[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.TxTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Encodings) > 0 {
+		for iNdEx := len(m.Encodings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Encodings[iNdEx])
+			copy(dAtA[i:], m.Encodings[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.Encodings[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VersionFormats) > 0 {
+		for iNdEx := len(m.VersionFormats) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VersionFormats[iNdEx])
+			copy(dAtA[i:], m.VersionFormats[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.VersionFormats[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAuthorizations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthorizations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkInterchainAccountProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkInterchainAccountProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkInterchainAccountProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvisionInterchainAccountProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvisionInterchainAccountProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvisionInterchainAccountProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.AllowAccountReuse {
+		n += 2
+	}
+	if len(m.QueryOnlyMessages) > 0 {
+		for _, s := range m.QueryOnlyMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.AllowAccountCreation {
+		n += 2
+	}
+	if m.IdempotencyKeyRetention != 0 {
+		n += 1 + sovHost(uint64(m.IdempotencyKeyRetention))
+	}
+	if m.StrictDecoding {
+		n += 2
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MinGasBudget != 0 {
+		n += 1 + sovHost(uint64(m.MinGasBudget))
+	}
+	if m.MaxGasBudget != 0 {
+		n += 1 + sovHost(uint64(m.MaxGasBudget))
+	}
+	return n
+}
+
+func (m *IdempotentExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHost(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *HostCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.VersionFormats) > 0 {
+		for _, s := range m.VersionFormats {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.Encodings) > 0 {
+		for _, s := range m.Encodings {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.TxTypes) > 0 {
+		for _, s := range m.TxTypes {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.PacketTypes) > 0 {
+		for _, s := range m.PacketTypes {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.AllowAccountCreation {
+		n += 2
+	}
+	if len(m.Compressions) > 0 {
+		for _, s := range m.Compressions {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountAuthorizations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LinkInterchainAccountProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	return n
+}
+
+func (m *ProvisionInterchainAccountProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	return n
+}
+
+func sovHost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHost(x uint64) (n int) {
+	return sovHost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAccountReuse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAccountReuse = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryOnlyMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryOnlyMessages = append(m.QueryOnlyMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAccountCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAccountCreation = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKeyRetention", wireType)
+			}
+			m.IdempotencyKeyRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdempotencyKeyRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictDecoding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictDecoding = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasBudget", wireType)
+			}
+			m.MinGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasBudget", wireType)
+			}
+			m.MaxGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdempotentExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdempotentExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdempotentExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostCapabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostCapabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionFormats", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionFormats = append(m.VersionFormats, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encodings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encodings = append(m.Encodings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxTypes = append(m.TxTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketTypes = append(m.PacketTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAccountCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAccountCreation = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compressions = append(m.Compressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAuthorizations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAuthorizations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, MessageAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinkInterchainAccountProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkInterchainAccountProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkInterchainAccountProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HostEnabled {
-		n += 2
-	}
-	if len(m.AllowMessages) > 0 {
-		for _, s := range m.AllowMessages {
-			l = len(s)
-			n += 1 + l + sovHost(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovHost(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHost(x uint64) (n int) {
-	return sovHost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ProvisionInterchainAccountProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -212,17 +2419,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvisionInterchainAccountProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvisionInterchainAccountProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHost
@@ -232,15 +2439,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HostEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -268,8 +2487,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
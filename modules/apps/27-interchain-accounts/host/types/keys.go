@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,12 +13,79 @@ const (
 
 	// StoreKey is the store key string for the interchain accounts host module
 	StoreKey = SubModuleName
+
+	// RouterKey is the message route for the interchain accounts host module
+	RouterKey = SubModuleName
 )
 
-// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
+var (
+	// ConnectionAccountKeyPrefix defines the key prefix used to index interchain accounts by host connection identifier
+	ConnectionAccountKeyPrefix = "connectionAccount"
+
+	// ReadOnlyAccountKeyPrefix defines the key prefix used to store the interchain accounts registered in read-only mode
+	ReadOnlyAccountKeyPrefix = "readOnlyAccount"
+
+	// AccountAuthorizationsKeyPrefix defines the key prefix used to store the message authorizations of interchain accounts
+	AccountAuthorizationsKeyPrefix = "accountAuthorizations"
+
+	// IdempotentExecutionKeyPrefix defines the key prefix used to store the executions of interchain account transactions
+	// carrying an idempotency key
+	IdempotentExecutionKeyPrefix = "idempotentExecution"
+
+	// IdempotentExecutionHeightKeyPrefix defines the key prefix used to index the executions of interchain account
+	// transactions carrying an idempotency key by the block height at which they were executed
+	IdempotentExecutionHeightKeyPrefix = "idempotentExecutionHeight"
+)
+
+// KeyConnectionAccountPrefix creates and returns a new key prefix used to iterate the interchain accounts registered over the provided connection
+func KeyConnectionAccountPrefix(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", ConnectionAccountKeyPrefix, connectionID))
+}
+
+// KeyConnectionAccount creates and returns a new key used to index the interchain account associated with the provided connection and controller port
+func KeyConnectionAccount(connectionID, portID string) []byte {
+	return append(KeyConnectionAccountPrefix(connectionID), []byte(portID)...)
+}
+
+// KeyReadOnlyAccount creates and returns a new key used to mark the provided interchain account address as read-only
+func KeyReadOnlyAccount(accAddr string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ReadOnlyAccountKeyPrefix, accAddr))
+}
+
+// KeyAccountAuthorizations creates and returns a new key used to store the message authorizations of the provided interchain account address
+func KeyAccountAuthorizations(accAddr string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", AccountAuthorizationsKeyPrefix, accAddr))
+}
+
+// KeyIdempotentExecution creates and returns a new key used to store the execution of the transaction carrying the
+// provided idempotency key by the provided interchain account address
+func KeyIdempotentExecution(accAddr, idempotencyKey string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", IdempotentExecutionKeyPrefix, accAddr, idempotencyKey))
+}
+
+// KeyIdempotentExecutionHeightPrefix creates and returns a new key prefix used to iterate the executions of transactions
+// carrying an idempotency key at the provided block height. The height is big endian encoded such that executions are
+// iterated in ascending height order
+func KeyIdempotentExecutionHeightPrefix(height uint64) []byte {
+	return append([]byte(IdempotentExecutionHeightKeyPrefix+"/"), sdk.Uint64ToBigEndian(height)...)
+}
+
+// KeyIdempotentExecutionHeight creates and returns a new key used to index the execution of the transaction carrying the
+// provided idempotency key by the provided interchain account address at the provided block height
+func KeyIdempotentExecutionHeight(height uint64, accAddr, idempotencyKey string) []byte {
+	return append(KeyIdempotentExecutionHeightPrefix(height), []byte(fmt.Sprintf("/%s/%s", accAddr, idempotencyKey))...)
+}
+
+// ContainsMsgType returns true if the sdk.Msg TypeURL is allowed by allowMsgs, otherwise false.
+// Entries ending with the Wildcard allow every TypeURL with the preceding prefix.
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
+	typeURL := sdk.MsgTypeURL(msg)
 	for _, v := range allowMsgs {
-		if v == sdk.MsgTypeURL(msg) {
+		if v == typeURL {
+			return true
+		}
+
+		if strings.HasSuffix(v, Wildcard) && strings.HasPrefix(typeURL, strings.TrimSuffix(v, Wildcard)) {
 			return true
 		}
 	}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EncryptedMemoHandler defines the function used by the host chain to process the encrypted memo carried by an
+// interchain accounts packet prior to executing its transaction. The memo is opaque to the interchain accounts module,
+// decrypting and interpreting it is the sole responsibility of the handler. Returning an error rejects the packet,
+// its transaction is not executed and the packet is acknowledged with an error.
+type EncryptedMemoHandler func(ctx sdk.Context, interchainAccountAddr sdk.AccAddress, encryptedMemo []byte) error
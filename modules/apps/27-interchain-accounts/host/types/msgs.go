@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// msg types
+const (
+	TypeMsgSetAccountAuthorizations = "set_account_authorizations"
+)
+
+var _ sdk.Msg = &MsgSetAccountAuthorizations{}
+
+// NewMsgSetAccountAuthorizations creates a new MsgSetAccountAuthorizations instance
+func NewMsgSetAccountAuthorizations(signer string, authorizations []MessageAuthorization) *MsgSetAccountAuthorizations {
+	return &MsgSetAccountAuthorizations{
+		Signer:         signer,
+		Authorizations: authorizations,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgSetAccountAuthorizations) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSetAccountAuthorizations) Type() string {
+	return TypeMsgSetAccountAuthorizations
+}
+
+// ValidateBasic performs a basic check of the MsgSetAccountAuthorizations fields. An empty list of authorizations
+// is permitted, but is rejected by the msg server unless the interchain account is not yet restricted.
+func (msg MsgSetAccountAuthorizations) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateAuthorizations(msg.Authorizations)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSetAccountAuthorizations) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg. The interchain account is the signer.
+func (msg MsgSetAccountAuthorizations) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestMsgSetAccountAuthorizationsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetAccountAuthorizations
+		expPass bool
+	}{
+		{"success", types.NewMsgSetAccountAuthorizations(accAddress, []types.MessageAuthorization{{TypeUrl: msgSendTypeURL, AllowedAddresses: []string{recipient}}}), true},
+		{"success - empty authorizations", types.NewMsgSetAccountAuthorizations(accAddress, nil), true},
+		{"invalid signer address", types.NewMsgSetAccountAuthorizations("invalid", nil), false},
+		{"invalid authorization", types.NewMsgSetAccountAuthorizations(accAddress, []types.MessageAuthorization{{TypeUrl: ""}}), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetAccountAuthorizationsGetSigners(t *testing.T) {
+	msg := types.NewMsgSetAccountAuthorizations(accAddress, nil)
+	require.Equal(t, accAddress, msg.GetSigners()[0].String())
+}
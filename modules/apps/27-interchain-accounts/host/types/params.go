@@ -10,6 +10,18 @@ import (
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+	// DefaultAllowAccountReuse is the default value for the allow account reuse param (set to false)
+	DefaultAllowAccountReuse = false
+	// DefaultAllowAccountCreation is the default value for the allow account creation param (set to true)
+	DefaultAllowAccountCreation = true
+	// DefaultIdempotencyKeyRetention is the default value for the idempotency key retention param (set to 0, disabled)
+	DefaultIdempotencyKeyRetention uint64 = 0
+	// DefaultStrictDecoding is the default value for the strict decoding param (set to false)
+	DefaultStrictDecoding = false
+	// DefaultMinGasBudget is the default value for the min gas budget param (set to 0)
+	DefaultMinGasBudget uint64 = 0
+	// DefaultMaxGasBudget is the default value for the max gas budget param (set to 0, unbounded)
+	DefaultMaxGasBudget uint64 = 0
 )
 
 var (
@@ -17,6 +29,22 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyAllowAccountReuse is the store key for the AllowAccountReuse Params
+	KeyAllowAccountReuse = []byte("AllowAccountReuse")
+	// KeyQueryOnlyMessages is the store key for the QueryOnlyMessages Params
+	KeyQueryOnlyMessages = []byte("QueryOnlyMessages")
+	// KeyAllowAccountCreation is the store key for the AllowAccountCreation Params
+	KeyAllowAccountCreation = []byte("AllowAccountCreation")
+	// KeyIdempotencyKeyRetention is the store key for the IdempotencyKeyRetention Params
+	KeyIdempotencyKeyRetention = []byte("IdempotencyKeyRetention")
+	// KeyStrictDecoding is the store key for the StrictDecoding Params
+	KeyStrictDecoding = []byte("StrictDecoding")
+	// KeyAllowQueries is the store key for the AllowQueries Params
+	KeyAllowQueries = []byte("AllowQueries")
+	// KeyMinGasBudget is the store key for the MinGasBudget Params
+	KeyMinGasBudget = []byte("MinGasBudget")
+	// KeyMaxGasBudget is the store key for the MaxGasBudget Params
+	KeyMaxGasBudget = []byte("MaxGasBudget")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -25,16 +53,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the host submodule
-func NewParams(enableHost bool, allowMsgs []string) Params {
+func NewParams(enableHost bool, allowMsgs []string, allowAccountReuse bool, queryOnlyMsgs []string, allowAccountCreation bool, idempotencyKeyRetention uint64, strictDecoding bool, allowQueries []string, minGasBudget, maxGasBudget uint64) Params {
 	return Params{
-		HostEnabled:   enableHost,
-		AllowMessages: allowMsgs,
+		HostEnabled:             enableHost,
+		AllowMessages:           allowMsgs,
+		AllowAccountReuse:       allowAccountReuse,
+		QueryOnlyMessages:       queryOnlyMsgs,
+		AllowAccountCreation:    allowAccountCreation,
+		IdempotencyKeyRetention: idempotencyKeyRetention,
+		StrictDecoding:          strictDecoding,
+		AllowQueries:            allowQueries,
+		MinGasBudget:            minGasBudget,
+		MaxGasBudget:            maxGasBudget,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	return NewParams(DefaultHostEnabled, nil, DefaultAllowAccountReuse, nil, DefaultAllowAccountCreation, DefaultIdempotencyKeyRetention, DefaultStrictDecoding, nil, DefaultMinGasBudget, DefaultMaxGasBudget)
 }
 
 // Validate validates all host submodule parameters
@@ -47,6 +83,42 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateEnabled(p.AllowAccountReuse); err != nil {
+		return err
+	}
+
+	if err := validateAllowlist(p.QueryOnlyMessages); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.AllowAccountCreation); err != nil {
+		return err
+	}
+
+	if err := validateRetention(p.IdempotencyKeyRetention); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.StrictDecoding); err != nil {
+		return err
+	}
+
+	if err := validateAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+
+	if err := validateGasBudget(p.MinGasBudget); err != nil {
+		return err
+	}
+
+	if err := validateGasBudget(p.MaxGasBudget); err != nil {
+		return err
+	}
+
+	if p.MaxGasBudget != 0 && p.MinGasBudget > p.MaxGasBudget {
+		return fmt.Errorf("min gas budget %d must not exceed max gas budget %d", p.MinGasBudget, p.MaxGasBudget)
+	}
+
 	return nil
 }
 
@@ -55,6 +127,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyAllowAccountReuse, p.AllowAccountReuse, validateEnabled),
+		paramtypes.NewParamSetPair(KeyQueryOnlyMessages, p.QueryOnlyMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyAllowAccountCreation, p.AllowAccountCreation, validateEnabled),
+		paramtypes.NewParamSetPair(KeyIdempotencyKeyRetention, p.IdempotencyKeyRetention, validateRetention),
+		paramtypes.NewParamSetPair(KeyStrictDecoding, p.StrictDecoding, validateEnabled),
+		paramtypes.NewParamSetPair(KeyAllowQueries, p.AllowQueries, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyMinGasBudget, p.MinGasBudget, validateGasBudget),
+		paramtypes.NewParamSetPair(KeyMaxGasBudget, p.MaxGasBudget, validateGasBudget),
 	}
 }
 
@@ -67,6 +147,24 @@ func validateEnabled(i interface{}) error {
 	return nil
 }
 
+func validateRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateGasBudget(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateAllowlist(i interface{}) error {
 	allowMsgs, ok := i.([]string)
 	if !ok {
@@ -77,6 +175,10 @@ func validateAllowlist(i interface{}) error {
 		if strings.TrimSpace(typeURL) == "" {
 			return fmt.Errorf("parameter must not contain empty strings: %s", allowMsgs)
 		}
+
+		if strings.Contains(strings.TrimSuffix(typeURL, Wildcard), Wildcard) {
+			return fmt.Errorf("parameter entries may only contain a wildcard as the last character: %s", typeURL)
+		}
 	}
 
 	return nil
@@ -10,5 +10,17 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParams(false, []string{}, false, nil, true, 0, false, nil, 0, 0).Validate())
+	require.NoError(t, types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, false, []string{"/cosmos.bank.v1beta1.MsgSend"}, true, 0, false, nil, 0, 0).Validate())
+	require.NoError(t, types.NewParams(true, nil, true, nil, false, 0, false, nil, 0, 0).Validate())
+	require.NoError(t, types.NewParams(true, []string{"/cosmos.bank.v1beta1.*", types.Wildcard}, false, nil, true, 0, false, nil, 0, 0).Validate())
+	require.NoError(t, types.NewParams(true, nil, false, nil, true, 100, false, nil, 0, 0).Validate())
+	require.NoError(t, types.NewParams(true, nil, false, nil, true, 0, true, nil, 0, 0).Validate())
+	require.NoError(t, types.NewParams(true, nil, false, nil, true, 0, false, []string{"/cosmos.bank.v1beta1.Query/Balance", "/cosmos.staking.v1beta1.Query/*"}, 0, 0).Validate())
+	require.NoError(t, types.NewParams(true, nil, false, nil, true, 0, false, nil, 100000, 0).Validate())
+	require.NoError(t, types.NewParams(true, nil, false, nil, true, 0, false, nil, 100000, 100000).Validate())
+	require.Error(t, types.NewParams(true, nil, false, nil, true, 0, false, nil, 200000, 100000).Validate())
+	require.Error(t, types.NewParams(true, nil, false, nil, true, 0, false, []string{" "}, 0, 0).Validate())
+	require.Error(t, types.NewParams(true, nil, false, []string{" "}, true, 0, false, nil, 0, 0).Validate())
+	require.Error(t, types.NewParams(true, []string{"/cosmos.*.v1beta1.MsgSend"}, false, nil, true, 0, false, nil, 0, 0).Validate())
 }
@@ -0,0 +1,106 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeLinkInterchainAccount defines the type for a LinkInterchainAccountProposal
+	ProposalTypeLinkInterchainAccount = "LinkInterchainAccount"
+
+	// ProposalTypeProvisionInterchainAccount defines the type for a ProvisionInterchainAccountProposal
+	ProposalTypeProvisionInterchainAccount = "ProvisionInterchainAccount"
+)
+
+var (
+	_ govtypes.Content = &LinkInterchainAccountProposal{}
+	_ govtypes.Content = &ProvisionInterchainAccountProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeLinkInterchainAccount)
+	govtypes.RegisterProposalType(ProposalTypeProvisionInterchainAccount)
+}
+
+// NewLinkInterchainAccountProposal creates a new interchain accounts host account linking proposal.
+func NewLinkInterchainAccountProposal(title, description, connectionID, portID, accountAddress string) govtypes.Content {
+	return &LinkInterchainAccountProposal{
+		Title:          title,
+		Description:    description,
+		ConnectionId:   connectionID,
+		PortId:         portID,
+		AccountAddress: accountAddress,
+	}
+}
+
+// GetTitle returns the title of an account linking proposal.
+func (lp *LinkInterchainAccountProposal) GetTitle() string { return lp.Title }
+
+// GetDescription returns the description of an account linking proposal.
+func (lp *LinkInterchainAccountProposal) GetDescription() string { return lp.Description }
+
+// ProposalRoute returns the routing key of an account linking proposal.
+func (lp *LinkInterchainAccountProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an account linking proposal.
+func (lp *LinkInterchainAccountProposal) ProposalType() string {
+	return ProposalTypeLinkInterchainAccount
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (lp *LinkInterchainAccountProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(lp); err != nil {
+		return err
+	}
+
+	if err := host.ConnectionIdentifierValidator(lp.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(lp.PortId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(lp.AccountAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid interchain account address %s: %s", lp.AccountAddress, err)
+	}
+
+	return nil
+}
+
+// NewProvisionInterchainAccountProposal creates a new interchain accounts host account provisioning proposal.
+func NewProvisionInterchainAccountProposal(title, description, portID string, readOnly bool) govtypes.Content {
+	return &ProvisionInterchainAccountProposal{
+		Title:       title,
+		Description: description,
+		PortId:      portID,
+		ReadOnly:    readOnly,
+	}
+}
+
+// GetTitle returns the title of an account provisioning proposal.
+func (pp *ProvisionInterchainAccountProposal) GetTitle() string { return pp.Title }
+
+// GetDescription returns the description of an account provisioning proposal.
+func (pp *ProvisionInterchainAccountProposal) GetDescription() string { return pp.Description }
+
+// ProposalRoute returns the routing key of an account provisioning proposal.
+func (pp *ProvisionInterchainAccountProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an account provisioning proposal.
+func (pp *ProvisionInterchainAccountProposal) ProposalType() string {
+	return ProposalTypeProvisionInterchainAccount
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (pp *ProvisionInterchainAccountProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(pp); err != nil {
+		return err
+	}
+
+	return host.PortIdentifierValidator(pp.PortId)
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestLinkInterchainAccountProposalValidateBasic(t *testing.T) {
+	accAddress := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	portID, err := icatypes.GeneratePortID(accAddress, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		proposal *types.LinkInterchainAccountProposal
+		expPass  bool
+	}{
+		{"success", &types.LinkInterchainAccountProposal{Title: "title", Description: "description", ConnectionId: ibctesting.FirstConnectionID, PortId: portID, AccountAddress: accAddress}, true},
+		{"empty title", &types.LinkInterchainAccountProposal{Title: "", Description: "description", ConnectionId: ibctesting.FirstConnectionID, PortId: portID, AccountAddress: accAddress}, false},
+		{"empty description", &types.LinkInterchainAccountProposal{Title: "title", Description: "", ConnectionId: ibctesting.FirstConnectionID, PortId: portID, AccountAddress: accAddress}, false},
+		{"invalid connection identifier", &types.LinkInterchainAccountProposal{Title: "title", Description: "description", ConnectionId: "", PortId: portID, AccountAddress: accAddress}, false},
+		{"invalid port identifier", &types.LinkInterchainAccountProposal{Title: "title", Description: "description", ConnectionId: ibctesting.FirstConnectionID, PortId: "", AccountAddress: accAddress}, false},
+		{"invalid account address", &types.LinkInterchainAccountProposal{Title: "title", Description: "description", ConnectionId: ibctesting.FirstConnectionID, PortId: portID, AccountAddress: "invalid-address"}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestProvisionInterchainAccountProposalValidateBasic(t *testing.T) {
+	portID, err := icatypes.GeneratePortID("cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs", ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		proposal *types.ProvisionInterchainAccountProposal
+		expPass  bool
+	}{
+		{"success", &types.ProvisionInterchainAccountProposal{Title: "title", Description: "description", PortId: portID}, true},
+		{"success, read-only", &types.ProvisionInterchainAccountProposal{Title: "title", Description: "description", PortId: portID, ReadOnly: true}, true},
+		{"empty title", &types.ProvisionInterchainAccountProposal{Title: "", Description: "description", PortId: portID}, false},
+		{"empty description", &types.ProvisionInterchainAccountProposal{Title: "title", Description: "", PortId: portID}, false},
+		{"invalid port identifier", &types.ProvisionInterchainAccountProposal{Title: "title", Description: "description", PortId: ""}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
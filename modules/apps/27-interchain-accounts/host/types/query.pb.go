@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,9 +113,384 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryInterchainAccountsByConnectionRequest is the request type for the Query/InterchainAccountsByConnection RPC
+// method.
+type QueryInterchainAccountsByConnectionRequest struct {
+	// host connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsByConnectionRequest) Reset() {
+	*m = QueryInterchainAccountsByConnectionRequest{}
+}
+func (m *QueryInterchainAccountsByConnectionRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInterchainAccountsByConnectionRequest) ProtoMessage() {}
+func (*QueryInterchainAccountsByConnectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryInterchainAccountsByConnectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsByConnectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsByConnectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountsByConnectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsByConnectionRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountsByConnectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsByConnectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsByConnectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsByConnectionRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsByConnectionRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountsByConnectionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInterchainAccountsByConnectionResponse is the response type for the Query/InterchainAccountsByConnection RPC
+// method.
+type QueryInterchainAccountsByConnectionResponse struct {
+	// list of interchain accounts registered over the connection
+	InterchainAccounts []InterchainAccountRecord `protobuf:"bytes,1,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsByConnectionResponse) Reset() {
+	*m = QueryInterchainAccountsByConnectionResponse{}
+}
+func (m *QueryInterchainAccountsByConnectionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInterchainAccountsByConnectionResponse) ProtoMessage() {}
+func (*QueryInterchainAccountsByConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryInterchainAccountsByConnectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsByConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsByConnectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountsByConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsByConnectionResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountsByConnectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsByConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsByConnectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsByConnectionResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsByConnectionResponse) GetInterchainAccounts() []InterchainAccountRecord {
+	if m != nil {
+		return m.InterchainAccounts
+	}
+	return nil
+}
+
+func (m *QueryInterchainAccountsByConnectionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllInterchainAccountsRequest is the request type for the Query/AllInterchainAccounts RPC method.
+type QueryAllInterchainAccountsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllInterchainAccountsRequest) Reset()         { *m = QueryAllInterchainAccountsRequest{} }
+func (m *QueryAllInterchainAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInterchainAccountsRequest) ProtoMessage()    {}
+func (*QueryAllInterchainAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{4}
+}
+func (m *QueryAllInterchainAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllInterchainAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllInterchainAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllInterchainAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInterchainAccountsRequest.Merge(m, src)
+}
+func (m *QueryAllInterchainAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllInterchainAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInterchainAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllInterchainAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryAllInterchainAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllInterchainAccountsResponse is the response type for the Query/AllInterchainAccounts RPC method.
+type QueryAllInterchainAccountsResponse struct {
+	// list of all interchain accounts registered on the host chain
+	InterchainAccounts []InterchainAccountRecord `protobuf:"bytes,1,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllInterchainAccountsResponse) Reset()         { *m = QueryAllInterchainAccountsResponse{} }
+func (m *QueryAllInterchainAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInterchainAccountsResponse) ProtoMessage()    {}
+func (*QueryAllInterchainAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{5}
+}
+func (m *QueryAllInterchainAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllInterchainAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllInterchainAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllInterchainAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInterchainAccountsResponse.Merge(m, src)
+}
+func (m *QueryAllInterchainAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllInterchainAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInterchainAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllInterchainAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryAllInterchainAccountsResponse) GetInterchainAccounts() []InterchainAccountRecord {
+	if m != nil {
+		return m.InterchainAccounts
+	}
+	return nil
+}
+
+func (m *QueryAllInterchainAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// InterchainAccountRecord describes an interchain account registered over a host connection
+type InterchainAccountRecord struct {
+	// controller port identifier associated with the interchain account
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// interchain account address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// owner of the interchain account on the controller chain
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *InterchainAccountRecord) Reset()         { *m = InterchainAccountRecord{} }
+func (m *InterchainAccountRecord) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountRecord) ProtoMessage()    {}
+func (*InterchainAccountRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{6}
+}
+func (m *InterchainAccountRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountRecord.Merge(m, src)
+}
+func (m *InterchainAccountRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountRecord proto.InternalMessageInfo
+
+func (m *InterchainAccountRecord) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InterchainAccountRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InterchainAccountRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryHostCapabilitiesRequest is the request type for the Query/HostCapabilities RPC method.
+type QueryHostCapabilitiesRequest struct {
+}
+
+func (m *QueryHostCapabilitiesRequest) Reset()         { *m = QueryHostCapabilitiesRequest{} }
+func (m *QueryHostCapabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostCapabilitiesRequest) ProtoMessage()    {}
+func (*QueryHostCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{7}
+}
+func (m *QueryHostCapabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostCapabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostCapabilitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostCapabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostCapabilitiesRequest.Merge(m, src)
+}
+func (m *QueryHostCapabilitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostCapabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostCapabilitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostCapabilitiesRequest proto.InternalMessageInfo
+
+// QueryHostCapabilitiesResponse is the response type for the Query/HostCapabilities RPC method.
+type QueryHostCapabilitiesResponse struct {
+	// capabilities defines the interchain accounts features supported by the host chain.
+	Capabilities HostCapabilities `protobuf:"bytes,1,opt,name=capabilities,proto3" json:"capabilities"`
+}
+
+func (m *QueryHostCapabilitiesResponse) Reset()         { *m = QueryHostCapabilitiesResponse{} }
+func (m *QueryHostCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostCapabilitiesResponse) ProtoMessage()    {}
+func (*QueryHostCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{8}
+}
+func (m *QueryHostCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostCapabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostCapabilitiesResponse.Merge(m, src)
+}
+func (m *QueryHostCapabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostCapabilitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostCapabilitiesResponse proto.InternalMessageInfo
+
+func (m *QueryHostCapabilitiesResponse) GetCapabilities() HostCapabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return HostCapabilities{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryInterchainAccountsByConnectionRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountsByConnectionRequest")
+	proto.RegisterType((*QueryInterchainAccountsByConnectionResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountsByConnectionResponse")
+	proto.RegisterType((*QueryAllInterchainAccountsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryAllInterchainAccountsRequest")
+	proto.RegisterType((*QueryAllInterchainAccountsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryAllInterchainAccountsResponse")
+	proto.RegisterType((*InterchainAccountRecord)(nil), "ibc.applications.interchain_accounts.host.v1.InterchainAccountRecord")
+	proto.RegisterType((*QueryHostCapabilitiesRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryHostCapabilitiesRequest")
+	proto.RegisterType((*QueryHostCapabilitiesResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryHostCapabilitiesResponse")
 }
 
 func init() {
@@ -122,28 +498,54 @@ func init() {
 }
 
 var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x3f, 0x4b, 0x03, 0x31,
-	0x18, 0xc6, 0x1b, 0xc1, 0x0e, 0xe7, 0x76, 0x76, 0x90, 0x22, 0x41, 0x3a, 0x39, 0xb4, 0x79, 0xe9,
-	0x1f, 0xa8, 0xa3, 0x3a, 0x8a, 0x83, 0x3a, 0xba, 0x48, 0x2e, 0x0d, 0x69, 0xa0, 0x97, 0x37, 0xbd,
-	0xe4, 0x0a, 0x5d, 0xfd, 0x04, 0x82, 0x1f, 0xc9, 0xc5, 0x45, 0x28, 0xb8, 0x38, 0x4a, 0xeb, 0x07,
-	0x91, 0xcb, 0x1d, 0x68, 0x51, 0x84, 0xc3, 0xed, 0xe5, 0x0d, 0xcf, 0xef, 0x79, 0x9e, 0x37, 0xd1,
-	0x89, 0x4e, 0x04, 0x70, 0x6b, 0x67, 0x5a, 0x70, 0xaf, 0xd1, 0x38, 0xd0, 0xc6, 0xcb, 0x4c, 0x4c,
-	0xb9, 0x36, 0x77, 0x5c, 0x08, 0xcc, 0x8d, 0x77, 0x30, 0x45, 0xe7, 0x61, 0xd1, 0x87, 0x79, 0x2e,
-	0xb3, 0x25, 0xb3, 0x19, 0x7a, 0x8c, 0xbb, 0x3a, 0x11, 0xec, 0xbb, 0x92, 0xfd, 0xa2, 0x64, 0x85,
-	0x92, 0x2d, 0xfa, 0xed, 0x43, 0x85, 0xa8, 0x66, 0x12, 0xb8, 0xd5, 0xc0, 0x8d, 0x41, 0x5f, 0x69,
-	0x02, 0xab, 0xdd, 0x52, 0xa8, 0x30, 0x8c, 0x50, 0x4c, 0xd5, 0x76, 0x5c, 0x2b, 0x5b, 0x70, 0x0a,
-	0xc2, 0x4e, 0x2b, 0x8a, 0xaf, 0x8b, 0xa4, 0x57, 0x3c, 0xe3, 0xa9, 0xbb, 0x91, 0xf3, 0x5c, 0x3a,
-	0xdf, 0x11, 0xd1, 0xfe, 0xd6, 0xd6, 0x59, 0x34, 0x4e, 0xc6, 0x97, 0x51, 0xd3, 0x86, 0xcd, 0x01,
-	0x39, 0x22, 0xc7, 0x7b, 0x83, 0x11, 0xab, 0x53, 0x8c, 0x55, 0xb4, 0x8a, 0x31, 0x78, 0x21, 0xd1,
-	0x6e, 0x70, 0x89, 0x9f, 0x48, 0xd4, 0x2c, 0x1f, 0xe3, 0xd3, 0x7a, 0xc8, 0x9f, 0xd9, 0xdb, 0x67,
-	0xff, 0x20, 0x94, 0x3d, 0x3b, 0xa3, 0xfb, 0xd7, 0x8f, 0xc7, 0x1d, 0x16, 0x77, 0xa1, 0x3a, 0xeb,
-	0xdf, 0xe7, 0x2c, 0xfb, 0x9c, 0x4f, 0x9e, 0xd7, 0x94, 0xac, 0xd6, 0x94, 0xbc, 0xaf, 0x29, 0x79,
-	0xd8, 0xd0, 0xc6, 0x6a, 0x43, 0x1b, 0x6f, 0x1b, 0xda, 0xb8, 0xbd, 0x50, 0xda, 0x4f, 0xf3, 0x84,
-	0x09, 0x4c, 0x41, 0xa0, 0x4b, 0xd1, 0x15, 0xe0, 0x9e, 0x42, 0x58, 0x0c, 0x21, 0xc5, 0x49, 0x3e,
-	0x93, 0xae, 0xb4, 0x19, 0x8c, 0x7b, 0x5f, 0x4e, 0xbd, 0x6d, 0x27, 0xbf, 0xb4, 0xd2, 0x25, 0xcd,
-	0xf0, 0x6f, 0xc3, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xea, 0x8f, 0x11, 0x8e, 0x02, 0x00,
-	0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x96, 0xcd, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0xa9, 0x4d, 0xe9, 0xb4, 0x8a, 0x4c, 0x23, 0x86, 0x50, 0x37, 0x75, 0x0f, 0x5a,
+	0xfa, 0xb1, 0x43, 0xd2, 0x42, 0xb5, 0xa2, 0x98, 0x14, 0x3f, 0x5a, 0x05, 0xeb, 0x82, 0xf8, 0x01,
+	0x52, 0x66, 0x77, 0x87, 0xcd, 0x60, 0xb2, 0xb3, 0xdd, 0xd9, 0x44, 0x82, 0x78, 0xf1, 0xa6, 0x27,
+	0xa1, 0x37, 0xff, 0x0d, 0xfd, 0x0f, 0xbc, 0xf4, 0x58, 0xf0, 0xe2, 0x29, 0x48, 0x2b, 0x78, 0xf3,
+	0xd0, 0xbb, 0x20, 0x99, 0x9d, 0x36, 0x49, 0xb3, 0x8d, 0x49, 0xac, 0x27, 0x6f, 0xbb, 0xb3, 0xfb,
+	0x3e, 0xef, 0x33, 0xbf, 0x77, 0xe7, 0x61, 0xc1, 0x15, 0x6a, 0x5a, 0x08, 0x7b, 0x5e, 0x89, 0x5a,
+	0x38, 0xa0, 0xcc, 0xe5, 0x88, 0xba, 0x01, 0xf1, 0xad, 0x22, 0xa6, 0xee, 0x06, 0xb6, 0x2c, 0x56,
+	0x71, 0x03, 0x8e, 0x8a, 0x8c, 0x07, 0xa8, 0x9a, 0x45, 0x9b, 0x15, 0xe2, 0xd7, 0x74, 0xcf, 0x67,
+	0x01, 0x83, 0x73, 0xd4, 0xb4, 0xf4, 0xd6, 0x4a, 0x3d, 0xa2, 0x52, 0x6f, 0x54, 0xea, 0xd5, 0x6c,
+	0x7a, 0xd2, 0x61, 0xcc, 0x29, 0x11, 0x84, 0x3d, 0x8a, 0xb0, 0xeb, 0xb2, 0x40, 0xd6, 0x08, 0xad,
+	0x74, 0xd2, 0x61, 0x0e, 0x13, 0x97, 0xa8, 0x71, 0x25, 0x57, 0x67, 0x2c, 0xc6, 0xcb, 0x8c, 0x23,
+	0x13, 0x73, 0x12, 0xb6, 0x46, 0xd5, 0xac, 0x49, 0x02, 0x9c, 0x45, 0x1e, 0x76, 0xa8, 0x2b, 0x24,
+	0xe4, 0xbb, 0x4b, 0x7d, 0xed, 0x43, 0xb8, 0x12, 0x85, 0x5a, 0x12, 0xc0, 0x87, 0x0d, 0xe9, 0x75,
+	0xec, 0xe3, 0x32, 0x37, 0xc8, 0x66, 0x85, 0xf0, 0x40, 0xb3, 0xc0, 0x44, 0xdb, 0x2a, 0xf7, 0x98,
+	0xcb, 0x09, 0xbc, 0x0f, 0x12, 0x9e, 0x58, 0x49, 0x29, 0x53, 0xca, 0xf4, 0x58, 0x6e, 0x51, 0xef,
+	0x07, 0x82, 0x2e, 0xd5, 0xa4, 0x86, 0xf6, 0x51, 0x01, 0x33, 0xa2, 0xcb, 0xea, 0x61, 0x4d, 0x5e,
+	0x96, 0x14, 0x6a, 0x2b, 0xcc, 0x75, 0x89, 0xd5, 0xd0, 0x94, 0x9e, 0xe0, 0x75, 0x70, 0xda, 0x3a,
+	0x5c, 0xdc, 0xa0, 0xb6, 0xf0, 0x30, 0x5a, 0x48, 0xed, 0xd7, 0x33, 0xc9, 0x1a, 0x2e, 0x97, 0x96,
+	0xb5, 0xb6, 0xc7, 0x9a, 0x31, 0xde, 0xbc, 0x5f, 0xb5, 0xe1, 0x6d, 0x00, 0x9a, 0xd4, 0x52, 0x71,
+	0xe1, 0xff, 0x92, 0x1e, 0x22, 0xd6, 0x1b, 0x88, 0xf5, 0x70, 0xba, 0x12, 0xb1, 0xbe, 0x8e, 0x1d,
+	0x22, 0x5b, 0x1b, 0x2d, 0x95, 0xda, 0x56, 0x1c, 0xcc, 0xf6, 0xe4, 0x5a, 0x32, 0xfb, 0xa0, 0x80,
+	0x89, 0x08, 0x28, 0x29, 0x65, 0x6a, 0x68, 0x7a, 0x2c, 0x77, 0xab, 0x3f, 0x82, 0x1d, 0x3d, 0x0d,
+	0x62, 0x31, 0xdf, 0x2e, 0x68, 0xdb, 0xf5, 0x4c, 0x6c, 0xbf, 0x9e, 0x49, 0x87, 0x20, 0x22, 0x24,
+	0x34, 0x03, 0xd2, 0x0e, 0xc3, 0xf0, 0x4e, 0x04, 0x94, 0xcb, 0x7f, 0x84, 0x12, 0xee, 0xac, 0x8d,
+	0xca, 0x0b, 0x70, 0x51, 0x40, 0xc9, 0x97, 0x4a, 0x9d, 0x5c, 0x0e, 0x26, 0xd8, 0x3e, 0x02, 0x65,
+	0xe0, 0x11, 0xbc, 0x8b, 0x03, 0xad, 0x5b, 0xb7, 0xff, 0x8a, 0x7c, 0x15, 0x9c, 0x3f, 0xc6, 0x1b,
+	0x9c, 0x05, 0x23, 0x1e, 0xf3, 0x83, 0xe6, 0x59, 0x81, 0xfb, 0xf5, 0xcc, 0x99, 0xd0, 0xa8, 0x7c,
+	0xa0, 0x19, 0x89, 0xc6, 0xd5, 0xaa, 0x0d, 0x53, 0x60, 0x04, 0xdb, 0xb6, 0x4f, 0x38, 0x17, 0x6e,
+	0x46, 0x8d, 0x83, 0x5b, 0x98, 0x04, 0xc3, 0xec, 0xa5, 0x4b, 0xfc, 0xd4, 0x90, 0x58, 0x0f, 0x6f,
+	0x34, 0x15, 0x4c, 0x8a, 0x19, 0xdc, 0x65, 0x3c, 0x58, 0xc1, 0x1e, 0x36, 0x69, 0x89, 0x06, 0x94,
+	0x1c, 0x46, 0xc8, 0x5b, 0x05, 0x5c, 0x38, 0xe6, 0x05, 0x39, 0x9f, 0x22, 0x18, 0xb7, 0x5a, 0xd6,
+	0xe5, 0x07, 0x71, 0xa3, 0xbf, 0xb9, 0x1c, 0x55, 0x2f, 0x9c, 0x6a, 0x0c, 0xc4, 0x68, 0x53, 0xce,
+	0xfd, 0x1c, 0x01, 0xc3, 0xc2, 0x0b, 0xfc, 0xac, 0x80, 0x44, 0x18, 0x43, 0xf0, 0x66, 0x7f, 0x8d,
+	0x3a, 0x53, 0x32, 0x9d, 0xff, 0x0b, 0x85, 0x90, 0x81, 0xb6, 0xf8, 0xe6, 0xcb, 0xf7, 0xad, 0xb8,
+	0x0e, 0xe7, 0x90, 0x0c, 0xf0, 0xee, 0xc1, 0x1d, 0x26, 0x27, 0xfc, 0x14, 0x07, 0x6a, 0xf7, 0xf8,
+	0x81, 0x4f, 0x06, 0xf0, 0xd6, 0x53, 0x0e, 0xa7, 0x9f, 0xfe, 0x03, 0x65, 0x49, 0xe3, 0xb9, 0xa0,
+	0xf1, 0x18, 0x3e, 0xea, 0x8d, 0x46, 0x33, 0xdf, 0x39, 0x7a, 0xd5, 0x16, 0xfe, 0xaf, 0xa3, 0xea,
+	0xe0, 0x2f, 0x05, 0x9c, 0x8b, 0x8c, 0x0c, 0xf8, 0x60, 0x80, 0x3d, 0x75, 0x8b, 0xba, 0xf4, 0xfa,
+	0xc9, 0x09, 0x4a, 0x36, 0x79, 0xc1, 0xe6, 0x1a, 0xbc, 0xda, 0x1b, 0x9b, 0xa8, 0xfd, 0xff, 0x50,
+	0xc0, 0xd9, 0xa3, 0xe7, 0x05, 0xae, 0x0d, 0xe0, 0xf4, 0x98, 0x33, 0x9f, 0xbe, 0x77, 0x22, 0x5a,
+	0x72, 0xc3, 0xcb, 0x62, 0xc3, 0x8b, 0x30, 0xd7, 0xe3, 0xc7, 0xd0, 0x1a, 0x02, 0xf6, 0xf6, 0xae,
+	0xaa, 0xec, 0xec, 0xaa, 0xca, 0xb7, 0x5d, 0x55, 0x79, 0xbf, 0xa7, 0xc6, 0x76, 0xf6, 0xd4, 0xd8,
+	0xd7, 0x3d, 0x35, 0xf6, 0x6c, 0xcd, 0xa1, 0x41, 0xb1, 0x62, 0xea, 0x16, 0x2b, 0x23, 0xf9, 0x7f,
+	0x45, 0x4d, 0x6b, 0xde, 0x61, 0xa8, 0xba, 0x80, 0xca, 0xcc, 0xae, 0x94, 0x08, 0x0f, 0x9b, 0xe5,
+	0x96, 0xe6, 0x9b, 0xfd, 0xe6, 0xdb, 0xfb, 0x05, 0x35, 0x8f, 0x70, 0x33, 0x21, 0x7e, 0xa1, 0x16,
+	0x7e, 0x0f, 0x00, 0x31, 0xec, 0x90, 0xd3, 0x45, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,8 +560,17 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
-	// Params queries all parameters of the ICA host submodule.
+	// Params queries all parameters of the ICA host submodule. The parameters in effect at a past block may be
+	// queried by setting the x-cosmos-block-height gRPC metadata header to the block height. Historical queries
+	// are only served for heights whose state has not been pruned by the queried node, such that nodes serving
+	// historical parameters must be configured with a pruning strategy retaining the heights of interest.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InterchainAccountsByConnection queries all interchain accounts registered over a particular host connection.
+	InterchainAccountsByConnection(ctx context.Context, in *QueryInterchainAccountsByConnectionRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsByConnectionResponse, error)
+	// AllInterchainAccounts queries all interchain accounts registered on the host chain.
+	AllInterchainAccounts(ctx context.Context, in *QueryAllInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryAllInterchainAccountsResponse, error)
+	// HostCapabilities queries the interchain accounts features supported by the host chain.
+	HostCapabilities(ctx context.Context, in *QueryHostCapabilitiesRequest, opts ...grpc.CallOption) (*QueryHostCapabilitiesResponse, error)
 }
 
 type queryClient struct {
@@ -179,10 +590,46 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) InterchainAccountsByConnection(ctx context.Context, in *QueryInterchainAccountsByConnectionRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsByConnectionResponse, error) {
+	out := new(QueryInterchainAccountsByConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccountsByConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllInterchainAccounts(ctx context.Context, in *QueryAllInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryAllInterchainAccountsResponse, error) {
+	out := new(QueryAllInterchainAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/AllInterchainAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostCapabilities(ctx context.Context, in *QueryHostCapabilitiesRequest, opts ...grpc.CallOption) (*QueryHostCapabilitiesResponse, error) {
+	out := new(QueryHostCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/HostCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
-	// Params queries all parameters of the ICA host submodule.
+	// Params queries all parameters of the ICA host submodule. The parameters in effect at a past block may be
+	// queried by setting the x-cosmos-block-height gRPC metadata header to the block height. Historical queries
+	// are only served for heights whose state has not been pruned by the queried node, such that nodes serving
+	// historical parameters must be configured with a pruning strategy retaining the heights of interest.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InterchainAccountsByConnection queries all interchain accounts registered over a particular host connection.
+	InterchainAccountsByConnection(context.Context, *QueryInterchainAccountsByConnectionRequest) (*QueryInterchainAccountsByConnectionResponse, error)
+	// AllInterchainAccounts queries all interchain accounts registered on the host chain.
+	AllInterchainAccounts(context.Context, *QueryAllInterchainAccountsRequest) (*QueryAllInterchainAccountsResponse, error)
+	// HostCapabilities queries the interchain accounts features supported by the host chain.
+	HostCapabilities(context.Context, *QueryHostCapabilitiesRequest) (*QueryHostCapabilitiesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -192,6 +639,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) InterchainAccountsByConnection(ctx context.Context, req *QueryInterchainAccountsByConnectionRequest) (*QueryInterchainAccountsByConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountsByConnection not implemented")
+}
+func (*UnimplementedQueryServer) AllInterchainAccounts(ctx context.Context, req *QueryAllInterchainAccountsRequest) (*QueryAllInterchainAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllInterchainAccounts not implemented")
+}
+func (*UnimplementedQueryServer) HostCapabilities(ctx context.Context, req *QueryHostCapabilitiesRequest) (*QueryHostCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostCapabilities not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -215,35 +671,101 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InterchainAccountsByConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountsByConnectionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountsByConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccountsByConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountsByConnection(ctx, req.(*QueryInterchainAccountsByConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Query_AllInterchainAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInterchainAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllInterchainAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/AllInterchainAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllInterchainAccounts(ctx, req.(*QueryAllInterchainAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/HostCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostCapabilities(ctx, req.(*QueryHostCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "InterchainAccountsByConnection",
+			Handler:    _Query_InterchainAccountsByConnection_Handler,
+		},
+		{
+			MethodName: "AllInterchainAccounts",
+			Handler:    _Query_AllInterchainAccounts_Handler,
+		},
+		{
+			MethodName: "HostCapabilities",
+			Handler:    _Query_HostCapabilities_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -275,57 +797,1167 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsByConnectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsByConnectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsByConnectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsByConnectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsByConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsByConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InterchainAccounts) > 0 {
+		for iNdEx := len(m.InterchainAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterchainAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInterchainAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInterchainAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInterchainAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInterchainAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInterchainAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInterchainAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InterchainAccounts) > 0 {
+		for iNdEx := len(m.InterchainAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterchainAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainAccountRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostCapabilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostCapabilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostCapabilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostCapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostCapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostCapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Capabilities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountsByConnectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountsByConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterchainAccounts) > 0 {
+		for _, e := range m.InterchainAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInterchainAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInterchainAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterchainAccounts) > 0 {
+		for _, e := range m.InterchainAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *InterchainAccountRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostCapabilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostCapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capabilities.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsByConnectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsByConnectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsByConnectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsByConnectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsByConnectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsByConnectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccounts = append(m.InterchainAccounts, InterchainAccountRecord{})
+			if err := m.InterchainAccounts[len(m.InterchainAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInterchainAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInterchainAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInterchainAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInterchainAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInterchainAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInterchainAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccounts = append(m.InterchainAccounts, InterchainAccountRecord{})
+			if err := m.InterchainAccounts[len(m.InterchainAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InterchainAccountRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostCapabilitiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -348,10 +1980,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostCapabilitiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostCapabilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -375,7 +2007,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHostCapabilitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -398,15 +2030,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostCapabilitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostCapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -433,10 +2065,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SignerAuthorizer defines the function used by the host chain to determine whether a message executed on behalf of an
+// interchain account may require the signature of the provided signer in addition to the interchain account itself.
+// The interchain account is the only signature the host is able to provide, returning true for any other signer
+// authorizes the message to be executed without that signer's signature.
+type SignerAuthorizer func(ctx sdk.Context, interchainAccountAddr sdk.AccAddress, msg sdk.Msg, signer sdk.AccAddress) bool
+
+var _ SignerAuthorizer = RejectAdditionalSigners
+
+// RejectAdditionalSigners is the default SignerAuthorizer used by the host chain. It rejects every signer other than
+// the interchain account, requiring the interchain account to be the sole signer of each executed message.
+func RejectAdditionalSigners(_ sdk.Context, _ sdk.AccAddress, _ sdk.Msg, _ sdk.AccAddress) bool {
+	return false
+}
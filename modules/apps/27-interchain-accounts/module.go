@@ -51,6 +51,8 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 // RegisterInterfaces registers module concrete types into protobuf Any
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+	controllertypes.RegisterInterfaces(registry)
+	hosttypes.RegisterInterfaces(registry)
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the IBC
@@ -81,7 +83,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 
 // GetTxCmd implements AppModuleBasic interface
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.NewTxCmd()
 }
 
 // GetQueryCmd implements AppModuleBasic interface
@@ -105,7 +107,10 @@ func NewAppModule(controllerKeeper *controllerkeeper.Keeper, hostKeeper *hostkee
 }
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	if am.controllerKeeper != nil {
+		controllerkeeper.RegisterInvariants(ir, *am.controllerKeeper)
+	}
 }
 
 // Route implements the AppModule interface
@@ -130,6 +135,39 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 
 // RegisterServices registers module services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	if am.controllerKeeper != nil {
+		controllertypes.RegisterMsgServer(cfg.MsgServer(), controllerkeeper.NewMsgServerImpl(*am.controllerKeeper))
+		controllertypes.RegisterQueryServer(cfg.QueryServer(), am.controllerKeeper)
+	}
+
+	if am.hostKeeper != nil {
+		hosttypes.RegisterMsgServer(cfg.MsgServer(), hostkeeper.NewMsgServerImpl(*am.hostKeeper))
+		hosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
+	}
+
+	// the interchain accounts store only requires migrating when the host submodule is enabled
+	migrate1to2 := func(sdk.Context) error { return nil }
+	if am.hostKeeper != nil {
+		migrate1to2 = hostkeeper.NewMigrator(am.hostKeeper).Migrate1to2
+	}
+	cfg.RegisterMigration(types.ModuleName, 1, migrate1to2)
+
+	// the parameters introduced since version 2 are set for each enabled submodule
+	cfg.RegisterMigration(types.ModuleName, 2, func(ctx sdk.Context) error {
+		if am.controllerKeeper != nil {
+			if err := controllerkeeper.NewMigrator(am.controllerKeeper).Migrate2to3(ctx); err != nil {
+				return err
+			}
+		}
+
+		if am.hostKeeper != nil {
+			if err := hostkeeper.NewMigrator(am.hostKeeper).Migrate2to3(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
 // InitGenesis performs genesis initialization for the interchain accounts module.
@@ -170,13 +208,25 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
-// BeginBlock implements the AppModule interface
+// BeginBlock implements the AppModule interface. The idempotency keys retained by the host submodule are pruned
+// once their retention window elapses.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	if am.hostKeeper != nil {
+		am.hostKeeper.PruneIdempotentExecutions(ctx)
+	}
 }
 
-// EndBlock implements the AppModule interface
+// EndBlock implements the AppModule interface. The transactions held by the send queues of the controller submodule
+// are released, the scheduled automatic channel reopen attempts are retried and completed unbondings of interchain
+// accounts are pruned at the end of each block.
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if am.controllerKeeper != nil {
+		am.controllerKeeper.ReleaseQueuedTxs(ctx)
+		am.controllerKeeper.ProcessPendingReopens(ctx)
+		am.controllerKeeper.PruneCompletedUnbondings(ctx)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,193 @@
+package ica
+
+import (
+	"reflect"
+	"strconv"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+// MiddlewareConstructor constructs a middleware wrapping the provided IBC application
+type MiddlewareConstructor func(app porttypes.IBCModule) porttypes.Middleware
+
+// stackLayer pairs a middleware constructor with the ICS4Wrapper the constructed middleware
+// uses to send packets and write acknowledgements
+type stackLayer struct {
+	constructor MiddlewareConstructor
+	ics4Wrapper porttypes.ICS4Wrapper
+}
+
+// StackBuilder composes an ordered stack of middleware around a base interchain accounts IBC application.
+// Middleware is added from the innermost to the outermost layer. Every layer, as well as the base application,
+// declares the ICS4Wrapper it was configured with. This allows Build to verify that packets sent by the base
+// application traverse every layer of the stack on their way to core IBC.
+type StackBuilder struct {
+	base            porttypes.IBCModule
+	baseICS4Wrapper porttypes.ICS4Wrapper
+	layers          []stackLayer
+}
+
+// NewStackBuilder creates a new StackBuilder for the provided base interchain accounts IBC application.
+// The ics4Wrapper must be the ICS4Wrapper provided to the keeper of the base application.
+func NewStackBuilder(base porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper) *StackBuilder {
+	return &StackBuilder{
+		base:            base,
+		baseICS4Wrapper: ics4Wrapper,
+	}
+}
+
+// Use adds a middleware as the next outer layer of the stack. The ics4Wrapper must be the ICS4Wrapper the
+// middleware is configured with, which is the ICS4Wrapper of the next outer layer or the core IBC channel
+// keeper for the outermost layer.
+func (sb *StackBuilder) Use(constructor MiddlewareConstructor, ics4Wrapper porttypes.ICS4Wrapper) *StackBuilder {
+	sb.layers = append(sb.layers, stackLayer{
+		constructor: constructor,
+		ics4Wrapper: ics4Wrapper,
+	})
+
+	return sb
+}
+
+// Build validates the stack and constructs each layer from the innermost to the outermost, returning the
+// outermost layer to be registered on the IBC router. The ICS4Wrappers declared by the base application and
+// each layer must be distinct. Only the outermost layer may send directly through the provided channelKeeper,
+// as any other layer doing so bypasses the layers above it.
+func (sb *StackBuilder) Build(channelKeeper porttypes.ICS4Wrapper) (porttypes.IBCModule, error) {
+	if sb.base == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMiddlewareStack, "base application cannot be nil")
+	}
+
+	if sb.baseICS4Wrapper == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMiddlewareStack, "base application ICS4Wrapper cannot be nil")
+	}
+
+	if channelKeeper == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMiddlewareStack, "channel keeper cannot be nil")
+	}
+
+	// wrappers[0] is used by the base application and wrappers[i] by the layer at index i-1
+	wrappers := []porttypes.ICS4Wrapper{sb.baseICS4Wrapper}
+	for i, layer := range sb.layers {
+		if layer.constructor == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMiddlewareStack, "middleware constructor at layer %d cannot be nil", i)
+		}
+
+		if layer.ics4Wrapper == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMiddlewareStack, "middleware ICS4Wrapper at layer %d cannot be nil", i)
+		}
+
+		wrappers = append(wrappers, layer.ics4Wrapper)
+	}
+
+	for i, wrapper := range wrappers {
+		isOutermost := i == len(wrappers)-1
+		if sendsToCore := isSameICS4Wrapper(wrapper, channelKeeper); sendsToCore != isOutermost {
+			if isOutermost {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidMiddlewareStack, "%s must send packets through the channel keeper", layerName(i))
+			}
+
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMiddlewareStack, "%s sends packets through the channel keeper, bypassing middleware layer %d", layerName(i), i)
+		}
+
+		for j := 0; j < i; j++ {
+			if isSameICS4Wrapper(wrapper, wrappers[j]) {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidMiddlewareStack, "%s and %s send packets through the same ICS4Wrapper", layerName(j), layerName(i))
+			}
+		}
+	}
+
+	app := sb.base
+	for i, layer := range sb.layers {
+		middleware := layer.constructor(app)
+		if middleware == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMiddlewareStack, "middleware constructor at layer %d returned nil", i)
+		}
+
+		app = middleware
+	}
+
+	return app, nil
+}
+
+// MustBuild calls Build and panics on error. It is intended to be used during application construction.
+func (sb *StackBuilder) MustBuild(channelKeeper porttypes.ICS4Wrapper) porttypes.IBCModule {
+	app, err := sb.Build(channelKeeper)
+	if err != nil {
+		panic(err)
+	}
+
+	return app
+}
+
+// layerName returns a human-readable name for the user of the ICS4Wrapper at the provided index
+func layerName(index int) string {
+	if index == 0 {
+		return "base application"
+	}
+
+	return "middleware layer " + strconv.Itoa(index-1)
+}
+
+// isSameICS4Wrapper returns true if the provided ICS4Wrappers are identical. Keepers are commonly provided by
+// value, in which case they are compared field by field. Reference fields such as pointers and maps are compared
+// by address rather than by contents, as two copies of the same keeper share their references.
+func isSameICS4Wrapper(a, b porttypes.ICS4Wrapper) bool {
+	return isShallowEqual(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+// isShallowEqual compares the provided values without dereferencing reference kinds
+func isShallowEqual(a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	case reflect.Slice:
+		return a.Pointer() == b.Pointer() && a.Len() == b.Len()
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+
+		return isShallowEqual(a.Elem(), b.Elem())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !isShallowEqual(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if !isShallowEqual(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,277 @@
+package ica_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/stretchr/testify/suite"
+
+	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// TestOwnerAddress defines a reusable bech32 address for testing purposes
+const TestOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+type StackTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func TestStackTestSuite(t *testing.T) {
+	suite.Run(t, new(StackTestSuite))
+}
+
+func (suite *StackTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+// mockICS4Wrapper is an ICS4Wrapper used to identify the ICS4Wrapper a stack layer is configured with
+type mockICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+}
+
+// recordingMiddleware is a middleware which records the callbacks it receives before passing them to the
+// underlying application
+type recordingMiddleware struct {
+	porttypes.IBCModule
+	porttypes.ICS4Wrapper
+
+	name string
+	log  *[]string
+}
+
+func newRecordingMiddlewareConstructor(name string, ics4Wrapper porttypes.ICS4Wrapper, log *[]string) ica.MiddlewareConstructor {
+	return func(app porttypes.IBCModule) porttypes.Middleware {
+		return recordingMiddleware{IBCModule: app, ICS4Wrapper: ics4Wrapper, name: name, log: log}
+	}
+}
+
+func (im recordingMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	*im.log = append(*im.log, im.name)
+	return im.IBCModule.OnChanCloseInit(ctx, portID, channelID)
+}
+
+func (im recordingMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	*im.log = append(*im.log, im.name)
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+func (im recordingMiddleware) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	*im.log = append(*im.log, im.name)
+	return im.ICS4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+func (suite *StackTestSuite) TestStackBuilder() {
+	var (
+		builder       *ica.StackBuilder
+		channelKeeper porttypes.ICS4Wrapper
+		wrappers      []porttypes.ICS4Wrapper
+		log           []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success - no middleware", func() {
+				builder = ica.NewStackBuilder(icahost.NewIBCModule(suite.chainB.GetSimApp().ICAHostKeeper), channelKeeper)
+			}, true,
+		},
+		{
+			"base application is nil", func() {
+				builder = ica.NewStackBuilder(nil, wrappers[0])
+			}, false,
+		},
+		{
+			"base application ICS4Wrapper is nil", func() {
+				builder = ica.NewStackBuilder(icahost.NewIBCModule(suite.chainB.GetSimApp().ICAHostKeeper), nil)
+			}, false,
+		},
+		{
+			"channel keeper is nil", func() {
+				channelKeeper = nil
+			}, false,
+		},
+		{
+			"middleware constructor is nil", func() {
+				builder.Use(nil, &mockICS4Wrapper{})
+			}, false,
+		},
+		{
+			"middleware ICS4Wrapper is nil", func() {
+				builder.Use(newRecordingMiddlewareConstructor("layer-3", channelKeeper, &log), nil)
+			}, false,
+		},
+		{
+			"middleware constructor returns nil", func() {
+				builder.Use(func(porttypes.IBCModule) porttypes.Middleware { return nil }, &mockICS4Wrapper{})
+			}, false,
+		},
+		{
+			"base application bypasses the middleware", func() {
+				builder = ica.NewStackBuilder(icahost.NewIBCModule(suite.chainB.GetSimApp().ICAHostKeeper), channelKeeper).
+					Use(newRecordingMiddlewareConstructor("layer-0", channelKeeper, &log), channelKeeper)
+			}, false,
+		},
+		{
+			"outermost middleware does not send through the channel keeper", func() {
+				builder.Use(newRecordingMiddlewareConstructor("layer-3", &mockICS4Wrapper{}, &log), &mockICS4Wrapper{})
+			}, false,
+		},
+		{
+			"middleware layers send through the same ICS4Wrapper", func() {
+				builder = ica.NewStackBuilder(icahost.NewIBCModule(suite.chainB.GetSimApp().ICAHostKeeper), wrappers[0]).
+					Use(newRecordingMiddlewareConstructor("layer-0", wrappers[0], &log), wrappers[0]).
+					Use(newRecordingMiddlewareConstructor("layer-1", channelKeeper, &log), channelKeeper)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			channelKeeper = suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper
+			wrappers = []porttypes.ICS4Wrapper{&mockICS4Wrapper{}, &mockICS4Wrapper{}, &mockICS4Wrapper{}}
+
+			builder = ica.NewStackBuilder(icahost.NewIBCModule(suite.chainB.GetSimApp().ICAHostKeeper), wrappers[0]).
+				Use(newRecordingMiddlewareConstructor("layer-0", wrappers[1], &log), wrappers[1]).
+				Use(newRecordingMiddlewareConstructor("layer-1", wrappers[2], &log), wrappers[2]).
+				Use(newRecordingMiddlewareConstructor("layer-2", channelKeeper, &log), channelKeeper)
+
+			tc.malleate() // malleate mutates test data
+
+			app, err := builder.Build(channelKeeper)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(app)
+				suite.Require().NotPanics(func() { builder.MustBuild(channelKeeper) })
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(app)
+				suite.Require().Panics(func() { builder.MustBuild(channelKeeper) })
+			}
+		})
+	}
+}
+
+// TestStackEndToEnd executes an interchain account transaction on the host chain through a three layer stack
+func (suite *StackTestSuite) TestStackEndToEnd() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = icatypes.VersionPrefix
+	suite.coordinator.SetupConnections(path)
+
+	portID, err := icatypes.GeneratePortID(TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	suite.Require().NoError(err)
+
+	moduleAccAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(icatypes.ModuleName)
+	path.EndpointB.ChannelConfig.Version = icatypes.NewAppVersion(icatypes.VersionPrefix, icatypes.GenerateAddress(moduleAccAddr, portID).String())
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.InitInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// commit state changes for proof verification
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	var log []string
+	channelKeeper := suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper
+	wrappers := []porttypes.ICS4Wrapper{&mockICS4Wrapper{}, &mockICS4Wrapper{}, &mockICS4Wrapper{}}
+
+	stack, err := ica.NewStackBuilder(icahost.NewIBCModule(suite.chainB.GetSimApp().ICAHostKeeper), wrappers[0]).
+		Use(newRecordingMiddlewareConstructor("layer-0", wrappers[1], &log), wrappers[1]).
+		Use(newRecordingMiddlewareConstructor("layer-1", wrappers[2], &log), wrappers[2]).
+		Use(newRecordingMiddlewareConstructor("layer-2", channelKeeper, &log), channelKeeper).
+		Build(channelKeeper)
+	suite.Require().NoError(err)
+
+	// fund the interchain account
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), portID)
+	suite.Require().True(found)
+
+	amount, err := sdk.ParseCoinsNormalized("100stake")
+	suite.Require().NoError(err)
+
+	_, err = suite.chainB.SendMsgs(&banktypes.MsgSend{FromAddress: suite.chainB.SenderAccount.GetAddress().String(), ToAddress: interchainAccountAddr, Amount: amount})
+	suite.Require().NoError(err)
+
+	msg := &banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: amount}
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), hosttypes.NewParams(true, []string{sdk.MsgTypeURL(msg)}, false, nil, true, 0, false, nil, 0, 0))
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf, "")
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	packet := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+	ack := stack.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
+	suite.Require().True(ack.Success())
+	suite.Require().Equal([]string{"layer-2", "layer-1", "layer-0"}, log)
+
+	// the interchain account has sent its funds back
+	accAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), accAddr)
+	suite.Require().True(balance.IsZero())
+
+	// the host application rejects channel closure initiated by the user through each layer of the stack
+	log = nil
+	err = stack.OnChanCloseInit(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().Error(err)
+	suite.Require().Equal([]string{"layer-2", "layer-1", "layer-0"}, log)
+
+	// packets sent by the outermost layer are passed to core IBC
+	log = nil
+	outermost, ok := stack.(porttypes.Middleware)
+	suite.Require().True(ok)
+
+	chanCap, ok := suite.chainB.GetSimApp().ScopedICAHostKeeper.GetCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	suite.Require().True(ok)
+
+	packet = channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	suite.Require().NoError(outermost.SendPacket(suite.chainB.GetContext(), chanCap, packet))
+	suite.Require().Equal([]string{"layer-2"}, log)
+}
@@ -7,6 +7,7 @@ import (
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	yaml "gopkg.in/yaml.v2"
@@ -31,12 +32,35 @@ type interchainAccountPretty struct {
 	AccountOwner  string         `json:"account_owner" yaml:"account_owner"`
 }
 
+// AddressGenerator defines the function used to derive an interchain account address from the host chain's
+// interchain accounts module account address and the controller chain's port identifier
+type AddressGenerator func(moduleAccAddr sdk.AccAddress, portID string) sdk.AccAddress
+
+var _ AddressGenerator = GenerateAddress
+
 // GenerateAddress returns an sdk.AccAddress derived using the provided module account address and port identifier.
 // The sdk.AccAddress returned is a sub-address of the module account, using the controller chain's port identifier as the derivation key
 func GenerateAddress(moduleAccAddr sdk.AccAddress, portID string) sdk.AccAddress {
 	return sdk.AccAddress(sdkaddress.Derive(moduleAccAddr, []byte(portID)))
 }
 
+// ValidateDerivedAccountAddress asserts that the provided bech32 account address was derived by the provided AddressGenerator
+// using the host chain's interchain accounts module account address and the controller chain's port identifier.
+// The bech32 human readable prefix is ignored as it may differ between the controller and host chains
+func ValidateDerivedAccountAddress(generator AddressGenerator, portID, accAddr string) error {
+	_, bz, err := bech32.DecodeAndConvert(accAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAccountAddress, "failed to decode account address %s: %s", accAddr, err)
+	}
+
+	expAddr := generator(authtypes.NewModuleAddress(ModuleName), portID)
+	if !expAddr.Equals(sdk.AccAddress(bz)) {
+		return sdkerrors.Wrapf(ErrInvalidAccountAddress, "account address %s was not derived from port ID %s", accAddr, portID)
+	}
+
+	return nil
+}
+
 // NewInterchainAccount creates and returns a new InterchainAccount type
 func NewInterchainAccount(ba *authtypes.BaseAccount, accountOwner string) *InterchainAccount {
 	return &InterchainAccount{
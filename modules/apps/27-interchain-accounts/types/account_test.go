@@ -49,6 +49,44 @@ func (suite *TypesTestSuite) TestGenerateAddress() {
 	suite.Require().NotEmpty(accAddr)
 }
 
+func (suite *TypesTestSuite) TestValidateDerivedAccountAddress() {
+	moduleAccAddr := authtypes.NewModuleAddress(types.ModuleName)
+	accAddr := types.GenerateAddress(moduleAccAddr, TestPortID)
+
+	customGenerator := func(moduleAccAddr sdk.AccAddress, portID string) sdk.AccAddress {
+		return types.GenerateAddress(moduleAccAddr, fmt.Sprintf("custom/%s", portID))
+	}
+
+	testCases := []struct {
+		name      string
+		generator types.AddressGenerator
+		portID    string
+		address   string
+		expPass   bool
+	}{
+		{"success", types.GenerateAddress, TestPortID, accAddr.String(), true},
+		{"success: different bech32 prefix", types.GenerateAddress, TestPortID, sdk.MustBech32ifyAddressBytes("osmo", accAddr), true},
+		{"success: custom generator", customGenerator, TestPortID, customGenerator(moduleAccAddr, TestPortID).String(), true},
+		{"generator mismatch", customGenerator, TestPortID, accAddr.String(), false},
+		{"port ID mismatch", types.GenerateAddress, "invalid-port-id", accAddr.String(), false},
+		{"invalid bech32 address", types.GenerateAddress, TestPortID, "invalid-address", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := types.ValidateDerivedAccountAddress(tc.generator, tc.portID, tc.address)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestInterchainAccount() {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// AcknowledgementResult defines the typed result of an interchain accounts packet acknowledgement
+type AcknowledgementResult struct {
+	// Success is true if the packet was successfully executed on the host chain
+	Success bool
+	// Result contains the result bytes of a successful acknowledgement
+	Result []byte
+	// Error contains the error string of a failed acknowledgement
+	Error string
+}
+
+// ParseAcknowledgement decodes the provided JSON encoded channel acknowledgement envelope into an AcknowledgementResult.
+// An error is returned if the acknowledgement cannot be decoded or does not contain exactly one of a result or an error
+func ParseAcknowledgement(ack []byte) (AcknowledgementResult, error) {
+	var acknowledgement channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement); err != nil {
+		return AcknowledgementResult{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot unmarshal interchain accounts packet acknowledgement: %v", err)
+	}
+
+	if err := acknowledgement.ValidateBasic(); err != nil {
+		return AcknowledgementResult{}, err
+	}
+
+	switch resp := acknowledgement.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		return AcknowledgementResult{Success: true, Result: resp.Result}, nil
+	case *channeltypes.Acknowledgement_Error:
+		return AcknowledgementResult{Success: false, Error: resp.Error}, nil
+	default:
+		return AcknowledgementResult{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "unsupported acknowledgement response field type %T", resp)
+	}
+}
+
+// GetTxMsgData decodes the result of a successful acknowledgement into an sdk.TxMsgData containing
+// one entry per message executed on the host chain
+func (ar AcknowledgementResult) GetTxMsgData() (*sdk.TxMsgData, error) {
+	if !ar.Success {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot decode result of error acknowledgement: %s", ar.Error)
+	}
+
+	txMsgData := &sdk.TxMsgData{}
+	if err := proto.Unmarshal(ar.Result, txMsgData); err != nil {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement result into tx message data: %v", err)
+	}
+
+	return txMsgData, nil
+}
+
+// GetTxEvents decodes the result of a successful acknowledgement for a packet of type EXECUTE_TX_WITH_EVENTS
+// into the TxEvents emitted by the messages executed on the host chain
+func (ar AcknowledgementResult) GetTxEvents() (TxEvents, error) {
+	if !ar.Success {
+		return TxEvents{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot decode result of error acknowledgement: %s", ar.Error)
+	}
+
+	var txEvents TxEvents
+	if err := ModuleCdc.UnmarshalJSON(ar.Result, &txEvents); err != nil {
+		return TxEvents{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement result into tx events: %v", err)
+	}
+
+	return txEvents, nil
+}
+
+// GetTxQueryResult decodes the result of a successful acknowledgement for a packet carrying a query into the
+// TxQueryResult containing the result of the executed transaction and the response of the query
+func (ar AcknowledgementResult) GetTxQueryResult() (TxQueryResult, error) {
+	if !ar.Success {
+		return TxQueryResult{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot decode result of error acknowledgement: %s", ar.Error)
+	}
+
+	var txQueryResult TxQueryResult
+	if err := ModuleCdc.UnmarshalJSON(ar.Result, &txQueryResult); err != nil {
+		return TxQueryResult{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement result into tx query result: %v", err)
+	}
+
+	return txQueryResult, nil
+}
+
+// GetTxPartialResult decodes the result of a successful acknowledgement for a packet executed with continue on error
+// into the TxPartialResult containing the result of each message executed on the host chain
+func (ar AcknowledgementResult) GetTxPartialResult() (TxPartialResult, error) {
+	if !ar.Success {
+		return TxPartialResult{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot decode result of error acknowledgement: %s", ar.Error)
+	}
+
+	var txPartialResult TxPartialResult
+	if err := ModuleCdc.UnmarshalJSON(ar.Result, &txPartialResult); err != nil {
+		return TxPartialResult{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement result into tx partial result: %v", err)
+	}
+
+	return txPartialResult, nil
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *TypesTestSuite) TestParseAcknowledgement() {
+	txMsgData := &sdk.TxMsgData{
+		Data: []*sdk.MsgData{
+			{MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{}), Data: []byte("first")},
+			{MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{}), Data: []byte("second")},
+		},
+	}
+
+	txMsgDataBz, err := proto.Marshal(txMsgData)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		ack          []byte
+		expResult    types.AcknowledgementResult
+		expTxMsgData *sdk.TxMsgData
+		expPass      bool
+	}{
+		{
+			"success: result acknowledgement",
+			channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+			types.AcknowledgementResult{Success: true, Result: []byte{byte(1)}},
+			nil,
+			true,
+		},
+		{
+			"success: multi-message result acknowledgement",
+			channeltypes.NewResultAcknowledgement(txMsgDataBz).Acknowledgement(),
+			types.AcknowledgementResult{Success: true, Result: txMsgDataBz},
+			txMsgData,
+			true,
+		},
+		{
+			"success: error acknowledgement",
+			channeltypes.NewErrorAcknowledgement("execution failed").Acknowledgement(),
+			types.AcknowledgementResult{Success: false, Error: "execution failed"},
+			nil,
+			true,
+		},
+		{
+			"malformed acknowledgement",
+			[]byte("malformed"),
+			types.AcknowledgementResult{},
+			nil,
+			false,
+		},
+		{
+			"empty acknowledgement",
+			[]byte{},
+			types.AcknowledgementResult{},
+			nil,
+			false,
+		},
+		{
+			"acknowledgement without response",
+			[]byte("{}"),
+			types.AcknowledgementResult{},
+			nil,
+			false,
+		},
+		{
+			"empty error acknowledgement",
+			[]byte(`{"error":" "}`),
+			types.AcknowledgementResult{},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			result, err := types.ParseAcknowledgement(tc.ack)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+
+				txMsgData, err := result.GetTxMsgData()
+				if tc.expTxMsgData != nil {
+					suite.Require().NoError(err)
+					suite.Require().Equal(tc.expTxMsgData, txMsgData)
+				} else {
+					suite.Require().Error(err)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -30,9 +32,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
-// packed into Any's and inserted into the Messages field of a CosmosTx. The proto marshaled CosmosTx
-// bytes are returned.
-func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err error) {
+// packed into Any's and inserted into the Messages field of a CosmosTx. The CosmosTx is marshaled
+// using the provided encoding format, which must be one of the SupportedEncodings, and compressed
+// using the provided compression format, which must be empty or one of the SupportedCompressions.
+func SerializeCosmosTx(cdc codec.Codec, msgs []sdk.Msg, encoding, compression string) (bz []byte, err error) {
 	msgAnys := make([]*codectypes.Any, len(msgs))
 
 	for i, msg := range msgs {
@@ -46,22 +49,72 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err er
 		Messages: msgAnys,
 	}
 
-	bz, err = cdc.Marshal(cosmosTx)
+	switch encoding {
+	case EncodingProtobuf:
+		bz, err = cdc.Marshal(cosmosTx)
+	case EncodingProto3JSON:
+		bz, err = cdc.MarshalJSON(cosmosTx)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return CompressData(bz, compression)
+}
+
+// DeserializeCosmosTxStrict deserializes the transaction bytes in the same manner as DeserializeCosmosTx, rejecting
+// protobuf encoded transaction bytes which contain fields unknown to the provided codec, including within the packed
+// sdk messages. Proto3 JSON encoded transaction bytes always reject unknown fields. The codec must be a protobuf codec.
+func DeserializeCosmosTxStrict(cdc codec.Codec, data []byte, encoding, compression string) ([]sdk.Msg, error) {
+	if encoding != EncodingProtobuf {
+		return DeserializeCosmosTx(cdc, data, encoding, compression)
+	}
+
+	protoCdc, ok := cdc.(codec.ProtoCodecMarshaler)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "strict decoding requires a protobuf codec")
+	}
+
+	bz, err := DecompressData(data, compression, MaxPacketDataLength)
 	if err != nil {
 		return nil, err
 	}
 
-	return bz, nil
+	if err := unknownproto.RejectUnknownFieldsStrict(bz, &CosmosTx{}, protoCdc.InterfaceRegistry()); err != nil {
+		return nil, sdkerrors.Wrap(ErrUnknownFields, err.Error())
+	}
+
+	// the transaction bytes are already decompressed
+	return DeserializeCosmosTx(cdc, bz, encoding, "")
 }
 
-// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes
-// into a slice of sdk.Msg's.
-func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error) {
+// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes encoded using the provided
+// encoding format and compressed using the provided compression format into a slice of sdk.Msg's.
+// Compressed transaction bytes may not exceed MaxPacketDataLength once decompressed.
+func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding, compression string) ([]sdk.Msg, error) {
 	var cosmosTx CosmosTx
-	if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
+
+	data, err := DecompressData(data, compression, MaxPacketDataLength)
+	if err != nil {
 		return nil, err
 	}
 
+	switch encoding {
+	case EncodingProtobuf:
+		if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, err
+		}
+	case EncodingProto3JSON:
+		if err := cdc.UnmarshalJSON(data, &cosmosTx); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
 	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
 
 	for i, any := range cosmosTx.Messages {
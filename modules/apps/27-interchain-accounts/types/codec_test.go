@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -16,6 +17,9 @@ type caseRawBytes struct {
 	expPass bool
 }
 
+// unknownField is the protobuf encoding of a varint field with a field number unknown to every message used in testing
+var unknownField = []byte{0xa0, 0x06, 0x01}
+
 // mockSdkMsg defines a mock struct, used for testing codec error scenarios
 type mockSdkMsg struct{}
 
@@ -109,22 +113,118 @@ func (suite *TypesTestSuite) TestSerializeCosmosTx() {
 		},
 	}
 
-	testCasesAny := []caseRawBytes{}
+	for _, compression := range []string{"", types.CompressionGzip} {
+		for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+			testCasesAny := []caseRawBytes{}
+
+			for _, tc := range testCases {
+				bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs, encoding, compression)
+				if encoding == types.EncodingProto3JSON && !tc.expPass {
+					// unregistered msg types cannot be resolved when encoding to JSON
+					suite.Require().Error(err, tc.name)
+					continue
+				}
+
+				suite.Require().NoError(err, tc.name)
+
+				testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
+			}
+
+			for i, tc := range testCasesAny {
+				msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz, encoding, compression)
+				if tc.expPass {
+					suite.Require().NoError(err, tc.name)
+					suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
+				} else {
+					suite.Require().Error(err, tc.name)
+				}
+			}
+		}
+	}
+
+	// unsupported encoding formats are rejected
+	_, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, testCases[0].msgs, "amino", "")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte{}, "amino", "")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	// the encoding used to deserialize must match the encoding used to serialize
+	bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, testCases[0].msgs, types.EncodingProtobuf, "")
+	suite.Require().NoError(err)
+
+	_, err = types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, bz, types.EncodingProto3JSON, "")
+	suite.Require().Error(err)
+}
+
+func (suite *TypesTestSuite) TestDeserializeCosmosTxStrict() {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	msg := &banktypes.MsgSend{
+		FromAddress: TestOwnerAddress,
+		ToAddress:   TestOwnerAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+	}
+
+	bz, err := types.SerializeCosmosTx(cdc, []sdk.Msg{msg}, types.EncodingProtobuf, "")
+	suite.Require().NoError(err)
 
-	for _, tc := range testCases {
-		bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs)
-		suite.Require().NoError(err, tc.name)
+	msgBz, err := cdc.Marshal(msg)
+	suite.Require().NoError(err)
 
-		testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
+	msgWithUnknownFieldBz, err := cdc.Marshal(&types.CosmosTx{
+		Messages: []*codectypes.Any{
+			{
+				TypeUrl: sdk.MsgTypeURL(msg),
+				Value:   append(msgBz, unknownField...),
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	testCases := []caseRawBytes{
+		{
+			"transaction without unknown fields",
+			bz,
+			true,
+		},
+		{
+			"transaction with unknown field",
+			append(append([]byte{}, bz...), unknownField...),
+			false,
+		},
+		{
+			"sdk message with unknown field",
+			msgWithUnknownFieldBz,
+			false,
+		},
 	}
 
-	for i, tc := range testCasesAny {
-		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz)
-		if tc.expPass {
+	for _, compression := range []string{"", types.CompressionGzip} {
+		for _, tc := range testCases {
+			data, err := types.CompressData(tc.bz, compression)
+			suite.Require().NoError(err)
+
+			// unknown fields are discarded when decoding leniently
+			msgs, err := types.DeserializeCosmosTx(cdc, data, types.EncodingProtobuf, compression)
 			suite.Require().NoError(err, tc.name)
-			suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
-		} else {
-			suite.Require().Error(err, tc.name)
+			suite.Require().Equal([]sdk.Msg{msg}, msgs, tc.name)
+
+			msgs, err = types.DeserializeCosmosTxStrict(cdc, data, types.EncodingProtobuf, compression)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal([]sdk.Msg{msg}, msgs, tc.name)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrUnknownFields, tc.name)
+			}
 		}
 	}
+
+	// proto3 JSON encoded transactions are decoded as usual
+	bz, err = types.SerializeCosmosTx(cdc, []sdk.Msg{msg}, types.EncodingProto3JSON, "")
+	suite.Require().NoError(err)
+
+	msgs, err := types.DeserializeCosmosTxStrict(cdc, bz, types.EncodingProto3JSON, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Msg{msg}, msgs)
 }
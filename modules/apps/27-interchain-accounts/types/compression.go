@@ -0,0 +1,66 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CompressData compresses the provided data using the provided compression format, which must be one of the
+// SupportedCompressions. The data is returned unmodified if no compression format is provided.
+func CompressData(data []byte, compression string) ([]byte, error) {
+	switch compression {
+	case "":
+		return data, nil
+	case CompressionGzip:
+		var buf bytes.Buffer
+
+		writer, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported compression format %s", compression)
+	}
+}
+
+// DecompressData decompresses the provided data using the provided compression format, which must be one of the
+// SupportedCompressions. The data is returned unmodified if no compression format is provided. An error is returned
+// if the decompressed data exceeds maxLength bytes, the decompressed data is never buffered beyond this limit.
+func DecompressData(data []byte, compression string, maxLength int) ([]byte, error) {
+	switch compression {
+	case "":
+		return data, nil
+	case CompressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidCodec, "failed to decompress data: %s", err)
+		}
+		defer reader.Close()
+
+		bz, err := io.ReadAll(io.LimitReader(reader, int64(maxLength)+1))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidCodec, "failed to decompress data: %s", err)
+		}
+
+		if len(bz) > maxLength {
+			return nil, sdkerrors.Wrapf(ErrInvalidOutgoingData, "decompressed data exceeds maximum length of %d bytes", maxLength)
+		}
+
+		return bz, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported compression format %s", compression)
+	}
+}
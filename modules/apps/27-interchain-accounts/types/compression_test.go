@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *TypesTestSuite) TestCompressData() {
+	data := bytes.Repeat([]byte("interchain accounts "), 100)
+
+	compressed, err := types.CompressData(data, types.CompressionGzip)
+	suite.Require().NoError(err)
+	suite.Require().Less(len(compressed), len(data))
+
+	decompressed, err := types.DecompressData(compressed, types.CompressionGzip, types.MaxPacketDataLength)
+	suite.Require().NoError(err)
+	suite.Require().Equal(data, decompressed)
+
+	// data is returned unmodified if no compression format is provided
+	bz, err := types.CompressData(data, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(data, bz)
+
+	bz, err = types.DecompressData(data, "", types.MaxPacketDataLength)
+	suite.Require().NoError(err)
+	suite.Require().Equal(data, bz)
+
+	// unsupported compression formats are rejected
+	_, err = types.CompressData(data, "zstd")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DecompressData(data, "zstd", types.MaxPacketDataLength)
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	// data which is not gzip compressed is rejected
+	_, err = types.DecompressData(data, types.CompressionGzip, types.MaxPacketDataLength)
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	// truncated gzip data is rejected
+	_, err = types.DecompressData(compressed[:len(compressed)/2], types.CompressionGzip, types.MaxPacketDataLength)
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	// decompressed data may be exactly the maximum length
+	decompressed, err = types.DecompressData(compressed, types.CompressionGzip, len(data))
+	suite.Require().NoError(err)
+	suite.Require().Equal(data, decompressed)
+
+	_, err = types.DecompressData(compressed, types.CompressionGzip, len(data)-1)
+	suite.Require().ErrorIs(err, types.ErrInvalidOutgoingData)
+}
+
+func (suite *TypesTestSuite) TestDeserializeCosmosTxDecompressionBomb() {
+	// highly compressible data exceeding the maximum packet data length once decompressed
+	bomb, err := types.CompressData(make([]byte, 16*types.MaxPacketDataLength), types.CompressionGzip)
+	suite.Require().NoError(err)
+	suite.Require().Less(len(bomb), types.MaxPacketDataLength/16)
+
+	_, err = types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, bomb, types.EncodingProtobuf, types.CompressionGzip)
+	suite.Require().ErrorIs(err, types.ErrInvalidOutgoingData)
+}
+
+func BenchmarkSerializeCosmosTxCompression(b *testing.B) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	for _, numMsgs := range []int{1, 10, 100} {
+		msgs := make([]sdk.Msg, numMsgs)
+		for i := range msgs {
+			msgs[i] = &banktypes.MsgSend{
+				FromAddress: TestOwnerAddress,
+				ToAddress:   TestOwnerAddress,
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(i+1)))),
+			}
+		}
+
+		for _, compression := range []string{"", types.CompressionGzip} {
+			name := compression
+			if name == "" {
+				name = "none"
+			}
+
+			b.Run(fmt.Sprintf("msgs=%d/compression=%s", numMsgs, name), func(b *testing.B) {
+				var bz []byte
+				for i := 0; i < b.N; i++ {
+					var err error
+					if bz, err = types.SerializeCosmosTx(cdc, msgs, types.EncodingProto3JSON, compression); err != nil {
+						b.Fatal(err)
+					}
+				}
+
+				b.ReportMetric(float64(len(bz)), "bytes")
+			})
+		}
+	}
+}
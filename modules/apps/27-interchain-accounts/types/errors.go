@@ -17,4 +17,7 @@ var (
 	ErrInvalidVersion              = sdkerrors.Register(ModuleName, 11, "invalid interchain accounts version")
 	ErrInvalidAccountAddress       = sdkerrors.Register(ModuleName, 12, "invalid account address")
 	ErrUnsupported                 = sdkerrors.Register(ModuleName, 13, "interchain account does not support this action")
+	ErrInvalidCodec                = sdkerrors.Register(ModuleName, 14, "codec is not supported")
+	ErrInvalidMiddlewareStack      = sdkerrors.Register(ModuleName, 15, "invalid middleware stack")
+	ErrUnknownFields               = sdkerrors.Register(ModuleName, 16, "transaction contains unknown fields")
 )
@@ -0,0 +1,49 @@
+package types
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// MaxTxEventsLength defines the maximum combined length in bytes of the JSON encoded events included in the
+// acknowledgement of a packet of type EXECUTE_TX_WITH_EVENTS
+const MaxTxEventsLength = 8192
+
+// NewTxEvents converts the provided events into TxEvents. Events are included in the order they were emitted
+// until including the next event would exceed a combined JSON encoded length of maxLength bytes. Any remaining
+// events are omitted and Truncated is set to true.
+func NewTxEvents(events []abci.Event, maxLength int) TxEvents {
+	txEvents := TxEvents{
+		Events: []Event{},
+	}
+
+	var length int
+	for _, event := range events {
+		attributes := make([]EventAttribute, len(event.Attributes))
+		for i, attr := range event.Attributes {
+			attributes[i] = EventAttribute{
+				Key:   string(attr.Key),
+				Value: string(attr.Value),
+			}
+		}
+
+		txEvent := Event{
+			Type:       event.Type,
+			Attributes: attributes,
+		}
+
+		length += len(ModuleCdc.MustMarshalJSON(&txEvent))
+		if length > maxLength {
+			txEvents.Truncated = true
+			break
+		}
+
+		txEvents.Events = append(txEvents.Events, txEvent)
+	}
+
+	return txEvents
+}
+
+// GetBytes returns the JSON marshalled interchain account TxEvents.
+func (te TxEvents) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&te)
+}
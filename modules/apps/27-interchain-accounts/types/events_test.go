@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *TypesTestSuite) TestNewTxEvents() {
+	events := []abci.Event{
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: []byte("recipient"), Value: []byte("cosmos1recipient")}}},
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: []byte("sender"), Value: []byte("cosmos1sender")}}},
+	}
+
+	first := types.Event{Type: "transfer", Attributes: []types.EventAttribute{{Key: "recipient", Value: "cosmos1recipient"}}}
+	second := types.Event{Type: "message", Attributes: []types.EventAttribute{{Key: "sender", Value: "cosmos1sender"}}}
+	firstLength := len(types.ModuleCdc.MustMarshalJSON(&first))
+
+	testCases := []struct {
+		name      string
+		maxLength int
+		expEvents types.TxEvents
+	}{
+		{
+			"all events included",
+			types.MaxTxEventsLength,
+			types.TxEvents{Events: []types.Event{first, second}},
+		},
+		{
+			"events truncated",
+			firstLength,
+			types.TxEvents{Events: []types.Event{first}, Truncated: true},
+		},
+		{
+			"no events included",
+			0,
+			types.TxEvents{Events: []types.Event{}, Truncated: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			txEvents := types.NewTxEvents(events, tc.maxLength)
+			suite.Require().Equal(tc.expEvents, txEvents)
+
+			ack, err := types.ParseAcknowledgement(channeltypes.NewResultAcknowledgement(txEvents.GetBytes()).Acknowledgement())
+			suite.Require().NoError(err)
+
+			decoded, err := ack.GetTxEvents()
+			suite.Require().NoError(err)
+			suite.Require().Equal(txEvents, decoded)
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestGetTxEventsErrorAcknowledgement() {
+	ack, err := types.ParseAcknowledgement(channeltypes.NewErrorAcknowledgement("execution failed").Acknowledgement())
+	suite.Require().NoError(err)
+
+	_, err = ack.GetTxEvents()
+	suite.Require().Error(err)
+}
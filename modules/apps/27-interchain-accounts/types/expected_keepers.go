@@ -5,6 +5,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
@@ -21,13 +22,19 @@ type AccountKeeper interface {
 // ICS4Wrapper defines the expected ICS4Wrapper for middleware
 type ICS4Wrapper interface {
 	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+	WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement []byte) error
 }
 
 // ChannelKeeper defines the expected IBC channel keeper
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	HasPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) bool
+	GetPacketTimeout(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.PacketTimeout, bool)
+	GetPacketData(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool)
 	CounterpartyHops(ctx sdk.Context, channel channeltypes.Channel) ([]string, bool)
+	GetNextChannelSequence(ctx sdk.Context) uint64
 }
 
 // PortKeeper defines the expected IBC port keeper
@@ -35,3 +42,14 @@ type PortKeeper interface {
 	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
 	IsBound(ctx sdk.Context, portID string) bool
 }
+
+// ConnectionKeeper defines the expected IBC connection keeper
+type ConnectionKeeper interface {
+	GetConnection(ctx sdk.Context, connectionID string) (connection connectiontypes.ConnectionEnd, found bool)
+}
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -43,12 +45,13 @@ func DefaultControllerGenesis() ControllerGenesisState {
 }
 
 // NewControllerGenesisState creates a returns a new ControllerGenesisState instance
-func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
+func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params, labels []InterchainAccountLabel) ControllerGenesisState {
 	return ControllerGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Ports:              ports,
 		Params:             controllerParams,
+		Labels:             labels,
 	}
 }
 
@@ -80,6 +83,16 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	for _, label := range gs.Labels {
+		if err := host.PortIdentifierValidator(label.PortId); err != nil {
+			return err
+		}
+
+		if err := controllertypes.ValidateLabel(label.Label); err != nil {
+			return err
+		}
+	}
+
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
@@ -96,12 +109,14 @@ func DefaultHostGenesis() HostGenesisState {
 }
 
 // NewHostGenesisState creates a returns a new HostGenesisState instance
-func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params) HostGenesisState {
+func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params, readOnlyAccounts []string, accountAuthorizations []hosttypes.AccountAuthorizations) HostGenesisState {
 	return HostGenesisState{
-		ActiveChannels:     channels,
-		InterchainAccounts: accounts,
-		Port:               port,
-		Params:             hostParams,
+		ActiveChannels:        channels,
+		InterchainAccounts:    accounts,
+		Port:                  port,
+		Params:                hostParams,
+		ReadOnlyAccounts:      readOnlyAccounts,
+		AccountAuthorizations: accountAuthorizations,
 	}
 }
 
@@ -127,6 +142,26 @@ func (gs HostGenesisState) Validate() error {
 		}
 	}
 
+	for _, accAddr := range gs.ReadOnlyAccounts {
+		if err := ValidateAccountAddress(accAddr); err != nil {
+			return err
+		}
+	}
+
+	for _, accountAuthorizations := range gs.AccountAuthorizations {
+		if err := ValidateAccountAddress(accountAuthorizations.Address); err != nil {
+			return err
+		}
+
+		if len(accountAuthorizations.Authorizations) == 0 {
+			return sdkerrors.Wrapf(hosttypes.ErrInvalidAuthorization, "no authorizations defined for interchain account %s", accountAuthorizations.Address)
+		}
+
+		if err := hosttypes.ValidateAuthorizations(accountAuthorizations.Authorizations); err != nil {
+			return err
+		}
+	}
+
 	if err := host.PortIdentifierValidator(gs.Port); err != nil {
 		return err
 	}
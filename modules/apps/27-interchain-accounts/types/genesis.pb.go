@@ -84,6 +84,8 @@ type ControllerGenesisState struct {
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
 	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// labels defines the human-readable labels assigned to interchain accounts, keyed by controller port identifier
+	Labels []InterchainAccountLabel `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,12 +149,23 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetLabels() []InterchainAccountLabel {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
 	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
 	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// read_only_accounts defines the addresses of the interchain accounts registered in read-only mode
+	ReadOnlyAccounts []string `protobuf:"bytes,5,rep,name=read_only_accounts,json=readOnlyAccounts,proto3" json:"read_only_accounts,omitempty" yaml:"read_only_accounts"`
+	// account_authorizations defines the message authorizations of the interchain accounts restricted by them
+	AccountAuthorizations []types1.AccountAuthorizations `protobuf:"bytes,6,rep,name=account_authorizations,json=accountAuthorizations,proto3" json:"account_authorizations" yaml:"account_authorizations"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
@@ -216,6 +229,20 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
+func (m *HostGenesisState) GetReadOnlyAccounts() []string {
+	if m != nil {
+		return m.ReadOnlyAccounts
+	}
+	return nil
+}
+
+func (m *HostGenesisState) GetAccountAuthorizations() []types1.AccountAuthorizations {
+	if m != nil {
+		return m.AccountAuthorizations
+	}
+	return nil
+}
+
 // ActiveChannel contains a pairing of port ID and channel ID for an active interchain accounts channel
 type ActiveChannel struct {
 	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
@@ -322,12 +349,66 @@ func (m *RegisteredInterchainAccount) GetAccountAddress() string {
 	return ""
 }
 
+// InterchainAccountLabel contains a pairing of controller port ID and the label assigned to its interchain account
+type InterchainAccountLabel struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	Label  string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *InterchainAccountLabel) Reset()         { *m = InterchainAccountLabel{} }
+func (m *InterchainAccountLabel) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountLabel) ProtoMessage()    {}
+func (*InterchainAccountLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_629b3ced0911516b, []int{5}
+}
+func (m *InterchainAccountLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountLabel.Merge(m, src)
+}
+func (m *InterchainAccountLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountLabel proto.InternalMessageInfo
+
+func (m *InterchainAccountLabel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InterchainAccountLabel) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.v1.GenesisState")
 	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.v1.ControllerGenesisState")
 	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.v1.HostGenesisState")
 	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.v1.ActiveChannel")
 	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*InterchainAccountLabel)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountLabel")
 }
 
 func init() {
@@ -335,46 +416,53 @@ func init() {
 }
 
 var fileDescriptor_629b3ced0911516b = []byte{
-	// 611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0x66, 0xbf, 0xfd, 0x54, 0x0f, 0xc6, 0x30, 0x63, 0x0a, 0x45, 0x4a, 0x8b, 0x2f,
-	0xab, 0x84, 0x96, 0x68, 0x7f, 0x60, 0x62, 0x17, 0xb4, 0x14, 0x04, 0xbb, 0xa1, 0x70, 0x41, 0x5c,
-	0x22, 0xd7, 0xb1, 0x52, 0x4b, 0x69, 0x1c, 0xc5, 0x5e, 0xa5, 0x9d, 0xb8, 0x73, 0x81, 0x1b, 0xe2,
-	0x8a, 0xc4, 0xfb, 0xe0, 0xb8, 0xe3, 0x8e, 0x9c, 0x2a, 0xd4, 0xbe, 0x83, 0xbe, 0x02, 0x64, 0x27,
-	0xea, 0x9f, 0xd0, 0x4d, 0xe1, 0xce, 0xa9, 0x76, 0xfc, 0x7c, 0xbf, 0xcf, 0xe7, 0xf1, 0xe3, 0x3e,
-	0xe0, 0x09, 0xeb, 0x11, 0x17, 0xa7, 0x69, 0xcc, 0x08, 0x96, 0x8c, 0x27, 0xc2, 0x65, 0x89, 0xa4,
-	0x19, 0xe9, 0x63, 0x96, 0x04, 0x98, 0x10, 0x7e, 0x9e, 0x48, 0xe1, 0x0e, 0xf7, 0xdd, 0x88, 0x26,
-	0x54, 0x30, 0xe1, 0xa4, 0x19, 0x97, 0x1c, 0xee, 0xb2, 0x1e, 0x71, 0x16, 0x65, 0xce, 0x0a, 0x99,
-	0x33, 0xdc, 0x6f, 0x6e, 0x47, 0x3c, 0xe2, 0x5a, 0xe3, 0xaa, 0x55, 0x2e, 0x6f, 0x76, 0x2b, 0x65,
-	0x25, 0x3c, 0x91, 0x19, 0x8f, 0x63, 0x9a, 0x29, 0x80, 0xf9, 0xae, 0x30, 0x39, 0xae, 0x64, 0xd2,
-	0xe7, 0x42, 0x2a, 0xb9, 0xfa, 0xcd, 0x85, 0xe8, 0x47, 0x1d, 0xdc, 0x7a, 0x95, 0x97, 0xf3, 0x56,
-	0x62, 0x49, 0xe1, 0x37, 0x03, 0x58, 0x73, 0xfb, 0xa0, 0x28, 0x35, 0x10, 0xea, 0xd0, 0x32, 0xda,
-	0x46, 0x67, 0xe3, 0xe0, 0xb9, 0x53, 0xb1, 0x62, 0xa7, 0x3b, 0x33, 0x5a, 0xcc, 0xe1, 0xed, 0x5e,
-	0x8e, 0x5a, 0xb5, 0xe9, 0xa8, 0xd5, 0xba, 0xc0, 0x83, 0xf8, 0x04, 0x5d, 0x97, 0x0e, 0xf9, 0x3b,
-	0x64, 0xa5, 0x01, 0xfc, 0x68, 0x00, 0xa8, 0x8a, 0x28, 0xe1, 0xd5, 0x35, 0xde, 0xb3, 0xca, 0x78,
-	0xaf, 0xb9, 0x90, 0x4b, 0x60, 0x8f, 0x0a, 0xb0, 0x07, 0x39, 0xd8, 0x9f, 0x29, 0x90, 0xbf, 0xd5,
-	0x2f, 0x89, 0xd0, 0x77, 0x13, 0xec, 0xac, 0x2e, 0x14, 0x7e, 0x00, 0x77, 0x30, 0x91, 0x6c, 0x48,
-	0x03, 0xd2, 0xc7, 0x49, 0x42, 0x63, 0x61, 0x19, 0x6d, 0xb3, 0xb3, 0x71, 0xf0, 0xb4, 0x32, 0xe3,
-	0xa9, 0xd6, 0x77, 0x73, 0xb9, 0x67, 0x17, 0x80, 0x3b, 0x39, 0x60, 0xc9, 0x1c, 0xf9, 0x9b, 0x78,
-	0x31, 0x5c, 0xc0, 0xaf, 0x06, 0xb8, 0xb7, 0xc2, 0xd8, 0xaa, 0x6b, 0x8a, 0x17, 0x95, 0x29, 0x7c,
-	0x1a, 0x31, 0x21, 0x69, 0x46, 0xc3, 0xb3, 0x59, 0xc0, 0x69, 0x7e, 0xee, 0xa1, 0x82, 0xa9, 0x99,
-	0x33, 0xad, 0x70, 0x40, 0x3e, 0x64, 0x65, 0x99, 0x80, 0xdb, 0xe0, 0xbf, 0x94, 0x67, 0x52, 0x58,
-	0x66, 0xdb, 0xec, 0x34, 0xfc, 0x7c, 0x03, 0xdf, 0x81, 0xf5, 0x14, 0x67, 0x78, 0x20, 0xac, 0x35,
-	0xdd, 0xcd, 0x93, 0x6a, 0x8c, 0x0b, 0xff, 0x88, 0xe1, 0xbe, 0xf3, 0x46, 0x3b, 0x78, 0x6b, 0x8a,
-	0xcc, 0x2f, 0xfc, 0xd0, 0x17, 0x13, 0x6c, 0x95, 0x3b, 0xfe, 0xaf, 0x43, 0x37, 0x75, 0x08, 0x82,
-	0x35, 0xd5, 0x14, 0xcb, 0x6c, 0x1b, 0x9d, 0x86, 0xaf, 0xd7, 0xd0, 0x2f, 0xf5, 0xe7, 0xa8, 0x1a,
-	0xa1, 0x1e, 0x39, 0xd7, 0x75, 0x26, 0x03, 0xb7, 0x97, 0x2e, 0x11, 0x3e, 0x06, 0xff, 0xab, 0x64,
-	0x01, 0x0b, 0xf5, 0xc8, 0x69, 0x78, 0x70, 0x3a, 0x6a, 0x6d, 0xe6, 0xf4, 0xc5, 0x01, 0xf2, 0xd7,
-	0xd5, 0xea, 0x2c, 0x84, 0x47, 0x00, 0x14, 0xd7, 0xab, 0xe2, 0xeb, 0x3a, 0xfe, 0xfe, 0x74, 0xd4,
-	0xba, 0x5b, 0x4c, 0x97, 0xd9, 0x19, 0xf2, 0x1b, 0xc5, 0xe6, 0x2c, 0x44, 0x9f, 0x0c, 0xf0, 0xf0,
-	0x86, 0x3b, 0xfb, 0x3b, 0x84, 0xae, 0x7a, 0x45, 0x5a, 0x17, 0xe0, 0x30, 0xcc, 0xa8, 0x10, 0x05,
-	0x47, 0x73, 0xf1, 0x25, 0x2c, 0x05, 0xe8, 0x97, 0xa0, 0xbf, 0x9c, 0xe6, 0x1f, 0xbc, 0xe0, 0x72,
-	0x6c, 0x1b, 0x57, 0x63, 0xdb, 0xf8, 0x35, 0xb6, 0x8d, 0xcf, 0x13, 0xbb, 0x76, 0x35, 0xb1, 0x6b,
-	0x3f, 0x27, 0x76, 0xed, 0xfd, 0xcb, 0x88, 0xc9, 0xfe, 0x79, 0xcf, 0x21, 0x7c, 0xe0, 0x12, 0x2e,
-	0x06, 0x5c, 0xb8, 0xac, 0x47, 0xf6, 0x22, 0xee, 0x0e, 0x0f, 0xdd, 0x01, 0x0f, 0xcf, 0x63, 0x2a,
-	0xd4, 0xf4, 0x17, 0xee, 0xc1, 0xf1, 0xde, 0xfc, 0xf6, 0xf7, 0x66, 0x83, 0x5f, 0x5e, 0xa4, 0x54,
-	0xf4, 0xd6, 0xf5, 0xc8, 0x3f, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xb9, 0x8d, 0x2d, 0xe8,
-	0x06, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xe3, 0xdc, 0x3e, 0x65, 0xfa, 0x51, 0xca, 0xd0, 0x46, 0x26, 0xa8, 0x49, 0x18, 0x09,
+	0x35, 0x12, 0xaa, 0xad, 0x5e, 0xa0, 0xa2, 0x1b, 0x54, 0x07, 0x04, 0x15, 0x48, 0x20, 0xb3, 0x41,
+	0x20, 0x64, 0x4d, 0x6c, 0x2b, 0xb1, 0xe4, 0x78, 0x22, 0xcf, 0x24, 0x52, 0xd8, 0xb0, 0x61, 0x81,
+	0xd8, 0xc0, 0x96, 0x25, 0x3c, 0x09, 0xcb, 0x2e, 0xbb, 0x64, 0x15, 0xa1, 0xf6, 0x0d, 0xfa, 0x04,
+	0x68, 0x2e, 0xcd, 0xad, 0x6e, 0xe5, 0xec, 0x59, 0x65, 0x26, 0x73, 0xce, 0x7f, 0x7e, 0x67, 0xce,
+	0x7f, 0x3c, 0xe0, 0x7e, 0xd0, 0x72, 0x4d, 0xdc, 0xeb, 0x85, 0x81, 0x8b, 0x59, 0x40, 0x22, 0x6a,
+	0x06, 0x11, 0xf3, 0x63, 0xb7, 0x83, 0x83, 0xc8, 0xc1, 0xae, 0x4b, 0xfa, 0x11, 0xa3, 0xe6, 0x60,
+	0xcb, 0x6c, 0xfb, 0x91, 0x4f, 0x03, 0x6a, 0xf4, 0x62, 0xc2, 0x08, 0xdc, 0x08, 0x5a, 0xae, 0x31,
+	0x9d, 0x66, 0x24, 0xa4, 0x19, 0x83, 0xad, 0xca, 0x6a, 0x9b, 0xb4, 0x89, 0xc8, 0x31, 0xf9, 0x48,
+	0xa6, 0x57, 0x9a, 0xa9, 0x76, 0x75, 0x49, 0xc4, 0x62, 0x12, 0x86, 0x7e, 0xcc, 0x01, 0x26, 0x33,
+	0x25, 0xb2, 0x97, 0x4a, 0xa4, 0x43, 0x28, 0xe3, 0xe9, 0xfc, 0x57, 0x26, 0xa2, 0x5f, 0x59, 0xf0,
+	0xff, 0x53, 0x59, 0xce, 0x6b, 0x86, 0x99, 0x0f, 0x7f, 0x6a, 0x40, 0x9f, 0xc8, 0x3b, 0xaa, 0x54,
+	0x87, 0xf2, 0x45, 0x5d, 0xab, 0x6b, 0x8d, 0xa5, 0xed, 0x47, 0x46, 0xca, 0x8a, 0x8d, 0xe6, 0x58,
+	0x68, 0x7a, 0x0f, 0x6b, 0xe3, 0x68, 0x54, 0xcb, 0x9c, 0x8d, 0x6a, 0xb5, 0x21, 0xee, 0x86, 0xfb,
+	0xe8, 0xb2, 0xed, 0x90, 0x5d, 0x76, 0x13, 0x05, 0xe0, 0x17, 0x0d, 0x40, 0x5e, 0xc4, 0x1c, 0x5e,
+	0x56, 0xe0, 0x3d, 0x4c, 0x8d, 0xf7, 0x8c, 0x50, 0x36, 0x03, 0x76, 0x47, 0x81, 0xdd, 0x92, 0x60,
+	0x17, 0xb7, 0x40, 0xf6, 0x4a, 0x67, 0x2e, 0x09, 0x7d, 0xca, 0x83, 0x72, 0x72, 0xa1, 0xf0, 0x23,
+	0xb8, 0x8e, 0x5d, 0x16, 0x0c, 0x7c, 0xc7, 0xed, 0xe0, 0x28, 0xf2, 0x43, 0xaa, 0x6b, 0xf5, 0x5c,
+	0x63, 0x69, 0xfb, 0x41, 0x6a, 0xc6, 0x03, 0x91, 0xdf, 0x94, 0xe9, 0x56, 0x55, 0x01, 0x96, 0x25,
+	0xe0, 0x9c, 0x38, 0xb2, 0x97, 0xf1, 0x74, 0x38, 0x85, 0xdf, 0x35, 0x70, 0x33, 0x41, 0x58, 0xcf,
+	0x0a, 0x8a, 0xc7, 0xa9, 0x29, 0x6c, 0xbf, 0x1d, 0x50, 0xe6, 0xc7, 0xbe, 0x77, 0x38, 0x0e, 0x38,
+	0x90, 0xeb, 0x16, 0x52, 0x4c, 0x15, 0xc9, 0x94, 0xa0, 0x80, 0x6c, 0x18, 0xcc, 0xa7, 0x51, 0xb8,
+	0x0a, 0x0a, 0x3d, 0x12, 0x33, 0xaa, 0xe7, 0xea, 0xb9, 0x46, 0xc9, 0x96, 0x13, 0xf8, 0x06, 0x14,
+	0x7b, 0x38, 0xc6, 0x5d, 0xaa, 0xe7, 0x45, 0x37, 0xf7, 0xd3, 0x31, 0x4e, 0xdd, 0x88, 0xc1, 0x96,
+	0xf1, 0x4a, 0x28, 0x58, 0x79, 0x4e, 0x66, 0x2b, 0x3d, 0xf8, 0x1e, 0x14, 0x43, 0xdc, 0xe2, 0x3d,
+	0x28, 0xd4, 0x73, 0x0b, 0xd9, 0xf8, 0x42, 0xcd, 0x2f, 0xb8, 0xce, 0xb9, 0xbc, 0x14, 0x45, 0x9f,
+	0x0b, 0x60, 0x65, 0xde, 0x50, 0xff, 0x0c, 0x70, 0x95, 0x01, 0x20, 0xc8, 0xf3, 0x9e, 0xeb, 0xb9,
+	0xba, 0xd6, 0x28, 0xd9, 0x62, 0x0c, 0xed, 0xb9, 0xf6, 0xef, 0xa6, 0x23, 0x14, 0x5f, 0xb4, 0xcb,
+	0x1a, 0xff, 0x1c, 0xc0, 0xd8, 0xc7, 0x9e, 0x43, 0xa2, 0x70, 0x38, 0x39, 0x01, 0x6e, 0x82, 0x92,
+	0xb5, 0x3e, 0xb9, 0xed, 0x17, 0x63, 0x90, 0xbd, 0xc2, 0xff, 0x7c, 0x19, 0x85, 0xc3, 0x31, 0xf4,
+	0x0f, 0x0d, 0x94, 0xd5, 0xba, 0x83, 0xfb, 0xac, 0x43, 0xe2, 0xe0, 0x83, 0x04, 0xd3, 0x8b, 0xe2,
+	0x4c, 0x9b, 0x8b, 0x11, 0x2b, 0xe1, 0x83, 0x19, 0x29, 0xeb, 0xae, 0x3a, 0xd2, 0xf5, 0xf3, 0x36,
+	0x27, 0x6d, 0x88, 0xec, 0x35, 0x9c, 0x94, 0x8d, 0x62, 0x70, 0x6d, 0xc6, 0x35, 0xf0, 0x1e, 0xf8,
+	0x8f, 0x9f, 0xae, 0x13, 0x78, 0xe2, 0x13, 0x5e, 0xb2, 0xe0, 0xd9, 0xa8, 0xb6, 0x2c, 0xb5, 0xd5,
+	0x02, 0xb2, 0x8b, 0x7c, 0x74, 0xe8, 0xc1, 0x5d, 0x00, 0x94, 0x9f, 0x78, 0x7c, 0x56, 0xc4, 0xaf,
+	0x9d, 0x8d, 0x6a, 0x37, 0x64, 0xfc, 0x64, 0x0d, 0xd9, 0x25, 0x35, 0x39, 0xf4, 0xd0, 0x57, 0x0d,
+	0xdc, 0xbe, 0xc2, 0x24, 0x8b, 0x21, 0x34, 0xf9, 0xb5, 0x51, 0x25, 0x7b, 0x5e, 0xec, 0x53, 0xaa,
+	0x38, 0x2a, 0xd3, 0xd6, 0x9f, 0x09, 0x10, 0xd6, 0x97, 0x87, 0xa1, 0xfe, 0x78, 0x07, 0xca, 0xc9,
+	0x17, 0x77, 0x31, 0x96, 0x55, 0x50, 0x10, 0x37, 0x5c, 0x12, 0xd8, 0x72, 0x62, 0x39, 0x47, 0x27,
+	0x55, 0xed, 0xf8, 0xa4, 0xaa, 0xfd, 0x39, 0xa9, 0x6a, 0xdf, 0x4e, 0xab, 0x99, 0xe3, 0xd3, 0x6a,
+	0xe6, 0xf7, 0x69, 0x35, 0xf3, 0xf6, 0x49, 0x3b, 0x60, 0x9d, 0x7e, 0xcb, 0x70, 0x49, 0xd7, 0x74,
+	0x09, 0xed, 0x12, 0x6a, 0x06, 0x2d, 0x77, 0xb3, 0x4d, 0xcc, 0xc1, 0x8e, 0xd9, 0x25, 0x5e, 0x3f,
+	0xf4, 0x29, 0x7f, 0xaa, 0xa9, 0xb9, 0xbd, 0xb7, 0x39, 0x71, 0xc6, 0xe6, 0xf8, 0x95, 0x66, 0xc3,
+	0x9e, 0x4f, 0x5b, 0x45, 0xf1, 0x3e, 0xef, 0xfc, 0x1d, 0x00, 0xd0, 0x70, 0x99, 0x32, 0x95, 0x08,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -440,6 +528,20 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -510,6 +612,29 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountAuthorizations) > 0 {
+		for iNdEx := len(m.AccountAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReadOnlyAccounts) > 0 {
+		for iNdEx := len(m.ReadOnlyAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReadOnlyAccounts[iNdEx])
+			copy(dAtA[i:], m.ReadOnlyAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReadOnlyAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -632,6 +757,43 @@ func (m *RegisteredInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *InterchainAccountLabel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountLabel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -682,6 +844,12 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -709,6 +877,18 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReadOnlyAccounts) > 0 {
+		for _, s := range m.ReadOnlyAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountAuthorizations) > 0 {
+		for _, e := range m.AccountAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -746,6 +926,23 @@ func (m *RegisteredInterchainAccount) Size() (n int) {
 	return n
 }
 
+func (m *InterchainAccountLabel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1030,6 +1227,40 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, InterchainAccountLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1213,6 +1444,72 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnlyAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadOnlyAccounts = append(m.ReadOnlyAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAuthorizations = append(m.AccountAuthorizations, types1.AccountAuthorizations{})
+			if err := m.AccountAuthorizations[len(m.AccountAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1462,6 +1759,120 @@ func (m *RegisteredInterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InterchainAccountLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
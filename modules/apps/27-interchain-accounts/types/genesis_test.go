@@ -87,7 +87,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -101,7 +101,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -122,7 +122,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -143,7 +143,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -164,7 +164,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -212,7 +212,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -226,7 +226,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -247,7 +247,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -268,7 +268,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -289,7 +289,45 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams(), nil, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate read-only accounts - invalid account address",
+			func() {
+				registeredAccounts := []types.RegisteredInterchainAccount{
+					{
+						PortId:         TestPortID,
+						AccountAddress: TestOwnerAddress,
+					},
+				}
+
+				genesisState = types.NewHostGenesisState(nil, registeredAccounts, types.PortID, hosttypes.DefaultParams(), []string{"invalid address"}, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate account authorizations - invalid account address",
+			func() {
+				accountAuthorizations := []hosttypes.AccountAuthorizations{{Address: "invalid address", Authorizations: []hosttypes.MessageAuthorization{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}}}}
+				genesisState = types.NewHostGenesisState(nil, nil, types.PortID, hosttypes.DefaultParams(), nil, accountAuthorizations)
+			},
+			false,
+		},
+		{
+			"failed to validate account authorizations - no authorizations",
+			func() {
+				accountAuthorizations := []hosttypes.AccountAuthorizations{{Address: TestOwnerAddress}}
+				genesisState = types.NewHostGenesisState(nil, nil, types.PortID, hosttypes.DefaultParams(), nil, accountAuthorizations)
+			},
+			false,
+		},
+		{
+			"failed to validate account authorizations - invalid authorization",
+			func() {
+				accountAuthorizations := []hosttypes.AccountAuthorizations{{Address: TestOwnerAddress, Authorizations: []hosttypes.MessageAuthorization{{TypeUrl: ""}}}}
+				genesisState = types.NewHostGenesisState(nil, nil, types.PortID, hosttypes.DefaultParams(), nil, accountAuthorizations)
 			},
 			false,
 		},
@@ -11,6 +11,9 @@ const (
 	// VersionPrefix defines the current version for interchain accounts
 	VersionPrefix = "ics27-1"
 
+	// versionIdentifier defines the identifier preceding the version number of interchain accounts versions
+	versionIdentifier = "ics27"
+
 	// PortID is the default port id that the interchain accounts module binds to
 	PortID = "interchain-account"
 
@@ -0,0 +1,252 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// EncodingProtobuf defines the protocol buffers proto3 encoding format
+	EncodingProtobuf = "proto3"
+
+	// EncodingProto3JSON defines the proto3 JSON encoding format
+	EncodingProto3JSON = "proto3json"
+
+	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
+	TxTypeSDKMultiMsg = "sdk_multi_msg"
+
+	// TxTypeSDKQueryOnly defines the multi message transaction type of read-only interchain accounts.
+	// Read-only interchain accounts may only execute the messages the host chain considers not to mutate state
+	TxTypeSDKQueryOnly = "sdk_query_only"
+
+	// CompressionGzip defines the gzip compression format
+	CompressionGzip = "gzip"
+)
+
+var (
+	// SupportedEncodings defines the encoding formats which interchain accounts host chains are able to decode
+	SupportedEncodings = []string{EncodingProtobuf, EncodingProto3JSON}
+
+	// SupportedTxTypes defines the transaction types which interchain accounts host chains are able to execute
+	SupportedTxTypes = []string{TxTypeSDKMultiMsg, TxTypeSDKQueryOnly}
+
+	// SupportedCompressions defines the compression formats which interchain accounts host chains are able to decompress
+	SupportedCompressions = []string{CompressionGzip}
+)
+
+// NewICAMetadata creates and returns a new ICS27 ICAMetadata instance
+func NewICAMetadata(version, controllerConnectionID, hostConnectionID, accAddress, encoding, txType string) ICAMetadata {
+	return ICAMetadata{
+		Version:                version,
+		ControllerConnectionId: controllerConnectionID,
+		HostConnectionId:       hostConnectionID,
+		Address:                accAddress,
+		Encoding:               encoding,
+		TxType:                 txType,
+	}
+}
+
+// NewDefaultICAMetadata creates and returns a new ICS27 ICAMetadata instance containing the default
+// version, encoding and transaction type for the provided controller and host connection identifiers
+func NewDefaultICAMetadata(controllerConnectionID, hostConnectionID string) ICAMetadata {
+	return NewICAMetadata(VersionPrefix, controllerConnectionID, hostConnectionID, "", EncodingProtobuf, TxTypeSDKMultiMsg)
+}
+
+// EncodeICAMetadata returns the JSON encoded ICAMetadata to be used as the channel version
+func EncodeICAMetadata(metadata ICAMetadata) string {
+	return string(ModuleCdc.MustMarshalJSON(&metadata))
+}
+
+// IsICAMetadataVersion returns true if the provided channel version is encoded as ICAMetadata.
+// Channel versions using the legacy <app-version>.<account-address> format return false.
+func IsICAMetadataVersion(version string) bool {
+	return strings.HasPrefix(strings.TrimSpace(version), "{")
+}
+
+// ParseICAMetadata attempts to decode the ICAMetadata from the provided JSON encoded channel version
+func ParseICAMetadata(version string) (ICAMetadata, error) {
+	var metadata ICAMetadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return ICAMetadata{}, sdkerrors.Wrapf(ErrInvalidVersion, "failed to unmarshal ICS27 metadata from version %s: %s", version, err)
+	}
+
+	return metadata, nil
+}
+
+// IsSupportedEncoding returns true if the provided encoding format is supported by interchain accounts host chains
+func IsSupportedEncoding(encoding string) bool {
+	for _, supported := range SupportedEncodings {
+		if encoding == supported {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSupportedCompression returns true if the provided compression format is supported by interchain accounts host chains
+func IsSupportedCompression(compression string) bool {
+	for _, supported := range SupportedCompressions {
+		if compression == supported {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSupportedTxType returns true if the provided transaction type is supported by interchain accounts host chains
+func IsSupportedTxType(txType string) bool {
+	for _, supported := range SupportedTxTypes {
+		if txType == supported {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBasic performs stateless validation of the ICAMetadata. The version must be well formed but need not be one
+// of the SupportedVersions, allowing the version proposed by a newer controller chain to be negotiated down.
+// The address and encoding may be omitted by the controller chain, in which case they are provided by the host chain
+// during version negotiation.
+// The compression may be omitted, in which case interchain account transactions are not compressed.
+func (metadata ICAMetadata) ValidateBasic() error {
+	if _, err := ParseVersionNumber(metadata.Version); err != nil {
+		return err
+	}
+
+	if err := host.ConnectionIdentifierValidator(metadata.ControllerConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid controller connection ID")
+	}
+
+	if err := host.ConnectionIdentifierValidator(metadata.HostConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid host connection ID")
+	}
+
+	if metadata.Address != "" {
+		if err := ValidateAccountAddress(metadata.Address); err != nil {
+			return err
+		}
+	}
+
+	if metadata.Encoding != "" && !IsSupportedEncoding(metadata.Encoding) {
+		return sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s, expected one of %s", metadata.Encoding, SupportedEncodings)
+	}
+
+	if metadata.Compression != "" && !IsSupportedCompression(metadata.Compression) {
+		return sdkerrors.Wrapf(ErrInvalidCodec, "unsupported compression format %s, expected one of %s", metadata.Compression, SupportedCompressions)
+	}
+
+	if !IsSupportedTxType(metadata.TxType) {
+		return sdkerrors.Wrapf(ErrUnsupported, "unsupported transaction type %s, expected one of %s", metadata.TxType, SupportedTxTypes)
+	}
+
+	return nil
+}
+
+// ValidateNegotiatedICAMetadata ensures the ICAMetadata negotiated by the host chain is complete and
+// consistent with the ICAMetadata proposed by the controller chain. The negotiated version may be lower than
+// the proposed version, see NegotiateVersion. The gas budget is assigned by the host chain if the controller chain
+// did not propose one
+func ValidateNegotiatedICAMetadata(proposed, negotiated ICAMetadata) error {
+	if err := negotiated.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if negotiated.Address == "" {
+		return sdkerrors.Wrap(ErrInvalidAccountAddress, "negotiated metadata must contain the interchain account address")
+	}
+
+	if negotiated.Encoding == "" {
+		return sdkerrors.Wrap(ErrInvalidCodec, "negotiated metadata must contain the encoding format")
+	}
+
+	if proposed.Encoding != "" && proposed.Encoding != negotiated.Encoding {
+		return sdkerrors.Wrapf(ErrInvalidCodec, "expected encoding format %s, got %s", proposed.Encoding, negotiated.Encoding)
+	}
+
+	if err := ValidateNegotiatedVersion(proposed.Version, negotiated.Version); err != nil {
+		return err
+	}
+
+	if proposed.ControllerConnectionId != negotiated.ControllerConnectionId {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected controller connection ID %s, got %s", proposed.ControllerConnectionId, negotiated.ControllerConnectionId)
+	}
+
+	if proposed.HostConnectionId != negotiated.HostConnectionId {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected host connection ID %s, got %s", proposed.HostConnectionId, negotiated.HostConnectionId)
+	}
+
+	if proposed.TxType != negotiated.TxType {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected transaction type %s, got %s", proposed.TxType, negotiated.TxType)
+	}
+
+	if proposed.Compression != negotiated.Compression {
+		return sdkerrors.Wrapf(ErrInvalidCodec, "expected compression format %s, got %s", proposed.Compression, negotiated.Compression)
+	}
+
+	if proposed.GasBudget != 0 && proposed.GasBudget != negotiated.GasBudget {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected gas budget %d, got %d", proposed.GasBudget, negotiated.GasBudget)
+	}
+
+	return nil
+}
+
+// NewCompatibleVersion returns the channel version a controller chain should propose to register an interchain account
+// of the provided transaction type on a host chain with the provided capabilities. The highest of the SupportedVersions
+// which is also supported by the host chain is proposed. A version encoded as ICAMetadata is preferred, using the first
+// of the SupportedEncodings which is also supported by the host chain. The legacy version format is selected if the
+// host chain does not support ICAMetadata, in which case only the multi message transaction type may be used. An error is returned if the host submodule is disabled or no compatible version exists.
+func NewCompatibleVersion(capabilities hosttypes.HostCapabilities, controllerConnectionID, hostConnectionID, txType string) (string, error) {
+	if !capabilities.HostEnabled {
+		return "", hosttypes.ErrHostSubModuleDisabled
+	}
+
+	var version string
+	for i := len(SupportedVersions) - 1; i >= 0; i-- {
+		if capabilities.SupportsVersion(SupportedVersions[i]) {
+			version = SupportedVersions[i]
+			break
+		}
+	}
+
+	if version == "" {
+		return "", sdkerrors.Wrapf(ErrInvalidVersion, "host chain does not support any of the versions %s, supported versions %s", SupportedVersions, capabilities.Versions)
+	}
+
+	if !IsSupportedTxType(txType) || !capabilities.SupportsTxType(txType) {
+		return "", sdkerrors.Wrapf(ErrUnsupported, "host chain does not support transaction type %s, supported transaction types %s", txType, capabilities.TxTypes)
+	}
+
+	if capabilities.SupportsVersionFormat(hosttypes.VersionFormatICAMetadata) {
+		for _, encoding := range SupportedEncodings {
+			if !capabilities.SupportsEncoding(encoding) {
+				continue
+			}
+
+			metadata := NewICAMetadata(version, controllerConnectionID, hostConnectionID, "", encoding, txType)
+			if err := metadata.ValidateBasic(); err != nil {
+				return "", err
+			}
+
+			return EncodeICAMetadata(metadata), nil
+		}
+
+		return "", sdkerrors.Wrapf(ErrInvalidCodec, "host chain does not support any of the encoding formats %s, supported encoding formats %s", SupportedEncodings, capabilities.Encodings)
+	}
+
+	if capabilities.SupportsVersionFormat(hosttypes.VersionFormatLegacy) {
+		if txType != TxTypeSDKMultiMsg {
+			return "", sdkerrors.Wrapf(ErrUnsupported, "transaction type %s requires the %s version format", txType, hosttypes.VersionFormatICAMetadata)
+		}
+
+		return version, nil
+	}
+
+	return "", sdkerrors.Wrapf(ErrInvalidVersion, "host chain does not support any of the version formats %s, supported version formats %s", hosttypes.SupportedVersionFormats, capabilities.VersionFormats)
+}
@@ -0,0 +1,309 @@
+package types_test
+
+import (
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *TypesTestSuite) TestParseICAMetadata() {
+	metadata := types.NewDefaultICAMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+	version := types.EncodeICAMetadata(metadata)
+
+	suite.Require().True(types.IsICAMetadataVersion(version))
+	suite.Require().False(types.IsICAMetadataVersion(types.VersionPrefix))
+	suite.Require().False(types.IsICAMetadataVersion(types.NewAppVersion(types.VersionPrefix, TestOwnerAddress)))
+
+	parsed, err := types.ParseICAMetadata(version)
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, parsed)
+
+	_, err = types.ParseICAMetadata("{invalid-metadata")
+	suite.Require().Error(err)
+}
+
+func (suite *TypesTestSuite) TestICAMetadataValidateBasic() {
+	var metadata types.ICAMetadata
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: account address provided", func() {
+				metadata.Address = TestOwnerAddress
+			}, true,
+		},
+		{
+			"success: encoding omitted", func() {
+				metadata.Encoding = ""
+			}, true,
+		},
+		{
+			"success: query only transaction type", func() {
+				metadata.TxType = types.TxTypeSDKQueryOnly
+			}, true,
+		},
+		{
+			"success: gzip compression", func() {
+				metadata.Compression = types.CompressionGzip
+			}, true,
+		},
+		{
+			"invalid version", func() {
+				metadata.Version = "invalid-version"
+			}, false,
+		},
+		{
+			"invalid controller connection ID", func() {
+				metadata.ControllerConnectionId = "invalid connection"
+			}, false,
+		},
+		{
+			"invalid host connection ID", func() {
+				metadata.HostConnectionId = ""
+			}, false,
+		},
+		{
+			"invalid account address", func() {
+				metadata.Address = "invalid account address"
+			}, false,
+		},
+		{
+			"unsupported encoding", func() {
+				metadata.Encoding = "amino"
+			}, false,
+		},
+		{
+			"unsupported compression", func() {
+				metadata.Compression = "zstd"
+			}, false,
+		},
+		{
+			"unsupported transaction type", func() {
+				metadata.TxType = "invalid-tx-type"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			metadata = types.NewDefaultICAMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+
+			tc.malleate() // malleate mutates test data
+
+			err := metadata.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestValidateNegotiatedICAMetadata() {
+	var proposed, negotiated types.ICAMetadata
+
+	supportedVersions := types.SupportedVersions
+	defer func() { types.SupportedVersions = supportedVersions }()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: encoding selected by host", func() {
+				proposed.Encoding = ""
+			}, true,
+		},
+		{
+			"account address missing", func() {
+				negotiated.Address = ""
+			}, false,
+		},
+		{
+			"encoding missing", func() {
+				proposed.Encoding = ""
+				negotiated.Encoding = ""
+			}, false,
+		},
+		{
+			"success: version negotiated down", func() {
+				proposed.Version = "ics27-2"
+			}, true,
+		},
+		{
+			"negotiated version exceeds proposed version", func() {
+				types.SupportedVersions = []string{types.VersionPrefix, "ics27-2"}
+				negotiated.Version = "ics27-2"
+			}, false,
+		},
+		{
+			"unsupported negotiated version", func() {
+				proposed.Version = "ics27-3"
+				negotiated.Version = "ics27-2"
+			}, false,
+		},
+		{
+			"controller connection ID mismatch", func() {
+				negotiated.ControllerConnectionId = "connection-1"
+			}, false,
+		},
+		{
+			"host connection ID mismatch", func() {
+				negotiated.HostConnectionId = "connection-1"
+			}, false,
+		},
+		{
+			"transaction type mismatch", func() {
+				proposed.TxType = "invalid-tx-type"
+			}, false,
+		},
+		{
+			"compression mismatch", func() {
+				proposed.Compression = types.CompressionGzip
+			}, false,
+		},
+		{
+			"success: gas budget selected by host", func() {
+				negotiated.GasBudget = 100000
+			}, true,
+		},
+		{
+			"gas budget mismatch", func() {
+				proposed.GasBudget = 100000
+				negotiated.GasBudget = 200000
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			types.SupportedVersions = supportedVersions
+			proposed = types.NewDefaultICAMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+			negotiated = proposed
+			negotiated.Address = TestOwnerAddress
+
+			tc.malleate() // malleate mutates test data
+
+			err := types.ValidateNegotiatedICAMetadata(proposed, negotiated)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestNewCompatibleVersion() {
+	var (
+		capabilities hosttypes.HostCapabilities
+		txType       string
+		expVersion   string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: ICAMetadata with default encoding", func() {}, true,
+		},
+		{
+			"success: ICAMetadata with query only transaction type", func() {
+				txType = types.TxTypeSDKQueryOnly
+
+				metadata := types.NewDefaultICAMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+				metadata.TxType = types.TxTypeSDKQueryOnly
+				expVersion = types.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"success: ICAMetadata with encoding supported by the host chain", func() {
+				capabilities.Encodings = []string{"amino", types.EncodingProto3JSON}
+
+				metadata := types.NewDefaultICAMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+				metadata.Encoding = types.EncodingProto3JSON
+				expVersion = types.EncodeICAMetadata(metadata)
+			}, true,
+		},
+		{
+			"success: legacy version format", func() {
+				capabilities.VersionFormats = []string{hosttypes.VersionFormatLegacy}
+				expVersion = types.VersionPrefix
+			}, true,
+		},
+		{
+			"host submodule is disabled", func() {
+				capabilities.HostEnabled = false
+			}, false,
+		},
+		{
+			"version is not supported", func() {
+				capabilities.Versions = []string{"ics27-2"}
+			}, false,
+		},
+		{
+			"transaction type is not supported by the host chain", func() {
+				capabilities.TxTypes = []string{types.TxTypeSDKMultiMsg}
+				txType = types.TxTypeSDKQueryOnly
+			}, false,
+		},
+		{
+			"transaction type is not supported by the controller chain", func() {
+				capabilities.TxTypes = append(capabilities.TxTypes, "unknown")
+				txType = "unknown"
+			}, false,
+		},
+		{
+			"no supported encoding format", func() {
+				capabilities.Encodings = []string{"amino"}
+			}, false,
+		},
+		{
+			"query only transaction type requires ICAMetadata", func() {
+				capabilities.VersionFormats = []string{hosttypes.VersionFormatLegacy}
+				txType = types.TxTypeSDKQueryOnly
+			}, false,
+		},
+		{
+			"no supported version format", func() {
+				capabilities.VersionFormats = []string{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			capabilities = suite.chainA.GetSimApp().ICAHostKeeper.GetHostCapabilities(suite.chainA.GetContext())
+			txType = types.TxTypeSDKMultiMsg
+			expVersion = types.EncodeICAMetadata(types.NewDefaultICAMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID))
+
+			tc.malleate()
+
+			version, err := types.NewCompatibleVersion(capabilities, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, txType)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expVersion, version)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
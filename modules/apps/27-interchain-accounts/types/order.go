@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// SupportedChannelOrderings defines the channel orderings supported by interchain accounts channels
+var SupportedChannelOrderings = []channeltypes.Order{channeltypes.ORDERED}
+
+// ValidateChannelOrdering returns an error if the provided channel ordering is not one of the supported channel orderings
+func ValidateChannelOrdering(order channeltypes.Order) error {
+	for _, supported := range SupportedChannelOrderings {
+		if order == supported {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected one of %s, got %s", SupportedChannelOrderings, order)
+}
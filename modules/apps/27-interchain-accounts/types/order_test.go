@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *TypesTestSuite) TestValidateChannelOrdering() {
+	testCases := []struct {
+		name    string
+		order   channeltypes.Order
+		expPass bool
+	}{
+		{"success: ORDERED", channeltypes.ORDERED, true},
+		{"unsupported ordering: UNORDERED", channeltypes.UNORDERED, false},
+		{"unsupported ordering: NONE", channeltypes.NONE, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.ValidateChannelOrdering(tc.order)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, channeltypes.ErrInvalidChannelOrdering)
+			}
+		})
+	}
+}
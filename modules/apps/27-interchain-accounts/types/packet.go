@@ -1,16 +1,36 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/gogo/protobuf/jsonpb"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
-const MaxMemoCharLength = 256
+const (
+	// MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
+	MaxMemoCharLength = 256
+
+	// MaxIdempotencyKeyLength defines the maximum length for the InterchainAccountPacketData idempotency key field
+	MaxIdempotencyKeyLength = 128
+
+	// MaxPacketDataLength defines the maximum length in bytes of the decompressed InterchainAccountPacketData data field
+	MaxPacketDataLength = 1024 * 1024
+
+	// MaxPacketPriority defines the maximum value for the InterchainAccountPacketData priority field
+	MaxPacketPriority = 10
+
+	// MaxEncryptedMemoLength defines the maximum length in bytes of the InterchainAccountPacketData encrypted memo field
+	MaxEncryptedMemoLength = 4096
+)
 
 // ValidateBasic performs basic validation of the interchain account packet data.
-// The memo may be empty.
+// The memo, idempotency key and encrypted memo may be empty and the query may be nil. Continue on error may only be
+// enabled for packets of type EXECUTE_TX. The encrypted memo is opaque and only its length is validated.
 func (iapd InterchainAccountPacketData) ValidateBasic() error {
 	if iapd.Type == UNSPECIFIED {
 		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data type cannot be unspecified")
@@ -24,12 +44,56 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
 	}
 
+	if len(iapd.IdempotencyKey) > MaxIdempotencyKeyLength {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data idempotency key cannot be greater than %d characters", MaxIdempotencyKeyLength)
+	}
+
+	if iapd.ContinueOnError && iapd.Type != EXECUTE_TX {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "continue on error is not supported for packet data type %s", iapd.Type)
+	}
+
+	if iapd.Priority > MaxPacketPriority {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data priority cannot be greater than %d", MaxPacketPriority)
+	}
+
+	if len(iapd.EncryptedMemo) > MaxEncryptedMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data encrypted memo cannot be greater than %d bytes", MaxEncryptedMemoLength)
+	}
+
+	if iapd.Query != nil {
+		if err := iapd.Query.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// GetBytes returns the JSON marshalled interchain account packet data.
+// GetBytes returns the JSON marshalled interchain account packet data. The memo is always emitted as in the initial
+// version of the packet data, while the fields added since are omitted when empty, such that packets which do not
+// specify them are encoded identically to packets predating the fields.
 func (iapd InterchainAccountPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err := marshaler.Marshal(&buf, &iapd); err != nil {
+		panic(err)
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+		panic(err)
+	}
+
+	if _, ok := fields["memo"]; !ok {
+		fields["memo"] = json.RawMessage(`""`)
+	}
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
 }
 
 // GetBytes returns the JSON marshalled interchain account CosmosTx.
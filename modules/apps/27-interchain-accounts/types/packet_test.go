@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"strings"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 )
 
@@ -56,6 +58,105 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			false,
 		},
+		{
+			"success, idempotency key",
+			types.InterchainAccountPacketData{
+				Type:           types.EXECUTE_TX,
+				Data:           []byte("data"),
+				IdempotencyKey: "key",
+			},
+			true,
+		},
+		{
+			"idempotency key too large",
+			types.InterchainAccountPacketData{
+				Type:           types.EXECUTE_TX,
+				Data:           []byte("data"),
+				IdempotencyKey: strings.Repeat("k", types.MaxIdempotencyKeyLength+1),
+			},
+			false,
+		},
+		{
+			"success, continue on error",
+			types.InterchainAccountPacketData{
+				Type:            types.EXECUTE_TX,
+				Data:            []byte("data"),
+				ContinueOnError: true,
+			},
+			true,
+		},
+		{
+			"continue on error not supported for packet data type",
+			types.InterchainAccountPacketData{
+				Type:            types.EXECUTE_TX_WITH_EVENTS,
+				Data:            []byte("data"),
+				ContinueOnError: true,
+			},
+			false,
+		},
+		{
+			"success, priority",
+			types.InterchainAccountPacketData{
+				Type:     types.EXECUTE_TX,
+				Data:     []byte("data"),
+				Priority: types.MaxPacketPriority,
+			},
+			true,
+		},
+		{
+			"priority too large",
+			types.InterchainAccountPacketData{
+				Type:     types.EXECUTE_TX,
+				Data:     []byte("data"),
+				Priority: types.MaxPacketPriority + 1,
+			},
+			false,
+		},
+		{
+			"success, encrypted memo",
+			types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_TX,
+				Data:          []byte("data"),
+				EncryptedMemo: make([]byte, types.MaxEncryptedMemoLength),
+			},
+			true,
+		},
+		{
+			"encrypted memo too large",
+			types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_TX,
+				Data:          []byte("data"),
+				EncryptedMemo: make([]byte, types.MaxEncryptedMemoLength+1),
+			},
+			false,
+		},
+		{
+			"success, query",
+			types.InterchainAccountPacketData{
+				Type:  types.EXECUTE_TX,
+				Data:  []byte("data"),
+				Query: types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", nil),
+			},
+			true,
+		},
+		{
+			"query path is not a fully qualified gRPC method name",
+			types.InterchainAccountPacketData{
+				Type:  types.EXECUTE_TX,
+				Data:  []byte("data"),
+				Query: types.NewQueryRequest("cosmos.bank.v1beta1.Query/Balance", nil),
+			},
+			false,
+		},
+		{
+			"empty query path",
+			types.InterchainAccountPacketData{
+				Type:  types.EXECUTE_TX,
+				Data:  []byte("data"),
+				Query: types.NewQueryRequest("", nil),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -73,3 +174,86 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestGetBytes() {
+	testCases := []struct {
+		name       string
+		packetData types.InterchainAccountPacketData
+		expBytes   string
+	}{
+		{
+			"default packet is encoded identically to packets predating the optional fields",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+			},
+			`{"data":"ZGF0YQ==","memo":"","type":"TYPE_EXECUTE_TX"}`,
+		},
+		{
+			"memo",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: "memo",
+			},
+			`{"data":"ZGF0YQ==","memo":"memo","type":"TYPE_EXECUTE_TX"}`,
+		},
+		{
+			"idempotency key",
+			types.InterchainAccountPacketData{
+				Type:           types.EXECUTE_TX,
+				Data:           []byte("data"),
+				IdempotencyKey: "key",
+			},
+			`{"data":"ZGF0YQ==","idempotency_key":"key","memo":"","type":"TYPE_EXECUTE_TX"}`,
+		},
+		{
+			"query",
+			types.InterchainAccountPacketData{
+				Type:  types.EXECUTE_TX,
+				Data:  []byte("data"),
+				Query: types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", []byte("query")),
+			},
+			`{"data":"ZGF0YQ==","memo":"","query":{"data":"cXVlcnk=","path":"/cosmos.bank.v1beta1.Query/Balance"},"type":"TYPE_EXECUTE_TX"}`,
+		},
+		{
+			"continue on error",
+			types.InterchainAccountPacketData{
+				Type:            types.EXECUTE_TX,
+				Data:            []byte("data"),
+				ContinueOnError: true,
+			},
+			`{"continue_on_error":true,"data":"ZGF0YQ==","memo":"","type":"TYPE_EXECUTE_TX"}`,
+		},
+		{
+			"priority",
+			types.InterchainAccountPacketData{
+				Type:     types.EXECUTE_TX,
+				Data:     []byte("data"),
+				Priority: types.MaxPacketPriority,
+			},
+			`{"data":"ZGF0YQ==","memo":"","priority":10,"type":"TYPE_EXECUTE_TX"}`,
+		},
+		{
+			"encrypted memo",
+			types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_TX,
+				Data:          []byte("data"),
+				EncryptedMemo: []byte("encrypted"),
+			},
+			`{"data":"ZGF0YQ==","encrypted_memo":"ZW5jcnlwdGVk","memo":"","type":"TYPE_EXECUTE_TX"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			bz := tc.packetData.GetBytes()
+			suite.Require().Equal(tc.expBytes, string(bz))
+
+			var packetData types.InterchainAccountPacketData
+			err := types.ModuleCdc.UnmarshalJSON(bz, &packetData)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.packetData, packetData)
+		})
+	}
+}
@@ -76,3 +76,18 @@ func ParseHostConnSequence(portID string) (uint64, error) {
 
 	return seq, nil
 }
+
+// ParseControllerPortOwner attempts to parse the owner address from the provided port identifier
+// The port identifier must match the controller chain format outlined in (TODO: link spec), otherwise an error is returned
+func ParseControllerPortOwner(portID string) (string, error) {
+	s := strings.Split(portID, Delimiter)
+	if len(s) != 4 {
+		return "", sdkerrors.Wrap(porttypes.ErrInvalidPort, "failed to parse port identifier")
+	}
+
+	if strings.TrimSpace(s[3]) == "" {
+		return "", sdkerrors.Wrap(ErrInvalidAccountAddress, "owner address cannot be empty")
+	}
+
+	return s[3], nil
+}
@@ -167,3 +167,46 @@ func (suite *TypesTestSuite) TestParseHostConnSequence() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestParseControllerPortOwner() {
+
+	testCases := []struct {
+		name     string
+		portID   string
+		expValue string
+		expPass  bool
+	}{
+		{
+			"success",
+			TestPortID,
+			TestOwnerAddress,
+			true,
+		},
+		{
+			"failed to parse port identifier",
+			"invalid-port-id",
+			"",
+			false,
+		},
+		{
+			"empty owner address",
+			"ics27-1.0.0. ",
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			owner, err := types.ParseControllerPortOwner(tc.portID)
+
+			if tc.expPass {
+				suite.Require().Equal(tc.expValue, owner)
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Empty(owner)
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
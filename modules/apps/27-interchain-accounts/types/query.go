@@ -0,0 +1,41 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxQueryResponseLength defines the maximum length in bytes of the response of a query included in the
+// acknowledgement of a packet carrying a query
+const MaxQueryResponseLength = 8192
+
+// NewQueryRequest creates a new QueryRequest instance
+func NewQueryRequest(path string, data []byte) *QueryRequest {
+	return &QueryRequest{
+		Path: path,
+		Data: data,
+	}
+}
+
+// ValidateBasic performs basic validation of the query request. The query request data may be empty.
+func (qr QueryRequest) ValidateBasic() error {
+	if !strings.HasPrefix(qr.Path, "/") || strings.TrimSpace(qr.Path) != qr.Path {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "invalid query path %s, expected a fully qualified gRPC method name", qr.Path)
+	}
+
+	return nil
+}
+
+// NewTxQueryResult creates a new TxQueryResult instance
+func NewTxQueryResult(result, response []byte) TxQueryResult {
+	return TxQueryResult{
+		Result:   result,
+		Response: response,
+	}
+}
+
+// GetBytes returns the JSON marshalled interchain account TxQueryResult.
+func (tqr TxQueryResult) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&tqr)
+}
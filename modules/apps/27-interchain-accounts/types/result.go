@@ -0,0 +1,27 @@
+package types
+
+// NewMsgResult creates a new MsgResult instance. The message is considered successful if the provided error is nil.
+func NewMsgResult(err error) MsgResult {
+	if err != nil {
+		return MsgResult{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+
+	return MsgResult{
+		Success: true,
+	}
+}
+
+// NewTxPartialResult creates a new TxPartialResult instance
+func NewTxPartialResult(results []MsgResult) TxPartialResult {
+	return TxPartialResult{
+		Results: results,
+	}
+}
+
+// GetBytes returns the JSON marshalled interchain account TxPartialResult.
+func (tpr TxPartialResult) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&tpr)
+}
@@ -33,16 +33,21 @@ const (
 	UNSPECIFIED Type = 0
 	// Execute a transaction on an interchain accounts host chain
 	EXECUTE_TX Type = 1
+	// Execute a transaction on an interchain accounts host chain and include the events emitted by the executed
+	// messages in the acknowledgement
+	EXECUTE_TX_WITH_EVENTS Type = 2
 )
 
 var Type_name = map[int32]string{
 	0: "TYPE_UNSPECIFIED",
 	1: "TYPE_EXECUTE_TX",
+	2: "TYPE_EXECUTE_TX_WITH_EVENTS",
 }
 
 var Type_value = map[string]int32{
-	"TYPE_UNSPECIFIED": 0,
-	"TYPE_EXECUTE_TX":  1,
+	"TYPE_UNSPECIFIED":            0,
+	"TYPE_EXECUTE_TX":             1,
+	"TYPE_EXECUTE_TX_WITH_EVENTS": 2,
 }
 
 func (x Type) String() string {
@@ -58,6 +63,28 @@ type InterchainAccountPacketData struct {
 	Type Type   `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// idempotency_key is an optional key identifying the transaction. A host chain retaining idempotency keys
+	// executes a transaction at most once per interchain account and idempotency key within its retention window,
+	// acknowledging packets carrying an already executed key with the result of the original execution. Keys are
+	// scoped to the interchain account, the same key may be used by different interchain accounts.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// query is an optional query executed by the host chain after successfully executing the transaction. Its
+	// response is included in the acknowledgement alongside the result of the transaction, as a TxQueryResult.
+	Query *QueryRequest `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	// continue_on_error opts into the non-atomic execution of the transaction. Each message is executed independently
+	// and the state transitions of successful messages are committed even if other messages fail, the acknowledgement
+	// containing the result of each message as a TxPartialResult. Only supported by packets of type TYPE_EXECUTE_TX.
+	// Controllers must account for the transaction being partially applied on the host chain, messages relying on the
+	// state transitions of a previous message may observe the state prior to the failed message.
+	ContinueOnError bool `protobuf:"varint,6,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	// priority is an optional advisory priority of the packet, ranging from 0 (unspecified) to 10 (most urgent), which
+	// relayers may use to order their work across channels. It is not enforced by consensus and, as interchain account
+	// channels are ORDERED, does not affect the order in which packets on the same channel are delivered.
+	Priority uint32 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	// encrypted_memo is an optional opaque memo, e.g. encrypted for the host chain, which is not interpreted by the
+	// interchain accounts module. A host chain registering an encrypted memo handler passes it to the handler prior to
+	// executing the transaction, packets carrying an encrypted memo are rejected by host chains without a handler.
+	EncryptedMemo []byte `protobuf:"bytes,8,opt,name=encrypted_memo,json=encryptedMemo,proto3" json:"encrypted_memo,omitempty"`
 }
 
 func (m *InterchainAccountPacketData) Reset()         { *m = InterchainAccountPacketData{} }
@@ -114,6 +141,96 @@ func (m *InterchainAccountPacketData) GetMemo() string {
 	return ""
 }
 
+func (m *InterchainAccountPacketData) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *InterchainAccountPacketData) GetQuery() *QueryRequest {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *InterchainAccountPacketData) GetContinueOnError() bool {
+	if m != nil {
+		return m.ContinueOnError
+	}
+	return false
+}
+
+func (m *InterchainAccountPacketData) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *InterchainAccountPacketData) GetEncryptedMemo() []byte {
+	if m != nil {
+		return m.EncryptedMemo
+	}
+	return nil
+}
+
+// QueryRequest defines a gRPC query executed on an interchain accounts host chain
+type QueryRequest struct {
+	// path is the fully qualified gRPC method name of the query, e.g. "/cosmos.bank.v1beta1.Query/Balance"
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data is the protobuf encoded query request
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39bab93e18d89799, []int{1}
+}
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // CosmosTx contains a list of sdk.Msg's. It should be used when sending transactions to an SDK host chain.
 type CosmosTx struct {
 	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -123,7 +240,7 @@ func (m *CosmosTx) Reset()         { *m = CosmosTx{} }
 func (m *CosmosTx) String() string { return proto.CompactTextString(m) }
 func (*CosmosTx) ProtoMessage()    {}
 func (*CosmosTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39bab93e18d89799, []int{1}
+	return fileDescriptor_39bab93e18d89799, []int{2}
 }
 func (m *CosmosTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,10 +276,338 @@ func (m *CosmosTx) GetMessages() []*types.Any {
 	return nil
 }
 
+// TxEvents contains the events emitted by the messages of a transaction executed on an interchain accounts host chain.
+// It is included as the result of a successful acknowledgement for packets of type TYPE_EXECUTE_TX_WITH_EVENTS.
+type TxEvents struct {
+	Events []Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+	// truncated is true if events were omitted because the size limit for events included in an acknowledgement
+	// was reached
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *TxEvents) Reset()         { *m = TxEvents{} }
+func (m *TxEvents) String() string { return proto.CompactTextString(m) }
+func (*TxEvents) ProtoMessage()    {}
+func (*TxEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39bab93e18d89799, []int{3}
+}
+func (m *TxEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxEvents.Merge(m, src)
+}
+func (m *TxEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxEvents proto.InternalMessageInfo
+
+func (m *TxEvents) GetEvents() []Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *TxEvents) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// Event defines an event emitted during the execution of a message on an interchain accounts host chain
+type Event struct {
+	Type       string           `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Attributes []EventAttribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39bab93e18d89799, []int{4}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetAttributes() []EventAttribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// EventAttribute defines a key value pair attribute of an Event
+type EventAttribute struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
+func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
+func (*EventAttribute) ProtoMessage()    {}
+func (*EventAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39bab93e18d89799, []int{5}
+}
+func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttribute.Merge(m, src)
+}
+func (m *EventAttribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttribute proto.InternalMessageInfo
+
+func (m *EventAttribute) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *EventAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// TxQueryResult contains the result of a transaction executed on an interchain accounts host chain and the response of
+// the query executed after the transaction. It is included as the result of a successful acknowledgement for packets
+// carrying a query.
+type TxQueryResult struct {
+	// result is the result the acknowledgement would contain had the packet not carried a query
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// response is the protobuf encoded query response
+	Response []byte `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *TxQueryResult) Reset()         { *m = TxQueryResult{} }
+func (m *TxQueryResult) String() string { return proto.CompactTextString(m) }
+func (*TxQueryResult) ProtoMessage()    {}
+func (*TxQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39bab93e18d89799, []int{6}
+}
+func (m *TxQueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxQueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxQueryResult.Merge(m, src)
+}
+func (m *TxQueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxQueryResult proto.InternalMessageInfo
+
+func (m *TxQueryResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *TxQueryResult) GetResponse() []byte {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+// TxPartialResult contains the result of each message of a transaction executed on an interchain accounts host chain
+// with continue_on_error enabled. It is included as the result of a successful acknowledgement for packets opting
+// into non-atomic execution, results being ordered as the messages of the transaction.
+type TxPartialResult struct {
+	Results []MsgResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *TxPartialResult) Reset()         { *m = TxPartialResult{} }
+func (m *TxPartialResult) String() string { return proto.CompactTextString(m) }
+func (*TxPartialResult) ProtoMessage()    {}
+func (*TxPartialResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39bab93e18d89799, []int{7}
+}
+func (m *TxPartialResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxPartialResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxPartialResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxPartialResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPartialResult.Merge(m, src)
+}
+func (m *TxPartialResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxPartialResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPartialResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPartialResult proto.InternalMessageInfo
+
+func (m *TxPartialResult) GetResults() []MsgResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// MsgResult defines the result of a message executed on an interchain accounts host chain
+type MsgResult struct {
+	// success is true if the message was executed and its state transitions were committed
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// error contains the error of a failed message
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *MsgResult) Reset()         { *m = MsgResult{} }
+func (m *MsgResult) String() string { return proto.CompactTextString(m) }
+func (*MsgResult) ProtoMessage()    {}
+func (*MsgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39bab93e18d89799, []int{8}
+}
+func (m *MsgResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResult.Merge(m, src)
+}
+func (m *MsgResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResult proto.InternalMessageInfo
+
+func (m *MsgResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MsgResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
 	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
+	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.v1.QueryRequest")
 	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+	proto.RegisterType((*TxEvents)(nil), "ibc.applications.interchain_accounts.v1.TxEvents")
+	proto.RegisterType((*Event)(nil), "ibc.applications.interchain_accounts.v1.Event")
+	proto.RegisterType((*EventAttribute)(nil), "ibc.applications.interchain_accounts.v1.EventAttribute")
+	proto.RegisterType((*TxQueryResult)(nil), "ibc.applications.interchain_accounts.v1.TxQueryResult")
+	proto.RegisterType((*TxPartialResult)(nil), "ibc.applications.interchain_accounts.v1.TxPartialResult")
+	proto.RegisterType((*MsgResult)(nil), "ibc.applications.interchain_accounts.v1.MsgResult")
 }
 
 func init() {
@@ -170,32 +615,55 @@ func init() {
 }
 
 var fileDescriptor_39bab93e18d89799 = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0xcd, 0xb8, 0x41, 0xd6, 0x59, 0xd9, 0x2d, 0x61, 0x0f, 0x31, 0x42, 0x08, 0x2b, 0x62, 0x10,
-	0x32, 0xe3, 0xb6, 0x07, 0x2f, 0x5e, 0x6a, 0x1b, 0xa1, 0x17, 0x29, 0x31, 0x85, 0xea, 0x25, 0x4c,
-	0xa6, 0x63, 0x3a, 0xd8, 0x64, 0x42, 0x67, 0x52, 0xcc, 0x3f, 0x28, 0x3d, 0xf9, 0x07, 0x7a, 0xf2,
-	0xcf, 0x78, 0xec, 0xd1, 0xa3, 0xb4, 0x7f, 0x44, 0x32, 0xc1, 0xb6, 0x07, 0x0f, 0x7b, 0x7b, 0x3c,
-	0xbe, 0xf7, 0xe6, 0xbd, 0x79, 0xb0, 0xc7, 0x53, 0x8a, 0x49, 0x59, 0x2e, 0x38, 0x25, 0x8a, 0x8b,
-	0x42, 0x62, 0x5e, 0x28, 0xb6, 0xa4, 0x73, 0xc2, 0x8b, 0x84, 0x50, 0x2a, 0xaa, 0x42, 0x49, 0xbc,
-	0xba, 0xc7, 0xaa, 0x2e, 0x99, 0x44, 0xe5, 0x52, 0x28, 0x61, 0xbd, 0xe2, 0x29, 0x45, 0xe7, 0x22,
-	0xf4, 0x1f, 0x11, 0x5a, 0xdd, 0x3b, 0xcf, 0x32, 0x21, 0xb2, 0x05, 0xc3, 0x5a, 0x96, 0x56, 0x5f,
-	0x31, 0x29, 0xea, 0xd6, 0xc3, 0xb9, 0xcd, 0x44, 0x26, 0x34, 0xc4, 0x0d, 0x6a, 0xd9, 0xbb, 0x35,
-	0x80, 0xcf, 0x47, 0x47, 0xaf, 0x7e, 0x6b, 0x35, 0x26, 0xf4, 0x1b, 0x53, 0x43, 0xa2, 0x88, 0xd5,
-	0x87, 0x66, 0x13, 0xc4, 0x06, 0x1e, 0xf0, 0xaf, 0xbb, 0x01, 0x7a, 0x60, 0x10, 0x14, 0xd7, 0x25,
-	0x8b, 0xb4, 0xd4, 0xb2, 0xa0, 0x39, 0x23, 0x8a, 0xd8, 0x8f, 0x3c, 0xe0, 0x3f, 0x8d, 0x34, 0x6e,
-	0xb8, 0x9c, 0xe5, 0xc2, 0xbe, 0xf0, 0x80, 0xff, 0x24, 0xd2, 0xf8, 0xee, 0x1d, 0xbc, 0x1c, 0x08,
-	0x99, 0x0b, 0x19, 0x7f, 0xb7, 0xde, 0xc0, 0xcb, 0x9c, 0x49, 0x49, 0x32, 0x26, 0x6d, 0xe0, 0x5d,
-	0xf8, 0x57, 0xdd, 0x5b, 0xd4, 0x56, 0x43, 0xff, 0xaa, 0xa1, 0x7e, 0x51, 0x47, 0xc7, 0xab, 0xd7,
-	0x53, 0x68, 0x36, 0x6f, 0x5a, 0x2f, 0x61, 0x27, 0xfe, 0x3c, 0x0e, 0x93, 0xc9, 0xc7, 0x4f, 0xe3,
-	0x70, 0x30, 0xfa, 0x30, 0x0a, 0x87, 0x1d, 0xc3, 0xb9, 0xd9, 0x6c, 0xbd, 0xab, 0x33, 0xca, 0x7a,
-	0x01, 0x6f, 0xf4, 0x59, 0x38, 0x0d, 0x07, 0x93, 0x38, 0x4c, 0xe2, 0x69, 0x07, 0x38, 0xd7, 0x9b,
-	0xad, 0x07, 0x4f, 0x8c, 0x63, 0xae, 0x7f, 0xba, 0xc6, 0xfb, 0xe4, 0xd7, 0xde, 0x05, 0xbb, 0xbd,
-	0x0b, 0xfe, 0xec, 0x5d, 0xf0, 0xe3, 0xe0, 0x1a, 0xbb, 0x83, 0x6b, 0xfc, 0x3e, 0xb8, 0xc6, 0x97,
-	0x30, 0xe3, 0x6a, 0x5e, 0xa5, 0x88, 0x8a, 0x1c, 0x53, 0x1d, 0x1d, 0xf3, 0x94, 0x06, 0x99, 0xc0,
-	0xab, 0x1e, 0xce, 0xc5, 0xac, 0x5a, 0x30, 0xd9, 0x6c, 0x2d, 0x71, 0xf7, 0x6d, 0x70, 0xfa, 0xa8,
-	0xe0, 0x38, 0xb3, 0xde, 0x38, 0x7d, 0xac, 0x2b, 0xf5, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x69,
-	0x4d, 0xde, 0x7c, 0x1b, 0x02, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x77, 0xb3, 0xbb, 0xce, 0xdb, 0xdd, 0x24, 0x8c, 0x56, 0x95, 0x71, 0x51, 0xb0, 0x8c,
+	0xaa, 0x46, 0x95, 0x62, 0xd3, 0xac, 0xa0, 0x48, 0xe5, 0x92, 0xa6, 0x46, 0x44, 0xa5, 0x25, 0xb8,
+	0x2e, 0x14, 0x24, 0x64, 0x4d, 0x26, 0x83, 0xd7, 0x6a, 0xec, 0x71, 0x3d, 0xe3, 0x28, 0xe6, 0x2f,
+	0x40, 0x7b, 0xe2, 0xc2, 0x71, 0x4f, 0xfc, 0x33, 0x3d, 0xee, 0x91, 0x13, 0x42, 0xbb, 0x67, 0xfe,
+	0x07, 0xe4, 0xb1, 0xe3, 0x0d, 0x68, 0x0f, 0xe1, 0xf6, 0xde, 0x37, 0xf3, 0x7d, 0xfa, 0xde, 0x8f,
+	0x19, 0x38, 0x0d, 0x67, 0xc4, 0xc6, 0x49, 0xb2, 0x08, 0x09, 0x16, 0x21, 0x8b, 0xb9, 0x1d, 0xc6,
+	0x82, 0xa6, 0xe4, 0x0c, 0x87, 0xb1, 0x8f, 0x09, 0x61, 0x59, 0x2c, 0xb8, 0xbd, 0x7c, 0x68, 0x8b,
+	0x3c, 0xa1, 0xdc, 0x4a, 0x52, 0x26, 0x18, 0xba, 0x1f, 0xce, 0x88, 0xb5, 0x49, 0xb2, 0x6e, 0x21,
+	0x59, 0xcb, 0x87, 0xfa, 0xfb, 0x01, 0x63, 0xc1, 0x82, 0xda, 0x92, 0x36, 0xcb, 0x7e, 0xb2, 0x71,
+	0x9c, 0x97, 0x1a, 0xfa, 0x49, 0xc0, 0x02, 0x26, 0x43, 0xbb, 0x88, 0x4a, 0xd4, 0xfc, 0x7b, 0x07,
+	0xee, 0x4e, 0x6a, 0xad, 0x51, 0x29, 0x35, 0xc5, 0xe4, 0x0d, 0x15, 0x4f, 0xb1, 0xc0, 0x68, 0x04,
+	0xcd, 0xc2, 0x88, 0xa6, 0x18, 0x4a, 0xbf, 0x3d, 0x1c, 0x58, 0x5b, 0x1a, 0xb1, 0xbc, 0x3c, 0xa1,
+	0xae, 0xa4, 0x22, 0x04, 0xcd, 0x39, 0x16, 0x58, 0xdb, 0x31, 0x94, 0xfe, 0x91, 0x2b, 0xe3, 0x02,
+	0x8b, 0x68, 0xc4, 0xb4, 0x5d, 0x43, 0xe9, 0xb7, 0x5c, 0x19, 0xa3, 0xfb, 0xd0, 0x09, 0xe7, 0x34,
+	0x4a, 0x98, 0xa0, 0x31, 0xc9, 0xfd, 0x37, 0x34, 0xd7, 0x9a, 0xf2, 0xb8, 0xbd, 0x01, 0x3f, 0xa3,
+	0x39, 0x7a, 0x06, 0x7b, 0x6f, 0x33, 0x9a, 0xe6, 0xda, 0x9e, 0xa1, 0xf4, 0x0f, 0x87, 0x9f, 0x6c,
+	0x6d, 0xea, 0x9b, 0x82, 0xe5, 0xd2, 0xb7, 0x19, 0xe5, 0xc2, 0x2d, 0x35, 0xd0, 0x03, 0x78, 0x8f,
+	0xb0, 0x58, 0x84, 0x71, 0x46, 0x7d, 0x16, 0xfb, 0x34, 0x4d, 0x59, 0xaa, 0xed, 0x1b, 0x4a, 0x5f,
+	0x75, 0x3b, 0xeb, 0x83, 0xaf, 0x63, 0xa7, 0x80, 0x91, 0x0e, 0x6a, 0x92, 0x86, 0x2c, 0x0d, 0x45,
+	0xae, 0x1d, 0x18, 0x4a, 0xff, 0xd8, 0xad, 0x73, 0x74, 0x0f, 0xda, 0x34, 0x26, 0x69, 0x9e, 0x08,
+	0x3a, 0xf7, 0x65, 0x6d, 0xaa, 0xac, 0xf7, 0xb8, 0x46, 0x9f, 0xd3, 0x88, 0x99, 0x9f, 0xc2, 0xd1,
+	0xa6, 0x8b, 0xa2, 0x11, 0x09, 0x16, 0x67, 0xb2, 0xbf, 0x2d, 0x57, 0xc6, 0xb7, 0x35, 0xcc, 0xfc,
+	0x1c, 0xd4, 0x31, 0xe3, 0x11, 0xe3, 0xde, 0x0a, 0x7d, 0x0c, 0x6a, 0x44, 0x39, 0xc7, 0x01, 0xe5,
+	0x9a, 0x62, 0xec, 0xf6, 0x0f, 0x87, 0x27, 0x56, 0x39, 0x77, 0x6b, 0x3d, 0x77, 0x6b, 0x14, 0xe7,
+	0x6e, 0x7d, 0xcb, 0x5c, 0x82, 0xea, 0xad, 0x9c, 0x25, 0x8d, 0x05, 0x47, 0x5f, 0xc1, 0x3e, 0x95,
+	0x51, 0xc5, 0xb5, 0xb6, 0x6e, 0x9f, 0x14, 0x78, 0xd2, 0x7c, 0xf7, 0xe7, 0x87, 0x0d, 0xb7, 0xd2,
+	0x40, 0x1f, 0x40, 0x4b, 0xa4, 0x59, 0x4c, 0xb0, 0xa0, 0x73, 0x69, 0x58, 0x75, 0x6f, 0x00, 0xf3,
+	0x67, 0xd8, 0x93, 0xa4, 0xa2, 0xa4, 0x7a, 0x8d, 0x5a, 0xd5, 0x5e, 0xfc, 0x08, 0x80, 0x85, 0x48,
+	0xc3, 0x59, 0x26, 0x28, 0xd7, 0x76, 0xa4, 0x99, 0x47, 0xff, 0xcf, 0xcc, 0x68, 0xcd, 0xaf, 0x5c,
+	0x6d, 0x08, 0x9a, 0x9f, 0x41, 0xfb, 0xdf, 0x77, 0x50, 0x17, 0x76, 0x8b, 0xa5, 0x2a, 0x3d, 0x14,
+	0x21, 0x3a, 0x81, 0xbd, 0x25, 0x5e, 0x64, 0x54, 0x3a, 0x6f, 0xb9, 0x65, 0x62, 0x8e, 0xe1, 0xd8,
+	0x5b, 0x55, 0x53, 0xe2, 0xd9, 0x42, 0xa0, 0x3b, 0xb0, 0x9f, 0xca, 0x48, 0x72, 0x8f, 0xdc, 0x2a,
+	0x2b, 0xf6, 0x21, 0xa5, 0x3c, 0x61, 0x31, 0xa7, 0xd5, 0xb0, 0xea, 0xdc, 0xa4, 0xd0, 0xf1, 0x56,
+	0x53, 0x9c, 0x8a, 0x10, 0x2f, 0x2a, 0x19, 0x17, 0x0e, 0x4a, 0xe2, 0xba, 0xf5, 0xc3, 0xad, 0xab,
+	0x7d, 0xce, 0x83, 0x52, 0xa4, 0x2a, 0x74, 0x2d, 0x64, 0x3e, 0x86, 0x56, 0x7d, 0x86, 0x34, 0x38,
+	0xe0, 0x19, 0x21, 0x94, 0x73, 0x69, 0x54, 0x75, 0xd7, 0x69, 0x51, 0x68, 0xb9, 0xd9, 0x55, 0xa1,
+	0x32, 0x79, 0xf0, 0x9b, 0x02, 0xcd, 0xe2, 0xa1, 0xa2, 0x7b, 0xd0, 0xf5, 0xbe, 0x9f, 0x3a, 0xfe,
+	0xab, 0x17, 0x2f, 0xa7, 0xce, 0x78, 0xf2, 0xc5, 0xc4, 0x79, 0xda, 0x6d, 0xe8, 0x9d, 0xf3, 0x0b,
+	0xe3, 0x70, 0x03, 0x42, 0x1f, 0x41, 0x47, 0x5e, 0x73, 0x5e, 0x3b, 0xe3, 0x57, 0x9e, 0xe3, 0x7b,
+	0xaf, 0xbb, 0x8a, 0xde, 0x3e, 0xbf, 0x30, 0xe0, 0x06, 0x41, 0x8f, 0xe1, 0xee, 0x7f, 0x2e, 0xf9,
+	0xdf, 0x4d, 0xbc, 0x2f, 0x7d, 0xe7, 0x5b, 0xe7, 0x85, 0xf7, 0xb2, 0xbb, 0xa3, 0xeb, 0xe7, 0x17,
+	0xc6, 0x9d, 0xdb, 0x4f, 0xf5, 0xe6, 0x2f, 0xbf, 0xf7, 0x1a, 0x4f, 0xfc, 0x77, 0x57, 0x3d, 0xe5,
+	0xf2, 0xaa, 0xa7, 0xfc, 0x75, 0xd5, 0x53, 0x7e, 0xbd, 0xee, 0x35, 0x2e, 0xaf, 0x7b, 0x8d, 0x3f,
+	0xae, 0x7b, 0x8d, 0x1f, 0x9c, 0x20, 0x14, 0x67, 0xd9, 0xcc, 0x22, 0x2c, 0xb2, 0x89, 0x7c, 0x0f,
+	0x76, 0x38, 0x23, 0x83, 0x80, 0xd9, 0xcb, 0x53, 0x3b, 0x62, 0xf3, 0x6c, 0x41, 0x79, 0xf1, 0xbb,
+	0x72, 0x7b, 0xf8, 0x68, 0x70, 0xd3, 0xcb, 0x41, 0xfd, 0xb1, 0xca, 0x5f, 0x75, 0xb6, 0x2f, 0xdf,
+	0xc9, 0xe9, 0x3f, 0x03, 0x00, 0x07, 0xec, 0x50, 0xa6, 0x8d, 0x05, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
@@ -218,6 +686,47 @@ func (m *InterchainAccountPacketData) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.EncryptedMemo) > 0 {
+		i -= len(m.EncryptedMemo)
+		copy(dAtA[i:], m.EncryptedMemo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EncryptedMemo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ContinueOnError {
+		i--
+		if m.ContinueOnError {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -240,7 +749,7 @@ func (m *InterchainAccountPacketData) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *CosmosTx) Marshal() (dAtA []byte, err error) {
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -250,12 +759,49 @@ func (m *CosmosTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CosmosTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -277,6 +823,248 @@ func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxQueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxQueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxPartialResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxPartialResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxPartialResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -305,6 +1093,41 @@ func (m *InterchainAccountPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ContinueOnError {
+		n += 2
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
+	l = len(m.EncryptedMemo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -320,16 +1143,814 @@ func (m *CosmosTx) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *TxEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TxQueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TxPartialResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &QueryRequest{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueOnError = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedMemo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedMemo = append(m.EncryptedMemo[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedMemo == nil {
+				m.EncryptedMemo = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, EventAttribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
+func (m *EventAttribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -352,17 +1973,17 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterchainAccountPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterchainAccountPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -372,14 +1993,109 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -406,16 +2122,16 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -425,23 +2141,25 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.Response = append(m.Response[:0], dAtA[iNdEx:postIndex]...)
+			if m.Response == nil {
+				m.Response = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -464,7 +2182,7 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CosmosTx) Unmarshal(dAtA []byte) error {
+func (m *TxPartialResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -487,15 +2205,15 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CosmosTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxPartialResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CosmosTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxPartialResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -522,10 +2240,112 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, MsgResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,6 +16,10 @@ var DefaultMaxAddrLength = 128
 // strictly alphanumeric characters
 var IsValidAddr = regexp.MustCompile("^[a-zA-Z0-9]*$").MatchString
 
+// SupportedVersions defines the interchain accounts versions supported by this implementation in ascending order.
+// Chains negotiate the highest of the SupportedVersions which does not exceed the version proposed by the counterparty
+var SupportedVersions = []string{VersionPrefix}
+
 // NewVersion returns a complete version string in the format: VersionPrefix + Delimter + AccAddress
 func NewAppVersion(versionPrefix, accAddr string) string {
 	return fmt.Sprint(versionPrefix, Delimiter, accAddr)
@@ -41,8 +46,8 @@ func ValidateVersion(version string) error {
 		return sdkerrors.Wrapf(ErrInvalidVersion, "expected format <app-version%saccount-address>, got %s", Delimiter, version)
 	}
 
-	if s[0] != VersionPrefix {
-		return sdkerrors.Wrapf(ErrInvalidVersion, "expected %s, got %s", VersionPrefix, s[0])
+	if !IsSupportedVersion(s[0]) {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected one of %s, got %s", SupportedVersions, s[0])
 	}
 
 	if err := ValidateAccountAddress(s[1]); err != nil {
@@ -65,3 +70,77 @@ func ValidateAccountAddress(addr string) error {
 
 	return nil
 }
+
+// ParseVersionNumber attempts to extract the version number from the provided interchain accounts version in the
+// format ics27-<version-number>. Versions which are not supported by this implementation may be parsed, allowing the
+// versions proposed by newer counterparties to be negotiated down
+func ParseVersionNumber(version string) (uint64, error) {
+	s := strings.Split(version, "-")
+	if len(s) != 2 || s[0] != versionIdentifier {
+		return 0, sdkerrors.Wrapf(ErrInvalidVersion, "expected format %s-<version-number>, got %s", versionIdentifier, version)
+	}
+
+	number, err := strconv.ParseUint(s[1], 10, 64)
+	if err != nil || number == 0 || s[1] != strconv.FormatUint(number, 10) {
+		return 0, sdkerrors.Wrapf(ErrInvalidVersion, "invalid version number in version %s", version)
+	}
+
+	return number, nil
+}
+
+// IsSupportedVersion returns true if the provided version is one of the SupportedVersions
+func IsSupportedVersion(version string) bool {
+	for _, supported := range SupportedVersions {
+		if version == supported {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NegotiateVersion returns the highest of the SupportedVersions which does not exceed the provided proposed version.
+// An error is returned if the proposed version is malformed or lower than each of the SupportedVersions
+func NegotiateVersion(proposedVersion string) (string, error) {
+	proposedNumber, err := ParseVersionNumber(proposedVersion)
+	if err != nil {
+		return "", err
+	}
+
+	for i := len(SupportedVersions) - 1; i >= 0; i-- {
+		number, err := ParseVersionNumber(SupportedVersions[i])
+		if err != nil {
+			return "", err
+		}
+
+		if number <= proposedNumber {
+			return SupportedVersions[i], nil
+		}
+	}
+
+	return "", sdkerrors.Wrapf(ErrInvalidVersion, "no supported version %s does not exceed proposed version %s", SupportedVersions, proposedVersion)
+}
+
+// ValidateNegotiatedVersion asserts the negotiated version is one of the SupportedVersions and does not exceed the
+// proposed version
+func ValidateNegotiatedVersion(proposedVersion, negotiatedVersion string) error {
+	if !IsSupportedVersion(negotiatedVersion) {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected one of %s, got %s", SupportedVersions, negotiatedVersion)
+	}
+
+	proposedNumber, err := ParseVersionNumber(proposedVersion)
+	if err != nil {
+		return err
+	}
+
+	negotiatedNumber, err := ParseVersionNumber(negotiatedVersion)
+	if err != nil {
+		return err
+	}
+
+	if negotiatedNumber > proposedNumber {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "negotiated version %s exceeds proposed version %s", negotiatedVersion, proposedVersion)
+	}
+
+	return nil
+}
@@ -104,3 +104,104 @@ func (suite *TypesTestSuite) TestValidateVersion() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestParseVersionNumber() {
+	testCases := []struct {
+		name      string
+		version   string
+		expNumber uint64
+		expPass   bool
+	}{
+		{"success", types.VersionPrefix, 1, true},
+		{"success: unsupported version", "ics27-10", 10, true},
+		{"invalid version identifier", "ics20-1", 0, false},
+		{"missing version number", "ics27-", 0, false},
+		{"zero version number", "ics27-0", 0, false},
+		{"version number with leading zero", "ics27-01", 0, false},
+		{"non numeric version number", "ics27-a", 0, false},
+		{"legacy version with account address", types.NewAppVersion(types.VersionPrefix, TestOwnerAddress), 0, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			number, err := types.ParseVersionNumber(tc.version)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(tc.expNumber, number)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestNegotiateVersion() {
+	supportedVersions := types.SupportedVersions
+	defer func() { types.SupportedVersions = supportedVersions }()
+
+	testCases := []struct {
+		name              string
+		supportedVersions []string
+		proposedVersion   string
+		expVersion        string
+		expPass           bool
+	}{
+		{"success: same version", []string{types.VersionPrefix}, types.VersionPrefix, types.VersionPrefix, true},
+		{"success: newer proposed version negotiated down", []string{types.VersionPrefix}, "ics27-3", types.VersionPrefix, true},
+		{"success: highest supported version selected", []string{types.VersionPrefix, "ics27-2", "ics27-3"}, "ics27-3", "ics27-3", true},
+		{"success: highest supported version not exceeding the proposed version", []string{types.VersionPrefix, "ics27-2", "ics27-4"}, "ics27-3", "ics27-2", true},
+		{"success: older proposed version", []string{types.VersionPrefix, "ics27-2"}, types.VersionPrefix, types.VersionPrefix, true},
+		{"no overlap: proposed version lower than all supported versions", []string{"ics27-2", "ics27-3"}, types.VersionPrefix, "", false},
+		{"invalid proposed version", []string{types.VersionPrefix}, "ics27", "", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			types.SupportedVersions = tc.supportedVersions
+
+			version, err := types.NegotiateVersion(tc.proposedVersion)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(tc.expVersion, version)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Empty(version)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestValidateNegotiatedVersion() {
+	supportedVersions := types.SupportedVersions
+	defer func() { types.SupportedVersions = supportedVersions }()
+
+	types.SupportedVersions = []string{types.VersionPrefix, "ics27-2"}
+
+	testCases := []struct {
+		name              string
+		proposedVersion   string
+		negotiatedVersion string
+		expPass           bool
+	}{
+		{"success: same version", "ics27-2", "ics27-2", true},
+		{"success: negotiated down", "ics27-3", "ics27-2", true},
+		{"success: negotiated down to the lowest supported version", "ics27-3", types.VersionPrefix, true},
+		{"negotiated version exceeds proposed version", types.VersionPrefix, "ics27-2", false},
+		{"unsupported negotiated version", "ics27-3", "ics27-3", false},
+		{"invalid proposed version", "invalid-version", types.VersionPrefix, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.ValidateNegotiatedVersion(tc.proposedVersion, tc.negotiatedVersion)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
@@ -20,6 +20,17 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDenomTraces(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
+		GetCmdQueryChannelDenomTraces(),
+		GetCmdQueryDenomHops(),
+		GetCmdQueryChannelReceiverPrefix(),
+		GetCmdQueryVoucherSupply(),
+		GetCmdQueryAllVoucherSupplies(),
+		GetCmdQueryFrozenDenoms(),
+		GetCmdQueryPendingTransfersBySender(),
+		GetCmdQueryEscrowDiscrepancies(),
+		GetCmdQueryNativeDenomEscrows(),
+		GetCmdQueryDenomTaxRate(),
+		GetCmdQueryDenomTaxRates(),
 	)
 
 	return queryCmd
@@ -107,7 +107,7 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
-// GetCmdParams returns the command handler for ibc-transfer parameter querying.
+// GetCmdQueryEscrowAddress returns the command handler for ibc-transfer escrow address querying.
 func GetCmdQueryEscrowAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "escrow-address",
@@ -120,10 +120,305 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			port := args[0]
-			channel := args[1]
-			addr := types.GetEscrowAddress(port, channel)
-			return clientCtx.PrintString(fmt.Sprintf("%s\n", addr.String()))
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowAddressRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.EscrowAddress(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.EscrowAddress))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChannelDenomTraces defines the command to query the denomination trace infos
+// of all the vouchers received over a channel.
+func GetCmdQueryChannelDenomTraces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-denom-traces [port] [channel-id]",
+		Short:   "Query the trace info for all token denominations received over a channel",
+		Long:    "Query the trace info for all token denominations received over a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-denom-traces transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelDenomTracesRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelDenomTraces(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel denominations trace")
+
+	return cmd
+}
+
+// GetCmdQueryDenomHops defines the command to query the port and channel hops of the denomination
+// trace of a given token.
+func GetCmdQueryDenomHops() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-hops [denom]",
+		Short:   "Query the port and channel hops of the denom trace of a given denomination",
+		Long:    "Query the port and channel hops of the denom trace of a given denomination, either in the format 'ibc/{hash}' or a native base denomination",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-hops ibc/[hash]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomHopsRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomHops(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryChannelReceiverPrefix defines the command to query the bech32 prefix expected for receiver
+// addresses of transfers sent over a channel.
+func GetCmdQueryChannelReceiverPrefix() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-receiver-prefix [port] [channel-id]",
+		Short:   "Query the bech32 prefix expected for receivers of transfers sent over a channel",
+		Long:    "Query the bech32 prefix expected for receivers of transfers sent over a channel. An empty prefix indicates no prefix is enforced",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-receiver-prefix [port] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelReceiverPrefixRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelReceiverPrefix(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVoucherSupply defines the command to query the total supply of a voucher denomination.
+func GetCmdQueryVoucherSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "voucher-supply [denom]",
+		Short:   "Query the total supply of a voucher denomination",
+		Long:    "Query the total supply of a voucher denomination in the format 'ibc/{hash}' issued by the transfer module",
+		Example: fmt.Sprintf("%s query ibc-transfer voucher-supply ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVoucherSupplyRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.VoucherSupply(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAllVoucherSupplies defines the command to query the total supply of all the voucher
+// denominations issued by the transfer module.
+func GetCmdQueryAllVoucherSupplies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "voucher-supplies",
+		Short:   "Query the total supply of all voucher denominations",
+		Long:    "Query the total supply of all voucher denominations with a registered denomination trace",
+		Example: fmt.Sprintf("%s query ibc-transfer voucher-supplies", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllVoucherSuppliesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllVoucherSupplies(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "voucher supplies")
+
+	return cmd
+}
+
+// GetCmdQueryFrozenDenoms defines the command to query the denominations for which transfers are frozen.
+func GetCmdQueryFrozenDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-denoms",
+		Short:   "Query the denominations for which transfers are frozen",
+		Long:    "Query the denominations for which sending and receiving transfers are frozen by governance",
+		Example: fmt.Sprintf("%s query ibc-transfer frozen-denoms", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFrozenDenomsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FrozenDenoms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen denoms")
+
+	return cmd
+}
+
+// GetCmdQueryPendingTransfersBySender defines the command to query the in-flight outgoing transfers of a sender.
+func GetCmdQueryPendingTransfersBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-transfers [address]",
+		Short:   "Query the in-flight outgoing transfers of a sender",
+		Long:    "Query the outgoing transfers of a sender which have not yet been acknowledged or timed out. Transfers are only indexed if enabled by the transfer parameters",
+		Example: fmt.Sprintf("%s query ibc-transfer pending-transfers [address]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingTransfersBySenderRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingTransfersBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending transfers")
+
+	return cmd
+}
+
+// GetCmdQueryEscrowDiscrepancies defines the command to query the channel escrow accounts whose balances
+// do not match the amounts tracked as escrowed.
+func GetCmdQueryEscrowDiscrepancies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-discrepancies",
+		Short:   "Query the escrow accounts whose balances do not match the tracked escrowed amounts",
+		Long:    "Query the channel escrow accounts whose balances do not match the amounts tracked as escrowed by the transfer module, per channel and denomination",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-discrepancies", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowDiscrepancies(cmd.Context(), &types.QueryEscrowDiscrepanciesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -131,3 +426,103 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryNativeDenomEscrows defines the command to query the native denominations of the chain which are
+// escrowed by the transfer module.
+func GetCmdQueryNativeDenomEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "native-denom-escrows",
+		Short:   "Query the native denominations escrowed by the transfer module",
+		Long:    "Query the native denominations of the chain which are escrowed by the transfer module, together with the escrowed amounts per channel",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-transfer native-denom-escrows", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NativeDenomEscrows(cmd.Context(), &types.QueryNativeDenomEscrowsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDenomTaxRate defines the command to query the transfer tax rates of a denomination.
+func GetCmdQueryDenomTaxRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-tax-rate [denom]",
+		Short:   "Query the transfer tax rates of a denomination",
+		Long:    "Query the rates at which outgoing and incoming transfers of a denomination are taxed in favour of the community pool. Zero rates indicate transfers of the denomination are not taxed",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-tax-rate ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomTaxRateRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomTaxRate(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomTaxRates defines the command to query the transfer tax rates of all taxed denominations.
+func GetCmdQueryDenomTaxRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-tax-rates",
+		Short:   "Query the transfer tax rates of all taxed denominations",
+		Long:    "Query the rates at which outgoing and incoming transfers are taxed in favour of the community pool for all taxed denominations",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-tax-rates", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTaxRatesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTaxRates(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom tax rates")
+
+	return cmd
+}
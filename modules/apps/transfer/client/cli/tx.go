@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channelutils "github.com/cosmos/ibc-go/v3/modules/core/04-channel/client/utils"
@@ -22,6 +25,9 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagRefundAddress          = "refund-address"
+	flagExecuteModule          = "execute-module"
+	flagExecuteMsg             = "execute-msg"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
@@ -57,6 +63,24 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				coin.Denom = denomTrace.IBCDenom()
 			}
 
+			// reject receivers which are not addressed using the bech32 prefix expected by the counterparty chain
+			if !clientCtx.Offline {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.ChannelReceiverPrefix(cmd.Context(), &types.QueryChannelReceiverPrefixRequest{
+					PortId:    srcPort,
+					ChannelId: srcChannel,
+				})
+				if err != nil {
+					return err
+				}
+
+				if res.Bech32Prefix != "" {
+					if err := types.ValidateReceiverPrefix(receiver, res.Bech32Prefix); err != nil {
+						return err
+					}
+				}
+			}
+
 			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
 			if err != nil {
 				return err
@@ -110,9 +134,31 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
+			refundAddress, err := cmd.Flags().GetString(flagRefundAddress)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgTransfer(
 				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp,
 			)
+			msg.RefundAddress = refundAddress
+
+			executeModule, err := cmd.Flags().GetString(flagExecuteModule)
+			if err != nil {
+				return err
+			}
+
+			if executeModule != "" {
+				executeMsg, err := cmd.Flags().GetString(flagExecuteMsg)
+				if err != nil {
+					return err
+				}
+
+				execution := types.NewReceiverExecution(executeModule, []byte(executeMsg))
+				msg.Execution = &execution
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -120,7 +166,275 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagRefundAddress, "", "Address to be refunded if the transfer times out or fails. Defaults to the sender.")
+	cmd.Flags().String(flagExecuteModule, "", "Receiver module on the destination chain executing the execute message once the tokens are credited.")
+	cmd.Flags().String(flagExecuteMsg, "", "Message executed by the receiver module, encoded as defined by the receiver module.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// NewCmdSubmitMigrateChannelConnectionProposal implements a command handler for submitting a transfer channel
+// connection migration proposal transaction.
+func NewCmdSubmitMigrateChannelConnectionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-transfer-channel [channel-id] [connection-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to migrate a transfer channel to a new connection",
+		Long: "Submit a proposal to migrate a transfer channel to a new connection along with an initial deposit.\n" +
+			"Please specify the identifier of the transfer channel to be migrated.\n" +
+			"Please specify the identifier of the connection the channel will be migrated to. It must track the same counterparty chain.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal migrate-transfer-channel channel-0 connection-1 --title=<title> --description=<description> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			channelID := args[0]
+			connectionID := args[1]
+
+			content := types.NewMigrateChannelConnectionProposal(title, description, channelID, connectionID)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitSetChannelReceiverPrefixProposal implements a command handler for submitting a transfer channel
+// receiver prefix proposal transaction.
+func NewCmdSubmitSetChannelReceiverPrefixProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-channel-receiver-prefix [channel-id] [bech32-prefix]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a proposal to set the bech32 prefix expected for receivers of a transfer channel",
+		Long: "Submit a proposal to set the bech32 prefix expected for receivers of a transfer channel along with an initial deposit.\n" +
+			"Please specify the identifier of the transfer channel.\n" +
+			"Please specify the bech32 prefix of addresses on the counterparty chain. Omit the prefix to remove the expected prefix of the channel.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal set-transfer-channel-receiver-prefix channel-0 cosmos --title=<title> --description=<description> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			channelID := args[0]
+
+			var bech32Prefix string
+			if len(args) == 2 {
+				bech32Prefix = args[1]
+			}
+
+			content := types.NewSetChannelReceiverPrefixProposal(title, description, channelID, bech32Prefix)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitSetDenomFrozenProposal implements a command handler for submitting a transfer denomination
+// frozen proposal transaction.
+func NewCmdSubmitSetDenomFrozenProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-denom-frozen [denom] [frozen]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to freeze or unfreeze transfers of a denomination",
+		Long: "Submit a proposal to freeze or unfreeze transfers of a denomination along with an initial deposit.\n" +
+			"Please specify the denomination, either a voucher denomination in the format 'ibc/{hash}' or a native base denomination.\n" +
+			"Please specify true to freeze sending and receiving transfers of the denomination or false to unfreeze them.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal set-transfer-denom-frozen ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 true --title=<title> --description=<description> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			frozen, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetDenomFrozenProposal(title, description, args[0], frozen)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitSetDenomTaxRateProposal implements a command handler for submitting a transfer denomination
+// tax rate proposal transaction.
+func NewCmdSubmitSetDenomTaxRateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-denom-tax-rate [denom] [send-rate] [receive-rate]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to set the transfer tax rates of a denomination",
+		Long: "Submit a proposal to set the transfer tax rates of a denomination along with an initial deposit.\n" +
+			"Please specify the denomination, either a voucher denomination in the format 'ibc/{hash}' or a native base denomination.\n" +
+			"Please specify the decimal rates within [0, 1) at which outgoing and incoming transfers of the denomination are taxed in favour of the community pool. Zero rates disable the taxes.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal set-transfer-denom-tax-rate ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 0.01 0 --title=<title> --description=<description> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			sendRate, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			receiveRate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetDenomTaxRateProposal(title, description, args[0], sendRate, receiveRate)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/client/cli"
+)
+
+var (
+	MigrateChannelConnectionProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitMigrateChannelConnectionProposal, emptyRestHandler)
+	SetChannelReceiverPrefixProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetChannelReceiverPrefixProposal, emptyRestHandler)
+	SetDenomFrozenProposalHandler           = govclient.NewProposalHandler(cli.NewCmdSubmitSetDenomFrozenProposal, emptyRestHandler)
+	SetDenomTaxRateProposalHandler          = govclient.NewProposalHandler(cli.NewCmdSubmitSetDenomTaxRateProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-transfer",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC proposals")
+		},
+	}
+}
@@ -29,8 +29,8 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 }
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
-// channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
-// supported version. Only 2^32 channels are allowed to be created.
+// channel must be UNORDERED, use the correct port (by default 'transfer'), and use a version
+// accepted by the keeper's version validator. Only 2^32 channels are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx sdk.Context,
 	keeper keeper.Keeper,
@@ -58,10 +58,7 @@ func ValidateTransferChannelParams(
 		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
 	}
 
-	if version != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
-	}
-	return nil
+	return keeper.ValidateVersion(version)
 }
 
 // OnChanOpenInit implements the IBCModule interface
@@ -103,8 +100,8 @@ func (im IBCModule) OnChanOpenTry(
 		return err
 	}
 
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if err := im.keeper.ValidateVersion(counterpartyVersion); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty version")
 	}
 
 	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
@@ -128,8 +125,8 @@ func (im IBCModule) OnChanOpenAck(
 	channelID string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if err := im.keeper.ValidateVersion(counterpartyVersion); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty version")
 	}
 	return nil
 }
@@ -271,7 +268,7 @@ func (im IBCModule) OnTimeoutPacket(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.RefundRecipient()),
 			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
 			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
 		),
@@ -1,20 +1,36 @@
 package transfer_test
 
 import (
+	"fmt"
 	"math"
 
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
+// feeWrappedVersion is the transfer version wrapped by the ICS29 fee middleware
+var feeWrappedVersion = fmt.Sprintf(`{"fee_version":"%s","app_version":"%s"}`, types.FeeVersion, types.Version)
+
+// newFeeWrappedVersionModule returns a transfer IBC module using a copy of the chain's transfer keeper
+// configured to accept fee wrapped versions
+func newFeeWrappedVersionModule(chain *ibctesting.TestChain) porttypes.IBCModule {
+	transferKeeper := chain.GetSimApp().TransferKeeper
+	transferKeeper.SetVersionValidator(types.NewVersionValidator(true))
+
+	return transfer.NewIBCModule(transferKeeper)
+}
+
 func (suite *TransferTestSuite) TestOnChanOpenInit() {
 	var (
 		channel *channeltypes.Channel
 		path    *ibctesting.Path
 		chanCap *capabilitytypes.Capability
+		cbs     porttypes.IBCModule
 	)
 
 	testCases := []struct {
@@ -46,6 +62,17 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = "version"
 			}, false,
 		},
+		{
+			"fee wrapped version rejected by default", func() {
+				channel.Version = feeWrappedVersion
+			}, false,
+		},
+		{
+			"success: fee wrapped version accepted by configured version validator", func() {
+				channel.Version = feeWrappedVersion
+				cbs = newFeeWrappedVersionModule(suite.chainA)
+			}, true,
+		},
 		{
 			"capability already claimed", func() {
 				err := suite.chainA.GetSimApp().ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), chanCap, host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
@@ -78,7 +105,8 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 			chanCap, err = suite.chainA.App.GetScopedIBCKeeper().NewCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(ibctesting.TransferPort, path.EndpointA.ChannelID))
 			suite.Require().NoError(err)
 
-			cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+			var ok bool
+			cbs, ok = suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
 			suite.Require().True(ok)
 
 			tc.malleate() // explicitly change fields in channel and testChannel
@@ -103,6 +131,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		chanCap             *capabilitytypes.Capability
 		path                *ibctesting.Path
 		counterpartyVersion string
+		cbs                 porttypes.IBCModule
 	)
 
 	testCases := []struct {
@@ -145,6 +174,24 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 				counterpartyVersion = "version"
 			}, false,
 		},
+		{
+			"fee wrapped counterparty version rejected by default", func() {
+				counterpartyVersion = feeWrappedVersion
+			}, false,
+		},
+		{
+			"success: fee wrapped versions accepted by configured version validator", func() {
+				channel.Version = feeWrappedVersion
+				counterpartyVersion = feeWrappedVersion
+				cbs = newFeeWrappedVersionModule(suite.chainA)
+			}, true,
+		},
+		{
+			"malformed counterparty version rejected by configured version validator", func() {
+				counterpartyVersion = `{"fee_version":`
+				cbs = newFeeWrappedVersionModule(suite.chainA)
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -173,7 +220,8 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 			chanCap, err = suite.chainA.App.GetScopedIBCKeeper().NewCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(ibctesting.TransferPort, path.EndpointA.ChannelID))
 			suite.Require().NoError(err)
 
-			cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+			var ok bool
+			cbs, ok = suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
 			suite.Require().True(ok)
 
 			tc.malleate() // explicitly change fields in channel and testChannel
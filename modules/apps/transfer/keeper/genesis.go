@@ -13,6 +13,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 
 	for _, trace := range state.DenomTraces {
 		k.SetDenomTrace(ctx, trace)
+		k.setChannelDenomFromTrace(ctx, trace)
 	}
 
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -36,4 +36,10 @@ func (suite *KeeperTestSuite) TestGenesis() {
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
 	})
+
+	// each denomination trace is indexed under the channel it was received over
+	for i, trace := range traces.Sort() {
+		channelID := fmt.Sprintf("channelToChain%d", i)
+		suite.Require().Equal(types.Traces{trace}, suite.chainA.GetSimApp().TransferKeeper.GetChannelDenomTraces(suite.chainA.GetContext(), types.PortID, channelID))
+	}
 }
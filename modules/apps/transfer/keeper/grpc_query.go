@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,6 +13,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -81,3 +83,329 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// EscrowAddress implements the Query/EscrowAddress gRPC method
+func (q Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	escrowAddress := q.GetEscrowAddress(req.PortId, req.ChannelId)
+
+	return &types.QueryEscrowAddressResponse{
+		EscrowAddress: escrowAddress.String(),
+	}, nil
+}
+
+// ChannelDenomTraces implements the Query/ChannelDenomTraces gRPC method
+func (q Keeper) ChannelDenomTraces(c context.Context, req *types.QueryChannelDenomTracesRequest) (*types.QueryChannelDenomTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.ChannelDenomPrefix(req.PortId, req.ChannelId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		denomTrace, found := q.GetDenomTrace(ctx, key)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrTraceNotFound, "denom trace with hash %X", key)
+		}
+
+		traces = append(traces, denomTrace)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelDenomTracesResponse{
+		DenomTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+// DenomHops implements the Query/DenomHops gRPC method
+func (q Keeper) DenomHops(c context.Context, req *types.QueryDenomHopsRequest) (*types.QueryDenomHopsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateIBCDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// tokens which live on the original chain are not traced
+	denomTrace := types.DenomTrace{BaseDenom: req.Denom}
+
+	denomSplit := strings.SplitN(req.Denom, "/", 2)
+	if len(denomSplit) == 2 {
+		hash, err := types.ParseHexHash(denomSplit[1])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash %s, %s", denomSplit[1], err))
+		}
+
+		var found bool
+		denomTrace, found = q.GetDenomTrace(ctx, hash)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				sdkerrors.Wrap(types.ErrTraceNotFound, denomSplit[1]).Error(),
+			)
+		}
+	}
+
+	hops, err := denomTrace.GetHops()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomHopsResponse{
+		Hops:      hops,
+		BaseDenom: denomTrace.BaseDenom,
+	}, nil
+}
+
+// ChannelReceiverPrefix implements the Query/ChannelReceiverPrefix gRPC method
+func (q Keeper) ChannelReceiverPrefix(c context.Context, req *types.QueryChannelReceiverPrefixRequest) (*types.QueryChannelReceiverPrefixResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefix, _ := q.GetChannelReceiverPrefix(ctx, req.PortId, req.ChannelId)
+
+	return &types.QueryChannelReceiverPrefixResponse{
+		Bech32Prefix: prefix,
+	}, nil
+}
+
+// VoucherSupply implements the Query/VoucherSupply gRPC method
+func (q Keeper) VoucherSupply(c context.Context, req *types.QueryVoucherSupplyRequest) (*types.QueryVoucherSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	denomSplit := strings.SplitN(req.Denom, "/", 2)
+	if len(denomSplit) != 2 || denomSplit[0] != types.DenomPrefix {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("denomination %s is not a voucher denomination, expected format '%s/{hash}'", req.Denom, types.DenomPrefix))
+	}
+
+	hash, err := types.ParseHexHash(denomSplit[1])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash %s, %s", denomSplit[1], err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, found := q.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrTraceNotFound, denomSplit[1]).Error(),
+		)
+	}
+
+	return &types.QueryVoucherSupplyResponse{
+		Amount: q.bankKeeper.GetSupply(ctx, denomTrace.IBCDenom()),
+	}, nil
+}
+
+// AllVoucherSupplies implements the Query/AllVoucherSupplies gRPC method
+func (q Keeper) AllVoucherSupplies(c context.Context, req *types.QueryAllVoucherSuppliesRequest) (*types.QueryAllVoucherSuppliesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	supplies := []sdk.Coin{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomTraceKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		denomTrace, err := q.UnmarshalDenomTrace(value)
+		if err != nil {
+			return err
+		}
+
+		supplies = append(supplies, q.bankKeeper.GetSupply(ctx, denomTrace.IBCDenom()))
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllVoucherSuppliesResponse{
+		Supplies:   supplies,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FrozenDenoms implements the Query/FrozenDenoms gRPC method
+func (q Keeper) FrozenDenoms(c context.Context, req *types.QueryFrozenDenomsRequest) (*types.QueryFrozenDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denoms := []string{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.FrozenDenomKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		denoms = append(denoms, string(key))
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenDenomsResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomTaxRate implements the Query/DenomTaxRate gRPC method
+func (q Keeper) DenomTaxRate(c context.Context, req *types.QueryDenomTaxRateRequest) (*types.QueryDenomTaxRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateIBCDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	taxRate, found := q.GetDenomTaxRate(ctx, req.Denom)
+	if !found {
+		taxRate = types.NewDenomTaxRate(req.Denom, sdk.ZeroDec(), sdk.ZeroDec())
+	}
+
+	return &types.QueryDenomTaxRateResponse{
+		TaxRate: taxRate,
+	}, nil
+}
+
+// DenomTaxRates implements the Query/DenomTaxRates gRPC method
+func (q Keeper) DenomTaxRates(c context.Context, req *types.QueryDenomTaxRatesRequest) (*types.QueryDenomTaxRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	taxRates := []types.DenomTaxRate{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.TaxRateKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var taxRate types.DenomTaxRate
+		if err := q.cdc.Unmarshal(value, &taxRate); err != nil {
+			return err
+		}
+
+		taxRates = append(taxRates, taxRate)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTaxRatesResponse{
+		TaxRates:   taxRates,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PendingTransfersBySender implements the Query/PendingTransfersBySender gRPC method
+func (q Keeper) PendingTransfersBySender(c context.Context, req *types.QueryPendingTransfersBySenderRequest) (*types.QueryPendingTransfersBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingTransfers := []types.PendingTransfer{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.SenderPendingTransfersPrefix(req.Address))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pendingTransfer types.PendingTransfer
+		if err := q.cdc.Unmarshal(value, &pendingTransfer); err != nil {
+			return err
+		}
+
+		pendingTransfers = append(pendingTransfers, pendingTransfer)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingTransfersBySenderResponse{
+		PendingTransfers: pendingTransfers,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// EscrowDiscrepancies implements the Query/EscrowDiscrepancies gRPC method
+func (q Keeper) EscrowDiscrepancies(c context.Context, req *types.QueryEscrowDiscrepanciesRequest) (*types.QueryEscrowDiscrepanciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEscrowDiscrepanciesResponse{
+		Discrepancies: q.GetEscrowDiscrepancies(ctx),
+	}, nil
+}
+
+// NativeDenomEscrows implements the Query/NativeDenomEscrows gRPC method
+func (q Keeper) NativeDenomEscrows(c context.Context, req *types.QueryNativeDenomEscrowsRequest) (*types.QueryNativeDenomEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryNativeDenomEscrowsResponse{
+		DenomEscrows: q.GetNativeDenomEscrows(ctx),
+	}, nil
+}
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
 )
 
 func (suite *KeeperTestSuite) TestQueryDenomTrace() {
@@ -74,6 +76,85 @@ func (suite *KeeperTestSuite) TestQueryDenomTrace() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomHops() {
+	var (
+		req          *types.QueryDenomHopsRequest
+		expHops      []types.Hop
+		expBaseDenom string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: multiple hops",
+			func() {
+				denomTrace := types.DenomTrace{Path: "transfer/channelToA/transfer/channelToB", BaseDenom: "uatom"}
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+				expHops = []types.Hop{types.NewHop("transfer", "channelToA"), types.NewHop("transfer", "channelToB")}
+				expBaseDenom = "uatom"
+				req = &types.QueryDenomHopsRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
+		{
+			"success: native denomination",
+			func() {
+				expHops = nil
+				expBaseDenom = sdk.DefaultBondDenom
+				req = &types.QueryDenomHopsRequest{
+					Denom: sdk.DefaultBondDenom,
+				}
+			},
+			true,
+		},
+		{
+			"invalid denomination",
+			func() {
+				req = &types.QueryDenomHopsRequest{
+					Denom: "ibc/!@#!@#!",
+				}
+			},
+			false,
+		},
+		{
+			"not found denom trace",
+			func() {
+				denomTrace := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}
+				req = &types.QueryDenomHopsRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomHops(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expHops, res.Hops)
+				suite.Require().Equal(expBaseDenom, res.BaseDenom)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 	var (
 		req       *types.QueryDenomTracesRequest
@@ -140,3 +221,805 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryEscrowAddress() {
+	var (
+		req              *types.QueryEscrowAddressRequest
+		expEscrowAddress string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: derived escrow address",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    types.PortID,
+					ChannelId: "channel-0",
+				}
+				expEscrowAddress = types.GetEscrowAddress(types.PortID, "channel-0").String()
+			},
+			true,
+		},
+		{
+			"success: escrow address of a channel which does not exist",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    types.PortID,
+					ChannelId: "channel-141",
+				}
+				expEscrowAddress = "cosmos1x54ltnyg88k0ejmk8ytwrhd3ltm84xehrnlslf"
+			},
+			true,
+		},
+		{
+			"success: registered escrow address",
+			func() {
+				escrowAddress := sdk.AccAddress([]byte("escrow_address______"))
+				suite.chainA.GetSimApp().TransferKeeper.RegisterEscrowAddress(types.PortID, "channel-0", escrowAddress)
+
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    types.PortID,
+					ChannelId: "channel-0",
+				}
+				expEscrowAddress = escrowAddress.String()
+			},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    "",
+					ChannelId: "channel-0",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    types.PortID,
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.EscrowAddress(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expEscrowAddress, res.EscrowAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelDenomTraces() {
+	var (
+		req       *types.QueryChannelDenomTracesRequest
+		expTraces = types.Traces(nil)
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryChannelDenomTracesRequest{
+					PortId:    types.PortID,
+					ChannelId: "channel-0",
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"})
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channel-0/transfer/channel-1", BaseDenom: "uatom"})
+
+				// denomination received over another channel
+				otherTrace := types.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"}
+
+				for _, trace := range append(expTraces, otherTrace) {
+					suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+				}
+
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelDenom(suite.chainA.GetContext(), types.PortID, "channel-0", expTraces[0].Hash())
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelDenom(suite.chainA.GetContext(), types.PortID, "channel-0", expTraces[1].Hash())
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelDenom(suite.chainA.GetContext(), types.PortID, "channel-1", otherTrace.Hash())
+
+				req = &types.QueryChannelDenomTracesRequest{
+					PortId:    types.PortID,
+					ChannelId: "channel-0",
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryChannelDenomTracesRequest{
+					PortId:    "",
+					ChannelId: "channel-0",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryChannelDenomTracesRequest{
+					PortId:    types.PortID,
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expTraces = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.ChannelDenomTraces(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelReceiverPrefix() {
+	var (
+		req       *types.QueryChannelReceiverPrefixRequest
+		expPrefix string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expPrefix = "osmo"
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.PortID, "channel-0", expPrefix)
+
+				req = &types.QueryChannelReceiverPrefixRequest{
+					PortId:    types.PortID,
+					ChannelId: "channel-0",
+				}
+			},
+			true,
+		},
+		{
+			"success: no receiver prefix set",
+			func() {
+				req = &types.QueryChannelReceiverPrefixRequest{
+					PortId:    types.PortID,
+					ChannelId: "channel-0",
+				}
+			},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryChannelReceiverPrefixRequest{
+					PortId:    "",
+					ChannelId: "channel-0",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryChannelReceiverPrefixRequest{
+					PortId:    types.PortID,
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expPrefix = ""
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.ChannelReceiverPrefix(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPrefix, res.Bech32Prefix)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVoucherSupply() {
+	var (
+		req       *types.QueryVoucherSupplyRequest
+		expAmount sdk.Coin
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				denomTrace := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+				expAmount = sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(100))
+				err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, sdk.NewCoins(expAmount))
+				suite.Require().NoError(err)
+
+				req = &types.QueryVoucherSupplyRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
+		{
+			"success: no vouchers in circulation",
+			func() {
+				denomTrace := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+				expAmount = sdk.NewCoin(denomTrace.IBCDenom(), sdk.ZeroInt())
+				req = &types.QueryVoucherSupplyRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
+		{
+			"native denomination",
+			func() {
+				req = &types.QueryVoucherSupplyRequest{
+					Denom: sdk.DefaultBondDenom,
+				}
+			},
+			false,
+		},
+		{
+			"invalid denom trace hash",
+			func() {
+				req = &types.QueryVoucherSupplyRequest{
+					Denom: "ibc/!@#!@#!",
+				}
+			},
+			false,
+		},
+		{
+			"not found denom trace",
+			func() {
+				denomTrace := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}
+				req = &types.QueryVoucherSupplyRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.VoucherSupply(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAmount, res.Amount)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAllVoucherSupplies() {
+	var (
+		req         *types.QueryAllVoucherSuppliesRequest
+		expSupplies []sdk.Coin
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no voucher denominations",
+			func() {
+				expSupplies = []sdk.Coin{}
+				req = &types.QueryAllVoucherSuppliesRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				trace := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}
+				traceNotInCirculation := types.DenomTrace{Path: "transfer/channelToA/transfer/channelToB", BaseDenom: "uatom"}
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), traceNotInCirculation)
+
+				voucher := sdk.NewCoin(trace.IBCDenom(), sdk.NewInt(100))
+				err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, sdk.NewCoins(voucher))
+				suite.Require().NoError(err)
+
+				// native denominations are not included
+				err = suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+				suite.Require().NoError(err)
+
+				expSupplies = []sdk.Coin{voucher, sdk.NewCoin(traceNotInCirculation.IBCDenom(), sdk.ZeroInt())}
+				req = &types.QueryAllVoucherSuppliesRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.AllVoucherSupplies(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expSupplies, res.Supplies)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFrozenDenoms() {
+	var (
+		req       *types.QueryFrozenDenomsRequest
+		expDenoms []string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no frozen denominations",
+			func() {
+				expDenoms = []string{}
+				req = &types.QueryFrozenDenomsRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				voucherDenom := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}.IBCDenom()
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainA.GetContext(), voucherDenom)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+
+				expDenoms = []string{voucherDenom, sdk.DefaultBondDenom}
+				req = &types.QueryFrozenDenomsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.FrozenDenoms(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expDenoms, res.Denoms)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTaxRate() {
+	var (
+		req        *types.QueryDenomTaxRateRequest
+		expTaxRate types.DenomTaxRate
+	)
+
+	voucherDenom := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}.IBCDenom()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: denomination is not taxed",
+			func() {
+				expTaxRate = types.NewDenomTaxRate(voucherDenom, sdk.ZeroDec(), sdk.ZeroDec())
+				req = &types.QueryDenomTaxRateRequest{Denom: voucherDenom}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expTaxRate = types.NewDenomTaxRate(voucherDenom, sdk.NewDecWithPrec(1, 2), sdk.ZeroDec())
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTaxRate(suite.chainA.GetContext(), expTaxRate)
+
+				req = &types.QueryDenomTaxRateRequest{Denom: voucherDenom}
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryDenomTaxRateRequest{Denom: "ibc/invalidhash"}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTaxRate(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTaxRate, res.TaxRate)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTaxRates() {
+	var (
+		req         *types.QueryDenomTaxRatesRequest
+		expTaxRates []types.DenomTaxRate
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no taxed denominations",
+			func() {
+				expTaxRates = []types.DenomTaxRate{}
+				req = &types.QueryDenomTaxRatesRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				voucherDenom := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}.IBCDenom()
+				expTaxRates = []types.DenomTaxRate{
+					types.NewDenomTaxRate(voucherDenom, sdk.NewDecWithPrec(1, 2), sdk.ZeroDec()),
+					types.NewDenomTaxRate(sdk.DefaultBondDenom, sdk.ZeroDec(), sdk.NewDecWithPrec(5, 3)),
+				}
+
+				for _, taxRate := range expTaxRates {
+					suite.chainA.GetSimApp().TransferKeeper.SetDenomTaxRate(suite.chainA.GetContext(), taxRate)
+				}
+
+				req = &types.QueryDenomTaxRatesRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTaxRates(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expTaxRates, res.TaxRates)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingTransfersBySender() {
+	var (
+		req                 *types.QueryPendingTransfersBySenderRequest
+		expPendingTransfers []types.PendingTransfer
+		sender              string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid sender address",
+			func() {
+				req = &types.QueryPendingTransfersBySenderRequest{
+					Address: "invalid address",
+				}
+			},
+			false,
+		},
+		{
+			"success: no pending transfers",
+			func() {
+				expPendingTransfers = []types.PendingTransfer{}
+				req = &types.QueryPendingTransfersBySenderRequest{
+					Address: sender,
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expPendingTransfers = []types.PendingTransfer{
+					{PortId: types.PortID, ChannelId: "channel-0", Sequence: 1, Token: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), Receiver: "receiver"},
+					{PortId: types.PortID, ChannelId: "channel-1", Sequence: 2, Token: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)), Receiver: "receiver"},
+				}
+
+				for _, pendingTransfer := range expPendingTransfers {
+					suite.chainA.GetSimApp().TransferKeeper.SetPendingTransfer(suite.chainA.GetContext(), sender, pendingTransfer)
+				}
+
+				// pending transfer of a different sender
+				otherSender := suite.chainB.SenderAccount.GetAddress().String()
+				suite.chainA.GetSimApp().TransferKeeper.SetPendingTransfer(suite.chainA.GetContext(), otherSender, types.PendingTransfer{PortId: types.PortID, ChannelId: "channel-0", Sequence: 3})
+
+				req = &types.QueryPendingTransfersBySenderRequest{
+					Address: sender,
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			sender = suite.chainA.SenderAccount.GetAddress().String()
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.PendingTransfersBySender(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expPendingTransfers, res.PendingTransfers)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrowDiscrepancies() {
+	var (
+		req              *types.QueryEscrowDiscrepanciesRequest
+		expDiscrepancies []types.EscrowDiscrepancy
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no discrepancies",
+			func() {},
+			true,
+		},
+		{
+			"success",
+			func() {
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), types.PortID, "channel-0", channeltypes.Channel{})
+
+				escrowAddress := types.GetEscrowAddress(types.PortID, "channel-0")
+				coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, coins))
+
+				expDiscrepancies = []types.EscrowDiscrepancy{
+					{PortId: types.PortID, ChannelId: "channel-0", Denom: sdk.DefaultBondDenom, ExpectedAmount: sdk.ZeroInt(), ActualAmount: sdk.NewInt(100)},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			req = &types.QueryEscrowDiscrepanciesRequest{}
+			expDiscrepancies = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.EscrowDiscrepancies(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expDiscrepancies, res.Discrepancies)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryNativeDenomEscrows() {
+	var (
+		req             *types.QueryNativeDenomEscrowsRequest
+		expDenomEscrows []types.NativeDenomEscrow
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no escrowed native denominations",
+			func() {},
+			true,
+		},
+		{
+			"success",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), types.PortID, "channel-0", sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				expDenomEscrows = []types.NativeDenomEscrow{
+					{
+						Denom:       sdk.DefaultBondDenom,
+						TotalAmount: sdk.NewInt(100),
+						ChannelEscrows: []types.ChannelEscrow{
+							{PortId: types.PortID, ChannelId: "channel-0", Amount: sdk.NewInt(100)},
+						},
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			req = &types.QueryNativeDenomEscrowsRequest{}
+			expDenomEscrows = []types.NativeDenomEscrow{}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.NativeDenomEscrows(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expDenomEscrows, res.DenomEscrows)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
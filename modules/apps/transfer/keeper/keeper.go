@@ -1,12 +1,17 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -26,6 +31,16 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
+	distrKeeper   types.DistributionKeeper
+
+	hooks            types.TransferHooks
+	versionValidator types.VersionValidator
+
+	// escrowAddresses maps a port and channel identifier pair to a registered escrow address
+	escrowAddresses map[string]sdk.AccAddress
+
+	// receiverModules maps a receiver module name to the registered receiver module
+	receiverModules map[string]types.ReceiverModule
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
@@ -46,15 +61,81 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      key,
-		paramSpace:    paramSpace,
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
-		authKeeper:    authKeeper,
-		bankKeeper:    bankKeeper,
-		scopedKeeper:  scopedKeeper,
+		cdc:             cdc,
+		storeKey:        key,
+		paramSpace:      paramSpace,
+		channelKeeper:   channelKeeper,
+		portKeeper:      portKeeper,
+		authKeeper:      authKeeper,
+		bankKeeper:      bankKeeper,
+		scopedKeeper:    scopedKeeper,
+		escrowAddresses: make(map[string]sdk.AccAddress),
+		receiverModules: make(map[string]types.ReceiverModule),
+	}
+}
+
+// SetHooks sets the transfer hooks. It must be called before the keeper is passed to the
+// transfer IBC module, which holds a copy of the keeper.
+func (k *Keeper) SetHooks(hooks types.TransferHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set transfer hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// RegisterReceiverModule registers the receiver module under the provided name, allowing received
+// transfers to carry an execution for the receiver module. It must be called before the keeper is
+// passed to the transfer IBC module, which holds a copy of the keeper.
+func (k *Keeper) RegisterReceiverModule(name string, module types.ReceiverModule) *Keeper {
+	if strings.TrimSpace(name) == "" {
+		panic("cannot register receiver module with a blank name")
+	}
+
+	if _, found := k.receiverModules[name]; found {
+		panic(fmt.Sprintf("cannot register receiver module %s twice", name))
+	}
+
+	k.receiverModules[name] = module
+
+	return k
+}
+
+// SetVersionValidator sets the validator of the channel versions negotiated by the transfer module,
+// replacing the default validator which only accepts the plain transfer version. It must be called
+// before the keeper is passed to the transfer IBC module, which holds a copy of the keeper.
+func (k *Keeper) SetVersionValidator(versionValidator types.VersionValidator) *Keeper {
+	if k.versionValidator != nil {
+		panic("cannot set transfer version validator twice")
+	}
+
+	k.versionValidator = versionValidator
+
+	return k
+}
+
+// SetDistributionKeeper sets the distribution keeper used to credit transfer taxes to the community
+// pool. Transfer taxes cannot be enabled unless it is set. It must be called before the keeper is
+// passed to the transfer IBC module, which holds a copy of the keeper.
+func (k *Keeper) SetDistributionKeeper(distrKeeper types.DistributionKeeper) *Keeper {
+	if k.distrKeeper != nil {
+		panic("cannot set transfer distribution keeper twice")
+	}
+
+	k.distrKeeper = distrKeeper
+
+	return k
+}
+
+// ValidateVersion validates a channel version using the configured version validator.
+func (k Keeper) ValidateVersion(version string) error {
+	if k.versionValidator == nil {
+		return types.NewVersionValidator(false).ValidateVersion(version)
 	}
+
+	return k.versionValidator.ValidateVersion(version)
 }
 
 // Logger returns a module-specific logger.
@@ -67,6 +148,81 @@ func (k Keeper) GetTransferAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
 }
 
+// RegisterEscrowAddress registers the provided address as the escrow address of the specified channel, overriding
+// the address derived by types.GetEscrowAddress. It must be called during app initialization. An escrow address
+// may only be registered once per channel and may not be shared by multiple channels. Funds which are already
+// escrowed by the channel are moved to the registered address by the transfer store migration to version 2.
+// Escrow addresses registered for existing channels in later upgrades require invoking MigrateEscrowAccounts
+// in the upgrade handler.
+func (k Keeper) RegisterEscrowAddress(portID, channelID string, escrowAddress sdk.AccAddress) {
+	if escrowAddress.Empty() {
+		panic(fmt.Sprintf("escrow address for port ID %s and channel ID %s cannot be empty", portID, channelID))
+	}
+
+	key := escrowAddressKey(portID, channelID)
+	if _, found := k.escrowAddresses[key]; found {
+		panic(fmt.Sprintf("escrow address already registered for port ID %s and channel ID %s", portID, channelID))
+	}
+
+	for registeredKey, addr := range k.escrowAddresses {
+		if addr.Equals(escrowAddress) {
+			panic(fmt.Sprintf("escrow address %s already registered for %s", escrowAddress, registeredKey))
+		}
+	}
+
+	k.escrowAddresses[key] = escrowAddress
+}
+
+// GetEscrowAddress returns the escrow address for the specified channel. The registered escrow address
+// is returned if one exists, otherwise the address derived by types.GetEscrowAddress is returned.
+func (k Keeper) GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	if escrowAddress, found := k.escrowAddresses[escrowAddressKey(portID, channelID)]; found {
+		return escrowAddress
+	}
+
+	return types.GetEscrowAddress(portID, channelID)
+}
+
+// MigrateEscrowAccounts moves all funds held by the derived escrow address of each channel with a registered
+// escrow address to the registered escrow address. It is invoked by the transfer store migration to version 2,
+// and should be invoked in an upgrade handler when escrow addresses are registered for existing channels in
+// later upgrades.
+func (k Keeper) MigrateEscrowAccounts(ctx sdk.Context) error {
+	keys := make([]string, 0, len(k.escrowAddresses))
+	for key := range k.escrowAddresses {
+		keys = append(keys, key)
+	}
+
+	// iterate in a deterministic order
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		portID, channelID, err := host.ParseChannelPath(key)
+		if err != nil {
+			return err
+		}
+
+		derivedAddress := types.GetEscrowAddress(portID, channelID)
+		balances := k.bankKeeper.GetAllBalances(ctx, derivedAddress)
+		if balances.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, derivedAddress, k.escrowAddresses[key], balances); err != nil {
+			return sdkerrors.Wrapf(err, "failed to migrate escrowed funds for port ID %s and channel ID %s", portID, channelID)
+		}
+
+		k.Logger(ctx).Info("migrated escrowed funds", "port-id", portID, "channel-id", channelID, "escrow-address", k.escrowAddresses[key].String(), "amount", balances.String())
+	}
+
+	return nil
+}
+
+// escrowAddressKey returns the key used to store the registered escrow address of a channel
+func escrowAddressKey(portID, channelID string) string {
+	return host.ChannelPath(portID, channelID)
+}
+
 // IsBound checks if the transfer module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
@@ -144,6 +300,352 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
+// HasChannelDenom checks if the denomination trace with the given hash is indexed as received over the specified channel.
+func (k Keeper) HasChannelDenom(ctx sdk.Context, portID, channelID string, denomTraceHash tmbytes.HexBytes) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelDenomPrefix(portID, channelID))
+	return store.Has(denomTraceHash)
+}
+
+// SetChannelDenom indexes the denomination trace with the given hash as received over the specified channel.
+func (k Keeper) SetChannelDenom(ctx sdk.Context, portID, channelID string, denomTraceHash tmbytes.HexBytes) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelDenomPrefix(portID, channelID))
+	store.Set(denomTraceHash, []byte{0x01})
+}
+
+// GetChannelDenomTraces returns the trace information for all the denominations received over the specified channel.
+func (k Keeper) GetChannelDenomTraces(ctx sdk.Context, portID, channelID string) types.Traces {
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelDenomPrefix(portID, channelID))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if denomTrace, found := k.GetDenomTrace(ctx, iterator.Key()); found {
+			traces = append(traces, denomTrace)
+		}
+	}
+
+	return traces.Sort()
+}
+
+// setChannelDenomFromTrace indexes the denomination trace under the channel over which it was received
+// by this chain. The receiving channel is always the first port and channel identifier pair of the trace path.
+func (k Keeper) setChannelDenomFromTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
+	identifiers := strings.Split(denomTrace.Path, "/")
+	if len(identifiers) < 2 {
+		return
+	}
+
+	k.SetChannelDenom(ctx, identifiers[0], identifiers[1], denomTrace.Hash())
+}
+
+// GetChannelReceiverPrefix returns the bech32 prefix expected for receiver addresses of transfers sent over the specified channel.
+func (k Keeper) GetChannelReceiverPrefix(ctx sdk.Context, portID, channelID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelReceiverPrefixKey(portID, channelID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetChannelReceiverPrefix sets the bech32 prefix expected for receiver addresses of transfers sent over the specified channel.
+func (k Keeper) SetChannelReceiverPrefix(ctx sdk.Context, portID, channelID, bech32Prefix string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChannelReceiverPrefixKey(portID, channelID), []byte(bech32Prefix))
+}
+
+// DeleteChannelReceiverPrefix removes the bech32 prefix expected for receiver addresses of transfers sent over the specified channel.
+func (k Keeper) DeleteChannelReceiverPrefix(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChannelReceiverPrefixKey(portID, channelID))
+}
+
+// IsDenomFrozen returns true if transfers of the specified denomination are frozen.
+func (k Keeper) IsDenomFrozen(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.DenomFrozenKey(denom))
+}
+
+// SetDenomFrozen freezes transfers of the specified denomination.
+func (k Keeper) SetDenomFrozen(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomFrozenKey(denom), []byte{0x01})
+}
+
+// DeleteDenomFrozen unfreezes transfers of the specified denomination.
+func (k Keeper) DeleteDenomFrozen(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DenomFrozenKey(denom))
+}
+
+// GetDenomTaxRate returns the transfer tax rates of the specified denomination.
+func (k Keeper) GetDenomTaxRate(ctx sdk.Context, denom string) (types.DenomTaxRate, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomTaxRateKey(denom))
+	if bz == nil {
+		return types.DenomTaxRate{}, false
+	}
+
+	var taxRate types.DenomTaxRate
+	k.cdc.MustUnmarshal(bz, &taxRate)
+	return taxRate, true
+}
+
+// SetDenomTaxRate sets the transfer tax rates of a denomination.
+func (k Keeper) SetDenomTaxRate(ctx sdk.Context, taxRate types.DenomTaxRate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomTaxRateKey(taxRate.Denom), k.cdc.MustMarshal(&taxRate))
+}
+
+// DeleteDenomTaxRate removes the transfer tax rates of the specified denomination, disabling its taxes.
+func (k Keeper) DeleteDenomTaxRate(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DenomTaxRateKey(denom))
+}
+
+// GetPendingTransfer returns the in-flight outgoing transfer sent by the specified sender with the given
+// packet sequence over the specified channel.
+func (k Keeper) GetPendingTransfer(ctx sdk.Context, sender, portID, channelID string, sequence uint64) (types.PendingTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SenderPendingTransferKey(sender, portID, channelID, sequence))
+	if bz == nil {
+		return types.PendingTransfer{}, false
+	}
+
+	var pendingTransfer types.PendingTransfer
+	k.cdc.MustUnmarshal(bz, &pendingTransfer)
+	return pendingTransfer, true
+}
+
+// SetPendingTransfer indexes the outgoing transfer under its sender until it is acknowledged or timed out.
+func (k Keeper) SetPendingTransfer(ctx sdk.Context, sender string, pendingTransfer types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SenderPendingTransferKey(sender, pendingTransfer.PortId, pendingTransfer.ChannelId, pendingTransfer.Sequence), k.cdc.MustMarshal(&pendingTransfer))
+}
+
+// DeletePendingTransfer removes the outgoing transfer sent by the specified sender with the given packet
+// sequence over the specified channel from the index of in-flight transfers.
+func (k Keeper) DeletePendingTransfer(ctx sdk.Context, sender, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SenderPendingTransferKey(sender, portID, channelID, sequence))
+}
+
+// GetPendingSendTax returns the send tax held for the in-flight outgoing transfer with the given packet
+// sequence over the specified channel.
+func (k Keeper) GetPendingSendTax(ctx sdk.Context, portID, channelID string, sequence uint64) (sdk.Coin, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketSendTaxKey(portID, channelID, sequence))
+	if bz == nil {
+		return sdk.Coin{}, false
+	}
+
+	var tax sdk.Coin
+	k.cdc.MustUnmarshal(bz, &tax)
+	return tax, true
+}
+
+// SetPendingSendTax sets the send tax held for the outgoing transfer until it is acknowledged or timed out.
+func (k Keeper) SetPendingSendTax(ctx sdk.Context, portID, channelID string, sequence uint64, tax sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketSendTaxKey(portID, channelID, sequence), k.cdc.MustMarshal(&tax))
+}
+
+// DeletePendingSendTax removes the send tax held for the outgoing transfer with the given packet sequence
+// over the specified channel.
+func (k Keeper) DeletePendingSendTax(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketSendTaxKey(portID, channelID, sequence))
+}
+
+// GetChannelEscrow returns the amount of the specified denomination tracked as escrowed over the specified channel.
+func (k Keeper) GetChannelEscrow(ctx sdk.Context, portID, channelID, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelEscrowDenomKey(portID, channelID, denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+// SetChannelEscrow sets the amount of the specified denomination tracked as escrowed over the specified channel.
+// The entry is removed if the amount is zero.
+func (k Keeper) SetChannelEscrow(ctx sdk.Context, portID, channelID, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.ChannelEscrowDenomKey(portID, channelID, denom))
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.ChannelEscrowDenomKey(portID, channelID, denom), bz)
+}
+
+// GetChannelEscrows returns the amounts of all denominations tracked as escrowed over the specified channel.
+func (k Keeper) GetChannelEscrows(ctx sdk.Context, portID, channelID string) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelEscrowPrefix(portID, channelID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	coins := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		coins = coins.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return coins
+}
+
+// IterateChannelEscrows iterates over the amounts tracked as escrowed over all channels, ordered by channel and
+// denomination, and performs a callback function.
+func (k Keeper) IterateChannelEscrows(ctx sdk.Context, cb func(portID, channelID string, escrow sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChannelEscrowKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is formatted as {channel path}/{denom}, the denomination may contain slashes
+		keySplit := strings.SplitN(string(iterator.Key()[len(types.ChannelEscrowKey):]), "/", 6)
+		if len(keySplit) != 6 {
+			panic(fmt.Sprintf("invalid channel escrow key %s", iterator.Key()))
+		}
+
+		portID, channelID, err := host.ParseChannelPath(strings.Join(keySplit[:5], "/"))
+		if err != nil {
+			panic(err)
+		}
+
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(portID, channelID, sdk.NewCoin(keySplit[5], amount)) {
+			break
+		}
+	}
+}
+
+// GetNativeDenomEscrows returns the native denominations of the chain with a non-zero amount tracked as escrowed,
+// together with the escrowed amounts per channel. Vouchers of tokens received over IBC which are escrowed when
+// sent on to another chain are excluded.
+func (k Keeper) GetNativeDenomEscrows(ctx sdk.Context) []types.NativeDenomEscrow {
+	denomEscrows := make(map[string]*types.NativeDenomEscrow)
+	k.IterateChannelEscrows(ctx, func(portID, channelID string, escrow sdk.Coin) bool {
+		if strings.HasPrefix(escrow.Denom, types.DenomPrefix+"/") {
+			return false
+		}
+
+		denomEscrow, found := denomEscrows[escrow.Denom]
+		if !found {
+			denomEscrow = &types.NativeDenomEscrow{Denom: escrow.Denom, TotalAmount: sdk.ZeroInt()}
+			denomEscrows[escrow.Denom] = denomEscrow
+		}
+
+		denomEscrow.TotalAmount = denomEscrow.TotalAmount.Add(escrow.Amount)
+		denomEscrow.ChannelEscrows = append(denomEscrow.ChannelEscrows, types.ChannelEscrow{
+			PortId:    portID,
+			ChannelId: channelID,
+			Amount:    escrow.Amount,
+		})
+
+		return false
+	})
+
+	denoms := make([]string, 0, len(denomEscrows))
+	for denom := range denomEscrows {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	nativeDenomEscrows := make([]types.NativeDenomEscrow, len(denoms))
+	for i, denom := range denoms {
+		nativeDenomEscrows[i] = *denomEscrows[denom]
+	}
+
+	return nativeDenomEscrows
+}
+
+// GetEscrowDiscrepancies compares the balance of the escrow account of each channel bound to the transfer port
+// with the amounts tracked as escrowed over the channel and returns the denominations for which they differ.
+// Funds escrowed before escrow tracking was introduced are reported as discrepancies.
+func (k Keeper) GetEscrowDiscrepancies(ctx sdk.Context) []types.EscrowDiscrepancy {
+	portID := k.GetPort(ctx)
+
+	var discrepancies []types.EscrowDiscrepancy
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		expected := k.GetChannelEscrows(ctx, channel.PortId, channel.ChannelId)
+		actual := k.bankKeeper.GetAllBalances(ctx, k.GetEscrowAddress(channel.PortId, channel.ChannelId))
+
+		denoms := make(map[string]struct{})
+		for _, coin := range expected.Add(actual...) {
+			denoms[coin.Denom] = struct{}{}
+		}
+
+		sortedDenoms := make([]string, 0, len(denoms))
+		for denom := range denoms {
+			sortedDenoms = append(sortedDenoms, denom)
+		}
+		sort.Strings(sortedDenoms)
+
+		for _, denom := range sortedDenoms {
+			expectedAmount, actualAmount := expected.AmountOf(denom), actual.AmountOf(denom)
+			if expectedAmount.Equal(actualAmount) {
+				continue
+			}
+
+			discrepancies = append(discrepancies, types.EscrowDiscrepancy{
+				PortId:         channel.PortId,
+				ChannelId:      channel.ChannelId,
+				Denom:          denom,
+				ExpectedAmount: expectedAmount,
+				ActualAmount:   actualAmount,
+			})
+		}
+	}
+
+	return discrepancies
+}
+
+// trackEscrow increases the amount of the token denomination tracked as escrowed over the specified channel.
+func (k Keeper) trackEscrow(ctx sdk.Context, portID, channelID string, token sdk.Coin) {
+	amount := k.GetChannelEscrow(ctx, portID, channelID, token.Denom)
+	k.SetChannelEscrow(ctx, portID, channelID, token.Denom, amount.Add(token.Amount))
+}
+
+// untrackEscrow decreases the amount of the token denomination tracked as escrowed over the specified channel.
+// The tracked amount does not drop below zero, as funds escrowed before escrow tracking was introduced may be
+// unescrowed.
+func (k Keeper) untrackEscrow(ctx sdk.Context, portID, channelID string, token sdk.Coin) {
+	amount := k.GetChannelEscrow(ctx, portID, channelID, token.Denom)
+	if amount.LTE(token.Amount) {
+		amount = sdk.ZeroInt()
+	} else {
+		amount = amount.Sub(token.Amount)
+	}
+
+	k.SetChannelEscrow(ctx, portID, channelID, token.Denom, amount)
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
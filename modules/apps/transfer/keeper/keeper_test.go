@@ -9,7 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
 )
 
 type KeeperTestSuite struct {
@@ -54,6 +56,176 @@ func (suite *KeeperTestSuite) TestGetTransferAccount() {
 	suite.Require().Equal(expectedMaccAddr, macc.GetAddress())
 }
 
+func (suite *KeeperTestSuite) TestRegisterEscrowAddress() {
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	escrowAddress := sdk.AccAddress(crypto.AddressHash([]byte("escrow")))
+
+	// derived escrow address is returned when no escrow address is registered
+	suite.Require().Equal(types.GetEscrowAddress(types.PortID, "channel-0"), transferKeeper.GetEscrowAddress(types.PortID, "channel-0"))
+
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterEscrowAddress(types.PortID, "channel-0", sdk.AccAddress{})
+	})
+
+	suite.Require().NotPanics(func() {
+		transferKeeper.RegisterEscrowAddress(types.PortID, "channel-0", escrowAddress)
+	})
+	suite.Require().Equal(escrowAddress, transferKeeper.GetEscrowAddress(types.PortID, "channel-0"))
+
+	// other channels are unaffected
+	suite.Require().Equal(types.GetEscrowAddress(types.PortID, "channel-1"), transferKeeper.GetEscrowAddress(types.PortID, "channel-1"))
+
+	// escrow address already registered for the channel
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterEscrowAddress(types.PortID, "channel-0", sdk.AccAddress(crypto.AddressHash([]byte("other"))))
+	})
+
+	// escrow address already registered for another channel
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterEscrowAddress(types.PortID, "channel-1", escrowAddress)
+	})
+}
+
+func (suite *KeeperTestSuite) TestRegisterReceiverModule() {
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	receiverModule := mockVaultReceiver{}
+
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterReceiverModule(" ", receiverModule)
+	})
+
+	suite.Require().NotPanics(func() {
+		transferKeeper.RegisterReceiverModule("vault", receiverModule)
+	})
+
+	// receiver module already registered under the name
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterReceiverModule("vault", receiverModule)
+	})
+}
+
+func (suite *KeeperTestSuite) TestMigrateEscrowAccounts() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+	ctx := suite.chainA.GetContext()
+
+	derivedAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	escrowAddress := sdk.AccAddress(crypto.AddressHash([]byte("escrow")))
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), ctx, derivedAddress, coins))
+
+	transferKeeper.RegisterEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, escrowAddress)
+	suite.Require().NoError(transferKeeper.MigrateEscrowAccounts(ctx))
+
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, derivedAddress).IsZero())
+	suite.Require().Equal(coins, bankKeeper.GetAllBalances(ctx, escrowAddress))
+
+	// migration is a no-op once the derived escrow address is empty
+	suite.Require().NoError(transferKeeper.MigrateEscrowAccounts(ctx))
+	suite.Require().Equal(coins, bankKeeper.GetAllBalances(ctx, escrowAddress))
+}
+
+func (suite *KeeperTestSuite) TestGetEscrowDiscrepancies() {
+	var (
+		path             *ibctesting.Path
+		expDiscrepancies []types.EscrowDiscrepancy
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"no discrepancies",
+			func() {},
+		},
+		{
+			"orphaned escrow balance",
+			func() {
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)), sdk.NewCoin("atom", sdk.NewInt(10)))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, coins))
+
+				expDiscrepancies = []types.EscrowDiscrepancy{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Denom: "atom", ExpectedAmount: sdk.ZeroInt(), ActualAmount: sdk.NewInt(10)},
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Denom: sdk.DefaultBondDenom, ExpectedAmount: sdk.NewInt(100), ActualAmount: sdk.NewInt(150)},
+				}
+			},
+		},
+		{
+			"tracked amount missing from escrow",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(200))
+
+				expDiscrepancies = []types.EscrowDiscrepancy{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Denom: sdk.DefaultBondDenom, ExpectedAmount: sdk.NewInt(200), ActualAmount: sdk.NewInt(100)},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			expDiscrepancies = nil
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+			)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			discrepancies := suite.chainA.GetSimApp().TransferKeeper.GetEscrowDiscrepancies(suite.chainA.GetContext())
+			suite.Require().Equal(expDiscrepancies, discrepancies)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetNativeDenomEscrows() {
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	ctx := suite.chainA.GetContext()
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(types.PortID, "channel-2", "uatom")).IBCDenom()
+	factoryDenom := "factory/cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs/token"
+
+	suite.Require().Empty(transferKeeper.GetNativeDenomEscrows(ctx))
+
+	transferKeeper.SetChannelEscrow(ctx, types.PortID, "channel-0", sdk.DefaultBondDenom, sdk.NewInt(100))
+	transferKeeper.SetChannelEscrow(ctx, types.PortID, "channel-1", sdk.DefaultBondDenom, sdk.NewInt(50))
+	transferKeeper.SetChannelEscrow(ctx, types.PortID, "channel-0", factoryDenom, sdk.NewInt(10))
+	// vouchers received over IBC are not native denominations
+	transferKeeper.SetChannelEscrow(ctx, types.PortID, "channel-1", voucherDenom, sdk.NewInt(20))
+
+	expDenomEscrows := []types.NativeDenomEscrow{
+		{
+			Denom:       factoryDenom,
+			TotalAmount: sdk.NewInt(10),
+			ChannelEscrows: []types.ChannelEscrow{
+				{PortId: types.PortID, ChannelId: "channel-0", Amount: sdk.NewInt(10)},
+			},
+		},
+		{
+			Denom:       sdk.DefaultBondDenom,
+			TotalAmount: sdk.NewInt(150),
+			ChannelEscrows: []types.ChannelEscrow{
+				{PortId: types.PortID, ChannelId: "channel-0", Amount: sdk.NewInt(100)},
+				{PortId: types.PortID, ChannelId: "channel-1", Amount: sdk.NewInt(50)},
+			},
+		},
+	}
+
+	suite.Require().Equal(expDenomEscrows, transferKeeper.GetNativeDenomEscrows(ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// This migration
+// - sets the transfer parameters introduced since version 1 to their defaults
+// - moves the funds escrowed by existing channels with a registered escrow address to the registered escrow address
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := types.DefaultParams()
+
+	m.setParamIfNotExists(ctx, types.KeyIndexPendingTransfers, params.IndexPendingTransfers)
+	m.setParamIfNotExists(ctx, types.KeyMaxDenomHops, params.MaxDenomHops)
+
+	return m.keeper.MigrateEscrowAccounts(ctx)
+}
+
+// setParamIfNotExists sets the transfer parameter stored under the provided key to the provided value unless it
+// is already set, such that parameters updated by governance are preserved.
+func (m Migrator) setParamIfNotExists(ctx sdk.Context, key []byte, value interface{}) {
+	if !m.keeper.paramSpace.Has(ctx, key) {
+		m.keeper.paramSpace.Set(ctx, key, value)
+	}
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// migratedParamKeys holds the store keys of the transfer parameters introduced since version 1
+var migratedParamKeys = [][]byte{
+	types.KeyIndexPendingTransfers,
+	types.KeyMaxDenomHops,
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	var expParams types.Params
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: parameters missing from the store are set to their defaults",
+			func() {
+				paramStore := prefix.NewStore(suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+				for _, key := range migratedParamKeys {
+					paramStore.Delete(key)
+					suite.Require().False(suite.chainA.GetSimApp().GetSubspace(types.ModuleName).Has(suite.chainA.GetContext(), key))
+				}
+			},
+		},
+		{
+			"success: parameters set in the store are preserved",
+			func() {
+				expParams.IndexPendingTransfers = true
+				expParams.MaxDenomHops = 4
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), expParams)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			expParams = types.DefaultParams()
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), expParams)
+
+			tc.malleate()
+
+			err := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper).Migrate1to2(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(expParams, suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext()))
+		})
+	}
+}
+
+// test that transfers escrowed over a channel prior to the registration of its escrow address are refunded and
+// unescrowed from the registered escrow address once migrated
+func (suite *KeeperTestSuite) TestMigrate1to2EscrowAccounts() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	balance := bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+	// escrow the tokens of two transfers in the derived escrow address
+	for i := 0; i < 2; i++ {
+		err := transferKeeper.SendTransfer(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender, receiver.String(), timeoutHeight, 0)
+		suite.Require().NoError(err)
+	}
+
+	derivedAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	escrowAddress := sdk.AccAddress(crypto.AddressHash([]byte("escrow")))
+	transferKeeper.RegisterEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, escrowAddress)
+
+	err := keeper.NewMigrator(transferKeeper).Migrate1to2(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, derivedAddress).IsZero())
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, coin.Amount.MulRaw(2)), bankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom))
+
+	// the first transfer times out and is refunded from the registered escrow address
+	data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), receiver.String())
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	err = transferKeeper.OnTimeoutPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(balance.Sub(coin), bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+
+	// the vouchers of the second transfer are sent back and unescrowed from the registered escrow address
+	voucherDenom := types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)
+	data = types.NewFungibleTokenPacketData(voucherDenom, coin.Amount.String(), receiver.String(), sender.String())
+	packet = channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+	err = transferKeeper.OnRecvPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(balance, bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, escrowAddress).IsZero())
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, derivedAddress).IsZero())
+}
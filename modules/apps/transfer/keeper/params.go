@@ -19,9 +19,24 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// IsPendingTransferIndexEnabled retrieves the index pending transfers boolean from the paramstore
+func (k Keeper) IsPendingTransferIndexEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyIndexPendingTransfers, &res)
+	return res
+}
+
+// GetMaxDenomHops retrieves the maximum number of hops in the trace of a voucher denomination from the paramstore.
+// The number of hops is not limited if 0 is returned.
+func (k Keeper) GetMaxDenomHops(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxDenomHops, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx), k.IsPendingTransferIndexEnabled(ctx), k.GetMaxDenomHops(ctx))
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
@@ -9,6 +9,7 @@ func (suite *KeeperTestSuite) TestParams() {
 	suite.Require().Equal(expParams, params)
 
 	expParams.SendEnabled = false
+	expParams.IndexPendingTransfers = true
 	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
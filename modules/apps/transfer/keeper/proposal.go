@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// MigrateChannelConnectionProposal migrates the transfer channel specified in the proposal to the
+// proposed connection. The channel keeper validates that the new connection tracks the same counterparty
+// chain. The escrow account of a channel is derived from its port and channel identifiers, so escrowed
+// funds and channel sequences are not affected by the migration.
+func (k Keeper) MigrateChannelConnectionProposal(ctx sdk.Context, p *types.MigrateChannelConnectionProposal) error {
+	portID := k.GetPort(ctx)
+
+	if err := k.channelKeeper.MigrateChannelConnection(ctx, portID, p.ChannelId, p.ConnectionId); err != nil {
+		return sdkerrors.Wrapf(err, "failed to migrate channel %s on port %s to connection %s", p.ChannelId, portID, p.ConnectionId)
+	}
+
+	k.Logger(ctx).Info("transfer channel migrated to new connection", "port-id", portID, "channel-id", p.ChannelId, "connection-id", p.ConnectionId)
+
+	return nil
+}
+
+// SetChannelReceiverPrefixProposal sets the bech32 prefix expected for receiver addresses of transfers
+// sent over the transfer channel specified in the proposal. An empty prefix removes the expected prefix.
+func (k Keeper) SetChannelReceiverPrefixProposal(ctx sdk.Context, p *types.SetChannelReceiverPrefixProposal) error {
+	portID := k.GetPort(ctx)
+
+	if _, found := k.channelKeeper.GetChannel(ctx, portID, p.ChannelId); !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, p.ChannelId)
+	}
+
+	if p.Bech32Prefix == "" {
+		k.DeleteChannelReceiverPrefix(ctx, portID, p.ChannelId)
+		k.Logger(ctx).Info("transfer channel receiver prefix removed", "port-id", portID, "channel-id", p.ChannelId)
+
+		return nil
+	}
+
+	k.SetChannelReceiverPrefix(ctx, portID, p.ChannelId, p.Bech32Prefix)
+	k.Logger(ctx).Info("transfer channel receiver prefix set", "port-id", portID, "channel-id", p.ChannelId, "bech32-prefix", p.Bech32Prefix)
+
+	return nil
+}
+
+// SetDenomFrozenProposal freezes or unfreezes transfers of the denomination specified in the proposal.
+func (k Keeper) SetDenomFrozenProposal(ctx sdk.Context, p *types.SetDenomFrozenProposal) error {
+	if !p.Frozen {
+		k.DeleteDenomFrozen(ctx, p.Denom)
+		k.Logger(ctx).Info("transfers of denomination unfrozen", "denom", p.Denom)
+
+		return nil
+	}
+
+	k.SetDenomFrozen(ctx, p.Denom)
+	k.Logger(ctx).Info("transfers of denomination frozen", "denom", p.Denom)
+
+	return nil
+}
+
+// SetDenomTaxRateProposal sets the transfer tax rates of the denomination specified in the proposal. Zero send
+// and receive rates disable the taxes of the denomination. Taxes cannot be enabled unless a distribution keeper
+// is set, as collected taxes are credited to the community pool.
+func (k Keeper) SetDenomTaxRateProposal(ctx sdk.Context, p *types.SetDenomTaxRateProposal) error {
+	taxRate := types.NewDenomTaxRate(p.Denom, p.SendRate, p.ReceiveRate)
+	if taxRate.IsZero() {
+		k.DeleteDenomTaxRate(ctx, p.Denom)
+		k.Logger(ctx).Info("transfer taxes of denomination disabled", "denom", p.Denom)
+
+		return nil
+	}
+
+	if k.distrKeeper == nil {
+		return sdkerrors.Wrapf(types.ErrTransferTaxUnsupported, "distribution keeper not set, cannot tax transfers of denom %s", p.Denom)
+	}
+
+	k.SetDenomTaxRate(ctx, taxRate)
+	k.Logger(ctx).Info("transfer tax rates of denomination set", "denom", p.Denom, "send-rate", p.SendRate.String(), "receive-rate", p.ReceiveRate.String())
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestMigrateChannelConnectionProposal() {
+	var (
+		path     *ibctesting.Path
+		newPath  *ibctesting.Path
+		proposal *types.MigrateChannelConnectionProposal
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"channel not found", func() {
+				proposal.ChannelId = "channel-100"
+			}, false,
+		},
+		{
+			"connection not found", func() {
+				proposal.ConnectionId = "connection-100"
+			}, false,
+		},
+		{
+			"connection tracks a different counterparty chain", func() {
+				pathAtoC := NewTransferPath(suite.chainA, suite.chainC)
+				suite.coordinator.SetupConnections(pathAtoC)
+
+				proposal.ConnectionId = pathAtoC.EndpointA.ConnectionID
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			newPath = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(newPath)
+
+			proposal = types.NewMigrateChannelConnectionProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ChannelID, newPath.EndpointA.ConnectionID).(*types.MigrateChannelConnectionProposal)
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().TransferKeeper.MigrateChannelConnectionProposal(suite.chainA.GetContext(), proposal)
+
+			channel := path.EndpointA.GetChannel()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]string{newPath.EndpointA.ConnectionID}, channel.ConnectionHops)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal([]string{path.EndpointA.ConnectionID}, channel.ConnectionHops)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetChannelReceiverPrefixProposal() {
+	var (
+		path      *ibctesting.Path
+		proposal  *types.SetChannelReceiverPrefixProposal
+		expPrefix string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: empty prefix removes receiver prefix", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "cosmos")
+
+				proposal.Bech32Prefix = ""
+				expPrefix = ""
+			}, true,
+		},
+		{
+			"channel not found", func() {
+				proposal.ChannelId = "channel-100"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			expPrefix = "osmo"
+			proposal = types.NewSetChannelReceiverPrefixProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ChannelID, expPrefix).(*types.SetChannelReceiverPrefixProposal)
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefixProposal(suite.chainA.GetContext(), proposal)
+
+			prefix, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelReceiverPrefix(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPrefix != "", found)
+				suite.Require().Equal(expPrefix, prefix)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetDenomFrozenProposal() {
+	var (
+		proposal  *types.SetDenomFrozenProposal
+		expFrozen bool
+	)
+
+	voucherDenom := types.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: freeze denom", func() {},
+		},
+		{
+			"success: freeze already frozen denom", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainA.GetContext(), voucherDenom)
+			},
+		},
+		{
+			"success: unfreeze denom", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainA.GetContext(), voucherDenom)
+
+				proposal.Frozen = false
+				expFrozen = false
+			},
+		},
+		{
+			"success: unfreeze denom which is not frozen", func() {
+				proposal.Frozen = false
+				expFrozen = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expFrozen = true
+			proposal = types.NewSetDenomFrozenProposal(ibctesting.Title, ibctesting.Description, voucherDenom, true).(*types.SetDenomFrozenProposal)
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SetDenomFrozenProposal(suite.chainA.GetContext(), proposal)
+			suite.Require().NoError(err)
+
+			frozen := suite.chainA.GetSimApp().TransferKeeper.IsDenomFrozen(suite.chainA.GetContext(), voucherDenom)
+			suite.Require().Equal(expFrozen, frozen)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetDenomTaxRateProposal() {
+	var (
+		proposal   *types.SetDenomTaxRateProposal
+		expTaxRate types.DenomTaxRate
+		expFound   bool
+	)
+
+	voucherDenom := types.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: set tax rates", func() {},
+		},
+		{
+			"success: update tax rates", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTaxRate(suite.chainA.GetContext(), types.NewDenomTaxRate(voucherDenom, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)))
+			},
+		},
+		{
+			"success: disable taxes", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTaxRate(suite.chainA.GetContext(), expTaxRate)
+
+				proposal.SendRate = sdk.ZeroDec()
+				proposal.ReceiveRate = sdk.ZeroDec()
+				expFound = false
+			},
+		},
+		{
+			"success: disable taxes of untaxed denom", func() {
+				proposal.SendRate = sdk.ZeroDec()
+				proposal.ReceiveRate = sdk.ZeroDec()
+				expFound = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expFound = true
+			expTaxRate = types.NewDenomTaxRate(voucherDenom, sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2))
+			proposal = types.NewSetDenomTaxRateProposal(ibctesting.Title, ibctesting.Description, voucherDenom, expTaxRate.SendRate, expTaxRate.ReceiveRate).(*types.SetDenomTaxRateProposal)
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SetDenomTaxRateProposal(suite.chainA.GetContext(), proposal)
+			suite.Require().NoError(err)
+
+			taxRate, found := suite.chainA.GetSimApp().TransferKeeper.GetDenomTaxRate(suite.chainA.GetContext(), voucherDenom)
+			suite.Require().Equal(expFound, found)
+
+			if expFound {
+				suite.Require().Equal(expTaxRate, taxRate)
+			}
+		})
+	}
+}
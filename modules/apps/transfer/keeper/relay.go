@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -58,16 +59,91 @@ func (k Keeper) SendTransfer(
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 ) error {
+	return k.SendTransferWithRefundAddress(ctx, sourcePort, sourceChannel, token, sender, receiver, timeoutHeight, timeoutTimestamp, "")
+}
+
+// SendTransferAndExecute sends a transfer in the same manner as SendTransferWithRefundAddress. Once
+// the tokens are credited to the receiver on the destination chain the provided execution is executed
+// by the receiver module registered on the destination chain. The transfer fails and the tokens are
+// refunded if the execution fails.
+func (k Keeper) SendTransferAndExecute(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	refundAddress string,
+	execution types.ReceiverExecution,
+) error {
+	if err := execution.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.sendTransfer(ctx, sourcePort, sourceChannel, token, sender, receiver, timeoutHeight, timeoutTimestamp, refundAddress, &execution)
+}
+
+// SendTransferWithRefundAddress sends a transfer in the same manner as SendTransfer. If the transfer
+// times out or fails on the destination chain the tokens are refunded to the provided refund address
+// instead of the sender. The refund address must be a valid address on the sending chain. An empty
+// refund address defaults to the sender.
+func (k Keeper) SendTransferWithRefundAddress(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	refundAddress string,
+) error {
+	return k.sendTransfer(ctx, sourcePort, sourceChannel, token, sender, receiver, timeoutHeight, timeoutTimestamp, refundAddress, nil)
+}
+
+// sendTransfer sends a transfer refunded to the provided refund address, carrying the provided
+// execution for a receiver module on the destination chain if it is not nil
+func (k Keeper) sendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	refundAddress string,
+	execution *types.ReceiverExecution,
+) error {
 
 	if !k.GetSendEnabled(ctx) {
 		return types.ErrSendDisabled
 	}
 
+	if k.IsDenomFrozen(ctx, token.Denom) {
+		return sdkerrors.Wrapf(types.ErrDenomFrozen, "denom %s", token.Denom)
+	}
+
 	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	// reject receivers which are not addressed using the bech32 prefix expected by the counterparty chain
+	if prefix, found := k.GetChannelReceiverPrefix(ctx, sourcePort, sourceChannel); found {
+		if err := types.ValidateReceiverPrefix(receiver, prefix); err != nil {
+			return err
+		}
+	}
+
+	if refundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(refundAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "refund address could not be parsed as address: %v", err)
+		}
+	}
+
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
@@ -101,6 +177,14 @@ func (k Keeper) SendTransfer(
 		}
 	}
 
+	// the tax is held from the sender until the packet is acknowledged, only the remaining amount is transferred
+	if taxRate, found := k.GetDenomTaxRate(ctx, token.Denom); found {
+		token, err = k.holdSendTax(ctx, sourcePort, sourceChannel, sequence, sender, token, taxRate.SendRate)
+		if err != nil {
+			return err
+		}
+	}
+
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
 		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
@@ -114,7 +198,7 @@ func (k Keeper) SendTransfer(
 		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
 
 		// create the escrow address for the tokens
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		escrowAddress := k.GetEscrowAddress(sourcePort, sourceChannel)
 
 		// escrow source tokens. It fails if balance insufficient.
 		if err := k.bankKeeper.SendCoins(
@@ -123,6 +207,8 @@ func (k Keeper) SendTransfer(
 			return err
 		}
 
+		k.trackEscrow(ctx, sourcePort, sourceChannel, token)
+
 	} else {
 		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
 
@@ -146,6 +232,8 @@ func (k Keeper) SendTransfer(
 	packetData := types.NewFungibleTokenPacketData(
 		fullDenomPath, token.Amount.String(), sender.String(), receiver,
 	)
+	packetData.RefundAddress = refundAddress
+	packetData.Execution = execution
 
 	packet := channeltypes.NewPacket(
 		packetData.GetBytes(),
@@ -162,6 +250,16 @@ func (k Keeper) SendTransfer(
 		return err
 	}
 
+	if k.IsPendingTransferIndexEnabled(ctx) {
+		k.SetPendingTransfer(ctx, sender.String(), types.PendingTransfer{
+			PortId:    sourcePort,
+			ChannelId: sourceChannel,
+			Sequence:  sequence,
+			Token:     token,
+			Receiver:  receiver,
+		})
+	}
+
 	defer func() {
 		if token.Amount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -185,7 +283,10 @@ func (k Keeper) SendTransfer(
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
+// unescrowed and sent to the receiving address. If the packet carries an execution, the tokens
+// are instead credited to an intermediate address derived from the channel and sender, from which
+// the targeted receiver module executes it before the remaining funds are credited to the receiving
+// address. The transfer fails if the execution fails.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
@@ -202,6 +303,13 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
+	// the tokens of a transfer carrying an execution are credited to the intermediate address,
+	// such that the receiver module never acts on the funds of the receiver
+	recipient := receiver
+	if data.Execution != nil {
+		recipient = types.GetReceiverExecutionAddress(packet.GetDestPort(), packet.GetDestChannel(), data.Sender)
+	}
+
 	// parse the transfer amount
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
@@ -237,11 +345,15 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		if denomTrace.Path != "" {
 			denom = denomTrace.IBCDenom()
 		}
+		if k.IsDenomFrozen(ctx, denom) {
+			return sdkerrors.Wrapf(types.ErrDenomFrozen, "denom %s", denom)
+		}
+
 		token := sdk.NewCoin(denom, transferAmount)
 
 		// unescrow tokens
-		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
+		escrowAddress := k.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, recipient, sdk.NewCoins(token)); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
@@ -249,6 +361,20 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		k.untrackEscrow(ctx, packet.GetDestPort(), packet.GetDestChannel(), token)
+
+		// the tax is collected from the recipient, only the remaining amount is available to the receiver module
+		if taxRate, found := k.GetDenomTaxRate(ctx, denom); found {
+			token, err = k.collectTransferTax(ctx, recipient, token, taxRate.ReceiveRate)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := k.executeReceiverModule(ctx, data.Execution, recipient, receiver, token); err != nil {
+			return err
+		}
+
 		defer func() {
 			if transferAmount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
@@ -280,12 +406,34 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
 
+	// the error acknowledgement refunds the sender
+	if maxDenomHops := k.GetMaxDenomHops(ctx); maxDenomHops != 0 && denomTrace.HopCount() > maxDenomHops {
+		return sdkerrors.Wrapf(types.ErrMaxDenomHopsExceeded, "denom %s has %d hops, maximum %d", prefixedDenom, denomTrace.HopCount(), maxDenomHops)
+	}
+
+	voucherDenom := denomTrace.IBCDenom()
+	if k.IsDenomFrozen(ctx, voucherDenom) {
+		return sdkerrors.Wrapf(types.ErrDenomFrozen, "denom %s", voucherDenom)
+	}
+
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
+
+		// the registered hooks may supply the metadata of a voucher denomination on its first mint
+		if k.hooks != nil {
+			if metadata, ok := k.hooks.OnVoucherDenomCreated(ctx, denomTrace); ok {
+				if err := k.setVoucherDenomMetadata(ctx, voucherDenom, metadata); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if !k.HasChannelDenom(ctx, packet.GetDestPort(), packet.GetDestChannel(), traceHash) {
+		k.SetChannelDenom(ctx, packet.GetDestPort(), packet.GetDestChannel(), traceHash)
 	}
 
-	voucherDenom := denomTrace.IBCDenom()
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDenomTrace,
@@ -302,13 +450,25 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
-	// send to receiver
+	// send to recipient
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
+		ctx, types.ModuleName, recipient, sdk.NewCoins(voucher),
 	); err != nil {
 		return err
 	}
 
+	// the tax is collected from the recipient, only the remaining amount is available to the receiver module
+	if taxRate, found := k.GetDenomTaxRate(ctx, voucherDenom); found {
+		voucher, err = k.collectTransferTax(ctx, recipient, voucher, taxRate.ReceiveRate)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := k.executeReceiverModule(ctx, data.Execution, recipient, receiver, voucher); err != nil {
+		return err
+	}
+
 	defer func() {
 		if transferAmount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -330,31 +490,171 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	return nil
 }
 
+// executeReceiverModule executes the provided execution on the receiver module it targets once the
+// token is credited to the intermediate address, and credits the funds remaining at the intermediate
+// address to the receiver. The execution fails if the receiver module reduces any balance of the
+// receiver. Nothing is executed if the execution is nil.
+func (k Keeper) executeReceiverModule(ctx sdk.Context, execution *types.ReceiverExecution, intermediate, receiver sdk.AccAddress, token sdk.Coin) error {
+	if execution == nil {
+		return nil
+	}
+
+	module, found := k.receiverModules[execution.Module]
+	if !found {
+		return sdkerrors.Wrapf(types.ErrReceiverModuleNotFound, "receiver module %s", execution.Module)
+	}
+
+	receiverBalances := k.bankKeeper.GetAllBalances(ctx, receiver)
+
+	if err := module.OnTransferReceived(ctx, intermediate, receiver, token, execution.Msg); err != nil {
+		return sdkerrors.Wrapf(err, "receiver module %s execution failed", execution.Module)
+	}
+
+	if !k.bankKeeper.GetAllBalances(ctx, receiver).IsAllGTE(receiverBalances) {
+		return sdkerrors.Wrapf(types.ErrReceiverFundsSpent, "receiver module %s", execution.Module)
+	}
+
+	if remaining := k.bankKeeper.GetAllBalances(ctx, intermediate); !remaining.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, intermediate, receiver, remaining); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReceiverExecution,
+			sdk.NewAttribute(types.AttributeKeyReceiverModule, execution.Module),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.String()),
+		),
+	)
+
+	return nil
+}
+
+// holdSendTax transfers the send tax owed on the provided token at the provided rate from the sender to the
+// transfer module account and returns the remaining token. The tax is held for the outgoing transfer with the
+// given packet sequence until the packet is acknowledged. It is credited to the community pool on a successful
+// acknowledgement and refunded along with the token otherwise. Nothing is held if the tax rounds down to zero.
+func (k Keeper) holdSendTax(ctx sdk.Context, portID, channelID string, sequence uint64, sender sdk.AccAddress, token sdk.Coin, rate sdk.Dec) (sdk.Coin, error) {
+	tax := types.ComputeTax(token.Amount, rate)
+	if tax.IsZero() {
+		return token, nil
+	}
+
+	if k.distrKeeper == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrTransferTaxUnsupported, "distribution keeper not set, cannot collect tax on %s", token)
+	}
+
+	taxCoin := sdk.NewCoin(token.Denom, tax)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(taxCoin)); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(err, "failed to hold transfer tax of %s", taxCoin)
+	}
+
+	k.SetPendingSendTax(ctx, portID, channelID, sequence, taxCoin)
+
+	return token.Sub(taxCoin), nil
+}
+
+// collectSendTax credits the send tax held for the provided packet to the community pool. Nothing is
+// collected if no tax is held for the packet.
+func (k Keeper) collectSendTax(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	taxCoin, found := k.GetPendingSendTax(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeletePendingSendTax(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(taxCoin), k.authKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+		return sdkerrors.Wrapf(err, "failed to collect transfer tax of %s", taxCoin)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferTax,
+			sdk.NewAttribute(types.AttributeKeyTaxPayer, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, taxCoin.String()),
+		),
+	)
+
+	return nil
+}
+
+// refundSendTax refunds the send tax held for the provided packet to the refund address. Nothing is
+// refunded if no tax is held for the packet.
+func (k Keeper) refundSendTax(ctx sdk.Context, packet channeltypes.Packet, refundAddress sdk.AccAddress) error {
+	taxCoin, found := k.GetPendingSendTax(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeletePendingSendTax(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAddress, sdk.NewCoins(taxCoin))
+}
+
+// collectTransferTax credits the tax owed on the provided token at the provided rate to the community pool
+// from the payer and returns the remaining token. Rates are restricted to [0, 1), such that the remaining
+// amount is always positive. Nothing is collected if the tax rounds down to zero.
+func (k Keeper) collectTransferTax(ctx sdk.Context, payer sdk.AccAddress, token sdk.Coin, rate sdk.Dec) (sdk.Coin, error) {
+	tax := types.ComputeTax(token.Amount, rate)
+	if tax.IsZero() {
+		return token, nil
+	}
+
+	if k.distrKeeper == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrTransferTaxUnsupported, "distribution keeper not set, cannot collect tax on %s", token)
+	}
+
+	taxCoin := sdk.NewCoin(token.Denom, tax)
+	if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(taxCoin), payer); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(err, "failed to collect transfer tax of %s", taxCoin)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferTax,
+			sdk.NewAttribute(types.AttributeKeyTaxPayer, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, taxCoin.String()),
+		),
+	)
+
+	return token.Sub(taxCoin), nil
+}
+
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
-// was a success then nothing occurs. If the acknowledgement failed, then
-// the sender is refunded their tokens using the refundPacketToken function.
+// was a success then the send tax held for the packet is collected. If the
+// acknowledgement failed, then the sender is refunded their tokens and the
+// send tax using the refundPacketToken function.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	k.DeletePendingTransfer(ctx, data.Sender, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		return k.refundPacketToken(ctx, packet, data)
 	default:
-		// the acknowledgement succeeded on the receiving chain so nothing
-		// needs to be executed and no error needs to be returned
-		return nil
+		// the acknowledgement succeeded on the receiving chain so only the
+		// send tax held for the packet needs to be collected
+		return k.collectSendTax(ctx, packet, data)
 	}
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	k.DeletePendingTransfer(ctx, data.Sender, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
 	return k.refundPacketToken(ctx, packet, data)
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
+// the sending address. The send tax held for the packet is refunded along
+// with the tokens. If the packet specifies a refund address the tokens are
+// refunded to it instead of the sender.
 func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// NOTE: packet data type already checked in handler.go
 
@@ -368,15 +668,19 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	}
 	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
-	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	// decode the refund address, defaulting to the sender
+	sender, err := sdk.AccAddressFromBech32(data.RefundRecipient())
 	if err != nil {
 		return err
 	}
 
+	if err := k.refundSendTax(ctx, packet, sender); err != nil {
+		return err
+	}
+
 	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
-		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		escrowAddress := k.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
 		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(token)); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
@@ -385,6 +689,8 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		k.untrackEscrow(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), token)
+
 		return nil
 	}
 
@@ -421,3 +727,18 @@ func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	fullDenomPath := denomTrace.GetFullDenomPath()
 	return fullDenomPath, nil
 }
+
+// setVoucherDenomMetadata validates and registers the bank denomination metadata of a voucher denomination.
+func (k Keeper) setVoucherDenomMetadata(ctx sdk.Context, voucherDenom string, metadata banktypes.Metadata) error {
+	if metadata.Base != voucherDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidDenomMetadata, "base denomination %s does not match voucher denomination %s", metadata.Base, voucherDenom)
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenomMetadata, err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return nil
+}
@@ -6,9 +6,13 @@ import (
 	"github.com/cosmos/ibc-go/v3/testing/simapp"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
@@ -17,9 +21,10 @@ import (
 // chainA and coin that orignate on chainB
 func (suite *KeeperTestSuite) TestSendTransfer() {
 	var (
-		amount sdk.Coin
-		path   *ibctesting.Path
-		err    error
+		amount        sdk.Coin
+		path          *ibctesting.Path
+		refundAddress string
+		err           error
 	)
 
 	testCases := []struct {
@@ -39,6 +44,36 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.coordinator.CreateTransferChannels(path)
 				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(100))
 			}, false, true},
+		{"successful transfer to receiver with expected prefix",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.GetConfig().GetBech32AccountAddrPrefix())
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, true},
+		{"receiver does not have expected prefix",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "osmo")
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, false},
+		{"successful transfer with refund address",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				refundAddress = sdk.AccAddress([]byte("refund_address______")).String()
+			}, true, true},
+		{"invalid refund address",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				refundAddress = "invalid address"
+			}, true, false},
+		{"denom is frozen",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, false},
 		{"source channel not found",
 			func() {
 				// channel references wrong ID
@@ -91,6 +126,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			suite.SetupTest() // reset
 			path = NewTransferPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
+			refundAddress = "" // must be explicitly changed
 
 			tc.malleate()
 
@@ -116,9 +152,9 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.Require().NoError(err) // message committed
 			}
 
-			err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+			err = suite.chainA.GetSimApp().TransferKeeper.SendTransferWithRefundAddress(
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount,
-				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, refundAddress,
 			)
 
 			if tc.expPass {
@@ -130,15 +166,414 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 	}
 }
 
+// TestPendingTransferIndex tests that outgoing transfers are indexed by sender if enabled by the
+// transfer parameters and removed from the index on every completion path.
+func (suite *KeeperTestSuite) TestPendingTransferIndex() {
+	var (
+		path          *ibctesting.Path
+		timeoutHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		indexed  bool
+		malleate func()
+		complete func(packet channeltypes.Packet)
+	}{
+		{"indexing disabled", false, func() {}, nil},
+		{"removed on successful acknowledgement", true, func() {}, func(packet channeltypes.Packet) {
+			err := path.RelayPacket(packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+			suite.Require().NoError(err)
+		}},
+		{"removed on error acknowledgement", true, func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, false, 0))
+		}, func(packet channeltypes.Packet) {
+			err := path.RelayPacket(packet, channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled.Error()).Acknowledgement())
+			suite.Require().NoError(err)
+		}},
+		{"removed on timeout", true, func() {
+			timeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+		}, func(packet channeltypes.Packet) {
+			// need to update chainA's client representing chainB to prove missing receipt
+			err := path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			timeoutHeight = clienttypes.NewHeight(0, 110)
+
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, tc.indexed, 0))
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress().String()
+			receiver := suite.chainB.SenderAccount.GetAddress().String()
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender, receiver, timeoutHeight, 0)
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			expPendingTransfer := types.PendingTransfer{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+				Sequence:  1,
+				Token:     coin,
+				Receiver:  receiver,
+			}
+
+			pendingTransfer, found := suite.chainA.GetSimApp().TransferKeeper.GetPendingTransfer(suite.chainA.GetContext(), sender, expPendingTransfer.PortId, expPendingTransfer.ChannelId, expPendingTransfer.Sequence)
+			suite.Require().Equal(tc.indexed, found)
+			if !tc.indexed {
+				return
+			}
+
+			suite.Require().Equal(expPendingTransfer, pendingTransfer)
+
+			data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender, receiver)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			tc.complete(packet)
+
+			_, found = suite.chainA.GetSimApp().TransferKeeper.GetPendingTransfer(suite.chainA.GetContext(), sender, expPendingTransfer.PortId, expPendingTransfer.ChannelId, expPendingTransfer.Sequence)
+			suite.Require().False(found)
+		})
+	}
+}
+
+// TestChannelEscrowTracking tests that the amounts escrowed over a channel are tracked when tokens are
+// escrowed and released when tokens are unescrowed on every completion path.
+func (suite *KeeperTestSuite) TestChannelEscrowTracking() {
+	var (
+		path          *ibctesting.Path
+		timeoutHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		msg       string
+		expAmount sdk.Int
+		malleate  func()
+		complete  func(packet channeltypes.Packet)
+	}{
+		{"retained on successful acknowledgement", sdk.NewInt(100), func() {}, func(packet channeltypes.Packet) {
+			err := path.RelayPacket(packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+			suite.Require().NoError(err)
+		}},
+		{"released on error acknowledgement", sdk.ZeroInt(), func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, false, 0))
+		}, func(packet channeltypes.Packet) {
+			err := path.RelayPacket(packet, channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled.Error()).Acknowledgement())
+			suite.Require().NoError(err)
+		}},
+		{"released on timeout", sdk.ZeroInt(), func() {
+			timeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+		}, func(packet channeltypes.Packet) {
+			// need to update chainA's client representing chainB to prove missing receipt
+			err := path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+		}},
+		{"released when the tokens are sent back", sdk.ZeroInt(), func() {}, func(packet channeltypes.Packet) {
+			err := path.RelayPacket(packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+			suite.Require().NoError(err)
+
+			voucherDenom := types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)
+			voucher := sdk.NewCoin(types.ParseDenomTrace(voucherDenom).IBCDenom(), sdk.NewInt(100))
+			sender := suite.chainB.SenderAccount.GetAddress().String()
+			receiver := suite.chainA.SenderAccount.GetAddress().String()
+
+			msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucher, sender, receiver, timeoutHeight, 0)
+			_, err = suite.chainB.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			data := types.NewFungibleTokenPacketData(voucherDenom, voucher.Amount.String(), sender, receiver)
+			packet = channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+
+			err = path.RelayPacket(packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+			suite.Require().NoError(err)
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			timeoutHeight = clienttypes.NewHeight(0, 110)
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress().String()
+			receiver := suite.chainB.SenderAccount.GetAddress().String()
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender, receiver, timeoutHeight, 0)
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			amount := suite.chainA.GetSimApp().TransferKeeper.GetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom)
+			suite.Require().Equal(coin.Amount, amount)
+
+			data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender, receiver)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			tc.complete(packet)
+
+			amount = suite.chainA.GetSimApp().TransferKeeper.GetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom)
+			suite.Require().Equal(tc.expAmount, amount)
+
+			// the tracked amount matches the escrow account balance
+			suite.Require().Empty(suite.chainA.GetSimApp().TransferKeeper.GetEscrowDiscrepancies(suite.chainA.GetContext()))
+		})
+	}
+}
+
+// test collecting the taxes of a transfer from chainA to chainB, the send tax is held by chainA until the
+// successful acknowledgement and the receive tax is collected by chainB
+func (suite *KeeperTestSuite) TestTransferTax() {
+	testCases := []struct {
+		msg           string
+		amount        sdk.Int
+		sendRate      sdk.Dec
+		receiveRate   sdk.Dec
+		expSendTax    sdk.Int
+		expReceiveTax sdk.Int
+	}{
+		{"no taxes", sdk.NewInt(1000), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()},
+		{"send tax", sdk.NewInt(1000), sdk.NewDecWithPrec(15, 3), sdk.ZeroDec(), sdk.NewInt(15), sdk.ZeroInt()},
+		{"receive tax", sdk.NewInt(1000), sdk.ZeroDec(), sdk.NewDecWithPrec(1, 1), sdk.ZeroInt(), sdk.NewInt(100)},
+		{"send and receive taxes rounded down", sdk.NewInt(1000), sdk.NewDecWithPrec(15, 3), sdk.NewDecWithPrec(1, 1), sdk.NewInt(15), sdk.NewInt(98)},
+		{"taxes rounded down to zero", sdk.NewInt(10), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2), sdk.ZeroInt(), sdk.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+			suite.chainA.GetSimApp().TransferKeeper.SetDenomTaxRate(suite.chainA.GetContext(), types.NewDenomTaxRate(sdk.DefaultBondDenom, tc.sendRate, sdk.ZeroDec()))
+			suite.chainB.GetSimApp().TransferKeeper.SetDenomTaxRate(suite.chainB.GetContext(), types.NewDenomTaxRate(voucherDenom, sdk.ZeroDec(), tc.receiveRate))
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress()
+
+			ctxA := suite.chainA.GetContext()
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctxA, sender, sdk.DefaultBondDenom)
+			communityPoolA := suite.chainA.GetSimApp().DistrKeeper.GetFeePoolCommunityCoins(ctxA).AmountOf(sdk.DefaultBondDenom)
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, tc.amount), sender, receiver.String(), clienttypes.NewHeight(0, 110), 0)
+			suite.Require().NoError(err)
+
+			// the sender is charged the full amount, only the remaining amount is escrowed and the tax is held
+			sentAmount := tc.amount.Sub(tc.expSendTax)
+			suite.Require().Equal(senderBalance.Amount.Sub(tc.amount), suite.chainA.GetSimApp().BankKeeper.GetBalance(ctxA, sender, sdk.DefaultBondDenom).Amount)
+			suite.Require().Equal(sentAmount, suite.chainA.GetSimApp().TransferKeeper.GetChannelEscrow(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+			suite.Require().Equal(communityPoolA, suite.chainA.GetSimApp().DistrKeeper.GetFeePoolCommunityCoins(ctxA).AmountOf(sdk.DefaultBondDenom))
+
+			heldTax, found := suite.chainA.GetSimApp().TransferKeeper.GetPendingSendTax(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+			suite.Require().Equal(!tc.expSendTax.IsZero(), found)
+			if found {
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, tc.expSendTax), heldTax)
+			}
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, sentAmount.String(), sender.String(), receiver.String())
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 110), 0)
+
+			ctxB := suite.chainB.GetContext()
+			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(ctxB, packet, data)
+			suite.Require().NoError(err)
+
+			// the receiver is credited the remaining amount once the receive tax is collected
+			suite.Require().Equal(sentAmount.Sub(tc.expReceiveTax), suite.chainB.GetSimApp().BankKeeper.GetBalance(ctxB, receiver, voucherDenom).Amount)
+			suite.Require().Equal(tc.expReceiveTax.ToDec(), suite.chainB.GetSimApp().DistrKeeper.GetFeePoolCommunityCoins(ctxB).AmountOf(voucherDenom))
+
+			// the send tax is collected once the transfer is successfully acknowledged
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			err = suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(ctxA, packet, data, ack)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(communityPoolA.Add(tc.expSendTax.ToDec()), suite.chainA.GetSimApp().DistrKeeper.GetFeePoolCommunityCoins(ctxA).AmountOf(sdk.DefaultBondDenom))
+			_, found = suite.chainA.GetSimApp().TransferKeeper.GetPendingSendTax(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+			suite.Require().False(found)
+		})
+	}
+}
+
+// test that the send tax held for a transfer is refunded along with the tokens if the transfer times out
+// or is acknowledged with an error, for both native tokens and vouchers
+func (suite *KeeperTestSuite) TestTransferTaxRefund() {
+	var (
+		path  *ibctesting.Path
+		denom string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		refund   func(packet channeltypes.Packet, data types.FungibleTokenPacketData) error
+	}{
+		{"timeout", func() {}, func(packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+			return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+		}},
+		{"error acknowledgement", func() {}, func(packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+			ack := channeltypes.NewErrorAcknowledgement("failed packet transfer")
+			return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+		}},
+		{"timeout of voucher transfer", func() {
+			denom = suite.receiveVoucherOnChainA(path)
+		}, func(packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+			return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+		}},
+		{"error acknowledgement of voucher transfer", func() {
+			denom = suite.receiveVoucherOnChainA(path)
+		}, func(packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+			ack := channeltypes.NewErrorAcknowledgement("failed packet transfer")
+			return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			denom = sdk.DefaultBondDenom
+			tc.malleate()
+
+			suite.chainA.GetSimApp().TransferKeeper.SetDenomTaxRate(suite.chainA.GetContext(), types.NewDenomTaxRate(denom, sdk.NewDecWithPrec(1, 1), sdk.ZeroDec()))
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			amount := sdk.NewCoin(denom, sdk.NewInt(100))
+
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, denom)
+			communityPool := suite.chainA.GetSimApp().DistrKeeper.GetFeePoolCommunityCoins(suite.chainA.GetContext())
+			sequence, _ := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount, sender, receiver.String(), clienttypes.NewHeight(0, 110), 0)
+			suite.Require().NoError(err)
+
+			fullDenomPath := denom
+			if denom != sdk.DefaultBondDenom {
+				fullDenomPath, err = suite.chainA.GetSimApp().TransferKeeper.DenomPathFromHash(suite.chainA.GetContext(), denom)
+				suite.Require().NoError(err)
+			}
+
+			// only the amount remaining after the 10% send tax is transferred
+			data := types.NewFungibleTokenPacketData(fullDenomPath, "90", sender.String(), receiver.String())
+			packet := channeltypes.NewPacket(data.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 110), 0)
+
+			err = tc.refund(packet, data)
+			suite.Require().NoError(err)
+
+			// the sender is refunded the full amount including the send tax, which is never collected
+			suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, denom))
+			suite.Require().Equal(communityPool, suite.chainA.GetSimApp().DistrKeeper.GetFeePoolCommunityCoins(suite.chainA.GetContext()))
+			suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().TransferKeeper.GetTransferAccount(suite.chainA.GetContext()).GetAddress(), denom).IsZero())
+
+			_, found := suite.chainA.GetSimApp().TransferKeeper.GetPendingSendTax(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+			suite.Require().False(found)
+		})
+	}
+}
+
+// receiveVoucherOnChainA transfers native tokens of chainB to the sender on chainA over the provided path
+// and returns the voucher denomination received on chainA.
+func (suite *KeeperTestSuite) receiveVoucherOnChainA(path *ibctesting.Path) string {
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	sender := suite.chainB.SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+
+	msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin, sender.String(), receiver.String(), timeoutHeight, 0)
+	_, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), receiver.String())
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+	err = path.RelayPacket(packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	suite.Require().NoError(err)
+
+	return types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+}
+
 // test receiving coin on chainB with coin that orignate on chainA and
 // coin that orignated on chainB (source). The bulk of the testing occurs
 // in the test case for loop since setup is intensive for all cases. The
 // malleate function allows for testing invalid cases.
+// test sending from chainA over a channel whose connection is backed by a solo machine client,
+// which does not store consensus states by height, and refunding the transfer on timeout
+func (suite *KeeperTestSuite) TestSendTransferSolomachineClient() {
+	suite.SetupTest() // reset
+
+	ctx := suite.chainA.GetContext()
+	app := suite.chainA.GetSimApp()
+
+	solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "testing", 1)
+	clientID, err := app.IBCKeeper.ClientKeeper.CreateClient(ctx, solomachine.ClientState(), solomachine.ConsensusState())
+	suite.Require().NoError(err)
+
+	counterparty := connectiontypes.NewCounterparty("07-tendermint-0", ibctesting.FirstConnectionID, commitmenttypes.NewMerklePrefix([]byte("ibc")))
+	connection := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, clientID, counterparty, connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0, 0)
+	app.IBCKeeper.ConnectionKeeper.SetConnection(ctx, ibctesting.FirstConnectionID, connection)
+
+	portID, channelID := types.PortID, ibctesting.FirstChannelID
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(types.PortID, ibctesting.FirstChannelID), []string{ibctesting.FirstConnectionID}, types.Version)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, portID, channelID, channel)
+	app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, portID, channelID, 1)
+
+	chanCap, err := app.ScopedIBCKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	suite.Require().NoError(err)
+	err = app.ScopedTransferKeeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+	suite.Require().NoError(err)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	balance := app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+	timeoutHeight := clienttypes.NewHeight(0, solomachine.GetHeight().GetRevisionHeight()+100)
+	err = app.TransferKeeper.SendTransfer(ctx, portID, channelID, amount, sender, suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(err)
+
+	escrow := app.TransferKeeper.GetEscrowAddress(portID, channelID)
+	suite.Require().Equal(amount, app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom))
+
+	data := types.NewFungibleTokenPacketData(amount.Denom, amount.Amount.String(), sender.String(), suite.chainB.SenderAccount.GetAddress().String())
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, portID, channelID, types.PortID, ibctesting.FirstChannelID, timeoutHeight, 0)
+	suite.Require().True(app.IBCKeeper.ChannelKeeper.HasPacketCommitment(ctx, portID, channelID, packet.GetSequence()))
+
+	err = app.TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(balance, app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	suite.Require().True(app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).IsZero())
+}
+
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	var (
 		trace    types.DenomTrace
 		amount   sdk.Int
 		receiver string
+		path     *ibctesting.Path
 	)
 
 	testCases := []struct {
@@ -163,10 +598,25 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			amount = sdk.ZeroInt()
 		}, false, false},
 
+		{"success: voucher denom within max denom hops", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, false, 1))
+		}, false, true},
+		{"failure: voucher denom exceeds max denom hops", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, false, 1))
+			trace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+		}, false, false},
+		{"failure: voucher denom is frozen", func() {
+			voucherTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, trace.GetFullDenomPath()))
+			suite.chainB.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainB.GetContext(), voucherTrace.IBCDenom())
+		}, false, false},
+
 		// - coin being sent back to original chain (chainB)
 		{"tries to unescrow more tokens than allowed", func() {
 			amount = sdk.NewInt(1000000)
 		}, true, false},
+		{"failure: unescrowed denom is frozen", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainB.GetContext(), sdk.DefaultBondDenom)
+		}, true, false},
 	}
 
 	for _, tc := range testCases {
@@ -175,7 +625,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := NewTransferPath(suite.chainA, suite.chainB)
+			path = NewTransferPath(suite.chainA, suite.chainB)
 			suite.coordinator.Setup(path)
 			receiver = suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
 
@@ -218,6 +668,12 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				if !tc.recvIsSource {
+					// the minted voucher is indexed under the receiving channel
+					voucherTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, trace.GetFullDenomPath()))
+					suite.Require().True(suite.chainB.GetSimApp().TransferKeeper.HasChannelDenom(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherTrace.Hash()))
+				}
 			} else {
 				suite.Require().Error(err)
 			}
@@ -225,6 +681,225 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// mockTransferHooks records the transfer hook invocations and returns the configured metadata
+type mockTransferHooks struct {
+	denomTraces []types.DenomTrace
+	metadata    banktypes.Metadata
+	ok          bool
+}
+
+func (h *mockTransferHooks) OnVoucherDenomCreated(_ sdk.Context, denomTrace types.DenomTrace) (banktypes.Metadata, bool) {
+	h.denomTraces = append(h.denomTraces, denomTrace)
+	return h.metadata, h.ok
+}
+
+// test that the transfer hooks may supply the metadata of a voucher denomination on its first mint
+func (suite *KeeperTestSuite) TestOnRecvPacketVoucherDenomMetadata() {
+	var (
+		hooks        *mockTransferHooks
+		voucherTrace types.DenomTrace
+	)
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expMetadata bool
+		expPass     bool
+	}{
+		{"success: metadata supplied", func() {}, true, true},
+		{"success: no metadata supplied", func() {
+			hooks.ok = false
+		}, false, true},
+		{"base denomination does not match voucher denomination", func() {
+			hooks.metadata.Base = sdk.DefaultBondDenom
+		}, false, false},
+		{"invalid metadata", func() {
+			hooks.metadata.Name = ""
+		}, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			voucherTrace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			voucherDenom := voucherTrace.IBCDenom()
+
+			hooks = &mockTransferHooks{
+				metadata: banktypes.Metadata{
+					Name:       "Atom",
+					Symbol:     "ATOM",
+					Base:       voucherDenom,
+					Display:    voucherDenom,
+					DenomUnits: []*banktypes.DenomUnit{{Denom: voucherDenom, Exponent: 0}},
+				},
+				ok: true,
+			}
+			suite.chainB.GetSimApp().TransferKeeper.SetHooks(hooks)
+
+			tc.malleate()
+
+			receiver := suite.chainB.SenderAccount.GetAddress().String()
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), receiver)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			suite.Require().Equal([]types.DenomTrace{voucherTrace}, hooks.denomTraces)
+
+			metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucherDenom)
+			suite.Require().Equal(tc.expMetadata, found)
+			if tc.expMetadata {
+				suite.Require().Equal(hooks.metadata, metadata)
+			}
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// the hooks are not invoked on subsequent mints of the voucher denomination
+				packet.Sequence = 2
+				err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+				suite.Require().NoError(err)
+				suite.Require().Len(hooks.denomTraces, 1)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidDenomMetadata)
+			}
+		})
+	}
+}
+
+// mockVaultReceiver is a receiver module depositing the received tokens into a vault. It deposits
+// half of the tokens if the message is "half", fails the execution after the deposit if the message
+// is "fail" and additionally deposits funds of the receiver if the message is "drain".
+type mockVaultReceiver struct {
+	bankKeeper types.BankKeeper
+	vault      sdk.AccAddress
+}
+
+var errVaultDeposit = sdkerrors.Register("mock-vault", 2, "vault deposit failed")
+
+func (r mockVaultReceiver) OnTransferReceived(ctx sdk.Context, intermediate, receiver sdk.AccAddress, token sdk.Coin, msg []byte) error {
+	deposit := token
+	if string(msg) == "half" {
+		deposit.Amount = deposit.Amount.QuoRaw(2)
+	}
+
+	if err := r.bankKeeper.SendCoins(ctx, intermediate, r.vault, sdk.NewCoins(deposit)); err != nil {
+		return err
+	}
+
+	switch string(msg) {
+	case "fail":
+		return errVaultDeposit
+	case "drain":
+		return r.bankKeeper.SendCoins(ctx, receiver, r.vault, r.bankKeeper.GetAllBalances(ctx, receiver))
+	}
+
+	return nil
+}
+
+// test that the execution carried by a transfer is executed by the receiver module from the
+// intermediate address once the tokens are credited, and that the transfer is reverted and refunded
+// if the execution fails
+func (suite *KeeperTestSuite) TestReceiverExecution() {
+	var execution types.ReceiverExecution
+
+	vault := sdk.AccAddress([]byte("vault_______________"))
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expDeposit sdk.Int
+		expErr     error
+	}{
+		{"success", func() {}, sdk.NewInt(100), nil},
+		{"success: remaining tokens credited to the receiver", func() {
+			execution.Msg = []byte("half")
+		}, sdk.NewInt(50), nil},
+		{"receiver module execution fails after acting on the tokens", func() {
+			execution.Msg = []byte("fail")
+		}, sdk.ZeroInt(), sdkerrors.Wrapf(errVaultDeposit, "receiver module %s execution failed", "vault")},
+		{"receiver module spends the funds of the receiver", func() {
+			execution.Msg = []byte("drain")
+		}, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrReceiverFundsSpent, "receiver module %s", "vault")},
+		{"receiver module not registered", func() {
+			execution.Module = "unknown"
+		}, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrReceiverModuleNotFound, "receiver module %s", "unknown")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.chainB.GetSimApp().TransferKeeper.RegisterReceiverModule("vault", mockVaultReceiver{
+				bankKeeper: suite.chainB.GetSimApp().BankKeeper,
+				vault:      vault,
+			})
+
+			execution = types.NewReceiverExecution("vault", []byte("deposit"))
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			timeoutHeight := clienttypes.NewHeight(0, 110)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			receiverFunds := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), receiver)
+
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), receiver.String(), timeoutHeight, 0)
+			msg.Execution = &execution
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), receiver.String())
+			data.Execution = &execution
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			if tc.expErr != nil {
+				ack = channeltypes.NewErrorAcknowledgement(tc.expErr.Error())
+			}
+
+			err = path.RelayPacket(packet, ack.Acknowledgement())
+			suite.Require().NoError(err)
+
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			vaultBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), vault, voucherDenom)
+			receiverBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom)
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().TransferKeeper.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), sdk.DefaultBondDenom)
+			intermediate := types.GetReceiverExecutionAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sender.String())
+
+			// the tokens are either deposited into the vault by the receiver module or credited to the
+			// receiver, no funds remain at the intermediate address and the receiver funds are untouched
+			suite.Require().True(tc.expDeposit.Equal(vaultBalance.Amount))
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), intermediate).IsZero())
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), vault, sdk.DefaultBondDenom).IsZero())
+			suite.Require().Equal(receiverFunds, suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), receiver).Sub(sdk.NewCoins(receiverBalance)))
+
+			if tc.expErr == nil {
+				suite.Require().True(coin.Amount.Sub(tc.expDeposit).Equal(receiverBalance.Amount))
+				suite.Require().Equal(coin, escrowBalance)
+			} else {
+				suite.Require().True(receiverBalance.IsZero())
+				// the credit and the state changes of the receiver module are reverted and the sender is refunded
+				suite.Require().False(suite.chainB.GetSimApp().TransferKeeper.HasDenomTrace(suite.chainB.GetContext(), types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).Hash()))
+				suite.Require().True(escrowBalance.IsZero())
+				suite.Require().Equal(balance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
+
 // TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
 // and failure acknowledment leads to refund when attempting to send from chainA
 // to chainB. If sender is source than the denomination being refunded has no
@@ -267,6 +942,14 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
 			}, false, true},
+		{"successful refund of frozen denom", failedAck, func() {
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+			suite.chainA.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+
+			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+		}, false, true},
 	}
 
 	for _, tc := range testCases {
@@ -310,10 +993,11 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 // so the refunds are occurring on chainA.
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 	var (
-		trace  types.DenomTrace
-		path   *ibctesting.Path
-		amount sdk.Int
-		sender string
+		trace         types.DenomTrace
+		path          *ibctesting.Path
+		amount        sdk.Int
+		sender        string
+		refundAddress sdk.AccAddress
 	)
 
 	testCases := []struct {
@@ -335,12 +1019,49 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				trace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			}, true},
+		{"successful timeout refunds refund address instead of sender",
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				coin := sdk.NewCoin(trace.IBCDenom(), amount)
+				refundAddress = sdk.AccAddress([]byte("refund_address______"))
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			}, true},
+		{"successful timeout from sender as source chain with registered escrow address",
+			func() {
+				escrow := sdk.AccAddress([]byte("escrow_address______"))
+				suite.chainA.GetSimApp().TransferKeeper.RegisterEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, escrow)
+				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				coin := sdk.NewCoin(trace.IBCDenom(), amount)
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			}, true},
+		{"successful timeout of frozen voucher denom",
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				trace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+				coin := sdk.NewCoin(trace.IBCDenom(), amount)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomFrozen(suite.chainA.GetContext(), trace.IBCDenom())
+
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
 			}, true},
 		{"no balance for coin denom",
 			func() {
 				trace = types.ParseDenomTrace("bitcoin")
 			}, false},
+		{"unescrow failed with registered escrow address",
+			func() {
+				// derived escrow address is funded but the registered escrow address is used
+				suite.chainA.GetSimApp().TransferKeeper.RegisterEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.AccAddress([]byte("escrow_address______")))
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				coin := sdk.NewCoin(trace.IBCDenom(), amount)
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			}, false},
 		{"unescrow failed",
 			func() {
 				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
@@ -363,17 +1084,25 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			suite.coordinator.Setup(path)
 			amount = sdk.NewInt(100) // must be explicitly changed
 			sender = suite.chainA.SenderAccount.GetAddress().String()
+			refundAddress = nil // must be explicitly changed
 
 			tc.malleate()
 
 			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String())
+
+			refundee := suite.chainA.SenderAccount.GetAddress()
+			if refundAddress != nil {
+				data.RefundAddress = refundAddress.String()
+				refundee = refundAddress
+			}
+
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
-			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
+			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundee, trace.IBCDenom())
 
 			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
 
-			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
+			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundee, trace.IBCDenom())
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
 
 			if tc.expPass {
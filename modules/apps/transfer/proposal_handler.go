@@ -0,0 +1,32 @@
+package transfer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// NewProposalHandler defines the ibc transfer proposal handler
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.MigrateChannelConnectionProposal:
+			return k.MigrateChannelConnectionProposal(ctx, c)
+
+		case *types.SetChannelReceiverPrefixProposal:
+			return k.SetChannelReceiverPrefixProposal(ctx, c)
+
+		case *types.SetDenomFrozenProposal:
+			return k.SetDenomFrozenProposal(ctx, c)
+
+		case *types.SetDenomTaxRateProposal:
+			return k.SetDenomTaxRateProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc transfer proposal content type: %T", c)
+		}
+	}
+}
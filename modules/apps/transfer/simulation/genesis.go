@@ -42,7 +42,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	transferGenesis := types.GenesisState{
 		PortId:      portID,
 		DenomTraces: types.Traces{},
-		Params:      types.NewParams(sendEnabled, receiveEnabled),
+		Params:      types.NewParams(sendEnabled, receiveEnabled, types.DefaultIndexPendingTransfers, types.DefaultMaxDenomHops),
 	}
 
 	bz, err := json.MarshalIndent(&transferGenesis, "", " ")
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -131,6 +132,72 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Zero(balance.Amount.Int64())
 }
 
+// escrows funds on chainA, migrates the transfer channel on both chains to a new connection
+// using governance proposals and sends the vouchers back to chainA over the new connection.
+func (suite *TransferTestSuite) TestMigrateChannelConnection() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	// send from chainA to chainB
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coinToSendToB, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	fungibleTokenPacket := types.NewFungibleTokenPacketData(coinToSendToB.Denom, coinToSendToB.Amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+	packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err = path.RelayPacket(packet, ack.Acknowledgement())
+	suite.Require().NoError(err) // relay committed
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(coinToSendToB, balance)
+
+	// migrate the channel on both chains to a new connection
+	newPath := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(newPath)
+
+	proposalA := types.NewMigrateChannelConnectionProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ChannelID, newPath.EndpointA.ConnectionID)
+	err = transfer.NewProposalHandler(suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext(), proposalA)
+	suite.Require().NoError(err)
+
+	proposalB := types.NewMigrateChannelConnectionProposal(ibctesting.Title, ibctesting.Description, path.EndpointB.ChannelID, newPath.EndpointB.ConnectionID)
+	err = transfer.NewProposalHandler(suite.chainB.GetSimApp().TransferKeeper)(suite.chainB.GetContext(), proposalB)
+	suite.Require().NoError(err)
+
+	path.EndpointA.ClientID, path.EndpointA.ConnectionID = newPath.EndpointA.ClientID, newPath.EndpointA.ConnectionID
+	path.EndpointB.ClientID, path.EndpointB.ConnectionID = newPath.EndpointB.ClientID, newPath.EndpointB.ConnectionID
+
+	// escrowed funds are unaffected by the migration
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(coinToSendToB, balance)
+
+	// send the vouchers from chainB back to chainA over the new connection
+	voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	voucher := sdk.NewCoin(voucherDenomTrace.IBCDenom(), coinToSendToB.Amount)
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucher, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+
+	_, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	// sequences are preserved by the migration
+	fungibleTokenPacket = types.NewFungibleTokenPacketData(voucherDenomTrace.GetFullDenomPath(), voucher.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+	packet = channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+	err = path.RelayPacket(packet, ack.Acknowledgement())
+	suite.Require().NoError(err) // relay committed
+
+	// check that the escrowed funds were released
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), balance)
+
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucher.Denom)
+	suite.Require().Zero(balance.Amount.Int64())
+}
+
 func TestTransferTestSuite(t *testing.T) {
 	suite.Run(t, new(TransferTestSuite))
 }
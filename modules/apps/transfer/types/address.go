@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBech32Prefix performs a basic validation of a bech32 human readable part. It must be
+// non-blank, lowercase and may only contain printable ASCII characters.
+func ValidateBech32Prefix(prefix string) error {
+	if strings.TrimSpace(prefix) == "" {
+		return sdkerrors.Wrap(ErrInvalidReceiverPrefix, "bech32 prefix cannot be blank")
+	}
+
+	if prefix != strings.ToLower(prefix) {
+		return sdkerrors.Wrapf(ErrInvalidReceiverPrefix, "bech32 prefix %s must be lowercase", prefix)
+	}
+
+	for _, c := range prefix {
+		if c < 33 || c > 126 {
+			return sdkerrors.Wrapf(ErrInvalidReceiverPrefix, "bech32 prefix %s contains invalid character %q", prefix, c)
+		}
+	}
+
+	return nil
+}
+
+// ValidateReceiverPrefix checks that the receiver is a bech32 encoded address using the expected prefix.
+func ValidateReceiverPrefix(receiver, expectedPrefix string) error {
+	prefix, _, err := bech32.DecodeAndConvert(receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidReceiverPrefix, "receiver %s is not a valid bech32 address with prefix %s: %s", receiver, expectedPrefix, err)
+	}
+
+	if prefix != expectedPrefix {
+		return sdkerrors.Wrapf(ErrInvalidReceiverPrefix, "receiver %s has prefix %s, expected %s", receiver, prefix, expectedPrefix)
+	}
+
+	return nil
+}
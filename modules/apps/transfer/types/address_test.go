@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBech32Prefix(t *testing.T) {
+	testCases := []struct {
+		name    string
+		prefix  string
+		expPass bool
+	}{
+		{"valid prefix", "cosmos", true},
+		{"valid prefix with digits", "cosmos1valoper", true},
+		{"empty prefix", "", false},
+		{"blank prefix", "  ", false},
+		{"uppercase prefix", "Cosmos", false},
+		{"prefix with whitespace", "cos mos", false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateBech32Prefix(tc.prefix)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateReceiverPrefix(t *testing.T) {
+	testCases := []struct {
+		name     string
+		receiver string
+		prefix   string
+		expPass  bool
+	}{
+		{"valid receiver", addr2, "cosmos", true},
+		{"prefix mismatch", addr2, "osmo", false},
+		{"receiver is not a bech32 address", "0x1234", "cosmos", false},
+		{"empty receiver", "", "cosmos", false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateReceiverPrefix(tc.receiver, tc.prefix)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
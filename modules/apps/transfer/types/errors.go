@@ -6,12 +6,21 @@ import (
 
 // IBC transfer sentinel errors
 var (
-	ErrInvalidPacketTimeout    = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
-	ErrInvalidDenomForTransfer = sdkerrors.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
-	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 4, "invalid ICS20 version")
-	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 5, "invalid token amount")
-	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
-	ErrSendDisabled            = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
-	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
-	ErrMaxTransferChannels     = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidPacketTimeout     = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidDenomForTransfer  = sdkerrors.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
+	ErrInvalidVersion           = sdkerrors.Register(ModuleName, 4, "invalid ICS20 version")
+	ErrInvalidAmount            = sdkerrors.Register(ModuleName, 5, "invalid token amount")
+	ErrTraceNotFound            = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
+	ErrSendDisabled             = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled          = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels      = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidReceiverPrefix    = sdkerrors.Register(ModuleName, 10, "invalid receiver address prefix")
+	ErrDenomFrozen              = sdkerrors.Register(ModuleName, 11, "transfers of denomination are frozen")
+	ErrInvalidDenomMetadata     = sdkerrors.Register(ModuleName, 12, "invalid voucher denomination metadata")
+	ErrInvalidReceiverExecution = sdkerrors.Register(ModuleName, 13, "invalid receiver execution")
+	ErrReceiverModuleNotFound   = sdkerrors.Register(ModuleName, 14, "receiver module not found")
+	ErrMaxDenomHopsExceeded     = sdkerrors.Register(ModuleName, 15, "denomination trace exceeds the maximum number of hops")
+	ErrInvalidTaxRate           = sdkerrors.Register(ModuleName, 16, "invalid transfer tax rate")
+	ErrTransferTaxUnsupported   = sdkerrors.Register(ModuleName, 17, "transfer taxes are not supported")
+	ErrReceiverFundsSpent       = sdkerrors.Register(ModuleName, 18, "receiver module spent the funds of the receiver")
 )
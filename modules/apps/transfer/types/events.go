@@ -2,11 +2,13 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeTimeout           = "timeout"
+	EventTypePacket            = "fungible_token_packet"
+	EventTypeTransfer          = "ibc_transfer"
+	EventTypeChannelClose      = "channel_closed"
+	EventTypeDenomTrace        = "denomination_trace"
+	EventTypeReceiverExecution = "receiver_execution"
+	EventTypeTransferTax       = "transfer_tax"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
@@ -18,4 +20,6 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyReceiverModule = "receiver_module"
+	AttributeKeyTaxPayer       = "tax_payer"
 )
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TransferHooks defines the event hooks which may be registered on the transfer keeper
+type TransferHooks interface {
+	// OnVoucherDenomCreated is called when the denomination trace of a voucher is first stored while
+	// receiving a packet. The hook may return the bank denomination metadata to be registered for the
+	// voucher denomination, overriding any metadata already registered. No metadata is registered if
+	// the returned boolean is false.
+	OnVoucherDenomCreated(ctx sdk.Context, denomTrace DenomTrace) (banktypes.Metadata, bool)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 const (
@@ -36,8 +37,74 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// ChannelDenomKey defines the key prefix to index the denomination traces received over a channel
+	ChannelDenomKey = []byte{0x03}
+	// ReceiverPrefixKey defines the key prefix to store the bech32 prefix expected for receivers of a channel
+	ReceiverPrefixKey = []byte{0x04}
+	// FrozenDenomKey defines the key prefix to store the denominations for which transfers are frozen
+	FrozenDenomKey = []byte{0x05}
+	// PendingTransferKey defines the key prefix to index the outgoing transfers of a sender which are in-flight
+	PendingTransferKey = []byte{0x06}
+	// ChannelEscrowKey defines the key prefix to store the amounts escrowed over a channel per denomination
+	ChannelEscrowKey = []byte{0x07}
+	// TaxRateKey defines the key prefix to store the transfer tax rates of a denomination
+	TaxRateKey = []byte{0x08}
+	// SendTaxKey defines the key prefix to store the send taxes held for the outgoing transfers which are in-flight
+	SendTaxKey = []byte{0x09}
 )
 
+// ChannelDenomPrefix returns the store key prefix under which the hashes of the denomination
+// traces received over the specified channel are indexed.
+func ChannelDenomPrefix(portID, channelID string) []byte {
+	return append(ChannelDenomKey, []byte(fmt.Sprintf("%s/", host.ChannelPath(portID, channelID)))...)
+}
+
+// ChannelReceiverPrefixKey returns the store key under which the bech32 prefix expected for
+// receiver addresses of transfers sent over the specified channel is stored.
+func ChannelReceiverPrefixKey(portID, channelID string) []byte {
+	return append(ReceiverPrefixKey, []byte(host.ChannelPath(portID, channelID))...)
+}
+
+// DenomFrozenKey returns the store key under which the specified denomination is marked as frozen.
+func DenomFrozenKey(denom string) []byte {
+	return append(FrozenDenomKey, []byte(denom)...)
+}
+
+// DenomTaxRateKey returns the store key under which the transfer tax rates of the specified denomination are stored.
+func DenomTaxRateKey(denom string) []byte {
+	return append(TaxRateKey, []byte(denom)...)
+}
+
+// SenderPendingTransfersPrefix returns the store key prefix under which the in-flight outgoing
+// transfers of the specified sender are indexed.
+func SenderPendingTransfersPrefix(sender string) []byte {
+	return append(PendingTransferKey, []byte(fmt.Sprintf("%s/", sender))...)
+}
+
+// SenderPendingTransferKey returns the store key under which the in-flight outgoing transfer sent by
+// the specified sender with the given packet sequence over the specified channel is indexed.
+func SenderPendingTransferKey(sender, portID, channelID string, sequence uint64) []byte {
+	return append(SenderPendingTransfersPrefix(sender), []byte(fmt.Sprintf("%s/%d", host.ChannelPath(portID, channelID), sequence))...)
+}
+
+// PacketSendTaxKey returns the store key under which the send tax held for the in-flight outgoing transfer
+// with the given packet sequence over the specified channel is stored.
+func PacketSendTaxKey(portID, channelID string, sequence uint64) []byte {
+	return append(SendTaxKey, []byte(fmt.Sprintf("%s/%d", host.ChannelPath(portID, channelID), sequence))...)
+}
+
+// ChannelEscrowPrefix returns the store key prefix under which the amounts escrowed over the
+// specified channel are stored.
+func ChannelEscrowPrefix(portID, channelID string) []byte {
+	return append(ChannelEscrowKey, []byte(fmt.Sprintf("%s/", host.ChannelPath(portID, channelID)))...)
+}
+
+// ChannelEscrowDenomKey returns the store key under which the amount of the specified denomination
+// escrowed over the specified channel is stored.
+func ChannelEscrowDenomKey(portID, channelID, denom string) []byte {
+	return append(ChannelEscrowPrefix(portID, channelID), []byte(denom)...)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -53,3 +120,19 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
+
+// GetReceiverExecutionAddress returns the intermediate address credited with the tokens of a transfer
+// carrying an execution, from which the receiver module acts. It is derived from the destination channel
+// and the sender of the transfer, such that the receiver module never acts on the funds of the receiver.
+func GetReceiverExecutionAddress(portID, channelID, sender string) sdk.AccAddress {
+	// port and channel identifiers cannot contain a slash, preventing address collisions between
+	// intermediate addresses and with the escrow addresses created for the channels
+	contents := fmt.Sprintf("%s/%s/%s", portID, channelID, sender)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
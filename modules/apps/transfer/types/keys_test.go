@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,3 +23,24 @@ func TestGetEscrowAddress(t *testing.T) {
 	escrow2 := types.GetEscrowAddress(port2, channel2)
 	require.NotEqual(t, escrow1, escrow2)
 }
+
+// Test vectors pinning the escrow addresses derived for known port and channel identifiers. The derivation
+// must never change as the escrow accounts of existing channels hold the escrowed tokens.
+func TestGetEscrowAddressVectors(t *testing.T) {
+	testCases := []struct {
+		portID     string
+		channelID  string
+		expAddress string
+	}{
+		{"transfer", "channel-0", "ED23C6F4443F49C4B08F856350A5D2C65A203235"},
+		{"transfer", "channel-141", "352BF5CC8839ECFCCB763916E1DDB1FAF67A9B37"},
+		{"custom-port", "channel-7", "CBC1AACFD25A30263AFB614900A0BC479415A706"},
+	}
+
+	for _, tc := range testCases {
+		expAddress, err := hex.DecodeString(tc.expAddress)
+		require.NoError(t, err)
+
+		require.Equal(t, expAddress, []byte(types.GetEscrowAddress(tc.portID, tc.channelID)), "%s/%s", tc.portID, tc.channelID)
+	}
+}
@@ -67,6 +67,17 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
+	// NOTE: the refund address is optional but must be a valid address on the sending chain if provided
+	if msg.RefundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "refund address could not be parsed as address: %v", err)
+		}
+	}
+	if msg.Execution != nil {
+		if err := msg.Execution.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return ValidateIBCDenom(msg.Token.Denom)
 }
 
@@ -83,6 +83,10 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing sender address", NewMsgTransfer(validPort, validChannel, coin, emptyAddr, addr2, timeoutHeight, 0), false},
 		{"missing recipient address", NewMsgTransfer(validPort, validChannel, coin, addr1, "", timeoutHeight, 0), false},
 		{"empty coin", NewMsgTransfer(validPort, validChannel, sdk.Coin{}, addr1, addr2, timeoutHeight, 0), false},
+		{"valid msg with refund address", withRefundAddress(NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 0), addr2), true},
+		{"invalid refund address", withRefundAddress(NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 0), "invalid address"), false},
+		{"valid msg with execution", withExecution(NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 0), NewReceiverExecution("vault", []byte("deposit"))), true},
+		{"invalid execution", withExecution(NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 0), NewReceiverExecution(" ", []byte("deposit"))), false},
 	}
 
 	for i, tc := range testCases {
@@ -95,6 +99,16 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+func withRefundAddress(msg *MsgTransfer, refundAddress string) *MsgTransfer {
+	msg.RefundAddress = refundAddress
+	return msg
+}
+
+func withExecution(msg *MsgTransfer, execution ReceiverExecution) *MsgTransfer {
+	msg.Execution = &execution
+	return msg
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
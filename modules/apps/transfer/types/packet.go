@@ -1,9 +1,12 @@
 package types
 
 import (
+	"bytes"
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/jsonpb"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -51,10 +54,32 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	if strings.TrimSpace(ftpd.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
 	}
+	if ftpd.Execution != nil {
+		if err := ftpd.Execution.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return ValidatePrefixedDenom(ftpd.Denom)
 }
 
-// GetBytes is a helper for serialising
+// GetBytes is a helper for serialising. Empty fields are omitted so that packets which do not
+// specify the optional refund address or execution are encoded identically to packets predating the field.
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ftpd))
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err := marshaler.Marshal(&buf, &ftpd); err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(buf.Bytes())
+}
+
+// RefundRecipient returns the address to be refunded if the transfer times out or fails on the
+// destination chain. It defaults to the sender when no refund address is specified.
+func (ftpd FungibleTokenPacketData) RefundRecipient() string {
+	if ftpd.RefundAddress != "" {
+		return ftpd.RefundAddress
+	}
+
+	return ftpd.Sender
 }
@@ -34,6 +34,12 @@ type FungibleTokenPacketData struct {
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// optional address on the source chain to be refunded instead of the sender if the transfer
+	// times out or fails on the destination chain. It is omitted from the packet bytes when empty.
+	RefundAddress string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// optional action to be executed by a receiver module registered on the destination chain once
+	// the tokens are credited to the intermediate address of the transfer. It is omitted from the packet bytes when empty.
+	Execution *ReceiverExecution `protobuf:"bytes,6,opt,name=execution,proto3" json:"execution,omitempty"`
 }
 
 func (m *FungibleTokenPacketData) Reset()         { *m = FungibleTokenPacketData{} }
@@ -97,8 +103,81 @@ func (m *FungibleTokenPacketData) GetReceiver() string {
 	return ""
 }
 
+func (m *FungibleTokenPacketData) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *FungibleTokenPacketData) GetExecution() *ReceiverExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+// ReceiverExecution defines an action to be executed by a receiver module on the tokens of a transfer,
+// credited to an intermediate address derived from the destination channel and sender. Any funds
+// remaining at the intermediate address are then credited to the receiver. The transfer fails and the
+// tokens are refunded if the execution fails.
+type ReceiverExecution struct {
+	// name of the receiver module registered on the destination chain
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// message to be executed by the receiver module, encoded as defined by the receiver module
+	Msg []byte `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *ReceiverExecution) Reset()         { *m = ReceiverExecution{} }
+func (m *ReceiverExecution) String() string { return proto.CompactTextString(m) }
+func (*ReceiverExecution) ProtoMessage()    {}
+func (*ReceiverExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{1}
+}
+func (m *ReceiverExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiverExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiverExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverExecution.Merge(m, src)
+}
+func (m *ReceiverExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiverExecution proto.InternalMessageInfo
+
+func (m *ReceiverExecution) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ReceiverExecution) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketData")
+	proto.RegisterType((*ReceiverExecution)(nil), "ibc.applications.transfer.v2.ReceiverExecution")
 }
 
 func init() {
@@ -106,23 +185,28 @@ func init() {
 }
 
 var fileDescriptor_653ca2ce9a5ca313 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0xbd, 0x4a, 0x04, 0x31,
-	0x14, 0x46, 0x27, 0xfe, 0x2c, 0x9a, 0x72, 0x10, 0x1d, 0x44, 0x82, 0x58, 0x69, 0x61, 0x02, 0xbb,
-	0x85, 0xbd, 0x88, 0xb5, 0x8a, 0x95, 0x5d, 0x92, 0xb9, 0x8e, 0x61, 0x27, 0xb9, 0x21, 0xc9, 0x0c,
-	0x88, 0x2f, 0xe1, 0x63, 0x59, 0x6e, 0x69, 0x29, 0x33, 0x2f, 0x22, 0x9b, 0xd1, 0x65, 0xcb, 0x73,
-	0xee, 0x77, 0x8b, 0x43, 0xaf, 0x8c, 0xd2, 0x42, 0x7a, 0xdf, 0x1a, 0x2d, 0x93, 0x41, 0x17, 0x45,
-	0x0a, 0xd2, 0xc5, 0x57, 0x08, 0xa2, 0x9f, 0x0b, 0x2f, 0xf5, 0x12, 0x12, 0xf7, 0x01, 0x13, 0x96,
-	0x67, 0x46, 0x69, 0xbe, 0x3d, 0xe5, 0xff, 0x53, 0xde, 0xcf, 0x2f, 0x3e, 0xe8, 0xc9, 0x7d, 0xe7,
-	0x1a, 0xa3, 0x5a, 0x78, 0xc6, 0x25, 0xb8, 0x87, 0xfc, 0x7a, 0x27, 0x93, 0x2c, 0x8f, 0xe8, 0x7e,
-	0x0d, 0x0e, 0x6d, 0x45, 0xce, 0xc9, 0xe5, 0xe1, 0xd3, 0x04, 0xe5, 0x31, 0x9d, 0x49, 0x8b, 0x9d,
-	0x4b, 0xd5, 0x4e, 0xd6, 0x7f, 0xb4, 0xf6, 0x11, 0x5c, 0x0d, 0xa1, 0xda, 0x9d, 0xfc, 0x44, 0xe5,
-	0x29, 0x3d, 0x08, 0xa0, 0xc1, 0xf4, 0x10, 0xaa, 0xbd, 0x7c, 0xd9, 0xf0, 0xed, 0xe3, 0xd7, 0xc0,
-	0xc8, 0x6a, 0x60, 0xe4, 0x67, 0x60, 0xe4, 0x73, 0x64, 0xc5, 0x6a, 0x64, 0xc5, 0xf7, 0xc8, 0x8a,
-	0x97, 0x9b, 0xc6, 0xa4, 0xb7, 0x4e, 0x71, 0x8d, 0x56, 0x68, 0x8c, 0x16, 0xa3, 0x30, 0x4a, 0x5f,
-	0x37, 0x28, 0xfa, 0x85, 0xb0, 0x58, 0x77, 0x2d, 0xc4, 0x75, 0xff, 0x56, 0x77, 0x7a, 0xf7, 0x10,
-	0xd5, 0x2c, 0x47, 0x2f, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x82, 0x62, 0x02, 0xc6, 0x21, 0x01,
-	0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcb, 0x4a, 0x3b, 0x31,
+	0x14, 0xc6, 0x9b, 0x7f, 0xff, 0x2d, 0x36, 0x5e, 0xd0, 0x20, 0x3a, 0x88, 0x0c, 0xa5, 0x20, 0xd4,
+	0x85, 0x09, 0xb4, 0x0b, 0x57, 0x2e, 0x14, 0x75, 0x27, 0xe8, 0xe0, 0xca, 0x8d, 0x64, 0x32, 0xa7,
+	0x63, 0x68, 0x27, 0x19, 0x92, 0xcc, 0xa0, 0x6f, 0xe1, 0x63, 0xb9, 0xec, 0xd2, 0xa5, 0x74, 0x9e,
+	0xc0, 0x37, 0x90, 0xb9, 0x54, 0x0b, 0x82, 0xbb, 0x7c, 0xbf, 0x73, 0xc9, 0xf9, 0xf8, 0xf0, 0xb1,
+	0x0c, 0x05, 0xe3, 0x69, 0x3a, 0x93, 0x82, 0x3b, 0xa9, 0x95, 0x65, 0xce, 0x70, 0x65, 0x27, 0x60,
+	0x58, 0x3e, 0x62, 0x29, 0x17, 0x53, 0x70, 0x34, 0x35, 0xda, 0x69, 0x72, 0x28, 0x43, 0x41, 0x57,
+	0x5b, 0xe9, 0xb2, 0x95, 0xe6, 0xa3, 0xc1, 0x27, 0xc2, 0xfb, 0xd7, 0x99, 0x8a, 0x65, 0x38, 0x83,
+	0x7b, 0x3d, 0x05, 0x75, 0x5b, 0xcd, 0x5e, 0x72, 0xc7, 0xc9, 0x2e, 0xee, 0x44, 0xa0, 0x74, 0xe2,
+	0xa1, 0x3e, 0x1a, 0xf6, 0x82, 0x5a, 0x90, 0x3d, 0xdc, 0xe5, 0x89, 0xce, 0x94, 0xf3, 0xfe, 0x55,
+	0xb8, 0x51, 0x25, 0xb7, 0xa0, 0x22, 0x30, 0x5e, 0xbb, 0xe6, 0xb5, 0x22, 0x07, 0x78, 0xcd, 0x80,
+	0x00, 0x99, 0x83, 0xf1, 0xfe, 0x57, 0x95, 0x6f, 0x4d, 0x8e, 0xf0, 0x96, 0x81, 0x49, 0xa6, 0xa2,
+	0x47, 0x1e, 0x45, 0x06, 0xac, 0xf5, 0x3a, 0x55, 0xc7, 0x66, 0x4d, 0xcf, 0x6b, 0x48, 0x6e, 0x70,
+	0x0f, 0x9e, 0x41, 0x64, 0xe5, 0xf9, 0x5e, 0xb7, 0x8f, 0x86, 0xeb, 0x23, 0x46, 0xff, 0xb2, 0x45,
+	0x83, 0xe6, 0x87, 0xab, 0xe5, 0x58, 0xf0, 0xb3, 0x61, 0x70, 0x86, 0x77, 0x7e, 0xd5, 0xcb, 0xf3,
+	0x13, 0x1d, 0x65, 0x33, 0x68, 0xdc, 0x36, 0x8a, 0x6c, 0xe3, 0x76, 0x62, 0xe3, 0xca, 0xeb, 0x46,
+	0x50, 0x3e, 0x2f, 0xee, 0xde, 0x16, 0x3e, 0x9a, 0x2f, 0x7c, 0xf4, 0xb1, 0xf0, 0xd1, 0x6b, 0xe1,
+	0xb7, 0xe6, 0x85, 0xdf, 0x7a, 0x2f, 0xfc, 0xd6, 0xc3, 0x69, 0x2c, 0xdd, 0x53, 0x16, 0x52, 0xa1,
+	0x13, 0x26, 0xb4, 0x4d, 0xb4, 0x65, 0x32, 0x14, 0x27, 0xb1, 0x66, 0xf9, 0x98, 0xd5, 0xfb, 0x6c,
+	0x99, 0xda, 0x4a, 0x5a, 0xee, 0x25, 0x05, 0x1b, 0x76, 0xab, 0xa8, 0xc6, 0x5f, 0x03, 0x00, 0x58,
+	0xb0, 0x0b, 0x95, 0xd7, 0x01, 0x00, 0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -145,6 +229,25 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -176,6 +279,43 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ReceiverExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -209,6 +349,31 @@ func (m *FungibleTokenPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *ReceiverExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -375,6 +540,190 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &ReceiverExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiverExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiverExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiverExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,8 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2), false},
 		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2), false},
 		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr), false},
+		{"valid packet with execution", withPacketExecution(NewFungibleTokenPacketData(denom, amount, addr1, addr2), NewReceiverExecution("vault", nil)), true},
+		{"invalid execution", withPacketExecution(NewFungibleTokenPacketData(denom, amount, addr1, addr2), NewReceiverExecution("", []byte("deposit"))), false},
 	}
 
 	for i, tc := range testCases {
@@ -40,3 +43,26 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func withPacketExecution(packetData FungibleTokenPacketData, execution ReceiverExecution) FungibleTokenPacketData {
+	packetData.Execution = &execution
+	return packetData
+}
+
+// TestFungibleTokenPacketDataGetBytes tests that the optional refund address and execution are omitted from the packet bytes when empty
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2)
+	expected := fmt.Sprintf(`{"amount":"100","denom":"transfer/gaiachannel/atom","receiver":"%s","sender":"%s"}`, addr2, addr1)
+	require.Equal(t, expected, string(packetData.GetBytes()))
+	require.Equal(t, addr1, packetData.RefundRecipient())
+
+	packetData.RefundAddress = addr2
+	expected = fmt.Sprintf(`{"amount":"100","denom":"transfer/gaiachannel/atom","receiver":"%s","refund_address":"%s","sender":"%s"}`, addr2, addr2, addr1)
+	require.Equal(t, expected, string(packetData.GetBytes()))
+	require.Equal(t, addr2, packetData.RefundRecipient())
+
+	packetData.RefundAddress = ""
+	packetData.Execution = &ReceiverExecution{Module: "vault", Msg: []byte("deposit")}
+	expected = fmt.Sprintf(`{"amount":"100","denom":"transfer/gaiachannel/atom","execution":{"module":"vault","msg":"ZGVwb3NpdA=="},"receiver":"%s","sender":"%s"}`, addr2, addr1)
+	require.Equal(t, expected, string(packetData.GetBytes()))
+}
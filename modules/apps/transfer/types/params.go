@@ -11,6 +11,10 @@ const (
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// DefaultIndexPendingTransfers disabled
+	DefaultIndexPendingTransfers = false
+	// DefaultMaxDenomHops unlimited
+	DefaultMaxDenomHops uint64 = 0
 )
 
 var (
@@ -18,6 +22,10 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeyIndexPendingTransfers is store's key for IndexPendingTransfers Params
+	KeyIndexPendingTransfers = []byte("IndexPendingTransfers")
+	// KeyMaxDenomHops is store's key for MaxDenomHops Params
+	KeyMaxDenomHops = []byte("MaxDenomHops")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -26,16 +34,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(enableSend, enableReceive, indexPendingTransfers bool, maxDenomHops uint64) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:           enableSend,
+		ReceiveEnabled:        enableReceive,
+		IndexPendingTransfers: indexPendingTransfers,
+		MaxDenomHops:          maxDenomHops,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled, DefaultIndexPendingTransfers, DefaultMaxDenomHops)
 }
 
 // Validate all ibc-transfer module parameters
@@ -44,7 +54,15 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabled(p.ReceiveEnabled)
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.IndexPendingTransfers); err != nil {
+		return err
+	}
+
+	return validateMaxDenomHops(p.MaxDenomHops)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,6 +70,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyIndexPendingTransfers, p.IndexPendingTransfers, validateEnabled),
+		paramtypes.NewParamSetPair(KeyMaxDenomHops, p.MaxDenomHops, validateMaxDenomHops),
 	}
 }
 
@@ -63,3 +83,12 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxDenomHops(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -8,5 +8,6 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, DefaultParams().Validate())
-	require.NoError(t, NewParams(true, false).Validate())
+	require.NoError(t, NewParams(true, false, true, 0).Validate())
+	require.NoError(t, NewParams(true, true, false, 4).Validate())
 }
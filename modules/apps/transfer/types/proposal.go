@@ -0,0 +1,191 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeMigrateChannelConnection defines the type for a MigrateChannelConnectionProposal
+	ProposalTypeMigrateChannelConnection = "MigrateChannelConnection"
+
+	// ProposalTypeSetChannelReceiverPrefix defines the type for a SetChannelReceiverPrefixProposal
+	ProposalTypeSetChannelReceiverPrefix = "SetChannelReceiverPrefix"
+
+	// ProposalTypeSetDenomFrozen defines the type for a SetDenomFrozenProposal
+	ProposalTypeSetDenomFrozen = "SetDenomFrozen"
+
+	// ProposalTypeSetDenomTaxRate defines the type for a SetDenomTaxRateProposal
+	ProposalTypeSetDenomTaxRate = "SetDenomTaxRate"
+)
+
+var (
+	_ govtypes.Content = &MigrateChannelConnectionProposal{}
+	_ govtypes.Content = &SetChannelReceiverPrefixProposal{}
+	_ govtypes.Content = &SetDenomFrozenProposal{}
+	_ govtypes.Content = &SetDenomTaxRateProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeMigrateChannelConnection)
+	govtypes.RegisterProposalType(ProposalTypeSetChannelReceiverPrefix)
+	govtypes.RegisterProposalType(ProposalTypeSetDenomFrozen)
+	govtypes.RegisterProposalType(ProposalTypeSetDenomTaxRate)
+}
+
+// NewMigrateChannelConnectionProposal creates a new transfer channel connection migration proposal.
+func NewMigrateChannelConnectionProposal(title, description, channelID, connectionID string) govtypes.Content {
+	return &MigrateChannelConnectionProposal{
+		Title:        title,
+		Description:  description,
+		ChannelId:    channelID,
+		ConnectionId: connectionID,
+	}
+}
+
+// GetTitle returns the title of a channel connection migration proposal.
+func (mcp *MigrateChannelConnectionProposal) GetTitle() string { return mcp.Title }
+
+// GetDescription returns the description of a channel connection migration proposal.
+func (mcp *MigrateChannelConnectionProposal) GetDescription() string { return mcp.Description }
+
+// ProposalRoute returns the routing key of a channel connection migration proposal.
+func (mcp *MigrateChannelConnectionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel connection migration proposal.
+func (mcp *MigrateChannelConnectionProposal) ProposalType() string {
+	return ProposalTypeMigrateChannelConnection
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (mcp *MigrateChannelConnectionProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(mcp); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(mcp.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := connectiontypes.ParseConnectionSequence(mcp.ConnectionId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetChannelReceiverPrefixProposal creates a new transfer channel receiver prefix proposal.
+func NewSetChannelReceiverPrefixProposal(title, description, channelID, bech32Prefix string) govtypes.Content {
+	return &SetChannelReceiverPrefixProposal{
+		Title:        title,
+		Description:  description,
+		ChannelId:    channelID,
+		Bech32Prefix: bech32Prefix,
+	}
+}
+
+// GetTitle returns the title of a channel receiver prefix proposal.
+func (scp *SetChannelReceiverPrefixProposal) GetTitle() string { return scp.Title }
+
+// GetDescription returns the description of a channel receiver prefix proposal.
+func (scp *SetChannelReceiverPrefixProposal) GetDescription() string { return scp.Description }
+
+// ProposalRoute returns the routing key of a channel receiver prefix proposal.
+func (scp *SetChannelReceiverPrefixProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel receiver prefix proposal.
+func (scp *SetChannelReceiverPrefixProposal) ProposalType() string {
+	return ProposalTypeSetChannelReceiverPrefix
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (scp *SetChannelReceiverPrefixProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(scp); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(scp.ChannelId); err != nil {
+		return err
+	}
+
+	// an empty prefix removes the receiver prefix of the channel
+	if scp.Bech32Prefix == "" {
+		return nil
+	}
+
+	return ValidateBech32Prefix(scp.Bech32Prefix)
+}
+
+// NewSetDenomFrozenProposal creates a new transfer denomination freeze proposal.
+func NewSetDenomFrozenProposal(title, description, denom string, frozen bool) govtypes.Content {
+	return &SetDenomFrozenProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		Frozen:      frozen,
+	}
+}
+
+// GetTitle returns the title of a denomination freeze proposal.
+func (sdp *SetDenomFrozenProposal) GetTitle() string { return sdp.Title }
+
+// GetDescription returns the description of a denomination freeze proposal.
+func (sdp *SetDenomFrozenProposal) GetDescription() string { return sdp.Description }
+
+// ProposalRoute returns the routing key of a denomination freeze proposal.
+func (sdp *SetDenomFrozenProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a denomination freeze proposal.
+func (sdp *SetDenomFrozenProposal) ProposalType() string {
+	return ProposalTypeSetDenomFrozen
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (sdp *SetDenomFrozenProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sdp); err != nil {
+		return err
+	}
+
+	return ValidateIBCDenom(sdp.Denom)
+}
+
+// NewSetDenomTaxRateProposal creates a new transfer denomination tax rate proposal.
+func NewSetDenomTaxRateProposal(title, description, denom string, sendRate, receiveRate sdk.Dec) govtypes.Content {
+	return &SetDenomTaxRateProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		SendRate:    sendRate,
+		ReceiveRate: receiveRate,
+	}
+}
+
+// GetTitle returns the title of a denomination tax rate proposal.
+func (stp *SetDenomTaxRateProposal) GetTitle() string { return stp.Title }
+
+// GetDescription returns the description of a denomination tax rate proposal.
+func (stp *SetDenomTaxRateProposal) GetDescription() string { return stp.Description }
+
+// ProposalRoute returns the routing key of a denomination tax rate proposal.
+func (stp *SetDenomTaxRateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a denomination tax rate proposal.
+func (stp *SetDenomTaxRateProposal) ProposalType() string {
+	return ProposalTypeSetDenomTaxRate
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (stp *SetDenomTaxRateProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(stp); err != nil {
+		return err
+	}
+
+	if err := ValidateIBCDenom(stp.Denom); err != nil {
+		return err
+	}
+
+	return NewDenomTaxRate(stp.Denom, stp.SendRate, stp.ReceiveRate).Validate()
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateChannelConnectionProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *MigrateChannelConnectionProposal
+		expPass  bool
+	}{
+		{"success", &MigrateChannelConnectionProposal{"title", "description", "channel-0", "connection-1"}, true},
+		{"empty title", &MigrateChannelConnectionProposal{"", "description", "channel-0", "connection-1"}, false},
+		{"empty description", &MigrateChannelConnectionProposal{"title", "", "channel-0", "connection-1"}, false},
+		{"invalid channel identifier", &MigrateChannelConnectionProposal{"title", "description", invalidChannel, "connection-1"}, false},
+		{"invalid connection identifier", &MigrateChannelConnectionProposal{"title", "description", "channel-0", "invalid-connection"}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestSetChannelReceiverPrefixProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *SetChannelReceiverPrefixProposal
+		expPass  bool
+	}{
+		{"success", &SetChannelReceiverPrefixProposal{"title", "description", "channel-0", "osmo"}, true},
+		{"success: empty prefix", &SetChannelReceiverPrefixProposal{"title", "description", "channel-0", ""}, true},
+		{"empty title", &SetChannelReceiverPrefixProposal{"", "description", "channel-0", "osmo"}, false},
+		{"empty description", &SetChannelReceiverPrefixProposal{"title", "", "channel-0", "osmo"}, false},
+		{"invalid channel identifier", &SetChannelReceiverPrefixProposal{"title", "description", invalidChannel, "osmo"}, false},
+		{"invalid bech32 prefix", &SetChannelReceiverPrefixProposal{"title", "description", "channel-0", "Osmo"}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestSetDenomFrozenProposalValidateBasic(t *testing.T) {
+	voucherDenom := ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	testCases := []struct {
+		name     string
+		proposal *SetDenomFrozenProposal
+		expPass  bool
+	}{
+		{"success: voucher denom", &SetDenomFrozenProposal{"title", "description", voucherDenom, true}, true},
+		{"success: base denom", &SetDenomFrozenProposal{"title", "description", "uatom", true}, true},
+		{"success: unfreeze", &SetDenomFrozenProposal{"title", "description", voucherDenom, false}, true},
+		{"empty title", &SetDenomFrozenProposal{"", "description", voucherDenom, true}, false},
+		{"empty description", &SetDenomFrozenProposal{"title", "", voucherDenom, true}, false},
+		{"empty denom", &SetDenomFrozenProposal{"title", "description", "", true}, false},
+		{"invalid voucher denom", &SetDenomFrozenProposal{"title", "description", "ibc/invalidhash", true}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestSetDenomTaxRateProposalValidateBasic(t *testing.T) {
+	voucherDenom := ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	rate := sdk.NewDecWithPrec(1, 2)
+
+	testCases := []struct {
+		name     string
+		proposal *SetDenomTaxRateProposal
+		expPass  bool
+	}{
+		{"success: voucher denom", &SetDenomTaxRateProposal{"title", "description", voucherDenom, rate, rate}, true},
+		{"success: base denom", &SetDenomTaxRateProposal{"title", "description", "uatom", rate, sdk.ZeroDec()}, true},
+		{"success: disable taxes", &SetDenomTaxRateProposal{"title", "description", voucherDenom, sdk.ZeroDec(), sdk.ZeroDec()}, true},
+		{"empty title", &SetDenomTaxRateProposal{"", "description", voucherDenom, rate, rate}, false},
+		{"empty description", &SetDenomTaxRateProposal{"title", "", voucherDenom, rate, rate}, false},
+		{"empty denom", &SetDenomTaxRateProposal{"title", "description", "", rate, rate}, false},
+		{"invalid voucher denom", &SetDenomTaxRateProposal{"title", "description", "ibc/invalidhash", rate, rate}, false},
+		{"nil send rate", &SetDenomTaxRateProposal{"title", "description", voucherDenom, sdk.Dec{}, rate}, false},
+		{"negative receive rate", &SetDenomTaxRateProposal{"title", "description", voucherDenom, rate, rate.Neg()}, false},
+		{"send rate of one", &SetDenomTaxRateProposal{"title", "description", voucherDenom, sdk.OneDec(), rate}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ReceiverModule defines the interface which modules must implement to be registered on the transfer
+// keeper as the target of the executions carried by received transfers
+type ReceiverModule interface {
+	// OnTransferReceived is called once the transferred token is credited to the intermediate address
+	// of a packet carrying an execution for the receiver module. The intermediate address is derived from
+	// the destination channel and the sender of the transfer, and the receiver module acts from it on
+	// behalf of the receiver. Any funds remaining at the intermediate address after the execution are
+	// credited to the receiver. The provided message is the message of the execution and the token is
+	// denominated as on the receiving chain. Returning an error, or reducing the balances of the receiver,
+	// fails the transfer, reverting the credit and any state changes of the receiver module, and results
+	// in the tokens being refunded to the sender.
+	OnTransferReceived(ctx sdk.Context, intermediate, receiver sdk.AccAddress, token sdk.Coin, msg []byte) error
+}
+
+// NewReceiverExecution creates a new ReceiverExecution instance
+func NewReceiverExecution(module string, msg []byte) ReceiverExecution {
+	return ReceiverExecution{
+		Module: module,
+		Msg:    msg,
+	}
+}
+
+// ValidateBasic performs basic validation of the ReceiverExecution. The message may be empty.
+func (re ReceiverExecution) ValidateBasic() error {
+	if strings.TrimSpace(re.Module) == "" {
+		return sdkerrors.Wrap(ErrInvalidReceiverExecution, "receiver module cannot be blank")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewDenomTaxRate creates a new DenomTaxRate instance.
+func NewDenomTaxRate(denom string, sendRate, receiveRate sdk.Dec) DenomTaxRate {
+	return DenomTaxRate{
+		Denom:       denom,
+		SendRate:    sendRate,
+		ReceiveRate: receiveRate,
+	}
+}
+
+// IsZero returns true if neither outgoing nor incoming transfers of the denomination are taxed.
+func (dtr DenomTaxRate) IsZero() bool {
+	return dtr.SendRate.IsZero() && dtr.ReceiveRate.IsZero()
+}
+
+// Validate performs a basic validation of the send and receive rates.
+func (dtr DenomTaxRate) Validate() error {
+	if err := ValidateTaxRate(dtr.SendRate); err != nil {
+		return sdkerrors.Wrap(err, "send rate")
+	}
+
+	if err := ValidateTaxRate(dtr.ReceiveRate); err != nil {
+		return sdkerrors.Wrap(err, "receive rate")
+	}
+
+	return nil
+}
+
+// ValidateTaxRate checks that the provided tax rate is within [0, 1). A rate of zero disables the
+// tax, a rate of one is rejected as the transferred amount must not be taxed in full.
+func ValidateTaxRate(rate sdk.Dec) error {
+	if rate.IsNil() {
+		return sdkerrors.Wrap(ErrInvalidTaxRate, "rate cannot be nil")
+	}
+
+	if rate.IsNegative() || rate.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidTaxRate, "rate %s must be within [0, 1)", rate)
+	}
+
+	return nil
+}
+
+// ComputeTax returns the tax owed on the provided amount at the provided rate, rounded down to an
+// integer amount. The product is computed exactly on the underlying integers of the amount and the
+// rate, such that the tax is deterministic and never exceeds the amount.
+func ComputeTax(amount sdk.Int, rate sdk.Dec) sdk.Int {
+	tax := new(big.Int).Mul(amount.BigInt(), rate.BigInt())
+	tax.Quo(tax, sdk.OneDec().BigInt())
+
+	return sdk.NewIntFromBigInt(tax)
+}
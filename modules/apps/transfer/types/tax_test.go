@@ -0,0 +1,66 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeTax(t *testing.T) {
+	// the largest amount representable by sdk.Int
+	maxAmount := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+
+	testCases := []struct {
+		name   string
+		amount sdk.Int
+		rate   sdk.Dec
+		expTax sdk.Int
+	}{
+		{"zero rate", sdk.NewInt(1000), sdk.ZeroDec(), sdk.ZeroInt()},
+		{"exact", sdk.NewInt(1000), sdk.NewDecWithPrec(15, 3), sdk.NewInt(15)},
+		{"rounded down", sdk.NewInt(985), sdk.NewDecWithPrec(1, 1), sdk.NewInt(98)},
+		{"rounded down to zero", sdk.NewInt(10), sdk.NewDecWithPrec(5, 2), sdk.ZeroInt()},
+		{"smallest rate", sdk.NewInt(1000000000000000000), sdk.SmallestDec(), sdk.OneInt()},
+		{"largest rate", sdk.NewInt(1), sdk.OneDec().Sub(sdk.SmallestDec()), sdk.ZeroInt()},
+		{"max amount", maxAmount, sdk.NewDecWithPrec(5, 1), maxAmount.QuoRaw(2)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tax := ComputeTax(tc.amount, tc.rate)
+			require.True(t, tc.expTax.Equal(tax), "expected tax %s, got %s", tc.expTax, tax)
+		})
+	}
+}
+
+func TestValidateTaxRate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rate    sdk.Dec
+		expPass bool
+	}{
+		{"zero rate", sdk.ZeroDec(), true},
+		{"valid rate", sdk.NewDecWithPrec(1, 2), true},
+		{"largest rate", sdk.OneDec().Sub(sdk.SmallestDec()), true},
+		{"nil rate", sdk.Dec{}, false},
+		{"negative rate", sdk.NewDecWithPrec(-1, 2), false},
+		{"rate of one", sdk.OneDec(), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTaxRate(tc.rate)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -38,6 +38,63 @@ func ParseDenomTrace(rawDenom string) DenomTrace {
 	}
 }
 
+// ParseDenomTraceHops parses a string with the ibc prefix (denom trace) and the base denomination
+// into the ordered list of port and channel hops and the base denomination. An error is returned
+// if the denom trace is not correctly formatted.
+//
+// Examples:
+//
+// 	- "portidone/channelidone/portidtwo/channelidtwo/uatom" => [{portidone channelidone} {portidtwo channelidtwo}], "uatom"
+// 	- "uatom" => [], "uatom"
+func ParseDenomTraceHops(rawDenom string) ([]Hop, string, error) {
+	denomTrace := ParseDenomTrace(rawDenom)
+	hops, err := denomTrace.GetHops()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return hops, denomTrace.BaseDenom, nil
+}
+
+// NewHop creates a new Hop instance
+func NewHop(portID, channelID string) Hop {
+	return Hop{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// GetHops validates the DenomTrace and decomposes its trace path into the ordered list of port
+// and channel hops. The first hop is the channel end the token was last received over. An empty
+// list is returned if the token lives on the original chain.
+func (dt DenomTrace) GetHops() ([]Hop, error) {
+	if err := dt.Validate(); err != nil {
+		return nil, err
+	}
+
+	hops := []Hop{}
+	if dt.Path == "" {
+		return hops, nil
+	}
+
+	identifiers := strings.Split(dt.Path, "/")
+	for i := 0; i < len(identifiers); i += 2 {
+		hops = append(hops, NewHop(identifiers[i], identifiers[i+1]))
+	}
+
+	return hops, nil
+}
+
+// HopCount returns the number of port and channel hops in the trace path of the DenomTrace. An
+// incomplete trailing hop of a malformed trace path is counted as a hop.
+func (dt DenomTrace) HopCount() uint64 {
+	if dt.Path == "" {
+		return 0
+	}
+
+	return uint64(strings.Count(dt.Path, "/")/2 + 1)
+}
+
 // Hash returns the hex bytes of the SHA256 hash of the DenomTrace fields using the following formula:
 //
 // hash = sha256(tracePath + "/" + baseDenom)
@@ -26,6 +26,55 @@ func TestParseDenomTrace(t *testing.T) {
 	}
 }
 
+func TestParseDenomTraceHops(t *testing.T) {
+	testCases := []struct {
+		name         string
+		denom        string
+		expHops      []Hop
+		expBaseDenom string
+		expError     bool
+	}{
+		{"base denom", "uatom", []Hop{}, "uatom", false},
+		{"single hop", "transfer/channelToA/uatom", []Hop{{PortId: "transfer", ChannelId: "channelToA"}}, "uatom", false},
+		{
+			"multiple hops", "transfer/channelToA/transfer/channelToB/uatom",
+			[]Hop{{PortId: "transfer", ChannelId: "channelToA"}, {PortId: "transfer", ChannelId: "channelToB"}}, "uatom", false,
+		},
+		{"empty denom", "", nil, "", true},
+		{"incomplete path", "transfer/uatom", nil, "", true},
+		{"invalid path (1)", "transfer//uatom", nil, "", true},
+		{"invalid path (2)", "transfer/channelToA/uatom/", nil, "", true},
+	}
+
+	for _, tc := range testCases {
+		hops, baseDenom, err := ParseDenomTraceHops(tc.denom)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expHops, hops, tc.name)
+		require.Equal(t, tc.expBaseDenom, baseDenom, tc.name)
+	}
+}
+
+func TestDenomTrace_HopCount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    string
+		expCount uint64
+	}{
+		{"base denom", "uatom", 0},
+		{"single hop", "transfer/channelToA/uatom", 1},
+		{"multiple hops", "transfer/channelToA/transfer/channelToB/uatom", 2},
+		{"incomplete path", "transfer/channelToA/transfer/uatom", 2},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expCount, ParseDenomTrace(tc.denom).HopCount(), tc.name)
+	}
+}
+
 func TestDenomTrace_IBCDenom(t *testing.T) {
 	testCases := []struct {
 		name     string
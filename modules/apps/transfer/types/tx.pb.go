@@ -50,6 +50,12 @@ type MsgTransfer struct {
 	// Timeout timestamp (in nanoseconds) relative to the current block timestamp.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+	// optional address to be refunded instead of the sender if the transfer times out or fails on the
+	// destination chain. Defaults to the sender when empty.
+	RefundAddress string `protobuf:"bytes,8,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
+	// optional action to be executed by a receiver module on the destination chain once the tokens
+	// are credited to the intermediate address derived from the destination channel and sender.
+	Execution *ReceiverExecution `protobuf:"bytes,9,opt,name=execution,proto3" json:"execution,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -132,38 +138,42 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x92, 0x86, 0x70, 0x51, 0x2b, 0x30, 0xb4, 0x72, 0xa3, 0x62, 0x47, 0x96, 0x90,
-	0xc2, 0xc0, 0x9d, 0xdc, 0x0a, 0x55, 0xea, 0x84, 0xd2, 0x05, 0x86, 0x4a, 0x60, 0x75, 0x62, 0x29,
-	0xf6, 0xf5, 0x70, 0x4e, 0xc4, 0xf7, 0xac, 0xbb, 0x8b, 0x45, 0xbf, 0x01, 0x23, 0x1f, 0xa1, 0x33,
-	0x9f, 0xa4, 0x63, 0x47, 0xa6, 0x08, 0x25, 0x0b, 0x73, 0x3e, 0x01, 0x3a, 0xfb, 0x12, 0x92, 0x05,
-	0x31, 0xd9, 0xef, 0xfd, 0x7f, 0xef, 0xfe, 0x7a, 0xf7, 0xde, 0xa1, 0x17, 0x3c, 0xa3, 0x24, 0x2d,
-	0xcb, 0x09, 0xa7, 0xa9, 0xe6, 0x20, 0x14, 0xd1, 0x32, 0x15, 0xea, 0x33, 0x93, 0xa4, 0x8a, 0x89,
-	0xfe, 0x8a, 0x4b, 0x09, 0x1a, 0xbc, 0x23, 0x9e, 0x51, 0xbc, 0x89, 0xe1, 0x15, 0x86, 0xab, 0xb8,
-	0xff, 0x2c, 0x87, 0x1c, 0x6a, 0x90, 0x98, 0xbf, 0xa6, 0xa6, 0x1f, 0x50, 0x50, 0x05, 0x28, 0x92,
-	0xa5, 0x8a, 0x91, 0x2a, 0xce, 0x98, 0x4e, 0x63, 0x42, 0x81, 0x0b, 0xab, 0x87, 0xc6, 0x9a, 0x82,
-	0x64, 0x84, 0x4e, 0x38, 0x13, 0xda, 0x18, 0x36, 0x7f, 0x0d, 0x10, 0xfd, 0x68, 0xa1, 0xde, 0x85,
-	0xca, 0x2f, 0xad, 0x93, 0x77, 0x8a, 0x7a, 0x0a, 0xa6, 0x92, 0xb2, 0xab, 0x12, 0xa4, 0xf6, 0xdd,
-	0x81, 0x3b, 0x7c, 0x34, 0x3a, 0x58, 0xce, 0x42, 0xef, 0x26, 0x2d, 0x26, 0x67, 0xd1, 0x86, 0x18,
-	0x25, 0xa8, 0x89, 0xde, 0x83, 0xd4, 0xde, 0x1b, 0xb4, 0x67, 0x35, 0x3a, 0x4e, 0x85, 0x60, 0x13,
-	0xff, 0x41, 0x5d, 0x7b, 0xb8, 0x9c, 0x85, 0xfb, 0x5b, 0xb5, 0x56, 0x8f, 0x92, 0xdd, 0x26, 0x71,
-	0xde, 0xc4, 0xde, 0x6b, 0xb4, 0xa3, 0xe1, 0x0b, 0x13, 0x7e, 0x6b, 0xe0, 0x0e, 0x7b, 0xc7, 0x87,
-	0xb8, 0xe9, 0x0d, 0x9b, 0xde, 0xb0, 0xed, 0x0d, 0x9f, 0x03, 0x17, 0xa3, 0xf6, 0xdd, 0x2c, 0x74,
-	0x92, 0x86, 0xf6, 0x0e, 0x50, 0x47, 0x31, 0x71, 0xcd, 0xa4, 0xdf, 0x36, 0x86, 0x89, 0x8d, 0xbc,
-	0x3e, 0xea, 0x4a, 0x46, 0x19, 0xaf, 0x98, 0xf4, 0x77, 0x6a, 0x65, 0x1d, 0x7b, 0x9f, 0xd0, 0x9e,
-	0xe6, 0x05, 0x83, 0xa9, 0xbe, 0x1a, 0x33, 0x9e, 0x8f, 0xb5, 0xdf, 0xa9, 0x3d, 0xfb, 0xd8, 0xcc,
-	0xc0, 0xdc, 0x17, 0xb6, 0xb7, 0x54, 0xc5, 0xf8, 0x6d, 0x4d, 0x8c, 0x9e, 0x1b, 0xd3, 0xbf, 0xcd,
-	0x6c, 0xd7, 0x47, 0xc9, 0xae, 0x4d, 0x34, 0xb4, 0xf7, 0x0e, 0x3d, 0x59, 0x11, 0xe6, 0xab, 0x74,
-	0x5a, 0x94, 0xfe, 0xc3, 0x81, 0x3b, 0x6c, 0x8f, 0x8e, 0x96, 0xb3, 0xd0, 0xdf, 0x3e, 0x64, 0x8d,
-	0x44, 0xc9, 0x63, 0x9b, 0xbb, 0x5c, 0xa5, 0xce, 0xba, 0xdf, 0x6e, 0x43, 0xe7, 0xf7, 0x6d, 0xe8,
-	0x44, 0xfb, 0xe8, 0xe9, 0xc6, 0xac, 0x12, 0xa6, 0x4a, 0x10, 0x8a, 0x1d, 0x03, 0x6a, 0x5d, 0xa8,
-	0xdc, 0x1b, 0xa3, 0xee, 0x7a, 0x8c, 0x2f, 0xf1, 0xbf, 0x96, 0x09, 0x6f, 0x9c, 0xd2, 0x8f, 0xff,
-	0x1b, 0x5d, 0x19, 0x8e, 0x3e, 0xdc, 0xcd, 0x03, 0xf7, 0x7e, 0x1e, 0xb8, 0xbf, 0xe6, 0x81, 0xfb,
-	0x7d, 0x11, 0x38, 0xf7, 0x8b, 0xc0, 0xf9, 0xb9, 0x08, 0x9c, 0x8f, 0xa7, 0x39, 0xd7, 0xe3, 0x69,
-	0x86, 0x29, 0x14, 0xc4, 0xae, 0x26, 0xcf, 0xe8, 0xab, 0x1c, 0x48, 0x75, 0x42, 0x0a, 0xb8, 0x9e,
-	0x4e, 0x98, 0x32, 0x4f, 0x61, 0xe3, 0x09, 0xe8, 0x9b, 0x92, 0xa9, 0xac, 0x53, 0xaf, 0xe3, 0xc9,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x07, 0x3c, 0x39, 0x2c, 0x03, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x4e, 0xdb, 0x40,
+	0x10, 0xb5, 0x4b, 0xa0, 0x61, 0x11, 0xa8, 0x75, 0x0b, 0x32, 0x11, 0xb5, 0x91, 0xa5, 0x4a, 0x70,
+	0xe8, 0xae, 0x1c, 0x54, 0x21, 0x71, 0x6a, 0x83, 0x2a, 0xb5, 0x07, 0xa4, 0xd6, 0xe2, 0xd4, 0x0b,
+	0xb5, 0x37, 0x83, 0xb3, 0x22, 0xf6, 0x5a, 0xbb, 0x6b, 0x0b, 0xfe, 0xa0, 0xc7, 0x7e, 0x02, 0xff,
+	0xd1, 0x1f, 0xe0, 0xc8, 0xb1, 0xa7, 0xa8, 0x22, 0x97, 0x9e, 0xf3, 0x05, 0xd5, 0xda, 0x9b, 0x90,
+	0xf4, 0x10, 0xf5, 0xe4, 0x9d, 0x79, 0x6f, 0xe6, 0x79, 0xdf, 0xce, 0xa0, 0xd7, 0x2c, 0xa1, 0x24,
+	0x2e, 0x8a, 0x21, 0xa3, 0xb1, 0x62, 0x3c, 0x97, 0x44, 0x89, 0x38, 0x97, 0x97, 0x20, 0x48, 0x15,
+	0x12, 0x75, 0x8d, 0x0b, 0xc1, 0x15, 0x77, 0xf6, 0x58, 0x42, 0xf1, 0x3c, 0x0d, 0x4f, 0x69, 0xb8,
+	0x0a, 0x3b, 0x2f, 0x53, 0x9e, 0xf2, 0x9a, 0x48, 0xf4, 0xa9, 0xa9, 0xe9, 0x78, 0x94, 0xcb, 0x8c,
+	0x4b, 0x92, 0xc4, 0x12, 0x48, 0x15, 0x26, 0xa0, 0xe2, 0x90, 0x50, 0xce, 0x72, 0x83, 0xfb, 0x5a,
+	0x9a, 0x72, 0x01, 0x84, 0x0e, 0x19, 0xe4, 0x4a, 0x0b, 0x36, 0x27, 0x43, 0x38, 0x5c, 0xf2, 0x6f,
+	0x5d, 0x52, 0xc4, 0xf4, 0x0a, 0x0c, 0x35, 0xf8, 0xd9, 0x42, 0x1b, 0x67, 0x32, 0x3d, 0x37, 0xb8,
+	0x73, 0x8c, 0x36, 0x24, 0x2f, 0x05, 0x85, 0x8b, 0x82, 0x0b, 0xe5, 0xda, 0xfb, 0xf6, 0xc1, 0x7a,
+	0x6f, 0x67, 0x32, 0xf2, 0x9d, 0x9b, 0x38, 0x1b, 0x9e, 0x04, 0x73, 0x60, 0x10, 0xa1, 0x26, 0xfa,
+	0xcc, 0x85, 0x72, 0xde, 0xa1, 0x2d, 0x83, 0xd1, 0x41, 0x9c, 0xe7, 0x30, 0x74, 0x9f, 0xd4, 0xb5,
+	0xbb, 0x93, 0x91, 0xbf, 0xbd, 0x50, 0x6b, 0xf0, 0x20, 0xda, 0x6c, 0x12, 0xa7, 0x4d, 0xec, 0xbc,
+	0x45, 0xab, 0x8a, 0x5f, 0x41, 0xee, 0xae, 0xec, 0xdb, 0x07, 0x1b, 0xdd, 0x5d, 0xdc, 0xd8, 0x80,
+	0xb5, 0x0d, 0xd8, 0xd8, 0x80, 0x4f, 0x39, 0xcb, 0x7b, 0xad, 0xbb, 0x91, 0x6f, 0x45, 0x0d, 0xdb,
+	0xd9, 0x41, 0x6b, 0x12, 0xf2, 0x3e, 0x08, 0xb7, 0xa5, 0x05, 0x23, 0x13, 0x39, 0x1d, 0xd4, 0x16,
+	0x40, 0x81, 0x55, 0x20, 0xdc, 0xd5, 0x1a, 0x99, 0xc5, 0xce, 0x37, 0xb4, 0xa5, 0x58, 0x06, 0xbc,
+	0x54, 0x17, 0x03, 0x60, 0xe9, 0x40, 0xb9, 0x6b, 0xb5, 0x66, 0x07, 0xeb, 0xe7, 0xd2, 0xd6, 0x62,
+	0x63, 0x68, 0x15, 0xe2, 0x8f, 0x35, 0xa3, 0xf7, 0x4a, 0x8b, 0x3e, 0x5e, 0x66, 0xb1, 0x3e, 0x88,
+	0x36, 0x4d, 0xa2, 0x61, 0x3b, 0x9f, 0xd0, 0xf3, 0x29, 0x43, 0x7f, 0xa5, 0x8a, 0xb3, 0xc2, 0x7d,
+	0xba, 0x6f, 0x1f, 0xb4, 0x7a, 0x7b, 0x93, 0x91, 0xef, 0x2e, 0x36, 0x99, 0x51, 0x82, 0xe8, 0x99,
+	0xc9, 0x9d, 0x4f, 0x53, 0xda, 0x59, 0x01, 0x97, 0x65, 0xde, 0xbf, 0x88, 0xfb, 0x7d, 0x01, 0x52,
+	0xba, 0xed, 0x7f, 0x9d, 0x5d, 0xc4, 0x83, 0x68, 0xb3, 0x49, 0xbc, 0x6f, 0x62, 0xe7, 0x0c, 0xad,
+	0xc3, 0x35, 0xd0, 0x52, 0xcf, 0x82, 0xbb, 0x5e, 0xdf, 0x94, 0xe0, 0x25, 0x83, 0xd9, 0xc5, 0x91,
+	0x71, 0xea, 0xc3, 0xb4, 0x2c, 0x7a, 0xec, 0x70, 0xd2, 0xfe, 0x7e, 0xeb, 0x5b, 0x7f, 0x6e, 0x7d,
+	0x2b, 0xd8, 0x46, 0x2f, 0xe6, 0x86, 0x27, 0x02, 0x59, 0xf0, 0x5c, 0x42, 0x97, 0xa3, 0x95, 0x33,
+	0x99, 0x3a, 0x03, 0xd4, 0x9e, 0xcd, 0xd5, 0xe1, 0x32, 0xbd, 0x10, 0xcf, 0x75, 0xe9, 0x84, 0xff,
+	0x4d, 0x9d, 0x0a, 0xf6, 0xbe, 0xdc, 0x3d, 0x78, 0xf6, 0xfd, 0x83, 0x67, 0xff, 0x7e, 0xf0, 0xec,
+	0x1f, 0x63, 0xcf, 0xba, 0x1f, 0x7b, 0xd6, 0xaf, 0xb1, 0x67, 0x7d, 0x3d, 0x4e, 0x99, 0x1a, 0x94,
+	0x09, 0xa6, 0x3c, 0x23, 0x66, 0xad, 0x58, 0x42, 0xdf, 0xa4, 0x9c, 0x54, 0x47, 0x24, 0xe3, 0xfd,
+	0x72, 0x08, 0x52, 0xaf, 0xca, 0xdc, 0x8a, 0xa8, 0x9b, 0x02, 0x64, 0xb2, 0x56, 0xef, 0xc7, 0xd1,
+	0xdf, 0x01, 0x00, 0x61, 0xb1, 0x60, 0x2a, 0xe8, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +278,25 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.TimeoutTimestamp != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
 		i--
@@ -387,6 +416,14 @@ func (m *MsgTransfer) Size() (n int) {
 	if m.TimeoutTimestamp != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
 	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -647,6 +684,74 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &ReceiverExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
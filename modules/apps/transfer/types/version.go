@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeVersion defines the version of the ICS29 fee middleware which may wrap the transfer version
+// when the transfer module is composed with the fee middleware
+const FeeVersion = "ics29-1"
+
+// VersionValidator defines the interface used by the transfer module to validate the channel versions
+// proposed and negotiated during the channel handshake
+type VersionValidator interface {
+	// ValidateVersion returns an error if the provided channel version is not accepted by the transfer module.
+	ValidateVersion(version string) error
+}
+
+// feeMetadata defines the channel version format used by the ICS29 fee middleware to wrap the version
+// of the underlying application
+type feeMetadata struct {
+	FeeVersion string `json:"fee_version"`
+	AppVersion string `json:"app_version"`
+}
+
+// versionValidator is the VersionValidator implementation provided by the transfer module
+type versionValidator struct {
+	allowFeeWrapped bool
+}
+
+// NewVersionValidator returns a VersionValidator which accepts the plain transfer version. If allowFeeWrapped
+// is true the transfer version wrapped by the ICS29 fee middleware is accepted as well.
+func NewVersionValidator(allowFeeWrapped bool) VersionValidator {
+	return versionValidator{
+		allowFeeWrapped: allowFeeWrapped,
+	}
+}
+
+// ValidateVersion implements VersionValidator
+func (v versionValidator) ValidateVersion(version string) error {
+	if version == Version {
+		return nil
+	}
+
+	if !v.allowFeeWrapped {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "got %s, expected %s", version, Version)
+	}
+
+	var metadata feeMetadata
+	if err := json.Unmarshal([]byte(version), &metadata); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "got %s, expected %s or a version wrapped by the fee middleware", version, Version)
+	}
+
+	if metadata.FeeVersion != FeeVersion {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "got fee version %s, expected %s", metadata.FeeVersion, FeeVersion)
+	}
+
+	if metadata.AppVersion != Version {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "got app version %s, expected %s", metadata.AppVersion, Version)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+func TestValidateVersion(t *testing.T) {
+	feeWrappedVersion := fmt.Sprintf(`{"fee_version":"%s","app_version":"%s"}`, types.FeeVersion, types.Version)
+
+	testCases := []struct {
+		name            string
+		version         string
+		allowFeeWrapped bool
+		expPass         bool
+	}{
+		{"plain version", types.Version, false, true},
+		{"plain version with fee wrapped versions allowed", types.Version, true, true},
+		{"fee wrapped version", feeWrappedVersion, true, true},
+		{"fee wrapped version not allowed", feeWrappedVersion, false, false},
+		{"invalid plain version", "ics20-2", true, false},
+		{"malformed fee wrapped version", `{"fee_version":`, true, false},
+		{"invalid fee version", fmt.Sprintf(`{"fee_version":"ics29-2","app_version":"%s"}`, types.Version), true, false},
+		{"invalid wrapped app version", fmt.Sprintf(`{"fee_version":"%s","app_version":"ics20-2"}`, types.FeeVersion), true, false},
+		{"empty version", "", true, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.NewVersionValidator(tc.allowFeeWrapped).ValidateVersion(tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
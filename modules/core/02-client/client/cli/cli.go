@@ -21,10 +21,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryClientExpiryInfo(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusState(),
+		GetCmdQueryConsensusStateMetadata(),
 		GetCmdQueryHeader(),
 		GetCmdSelfConsensusState(),
+		GetCmdValidateSelfClientState(),
 		GetCmdParams(),
 	)
 
@@ -3,15 +3,18 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/client/utils"
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 const (
@@ -122,6 +125,41 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientExpiryInfo defines the command to query the remaining trusting period window of a client.
+func GetCmdQueryClientExpiryInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "expiry [client-id]",
+		Short:   "Query client expiry information",
+		Long:    "Query the latest consensus state timestamp, the trusting period and the time remaining before the client expires if it is not updated",
+		Example: fmt.Sprintf("%s query %s %s expiry [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClientExpiryInfoRequest{
+				ClientId: clientID,
+			}
+
+			res, err := queryClient.ClientExpiryInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
@@ -212,6 +250,49 @@ If the '--latest' flag is included, the query returns the latest consensus state
 	return cmd
 }
 
+// GetCmdQueryConsensusStateMetadata defines the command to query the processed time and height of a
+// consensus state of a client at a given height.
+func GetCmdQueryConsensusStateMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consensus-state-metadata [client-id] [height]",
+		Short:   "Query the processed time and height of a consensus state of a client at a given height",
+		Long:    "Query the time and block height at which the consensus state of a client at a given height was stored. These are used to determine when the delay period of a connection has passed.",
+		Example: fmt.Sprintf("%s query %s %s consensus-state-metadata [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			height, err := types.ParseHeight(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsensusStateMetadataRequest{
+				ClientId:       clientID,
+				RevisionNumber: height.GetRevisionNumber(),
+				RevisionHeight: height.GetRevisionHeight(),
+			}
+
+			res, err := queryClient.ConsensusStateMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryHeader defines the command to query the latest header on the chain
 func GetCmdQueryHeader() *cobra.Command {
 	cmd := &cobra.Command{
@@ -266,6 +347,60 @@ func GetCmdSelfConsensusState() *cobra.Command {
 	return cmd
 }
 
+// GetCmdValidateSelfClientState defines the command to validate a client state of this chain
+func GetCmdValidateSelfClientState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validate-self-client-state [path/to/client_state.json]",
+		Short:   "Validate a client state of this chain",
+		Long:    "Validate a client state of this chain, as proposed by a counterparty chain, against the parameters of this chain such as the chain ID and unbonding period. The client state may be provided as JSON or as a path to a .json file.",
+		Example: fmt.Sprintf("%s query %s %s validate-self-client-state path/to/client_state.json", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			// attempt to unmarshal client state argument
+			var clientState exported.ClientState
+			clientContentOrFileName := args[0]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(clientContentOrFileName), &clientState); err != nil {
+
+				// check for file path if JSON input is not provided
+				contents, err := ioutil.ReadFile(clientContentOrFileName)
+				if err != nil {
+					return errors.New("neither JSON input nor path to .json file for client state were provided")
+				}
+
+				if err := cdc.UnmarshalInterfaceJSON(contents, &clientState); err != nil {
+					return fmt.Errorf("error unmarshalling client state file: %w", err)
+				}
+			}
+
+			any, err := types.PackClientState(clientState)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidateSelfClientState(cmd.Context(), &types.QueryValidateSelfClientStateRequest{
+				ClientState: any,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc client parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
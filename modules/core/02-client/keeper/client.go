@@ -10,6 +10,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
 // CreateClient creates a new client state and populates it with a given consensus
@@ -25,6 +26,22 @@ func (k Keeper) CreateClient(
 		)
 	}
 
+	if tmClientState, ok := clientState.(*ibctmtypes.ClientState); ok {
+		if tmClientState.TrustingPeriod < params.MinTrustingPeriod {
+			return "", sdkerrors.Wrapf(
+				ibctmtypes.ErrInvalidTrustingPeriod,
+				"trusting period (%s) is less than the minimum trusting period (%s)", tmClientState.TrustingPeriod, params.MinTrustingPeriod,
+			)
+		}
+
+		if tmClientState.TrustingPeriod >= tmClientState.UnbondingPeriod {
+			return "", sdkerrors.Wrapf(
+				ibctmtypes.ErrInvalidTrustingPeriod,
+				"trusting period (%s) should be < unbonding period (%s)", tmClientState.TrustingPeriod, tmClientState.UnbondingPeriod,
+			)
+		}
+	}
+
 	clientID := k.GenerateClientIdentifier(ctx, clientState.ClientType())
 
 	k.SetClientState(ctx, clientID, clientState)
@@ -20,15 +20,23 @@ import (
 
 func (suite *KeeperTestSuite) TestCreateClient() {
 	cases := []struct {
-		msg         string
-		clientState exported.ClientState
-		expPass     bool
+		msg               string
+		clientState       exported.ClientState
+		minTrustingPeriod time.Duration
+		expPass           bool
 	}{
-		{"success", ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false), true},
-		{"client type not supported", localhosttypes.NewClientState(testChainID, clienttypes.NewHeight(0, 1)), false},
+		{"success", ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false), 0, true},
+		{"client type not supported", localhosttypes.NewClientState(testChainID, clienttypes.NewHeight(0, 1)), 0, false},
+		{"success: trusting period equal to minimum trusting period", ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false), trustingPeriod, true},
+		{"trusting period less than minimum trusting period", ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false), trustingPeriod + 1, false},
+		{"trusting period equal to unbonding period", ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, ubdPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false), 0, false},
+		{"trusting period greater than unbonding period", ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, ubdPeriod+1, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false), 0, false},
 	}
 
 	for i, tc := range cases {
+		params := suite.keeper.GetParams(suite.ctx)
+		params.MinTrustingPeriod = tc.minTrustingPeriod
+		suite.keeper.SetParams(suite.ctx, params)
 
 		clientID, err := suite.keeper.CreateClient(suite.ctx, tc.clientState, suite.consensusState)
 		if tc.expPass {
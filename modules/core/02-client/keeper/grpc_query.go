@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -215,6 +217,112 @@ func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 	}, nil
 }
 
+// ConsensusStateMetadata implements the Query/ConsensusStateMetadata gRPC method
+func (q Keeper) ConsensusStateMetadata(c context.Context, req *types.QueryConsensusStateMetadataRequest) (*types.QueryConsensusStateMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.RevisionHeight == 0 {
+		return nil, status.Error(codes.InvalidArgument, "consensus state height cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height := types.NewHeight(req.RevisionNumber, req.RevisionHeight)
+
+	if _, found := q.GetClientConsensusState(ctx, req.ClientId, height); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "client-id: %s, height: %s", req.ClientId, height).Error(),
+		)
+	}
+
+	// the processed time and height are stored alongside each consensus state by the light client,
+	// clients which do not enforce delay periods using this metadata will not have it set
+	clientStore := q.ClientStore(ctx, req.ClientId)
+	processedTime, found := ibctmtypes.GetProcessedTime(clientStore, height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConsensusStateMetadataNotFound, "processed time not found for client-id: %s, height: %s", req.ClientId, height).Error(),
+		)
+	}
+
+	processedHeight, found := ibctmtypes.GetProcessedHeight(clientStore, height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConsensusStateMetadataNotFound, "processed height not found for client-id: %s, height: %s", req.ClientId, height).Error(),
+		)
+	}
+
+	return &types.QueryConsensusStateMetadataResponse{
+		ProcessedTime:   processedTime,
+		ProcessedHeight: types.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight()),
+	}, nil
+}
+
+// ClientExpiryInfo implements the Query/ClientExpiryInfo gRPC method
+func (q Keeper) ClientExpiryInfo(c context.Context, req *types.QueryClientExpiryInfoRequest) (*types.QueryClientExpiryInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, found := q.GetClientState(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	// only tendermint clients define a trusting period
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			sdkerrors.Wrapf(types.ErrInvalidClientType, "client type %s does not define a trusting period", clientState.ClientType()).Error(),
+		)
+	}
+
+	latestHeight := clientState.GetLatestHeight()
+	consensusState, found := q.GetClientConsensusState(ctx, req.ClientId, latestHeight)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "client-id: %s, height: %s", req.ClientId, latestHeight).Error(),
+		)
+	}
+
+	clientStore := q.ClientStore(ctx, req.ClientId)
+	clientStatus := clientState.Status(ctx, clientStore, q.cdc)
+
+	latestTimestamp := time.Unix(0, int64(consensusState.GetTimestamp())).UTC()
+
+	// the time remaining is zero once the trusting period has elapsed
+	timeRemaining := latestTimestamp.Add(tmClientState.TrustingPeriod).Sub(ctx.BlockTime())
+	if timeRemaining < 0 {
+		timeRemaining = 0
+	}
+
+	return &types.QueryClientExpiryInfoResponse{
+		Status:                   clientStatus.String(),
+		LatestHeight:             types.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()),
+		LatestConsensusTimestamp: latestTimestamp,
+		TrustingPeriod:           tmClientState.TrustingPeriod,
+		TimeRemaining:            timeRemaining,
+	}, nil
+}
+
 // ClientParams implements the Query/ClientParams gRPC method
 func (q Keeper) ClientParams(c context.Context, _ *types.QueryClientParamsRequest) (*types.QueryClientParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -291,3 +399,53 @@ func (q Keeper) UpgradedConsensusState(c context.Context, req *types.QueryUpgrad
 		UpgradedConsensusState: any,
 	}, nil
 }
+
+// SelfConsensusState implements the Query/SelfConsensusState gRPC method
+func (q Keeper) SelfConsensusState(c context.Context, req *types.QuerySelfConsensusStateRequest) (*types.QuerySelfConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	height := types.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	if height.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be zero")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	consensusState, err := q.GetSelfConsensusState(ctx, height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	any, err := types.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySelfConsensusStateResponse{
+		ConsensusState: any,
+	}, nil
+}
+
+// ValidateSelfClientState implements the Query/ValidateSelfClientState gRPC method
+func (q Keeper) ValidateSelfClientState(c context.Context, req *types.QueryValidateSelfClientStateRequest) (*types.QueryValidateSelfClientStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ClientState == nil {
+		return nil, status.Error(codes.InvalidArgument, "client state cannot be nil")
+	}
+
+	var clientState exported.ClientState
+	if err := q.cdc.UnpackAny(req.ClientState, &clientState); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := q.ValidateSelfClient(ctx, clientState); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryValidateSelfClientStateResponse{}, nil
+}
@@ -491,6 +491,229 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientExpiryInfo() {
+	var (
+		req              *types.QueryClientExpiryInfoRequest
+		path             *ibctesting.Path
+		expStatus        string
+		expTimeRemaining func(*ibctmtypes.ClientState, time.Time) time.Duration
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{"invalid clientID",
+			func() {
+				req = &types.QueryClientExpiryInfoRequest{}
+			},
+			false,
+		},
+		{"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{"client does not define a trusting period",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.cdc, "solo machine", "", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), solomachine.ClientID, solomachine.ClientState())
+
+				req.ClientId = solomachine.ClientID
+			},
+			false,
+		},
+		{"consensus state not found",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+
+				// increment latest height so no consensus state is stored
+				clientState.LatestHeight = clientState.LatestHeight.Increment().(types.Height)
+				path.EndpointA.SetClientState(clientState)
+			},
+			false,
+		},
+		{"active client",
+			func() {},
+			true,
+		},
+		{"frozen client",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+
+				expStatus = exported.Frozen.String()
+			},
+			true,
+		},
+		{"expired client",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+
+				// advance the block time past the trusting period
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+				suite.coordinator.CommitBlock(suite.chainA)
+
+				expStatus = exported.Expired.String()
+				expTimeRemaining = func(*ibctmtypes.ClientState, time.Time) time.Duration { return 0 }
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			req = &types.QueryClientExpiryInfoRequest{
+				ClientId: path.EndpointA.ClientID,
+			}
+			expStatus = exported.Active.String()
+			expTimeRemaining = func(clientState *ibctmtypes.ClientState, latestTimestamp time.Time) time.Duration {
+				return latestTimestamp.Add(clientState.TrustingPeriod).Sub(suite.chainA.GetContext().BlockTime())
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ClientExpiryInfo(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				consensusState := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+				latestTimestamp := time.Unix(0, int64(consensusState.GetTimestamp())).UTC()
+
+				suite.Require().Equal(expStatus, res.Status)
+				suite.Require().Equal(clientState.GetLatestHeight(), res.LatestHeight)
+				suite.Require().Equal(latestTimestamp, res.LatestConsensusTimestamp)
+				suite.Require().Equal(clientState.TrustingPeriod, res.TrustingPeriod)
+				suite.Require().Equal(expTimeRemaining(clientState, latestTimestamp), res.TimeRemaining)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryConsensusStateMetadata() {
+	var (
+		req    *types.QueryConsensusStateMetadataRequest
+		path   *ibctesting.Path
+		height exported.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{"invalid clientID",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+		},
+		{"invalid height",
+			func() {
+				req.RevisionHeight = 0
+			},
+			false,
+		},
+		{"consensus state not found",
+			func() {
+				req.RevisionHeight = height.Increment().GetRevisionHeight()
+			},
+			false,
+		},
+		{"consensus state metadata not found",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.cdc, "solo machine", "", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), solomachine.ClientID, solomachine.ClientState())
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), solomachine.ClientID, solomachine.GetHeight(), solomachine.ConsensusState())
+
+				req = &types.QueryConsensusStateMetadataRequest{
+					ClientId:       solomachine.ClientID,
+					RevisionNumber: solomachine.GetHeight().GetRevisionNumber(),
+					RevisionHeight: solomachine.GetHeight().GetRevisionHeight(),
+				}
+			},
+			false,
+		},
+		{"success",
+			func() {},
+			true,
+		},
+		{"success - updated client",
+			func() {
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				height = path.EndpointA.GetClientState().GetLatestHeight()
+				req.RevisionNumber = height.GetRevisionNumber()
+				req.RevisionHeight = height.GetRevisionHeight()
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			height = path.EndpointA.GetClientState().GetLatestHeight()
+			req = &types.QueryConsensusStateMetadataRequest{
+				ClientId:       path.EndpointA.ClientID,
+				RevisionNumber: height.GetRevisionNumber(),
+				RevisionHeight: height.GetRevisionHeight(),
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ConsensusStateMetadata(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				expProcessedTime, found := ibctmtypes.GetProcessedTime(clientStore, height)
+				suite.Require().True(found)
+				expProcessedHeight, found := ibctmtypes.GetProcessedHeight(clientStore, height)
+				suite.Require().True(found)
+
+				suite.Require().Equal(expProcessedTime, res.ProcessedTime)
+				suite.Require().Equal(expProcessedHeight, res.ProcessedHeight)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUpgradedConsensusStates() {
 	var (
 		req               *types.QueryUpgradedConsensusStateRequest
@@ -559,3 +782,165 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.QueryServer.ClientParams(ctx, &types.QueryClientParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQuerySelfConsensusState() {
+	var (
+		req               *types.QuerySelfConsensusStateRequest
+		expConsensusState exported.ConsensusState
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				height := types.NewHeight(0, uint64(suite.chainA.GetContext().BlockHeight()-1))
+				req = &types.QuerySelfConsensusStateRequest{
+					RevisionNumber: height.RevisionNumber,
+					RevisionHeight: height.RevisionHeight,
+				}
+
+				var err error
+				expConsensusState, err = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetSelfConsensusState(suite.chainA.GetContext(), height)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"zero height",
+			func() {
+				req = &types.QuerySelfConsensusStateRequest{}
+			},
+			false,
+		},
+		{
+			"invalid revision number",
+			func() {
+				req = &types.QuerySelfConsensusStateRequest{
+					RevisionNumber: 1,
+					RevisionHeight: uint64(suite.chainA.GetContext().BlockHeight() - 1),
+				}
+			},
+			false,
+		},
+		{
+			"historical info not found",
+			func() {
+				req = &types.QuerySelfConsensusStateRequest{
+					RevisionHeight: uint64(suite.chainA.GetContext().BlockHeight() + 1),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.SelfConsensusState(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				consensusState, err := types.UnpackConsensusState(res.ConsensusState)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expConsensusState, consensusState)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryValidateSelfClientState() {
+	var req *types.QueryValidateSelfClientStateRequest
+
+	newClientState := func(chainID string, unbondingPeriod time.Duration) exported.ClientState {
+		height := types.NewHeight(0, uint64(suite.chainA.GetContext().BlockHeight()-1))
+		return ibctmtypes.NewClientState(chainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, unbondingPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				clientState, err := types.PackClientState(newClientState(suite.chainA.ChainID, ubdPeriod))
+				suite.Require().NoError(err)
+
+				req = &types.QueryValidateSelfClientStateRequest{ClientState: clientState}
+			},
+			true,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"client state is nil",
+			func() {
+				req = &types.QueryValidateSelfClientStateRequest{}
+			},
+			false,
+		},
+		{
+			"chain ID mismatch",
+			func() {
+				clientState, err := types.PackClientState(newClientState("gaiatestnet", ubdPeriod))
+				suite.Require().NoError(err)
+
+				req = &types.QueryValidateSelfClientStateRequest{ClientState: clientState}
+			},
+			false,
+		},
+		{
+			"unbonding period mismatch",
+			func() {
+				clientState, err := types.PackClientState(newClientState(suite.chainA.ChainID, ubdPeriod+time.Hour))
+				suite.Require().NoError(err)
+
+				req = &types.QueryValidateSelfClientStateRequest{ClientState: clientState}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ValidateSelfClientState(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 )
@@ -12,9 +14,17 @@ func (k Keeper) GetAllowedClients(ctx sdk.Context) []string {
 	return res
 }
 
+// GetMinTrustingPeriod retrieves the minimum trusting period accepted for tendermint clients from the paramstore.
+// A zero value is returned if the parameter has not been set.
+func (k Keeper) GetMinTrustingPeriod(ctx sdk.Context) time.Duration {
+	var res time.Duration
+	k.paramSpace.GetIfExists(ctx, types.KeyMinTrustingPeriod, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-client parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetAllowedClients(ctx)...)
+	return types.NewParams(k.GetMinTrustingPeriod(ctx), k.GetAllowedClients(ctx)...)
 }
 
 // SetParams sets the total set of ibc-client parameters.
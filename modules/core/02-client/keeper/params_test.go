@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 )
 
@@ -14,4 +16,9 @@ func (suite *KeeperTestSuite) TestParams() {
 	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Empty(expParams.AllowedClients)
+
+	expParams.MinTrustingPeriod = time.Hour
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(time.Hour, params.MinTrustingPeriod)
 }
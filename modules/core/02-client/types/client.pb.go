@@ -9,15 +9,19 @@ import (
 	types1 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -342,6 +346,9 @@ var xxx_messageInfo_Height proto.InternalMessageInfo
 type Params struct {
 	// allowed_clients defines the list of allowed client state types.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty" yaml:"allowed_clients"`
+	// min_trusting_period defines the minimum trusting period accepted for newly
+	// created tendermint clients. A zero value disables the check.
+	MinTrustingPeriod time.Duration `protobuf:"bytes,2,opt,name=min_trusting_period,json=minTrustingPeriod,proto3,stdduration" json:"min_trusting_period" yaml:"min_trusting_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -384,6 +391,13 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetMinTrustingPeriod() time.Duration {
+	if m != nil {
+		return m.MinTrustingPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
@@ -397,52 +411,56 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbd, 0x6e, 0xdb, 0x3a,
-	0x18, 0xb5, 0x12, 0x5f, 0x23, 0xa6, 0x83, 0x38, 0x57, 0xb1, 0x6f, 0x1c, 0xdf, 0xc0, 0x32, 0x88,
-	0x0e, 0x1e, 0x1a, 0xa9, 0x76, 0x80, 0x22, 0xf0, 0x56, 0x7b, 0x49, 0x86, 0x16, 0xae, 0x8a, 0xa0,
-	0x45, 0x17, 0x43, 0x3f, 0x8c, 0xcc, 0x40, 0x16, 0x0d, 0x91, 0x72, 0xeb, 0x37, 0xe8, 0xd8, 0xb1,
-	0x43, 0x87, 0x3c, 0x41, 0x9f, 0xa2, 0x43, 0xc6, 0x8c, 0x9d, 0x84, 0x22, 0x59, 0xba, 0x56, 0x6b,
-	0x97, 0x42, 0x24, 0x95, 0xd8, 0x4e, 0x52, 0x14, 0xed, 0x46, 0x1e, 0x1e, 0x9e, 0xef, 0x9c, 0x4f,
-	0xfa, 0x08, 0x34, 0x6c, 0x3b, 0x86, 0x43, 0x42, 0x64, 0x38, 0x3e, 0x46, 0x01, 0x33, 0xa6, 0x6d,
-	0xb9, 0xd2, 0x27, 0x21, 0x61, 0x44, 0x55, 0xb1, 0xed, 0xe8, 0x29, 0x41, 0x97, 0xf0, 0xb4, 0x5d,
-	0xaf, 0x78, 0xc4, 0x23, 0xfc, 0xd8, 0x48, 0x57, 0x82, 0x59, 0xdf, 0xf1, 0x08, 0xf1, 0x7c, 0x64,
-	0xf0, 0x9d, 0x1d, 0x9d, 0x18, 0x56, 0x30, 0x93, 0x47, 0x0f, 0x1c, 0x42, 0xc7, 0x84, 0x1a, 0xd1,
-	0xc4, 0x0b, 0x2d, 0x17, 0x19, 0xd3, 0xb6, 0x8d, 0x98, 0xd5, 0xce, 0xf6, 0x82, 0x05, 0x3f, 0x2a,
-	0xa0, 0x7a, 0xe4, 0xa2, 0x80, 0xe1, 0x13, 0x8c, 0xdc, 0x3e, 0x2f, 0xf7, 0x82, 0x59, 0x0c, 0xa9,
-	0x6d, 0x50, 0x14, 0xd5, 0x87, 0xd8, 0xad, 0x29, 0x4d, 0xa5, 0x55, 0xec, 0x55, 0x92, 0x58, 0xdb,
-	0x9c, 0x59, 0x63, 0xbf, 0x0b, 0xaf, 0x8f, 0xa0, 0xb9, 0x26, 0xd6, 0x47, 0xae, 0x3a, 0x00, 0xeb,
-	0x12, 0xa7, 0xa9, 0x44, 0x6d, 0xa5, 0xa9, 0xb4, 0x4a, 0x9d, 0x8a, 0x2e, 0x4c, 0xea, 0x99, 0x49,
-	0xfd, 0x49, 0x30, 0xeb, 0x6d, 0x27, 0xb1, 0xb6, 0xb5, 0xa0, 0xc5, 0xef, 0x40, 0xb3, 0xe4, 0xdc,
-	0x98, 0x80, 0x9f, 0x14, 0x50, 0xeb, 0x93, 0x80, 0xa2, 0x80, 0x46, 0x94, 0x43, 0x2f, 0x31, 0x1b,
-	0x1d, 0x22, 0xec, 0x8d, 0x98, 0x7a, 0x00, 0x0a, 0x23, 0xbe, 0xe2, 0xf6, 0x4a, 0x9d, 0xba, 0x7e,
-	0xbb, 0x6f, 0xba, 0xe0, 0xf6, 0xf2, 0xe7, 0xb1, 0x96, 0x33, 0x25, 0x5f, 0x7d, 0x05, 0xca, 0x4e,
-	0xa6, 0xfa, 0x1b, 0x5e, 0x77, 0x92, 0x58, 0xab, 0xa6, 0x5e, 0xe1, 0xd2, 0x2d, 0x68, 0x6e, 0x38,
-	0x0b, 0xee, 0xe0, 0x67, 0x05, 0x54, 0x45, 0x17, 0x17, 0x6d, 0xd3, 0x3f, 0xe9, 0xe7, 0x5b, 0xb0,
-	0xb9, 0x54, 0x90, 0xd6, 0x56, 0x9a, 0xab, 0xad, 0x52, 0xe7, 0xe1, 0x5d, 0x51, 0xef, 0x6b, 0x54,
-	0x4f, 0x4b, 0xc3, 0x27, 0xb1, 0xb6, 0x2d, 0x6b, 0x2d, 0x69, 0x42, 0xb3, 0xbc, 0x98, 0x82, 0xc2,
-	0xef, 0x0a, 0xa8, 0x88, 0x18, 0xc7, 0x13, 0xd7, 0x62, 0x68, 0x10, 0x92, 0x09, 0xa1, 0x96, 0xaf,
-	0x56, 0xc0, 0x3f, 0x0c, 0x33, 0x1f, 0x89, 0x04, 0xa6, 0xd8, 0xa8, 0x4d, 0x50, 0x72, 0x11, 0x75,
-	0x42, 0x3c, 0x61, 0x98, 0x04, 0xbc, 0x97, 0x45, 0x73, 0x1e, 0x52, 0x0f, 0xc1, 0xbf, 0x34, 0xb2,
-	0x4f, 0x91, 0xc3, 0x86, 0x37, 0x5d, 0x58, 0xe5, 0x5d, 0xd8, 0x4d, 0x62, 0xad, 0x26, 0x9c, 0xdd,
-	0xa2, 0x40, 0xb3, 0x2c, 0xb1, 0x7e, 0xd6, 0x94, 0xe7, 0xa0, 0x42, 0x23, 0x9b, 0x32, 0xcc, 0x22,
-	0x86, 0xe6, 0xc4, 0xf2, 0x5c, 0x4c, 0x4b, 0x62, 0xed, 0xff, 0x6b, 0xb1, 0x5b, 0x2c, 0x68, 0xaa,
-	0x37, 0x70, 0x26, 0xd9, 0xcd, 0xbf, 0x3b, 0xd3, 0x72, 0xf0, 0x87, 0x02, 0xca, 0xc7, 0x62, 0x38,
-	0xfe, 0x3a, 0xee, 0x63, 0x90, 0x9f, 0xf8, 0x56, 0xc0, 0x13, 0x96, 0x3a, 0xbb, 0xba, 0x98, 0x45,
-	0x3d, 0x9b, 0x3d, 0x39, 0x8b, 0xfa, 0xc0, 0xb7, 0x02, 0xf9, 0x6b, 0x72, 0xbe, 0x7a, 0x0a, 0xaa,
-	0x92, 0xe3, 0x0e, 0x17, 0x46, 0x29, 0xff, 0x8b, 0xdf, 0xb3, 0x99, 0xc4, 0xda, 0xae, 0xc8, 0x7c,
-	0xe7, 0x65, 0x68, 0x6e, 0x65, 0xf8, 0xdc, 0x80, 0x77, 0xd7, 0xd3, 0xd4, 0x1f, 0xce, 0xb4, 0xdc,
-	0xb7, 0x33, 0x4d, 0x49, 0x1f, 0x82, 0x82, 0x9c, 0xab, 0x3e, 0x28, 0x87, 0x68, 0x8a, 0x29, 0x26,
-	0xc1, 0x30, 0x88, 0xc6, 0x36, 0x0a, 0x79, 0xfc, 0x7c, 0xaf, 0x9e, 0xc4, 0xda, 0x7f, 0xa2, 0xd0,
-	0x12, 0x01, 0x9a, 0x1b, 0x19, 0xf2, 0x8c, 0x03, 0x0b, 0x22, 0x72, 0x4a, 0x57, 0xee, 0x15, 0x11,
-	0x84, 0x39, 0x11, 0xe1, 0xa4, 0xbb, 0x96, 0x59, 0x84, 0x4f, 0x41, 0x61, 0x60, 0x85, 0xd6, 0x98,
-	0xa6, 0xc2, 0x96, 0xef, 0x93, 0x37, 0xd7, 0x21, 0x69, 0x4d, 0x69, 0xae, 0xb6, 0x8a, 0xf3, 0xc2,
-	0x4b, 0x04, 0x68, 0x6e, 0x48, 0x44, 0xe4, 0xa7, 0x3d, 0xf3, 0xfc, 0xb2, 0xa1, 0x5c, 0x5c, 0x36,
-	0x94, 0xaf, 0x97, 0x0d, 0xe5, 0xfd, 0x55, 0x23, 0x77, 0x71, 0xd5, 0xc8, 0x7d, 0xb9, 0x6a, 0xe4,
-	0x5e, 0x1f, 0x78, 0x98, 0x8d, 0x22, 0x5b, 0x77, 0xc8, 0xd8, 0x90, 0x2f, 0x28, 0xb6, 0x9d, 0x3d,
-	0x8f, 0x18, 0xd3, 0x7d, 0x63, 0x4c, 0xdc, 0xc8, 0x47, 0x54, 0x3c, 0xde, 0x8f, 0x3a, 0x7b, 0xf2,
-	0xfd, 0x66, 0xb3, 0x09, 0xa2, 0x76, 0x81, 0x7f, 0x94, 0xfd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x74, 0xdf, 0x06, 0xc1, 0xdf, 0x05, 0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x3f, 0x6f, 0x23, 0x45,
+	0x1c, 0xf5, 0x26, 0xc6, 0x3a, 0x8f, 0x4f, 0xf1, 0xdd, 0xc6, 0xe6, 0x1c, 0x13, 0x79, 0xac, 0x11,
+	0x42, 0x2e, 0xb8, 0x5d, 0xec, 0x93, 0xd0, 0xc9, 0x1d, 0x36, 0xc5, 0xa5, 0x41, 0x66, 0x21, 0x02,
+	0xd1, 0x58, 0xfb, 0x67, 0xb2, 0x9e, 0x68, 0x77, 0x67, 0xd9, 0x99, 0x35, 0xf8, 0x1b, 0x50, 0x52,
+	0xa6, 0xa0, 0xc8, 0x27, 0xe0, 0x33, 0x50, 0x50, 0xa4, 0x4c, 0x49, 0xb5, 0xa0, 0xa4, 0xa1, 0x65,
+	0x5b, 0x1a, 0xb4, 0x33, 0xb3, 0x89, 0xff, 0x24, 0x08, 0x71, 0xdd, 0xec, 0xfb, 0xbd, 0x79, 0xf3,
+	0xde, 0x4f, 0xf3, 0x9b, 0x05, 0x90, 0x38, 0xae, 0xe9, 0xd2, 0x04, 0x9b, 0x6e, 0x40, 0x70, 0xc4,
+	0xcd, 0xe5, 0x50, 0xad, 0x8c, 0x38, 0xa1, 0x9c, 0xea, 0x3a, 0x71, 0x5c, 0xa3, 0x20, 0x18, 0x0a,
+	0x5e, 0x0e, 0xbb, 0x2d, 0x9f, 0xfa, 0x54, 0x94, 0xcd, 0x62, 0x25, 0x99, 0xdd, 0x23, 0x9f, 0x52,
+	0x3f, 0xc0, 0xa6, 0xf8, 0x72, 0xd2, 0x33, 0xd3, 0x8e, 0x56, 0xaa, 0xd4, 0xdb, 0x2e, 0x79, 0x69,
+	0x62, 0x73, 0x42, 0x23, 0x55, 0x7f, 0xdf, 0xa5, 0x2c, 0xa4, 0xcc, 0x4c, 0x63, 0x3f, 0xb1, 0x3d,
+	0x6c, 0x2e, 0x87, 0x0e, 0xe6, 0xf6, 0xb0, 0xfc, 0x96, 0x2c, 0xf4, 0x93, 0x06, 0xda, 0x27, 0x1e,
+	0x8e, 0x38, 0x39, 0x23, 0xd8, 0x9b, 0x0a, 0x3b, 0x5f, 0x70, 0x9b, 0x63, 0x7d, 0x08, 0xea, 0xd2,
+	0xdd, 0x9c, 0x78, 0x1d, 0xad, 0xaf, 0x0d, 0xea, 0x93, 0x56, 0x9e, 0xc1, 0x67, 0x2b, 0x3b, 0x0c,
+	0xc6, 0xe8, 0xae, 0x84, 0xac, 0x27, 0x72, 0x7d, 0xe2, 0xe9, 0x33, 0xf0, 0x54, 0xe1, 0xac, 0x90,
+	0xe8, 0xec, 0xf5, 0xb5, 0x41, 0x63, 0xd4, 0x32, 0xa4, 0x53, 0xa3, 0x74, 0x6a, 0x7c, 0x12, 0xad,
+	0x26, 0x2f, 0xf2, 0x0c, 0x1e, 0x6e, 0x68, 0x89, 0x3d, 0xc8, 0x6a, 0xb8, 0xf7, 0x26, 0xd0, 0xcf,
+	0x1a, 0xe8, 0x4c, 0x69, 0xc4, 0x70, 0xc4, 0x52, 0x26, 0xa0, 0xaf, 0x08, 0x5f, 0xbc, 0xc1, 0xc4,
+	0x5f, 0x70, 0xfd, 0x35, 0xa8, 0x2d, 0xc4, 0x4a, 0xd8, 0x6b, 0x8c, 0xba, 0xc6, 0x6e, 0x5f, 0x0d,
+	0xc9, 0x9d, 0x54, 0xaf, 0x32, 0x58, 0xb1, 0x14, 0x5f, 0xff, 0x1a, 0x34, 0xdd, 0x52, 0xf5, 0x3f,
+	0x78, 0x3d, 0xca, 0x33, 0xd8, 0x2e, 0xbc, 0xa2, 0xad, 0x5d, 0xc8, 0x3a, 0x70, 0x37, 0xdc, 0xa1,
+	0x5f, 0x35, 0xd0, 0x96, 0x5d, 0xdc, 0xb4, 0xcd, 0xfe, 0x4f, 0x3f, 0xbf, 0x07, 0xcf, 0xb6, 0x0e,
+	0x64, 0x9d, 0xbd, 0xfe, 0xfe, 0xa0, 0x31, 0xfa, 0xf0, 0xa1, 0xa8, 0x8f, 0x35, 0x6a, 0x02, 0x8b,
+	0xf0, 0x79, 0x06, 0x5f, 0xa8, 0xb3, 0xb6, 0x34, 0x91, 0xd5, 0xdc, 0x4c, 0xc1, 0xd0, 0x5f, 0x1a,
+	0x68, 0xc9, 0x18, 0xa7, 0xb1, 0x67, 0x73, 0x3c, 0x4b, 0x68, 0x4c, 0x99, 0x1d, 0xe8, 0x2d, 0xf0,
+	0x0e, 0x27, 0x3c, 0xc0, 0x32, 0x81, 0x25, 0x3f, 0xf4, 0x3e, 0x68, 0x78, 0x98, 0xb9, 0x09, 0x89,
+	0x8b, 0x0b, 0x28, 0x7a, 0x59, 0xb7, 0xd6, 0x21, 0xfd, 0x0d, 0x78, 0xce, 0x52, 0xe7, 0x1c, 0xbb,
+	0x7c, 0x7e, 0xdf, 0x85, 0x7d, 0xd1, 0x85, 0xe3, 0x3c, 0x83, 0x1d, 0xe9, 0x6c, 0x87, 0x82, 0xac,
+	0xa6, 0xc2, 0xa6, 0x65, 0x53, 0x3e, 0x07, 0x2d, 0x96, 0x3a, 0x8c, 0x13, 0x9e, 0x72, 0xbc, 0x26,
+	0x56, 0x15, 0x62, 0x30, 0xcf, 0xe0, 0x7b, 0x77, 0x62, 0x3b, 0x2c, 0x64, 0xe9, 0xf7, 0x70, 0x29,
+	0x39, 0xae, 0xfe, 0x70, 0x09, 0x2b, 0xe8, 0x6f, 0x0d, 0x34, 0x4f, 0xe5, 0x70, 0xbc, 0x75, 0xdc,
+	0x8f, 0x41, 0x35, 0x0e, 0xec, 0x48, 0x24, 0x6c, 0x8c, 0x8e, 0x0d, 0x39, 0x8b, 0x46, 0x39, 0x7b,
+	0x6a, 0x16, 0x8d, 0x59, 0x60, 0x47, 0xea, 0x6a, 0x0a, 0xbe, 0x7e, 0x0e, 0xda, 0x8a, 0xe3, 0xcd,
+	0x37, 0x46, 0xa9, 0xfa, 0x2f, 0xd7, 0xb3, 0x9f, 0x67, 0xf0, 0x58, 0x66, 0x7e, 0x70, 0x33, 0xb2,
+	0x0e, 0x4b, 0x7c, 0x6d, 0xc0, 0xc7, 0x4f, 0x8b, 0xd4, 0x17, 0x97, 0xb0, 0xf2, 0xe7, 0x25, 0xd4,
+	0x8a, 0x87, 0xa0, 0xa6, 0xe6, 0x6a, 0x0a, 0x9a, 0x09, 0x5e, 0x12, 0x46, 0x68, 0x34, 0x8f, 0xd2,
+	0xd0, 0xc1, 0x89, 0x88, 0x5f, 0x9d, 0x74, 0xf3, 0x0c, 0xbe, 0x2b, 0x0f, 0xda, 0x22, 0x20, 0xeb,
+	0xa0, 0x44, 0x3e, 0x13, 0xc0, 0x86, 0x88, 0x9a, 0xd2, 0xbd, 0x47, 0x45, 0x24, 0x61, 0x4d, 0x44,
+	0x3a, 0x19, 0x3f, 0x29, 0x2d, 0xa2, 0x5f, 0x34, 0x50, 0x9b, 0xd9, 0x89, 0x1d, 0xb2, 0x42, 0xd9,
+	0x0e, 0x02, 0xfa, 0xdd, 0x5d, 0x4a, 0xd6, 0xd1, 0xfa, 0xfb, 0x83, 0xfa, 0xba, 0xf2, 0x16, 0x01,
+	0x59, 0x07, 0x0a, 0x91, 0x0d, 0x60, 0xfa, 0xb7, 0xe0, 0x30, 0x24, 0xd1, 0x9c, 0x27, 0x29, 0xe3,
+	0x24, 0xf2, 0xe7, 0x31, 0x4e, 0x08, 0xf5, 0xd4, 0x2b, 0x70, 0xb4, 0xd3, 0xe6, 0x4f, 0xd5, 0xdb,
+	0x3a, 0xf9, 0x40, 0x8d, 0x52, 0x57, 0x9e, 0xf3, 0x80, 0x06, 0xba, 0xf8, 0x1d, 0x6a, 0xd6, 0xf3,
+	0x90, 0x44, 0x5f, 0xaa, 0xc2, 0x4c, 0xe0, 0x13, 0xeb, 0xea, 0xa6, 0xa7, 0x5d, 0xdf, 0xf4, 0xb4,
+	0x3f, 0x6e, 0x7a, 0xda, 0x8f, 0xb7, 0xbd, 0xca, 0xf5, 0x6d, 0xaf, 0xf2, 0xdb, 0x6d, 0xaf, 0xf2,
+	0xcd, 0x6b, 0x9f, 0xf0, 0x45, 0xea, 0x18, 0x2e, 0x0d, 0x4d, 0xf5, 0x6a, 0x13, 0xc7, 0x7d, 0xe9,
+	0x53, 0x73, 0xf9, 0xca, 0x0c, 0xa9, 0x97, 0x06, 0x98, 0xc9, 0x1f, 0xca, 0x47, 0xa3, 0x97, 0xea,
+	0x9f, 0xc2, 0x57, 0x31, 0x66, 0x4e, 0x4d, 0x38, 0x7c, 0xf5, 0xcf, 0x00, 0x2a, 0x51, 0x67, 0x96,
+	0x73, 0x06, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -772,6 +790,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinTrustingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTrustingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintClient(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -921,6 +947,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTrustingPeriod)
+	n += 1 + l + sovClient(uint64(l))
 	return n
 }
 
@@ -1793,6 +1821,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTrustingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinTrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
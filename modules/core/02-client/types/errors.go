@@ -34,4 +34,5 @@ var (
 	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 27, "invalid client state substitute")
 	ErrInvalidUpgradeProposal                 = sdkerrors.Register(SubModuleName, 28, "invalid upgrade proposal")
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client is not active")
+	ErrConsensusStateMetadataNotFound         = sdkerrors.Register(SubModuleName, 30, "consensus state metadata not found")
 )
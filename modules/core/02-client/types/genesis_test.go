@@ -101,7 +101,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 						},
 					),
 				},
-				types.NewParams(exported.Tendermint, exported.Localhost),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint, exported.Localhost),
 				false,
 				2,
 			),
@@ -132,7 +132,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Tendermint),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint),
 				false,
 				0,
 			),
@@ -149,7 +149,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 				},
 				nil,
 				nil,
-				types.NewParams(exported.Tendermint),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint),
 				false,
 				0,
 			),
@@ -180,7 +180,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Tendermint),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint),
 				false,
 				0,
 			),
@@ -211,7 +211,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Tendermint),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint),
 				false,
 				0,
 			),
@@ -242,7 +242,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Tendermint),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint),
 				false,
 				0,
 			),
@@ -273,7 +273,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Solomachine),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Solomachine),
 				false,
 				0,
 			),
@@ -312,7 +312,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 						},
 					),
 				},
-				types.NewParams(exported.Tendermint, exported.Localhost),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint, exported.Localhost),
 				false,
 				0,
 			),
@@ -348,7 +348,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 						},
 					),
 				},
-				types.NewParams(exported.Tendermint),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint),
 				false,
 				0,
 			),
@@ -378,7 +378,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(" "),
+				types.NewParams(types.DefaultMinTrustingPeriod, " "),
 				false,
 				0,
 			),
@@ -409,7 +409,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(" "),
+				types.NewParams(types.DefaultMinTrustingPeriod, " "),
 				true,
 				0,
 			),
@@ -440,7 +440,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Tendermint),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint),
 				true,
 				2,
 			),
@@ -471,7 +471,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Tendermint, exported.Localhost),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint, exported.Localhost),
 				false,
 				0,
 			),
@@ -502,7 +502,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Tendermint, exported.Localhost),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint, exported.Localhost),
 				false,
 				5,
 			),
@@ -530,7 +530,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
-				types.NewParams(exported.Tendermint, exported.Localhost),
+				types.NewParams(types.DefaultMinTrustingPeriod, exported.Tendermint, exported.Localhost),
 				false,
 				5,
 			),
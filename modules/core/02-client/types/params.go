@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -14,6 +15,12 @@ var (
 
 	// KeyAllowedClients is store's key for AllowedClients Params
 	KeyAllowedClients = []byte("AllowedClients")
+
+	// DefaultMinTrustingPeriod is zero, i.e. no minimum trusting period is enforced
+	DefaultMinTrustingPeriod time.Duration = 0
+
+	// KeyMinTrustingPeriod is store's key for MinTrustingPeriod Params
+	KeyMinTrustingPeriod = []byte("MinTrustingPeriod")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -22,19 +29,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc client module
-func NewParams(allowedClients ...string) Params {
+func NewParams(minTrustingPeriod time.Duration, allowedClients ...string) Params {
 	return Params{
-		AllowedClients: allowedClients,
+		AllowedClients:    allowedClients,
+		MinTrustingPeriod: minTrustingPeriod,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-client module
 func DefaultParams() Params {
-	return NewParams(DefaultAllowedClients...)
+	return NewParams(DefaultMinTrustingPeriod, DefaultAllowedClients...)
 }
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
+	if err := validateMinTrustingPeriod(p.MinTrustingPeriod); err != nil {
+		return err
+	}
+
 	return validateClients(p.AllowedClients)
 }
 
@@ -42,6 +54,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedClients, p.AllowedClients, validateClients),
+		paramtypes.NewParamSetPair(KeyMinTrustingPeriod, p.MinTrustingPeriod, validateMinTrustingPeriod),
 	}
 }
 
@@ -69,3 +82,16 @@ func validateClients(i interface{}) error {
 
 	return nil
 }
+
+func validateMinTrustingPeriod(i interface{}) error {
+	minTrustingPeriod, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if minTrustingPeriod < 0 {
+		return fmt.Errorf("minimum trusting period cannot be negative: %s", minTrustingPeriod)
+	}
+
+	return nil
+}
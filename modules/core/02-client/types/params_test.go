@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -15,8 +16,10 @@ func TestValidateParams(t *testing.T) {
 		expPass bool
 	}{
 		{"default params", DefaultParams(), true},
-		{"custom params", NewParams(exported.Tendermint), true},
-		{"blank client", NewParams(" "), false},
+		{"custom params", NewParams(DefaultMinTrustingPeriod, exported.Tendermint), true},
+		{"custom min trusting period", NewParams(time.Hour, exported.Tendermint), true},
+		{"blank client", NewParams(DefaultMinTrustingPeriod, " "), false},
+		{"negative min trusting period", NewParams(-time.Second, exported.Tendermint), false},
 	}
 
 	for _, tc := range testCases {
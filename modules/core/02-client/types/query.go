@@ -10,6 +10,8 @@ var (
 	_ codectypes.UnpackInterfacesMessage = QueryClientStatesResponse{}
 	_ codectypes.UnpackInterfacesMessage = QueryConsensusStateResponse{}
 	_ codectypes.UnpackInterfacesMessage = QueryConsensusStatesResponse{}
+	_ codectypes.UnpackInterfacesMessage = QuerySelfConsensusStateResponse{}
+	_ codectypes.UnpackInterfacesMessage = QueryValidateSelfClientStateRequest{}
 )
 
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
@@ -63,3 +65,13 @@ func NewQueryConsensusStateResponse(
 func (qcsr QueryConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(qcsr.ConsensusState, new(exported.ConsensusState))
 }
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qscsr QuerySelfConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(qscsr.ConsensusState, new(exported.ConsensusState))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qvscsr QueryValidateSelfClientStateRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(qvscsr.ClientState, new(exported.ClientState))
+}
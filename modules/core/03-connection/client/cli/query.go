@@ -85,6 +85,39 @@ func GetCmdQueryConnection() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryConnectionDetails defines the command to query the handshake metadata of a connection
+func GetCmdQueryConnectionDetails() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "details [connection-id]",
+		Short:   "Query the handshake metadata of a connection",
+		Long:    "Query the connection end, the client identifiers on both sides, the delay periods and the version features of a connection",
+		Example: fmt.Sprintf("%s query %s %s details [connection-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConnectionDetailsRequest{
+				ConnectionId: args[0],
+			}
+
+			res, err := queryClient.ConnectionDetails(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryClientConnections defines the command to query a client connections
 func GetCmdQueryClientConnections() *cobra.Command {
 	cmd := &cobra.Command{
@@ -93,18 +93,77 @@ func (q Keeper) ClientConnections(c context.Context, req *types.QueryClientConne
 	ctx := sdk.UnwrapSDKContext(c)
 	clientConnectionPaths, found := q.GetClientConnectionPaths(ctx, req.ClientId)
 	if !found {
-		return nil, status.Error(
-			codes.NotFound,
-			sdkerrors.Wrap(types.ErrClientConnectionPathsNotFound, req.ClientId).Error(),
-		)
+		// an existing client which does not back any connection has no connection paths
+		if _, found := q.clientKeeper.GetClientState(ctx, req.ClientId); !found {
+			return nil, status.Error(
+				codes.NotFound,
+				sdkerrors.Wrap(types.ErrClientConnectionPathsNotFound, req.ClientId).Error(),
+			)
+		}
+
+		clientConnectionPaths = []string{}
+	}
+
+	connectionPaths, pageRes, err := paginateConnectionPaths(clientConnectionPaths, req.Pagination)
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.QueryClientConnectionsResponse{
-		ConnectionPaths: clientConnectionPaths,
+		ConnectionPaths: connectionPaths,
 		ProofHeight:     clienttypes.GetSelfHeight(ctx),
+		Pagination:      pageRes,
 	}, nil
 }
 
+// paginateConnectionPaths returns the page of connection paths selected by the provided page request.
+// The client connection paths are stored as a single value, so the page key is the big endian encoded
+// index of the first connection path of the page. All connection paths are returned if the page request is nil.
+func paginateConnectionPaths(connectionPaths []string, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	if pageReq == nil {
+		return connectionPaths, nil, nil
+	}
+
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	start := pageReq.Offset
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		start = sdk.BigEndianToUint64(pageReq.Key)
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	total := uint64(len(connectionPaths))
+	if start > total {
+		start = total
+	}
+
+	end := start + limit
+	if end > total || end < start {
+		end = total
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return connectionPaths[start:end], pageRes, nil
+}
+
 // ConnectionClientState implements the Query/ConnectionClientState gRPC method
 func (q Keeper) ConnectionClientState(c context.Context, req *types.QueryConnectionClientStateRequest) (*types.QueryConnectionClientStateResponse, error) {
 	if req == nil {
@@ -177,3 +236,39 @@ func (q Keeper) ConnectionConsensusState(c context.Context, req *types.QueryConn
 	proofHeight := clienttypes.GetSelfHeight(ctx)
 	return types.NewQueryConnectionConsensusStateResponse(connection.ClientId, anyConsensusState, height, nil, proofHeight), nil
 }
+
+// ConnectionDetails implements the Query/ConnectionDetails gRPC method
+func (q Keeper) ConnectionDetails(c context.Context, req *types.QueryConnectionDetailsRequest) (*types.QueryConnectionDetailsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	connection, found := q.GetConnection(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrConnectionNotFound, req.ConnectionId).Error(),
+		)
+	}
+
+	versions := make([]types.VersionDetails, len(connection.Versions))
+	for i, version := range connection.Versions {
+		versions[i] = types.NewVersionDetails(version)
+	}
+
+	return &types.QueryConnectionDetailsResponse{
+		Connection:               connection,
+		ClientId:                 connection.ClientId,
+		CounterpartyClientId:     connection.Counterparty.ClientId,
+		CounterpartyConnectionId: connection.Counterparty.ConnectionId,
+		DelayPeriod:              connection.DelayPeriod,
+		BlockDelay:               q.getBlockDelay(ctx, connection),
+		Versions:                 versions,
+		Height:                   clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
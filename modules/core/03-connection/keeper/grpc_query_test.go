@@ -52,7 +52,7 @@ func (suite *KeeperTestSuite) TestQueryConnection() {
 				suite.Require().NoError(err)
 
 				counterparty := types.NewCounterparty(path.EndpointB.ClientID, "", suite.chainB.GetPrefix())
-				expConnection = types.NewConnectionEnd(types.INIT, path.EndpointA.ClientID, counterparty, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 500)
+				expConnection = types.NewConnectionEnd(types.INIT, path.EndpointA.ClientID, counterparty, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 500, 0)
 				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, expConnection)
 
 				req = &types.QueryConnectionRequest{
@@ -83,6 +83,118 @@ func (suite *KeeperTestSuite) TestQueryConnection() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConnectionDetails() {
+	var (
+		req        *types.QueryConnectionDetailsRequest
+		expDetails types.QueryConnectionDetailsResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{"invalid connectionID",
+			func() {
+				req = &types.QueryConnectionDetailsRequest{}
+			},
+			false,
+		},
+		{"connection not found",
+			func() {
+				req = &types.QueryConnectionDetailsRequest{
+					ConnectionId: ibctesting.InvalidID,
+				}
+			},
+			false,
+		},
+		{
+			"success, proposed versions and derived block delay",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				err := path.EndpointA.ConnOpenInit()
+				suite.Require().NoError(err)
+
+				// the time delay period spans a little more than two blocks
+				delayPeriod := 2*suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetMaxExpectedTimePerBlock(suite.chainA.GetContext()) + 1
+
+				counterparty := types.NewCounterparty(path.EndpointB.ClientID, "", suite.chainB.GetPrefix())
+				connection := types.NewConnectionEnd(types.INIT, path.EndpointA.ClientID, counterparty, types.ExportedVersionsToProto(types.GetCompatibleVersions()), delayPeriod, 0)
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, connection)
+
+				expDetails = types.QueryConnectionDetailsResponse{
+					Connection:           connection,
+					ClientId:             path.EndpointA.ClientID,
+					CounterpartyClientId: path.EndpointB.ClientID,
+					DelayPeriod:          delayPeriod,
+					BlockDelay:           3,
+					Versions:             []types.VersionDetails{{Identifier: types.DefaultIBCVersionIdentifier, SupportsOrdered: true, SupportsUnordered: true}},
+				}
+
+				req = &types.QueryConnectionDetailsRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+			},
+			true,
+		},
+		{
+			"success, negotiated version and block delay period",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				connection := path.EndpointA.GetConnection()
+				connection.Versions = []*types.Version{types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED", "ORDER_CUSTOM"})}
+				connection.BlockDelayPeriod = 5
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, connection)
+
+				expDetails = types.QueryConnectionDetailsResponse{
+					Connection:               connection,
+					ClientId:                 path.EndpointA.ClientID,
+					CounterpartyClientId:     path.EndpointB.ClientID,
+					CounterpartyConnectionId: path.EndpointB.ConnectionID,
+					BlockDelay:               5,
+					Versions:                 []types.VersionDetails{{Identifier: types.DefaultIBCVersionIdentifier, SupportsUnordered: true, UnknownFeatures: []string{"ORDER_CUSTOM"}}},
+				}
+
+				req = &types.QueryConnectionDetailsRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ConnectionDetails(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				expDetails.Height = res.Height
+				suite.Require().Equal(&expDetails, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConnections() {
 	var (
 		req            *types.QueryConnectionsRequest
@@ -126,9 +238,9 @@ func (suite *KeeperTestSuite) TestQueryConnections() {
 				// counterparty connection id is blank after open init
 				counterparty3 := types.NewCounterparty(path3.EndpointB.ClientID, "", suite.chainB.GetPrefix())
 
-				conn1 := types.NewConnectionEnd(types.OPEN, path1.EndpointA.ClientID, counterparty1, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0)
-				conn2 := types.NewConnectionEnd(types.OPEN, path2.EndpointA.ClientID, counterparty2, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0)
-				conn3 := types.NewConnectionEnd(types.INIT, path3.EndpointA.ClientID, counterparty3, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0)
+				conn1 := types.NewConnectionEnd(types.OPEN, path1.EndpointA.ClientID, counterparty1, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0, 0)
+				conn2 := types.NewConnectionEnd(types.OPEN, path2.EndpointA.ClientID, counterparty2, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0, 0)
+				conn3 := types.NewConnectionEnd(types.INIT, path3.EndpointA.ClientID, counterparty3, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0, 0)
 
 				iconn1 := types.NewIdentifiedConnection(path1.EndpointA.ConnectionID, conn1)
 				iconn2 := types.NewIdentifiedConnection(path2.EndpointA.ConnectionID, conn2)
@@ -221,6 +333,74 @@ func (suite *KeeperTestSuite) TestQueryClientConnections() {
 			},
 			true,
 		},
+		{
+			"success, client without connections",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				expPaths = []string{}
+
+				req = &types.QueryClientConnectionsRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+			},
+			true,
+		},
+		{
+			"success, paginated by offset",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetClientConnectionPaths(suite.chainA.GetContext(), path.EndpointA.ClientID, []string{"connection-0", "connection-1", "connection-2"})
+				expPaths = []string{"connection-1", "connection-2"}
+
+				req = &types.QueryClientConnectionsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Offset:     1,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success, paginated by key",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetClientConnectionPaths(suite.chainA.GetContext(), path.EndpointA.ClientID, []string{"connection-0", "connection-1", "connection-2"})
+				expPaths = []string{"connection-2"}
+
+				req = &types.QueryClientConnectionsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:   sdk.Uint64ToBigEndian(2),
+						Limit: 2,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"invalid pagination key",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				req = &types.QueryClientConnectionsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key: []byte("invalid"),
+					},
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -25,7 +25,13 @@ func (k Keeper) ConnOpenInit(
 	counterparty types.Counterparty, // counterpartyPrefix, counterpartyClientIdentifier
 	version *types.Version,
 	delayPeriod uint64,
+	blockDelayPeriod uint64,
 ) (string, error) {
+	// only clients of an allowed client type may be used as the basis of a connection
+	if err := k.validateClientAllowed(ctx, clientID); err != nil {
+		return "", err
+	}
+
 	versions := types.GetCompatibleVersions()
 	if version != nil {
 		if !types.IsSupportedVersion(version) {
@@ -37,7 +43,7 @@ func (k Keeper) ConnOpenInit(
 
 	// connection defines chain A's ConnectionEnd
 	connectionID := k.GenerateConnectionIdentifier(ctx)
-	connection := types.NewConnectionEnd(types.INIT, clientID, counterparty, types.ExportedVersionsToProto(versions), delayPeriod)
+	connection := types.NewConnectionEnd(types.INIT, clientID, counterparty, types.ExportedVersionsToProto(versions), delayPeriod, blockDelayPeriod)
 	k.SetConnection(ctx, connectionID, connection)
 
 	if err := k.addConnectionToClient(ctx, clientID, connectionID); err != nil {
@@ -64,6 +70,7 @@ func (k Keeper) ConnOpenTry(
 	previousConnectionID string, // previousIdentifier
 	counterparty types.Counterparty, // counterpartyConnectionIdentifier, counterpartyPrefix and counterpartyClientIdentifier
 	delayPeriod uint64,
+	blockDelayPeriod uint64,
 	clientID string, // clientID of chainA
 	clientState exported.ClientState, // clientState that chainA has for chainB
 	counterpartyVersions []exported.Version, // supported versions of chain A
@@ -79,6 +86,11 @@ func (k Keeper) ConnOpenTry(
 		found              bool
 	)
 
+	// only clients of an allowed client type may be used as the basis of a connection
+	if err := k.validateClientAllowed(ctx, clientID); err != nil {
+		return "", err
+	}
+
 	// empty connection identifier indicates continuing a previous connection handshake
 	if previousConnectionID != "" {
 		// ensure that the previous connection exists
@@ -91,12 +103,13 @@ func (k Keeper) ConnOpenTry(
 		// counterparty is chainA and connection is on INIT stage.
 		// Check that existing connection versions for initialized connection is equal to compatible
 		// versions for this chain.
-		// ensure that existing connection's delay period is the same as desired delay period.
+		// ensure that existing connection's delay periods are the same as desired delay periods.
 		if !(previousConnection.Counterparty.ConnectionId == "" &&
 			bytes.Equal(previousConnection.Counterparty.Prefix.Bytes(), counterparty.Prefix.Bytes()) &&
 			previousConnection.ClientId == clientID &&
 			previousConnection.Counterparty.ClientId == counterparty.ClientId &&
-			previousConnection.DelayPeriod == delayPeriod) {
+			previousConnection.DelayPeriod == delayPeriod &&
+			previousConnection.BlockDelayPeriod == blockDelayPeriod) {
 			return "", sdkerrors.Wrap(types.ErrInvalidConnection, "connection fields mismatch previous connection fields")
 		}
 
@@ -132,10 +145,10 @@ func (k Keeper) ConnOpenTry(
 
 	// expectedConnection defines Chain A's ConnectionEnd
 	// NOTE: chain A's counterparty is chain B (i.e where this code is executed)
-	// NOTE: chainA and chainB must have the same delay period
+	// NOTE: chainA and chainB must have the same delay periods
 	prefix := k.GetCommitmentPrefix()
 	expectedCounterparty := types.NewCounterparty(clientID, "", commitmenttypes.NewMerklePrefix(prefix.Bytes()))
-	expectedConnection := types.NewConnectionEnd(types.INIT, counterparty.ClientId, expectedCounterparty, types.ExportedVersionsToProto(counterpartyVersions), delayPeriod)
+	expectedConnection := types.NewConnectionEnd(types.INIT, counterparty.ClientId, expectedCounterparty, types.ExportedVersionsToProto(counterpartyVersions), delayPeriod, blockDelayPeriod)
 
 	supportedVersions := types.GetCompatibleVersions()
 	if len(previousConnection.Versions) != 0 {
@@ -151,7 +164,7 @@ func (k Keeper) ConnOpenTry(
 	}
 
 	// connection defines chain B's ConnectionEnd
-	connection := types.NewConnectionEnd(types.TRYOPEN, clientID, counterparty, []*types.Version{version}, delayPeriod)
+	connection := types.NewConnectionEnd(types.TRYOPEN, clientID, counterparty, []*types.Version{version}, delayPeriod, blockDelayPeriod)
 
 	// Check that ChainA committed expectedConnectionEnd to its state
 	if err := k.VerifyConnectionState(
@@ -257,7 +270,7 @@ func (k Keeper) ConnOpenAck(
 
 	prefix := k.GetCommitmentPrefix()
 	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes()))
-	expectedConnection := types.NewConnectionEnd(types.TRYOPEN, connection.Counterparty.ClientId, expectedCounterparty, []*types.Version{version}, connection.DelayPeriod)
+	expectedConnection := types.NewConnectionEnd(types.TRYOPEN, connection.Counterparty.ClientId, expectedCounterparty, []*types.Version{version}, connection.DelayPeriod, connection.BlockDelayPeriod)
 
 	// Ensure that ChainB stored expected connectionEnd in its state during ConnOpenTry
 	if err := k.VerifyConnectionState(
@@ -319,7 +332,7 @@ func (k Keeper) ConnOpenConfirm(
 
 	prefix := k.GetCommitmentPrefix()
 	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes()))
-	expectedConnection := types.NewConnectionEnd(types.OPEN, connection.Counterparty.ClientId, expectedCounterparty, connection.Versions, connection.DelayPeriod)
+	expectedConnection := types.NewConnectionEnd(types.OPEN, connection.Counterparty.ClientId, expectedCounterparty, connection.Versions, connection.DelayPeriod, connection.BlockDelayPeriod)
 
 	// Check that connection on ChainA is open
 	if err := k.VerifyConnectionState(
@@ -15,10 +15,11 @@ import (
 // chainB which is yet UNINITIALIZED
 func (suite *KeeperTestSuite) TestConnOpenInit() {
 	var (
-		path         *ibctesting.Path
-		version      *types.Version
-		delayPeriod  uint64
-		emptyConnBID bool
+		path             *ibctesting.Path
+		version          *types.Version
+		delayPeriod      uint64
+		blockDelayPeriod uint64
+		emptyConnBID     bool
 	)
 
 	testCases := []struct {
@@ -37,6 +38,9 @@ func (suite *KeeperTestSuite) TestConnOpenInit() {
 		{"success with non zero delayPeriod", func() {
 			delayPeriod = uint64(time.Hour.Nanoseconds())
 		}, true},
+		{"success with non zero blockDelayPeriod", func() {
+			blockDelayPeriod = 10
+		}, true},
 
 		{"invalid version", func() {
 			version = &types.Version{}
@@ -45,6 +49,9 @@ func (suite *KeeperTestSuite) TestConnOpenInit() {
 			// set path.EndpointA.ClientID to invalid client identifier
 			path.EndpointA.ClientID = "clientidentifier"
 		}, false},
+		{"client type not in allowlist", func() {
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), clienttypes.NewParams(clienttypes.DefaultMinTrustingPeriod, exported.Solomachine))
+		}, false},
 	}
 
 	for _, tc := range testCases {
@@ -53,6 +60,7 @@ func (suite *KeeperTestSuite) TestConnOpenInit() {
 			suite.SetupTest()    // reset
 			emptyConnBID = false // must be explicitly changed
 			version = nil        // must be explicitly changed
+			blockDelayPeriod = 0
 			path = ibctesting.NewPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupClients(path)
 
@@ -63,11 +71,15 @@ func (suite *KeeperTestSuite) TestConnOpenInit() {
 			}
 			counterparty := types.NewCounterparty(path.EndpointB.ClientID, path.EndpointB.ConnectionID, suite.chainB.GetPrefix())
 
-			connectionID, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnOpenInit(suite.chainA.GetContext(), path.EndpointA.ClientID, counterparty, version, delayPeriod)
+			connectionID, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnOpenInit(suite.chainA.GetContext(), path.EndpointA.ClientID, counterparty, version, delayPeriod, blockDelayPeriod)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().Equal(types.FormatConnectionIdentifier(0), connectionID)
+
+				connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chainA.GetContext(), connectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(blockDelayPeriod, connection.BlockDelayPeriod)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", connectionID)
@@ -82,6 +94,7 @@ func (suite *KeeperTestSuite) TestConnOpenTry() {
 	var (
 		path                 *ibctesting.Path
 		delayPeriod          uint64
+		blockDelayPeriod     uint64
 		previousConnectionID string
 		versions             []exported.Version
 		consensusHeight      exported.Height
@@ -127,6 +140,34 @@ func (suite *KeeperTestSuite) TestConnOpenTry() {
 			// retrieve client state of chainA to pass as counterpartyClient
 			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
 		}, true},
+		{"success with block delay period", func() {
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+
+			blockDelayPeriod = 10
+
+			// set block delay period on counterparty to non-zero value
+			conn := path.EndpointA.GetConnection()
+			conn.BlockDelayPeriod = blockDelayPeriod
+			suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, conn)
+
+			// commit in order for proof to return correct value
+			suite.coordinator.CommitBlock(suite.chainA)
+			path.EndpointB.UpdateClient()
+
+			// retrieve client state of chainA to pass as counterpartyClient
+			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
+		}, true},
+		{"block delay period mismatches counterparty connection", func() {
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+
+			// counterparty connection was initialized with a zero block delay period
+			blockDelayPeriod = 10
+
+			// retrieve client state of chainA to pass as counterpartyClient
+			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
+		}, false},
 		{"invalid counterparty client", func() {
 			err := path.EndpointA.ConnOpenInit()
 			suite.Require().NoError(err)
@@ -230,6 +271,20 @@ func (suite *KeeperTestSuite) TestConnOpenTry() {
 
 			previousConnectionID = path.EndpointB.ConnectionID
 		}, false},
+		{"invalid previous connection has a different block delay period", func() {
+			err := suite.coordinator.ConnOpenInitOnBothChains(path)
+			suite.Require().NoError(err)
+
+			// modify the block delay period of the previous connection on chainB
+			conn := path.EndpointB.GetConnection()
+			conn.BlockDelayPeriod = 10
+			suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainB.GetContext(), path.EndpointB.ConnectionID, conn)
+
+			// retrieve client state of chainA to pass as counterpartyClient
+			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
+
+			previousConnectionID = path.EndpointB.ConnectionID
+		}, false},
 		{"invalid previous connection has invalid versions", func() {
 			// open init chainA
 			err := path.EndpointA.ConnOpenInit()
@@ -256,6 +311,15 @@ func (suite *KeeperTestSuite) TestConnOpenTry() {
 
 			previousConnectionID = path.EndpointB.ConnectionID
 		}, false},
+		{"client type not in allowlist", func() {
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+
+			// retrieve client state of chainA to pass as counterpartyClient
+			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
+
+			suite.chainB.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainB.GetContext(), clienttypes.NewParams(clienttypes.DefaultMinTrustingPeriod, exported.Solomachine))
+		}, false},
 	}
 
 	for _, tc := range testCases {
@@ -266,6 +330,8 @@ func (suite *KeeperTestSuite) TestConnOpenTry() {
 			consensusHeight = clienttypes.ZeroHeight() // must be explicitly changed in malleate
 			versions = types.GetCompatibleVersions()   // must be explicitly changed in malleate
 			previousConnectionID = ""
+			delayPeriod = 0
+			blockDelayPeriod = 0
 			path = ibctesting.NewPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupClients(path)
 
@@ -292,7 +358,7 @@ func (suite *KeeperTestSuite) TestConnOpenTry() {
 			proofClient, _ := suite.chainA.QueryProof(clientKey)
 
 			connectionID, err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnOpenTry(
-				suite.chainB.GetContext(), previousConnectionID, counterparty, delayPeriod, path.EndpointB.ClientID, counterpartyClient,
+				suite.chainB.GetContext(), previousConnectionID, counterparty, delayPeriod, blockDelayPeriod, path.EndpointB.ClientID, counterpartyClient,
 				versions, proofInit, proofClient, proofConsensus,
 				proofHeight, consensusHeight,
 			)
@@ -187,6 +187,24 @@ func (k Keeper) GetAllConnections(ctx sdk.Context) (connections []types.Identifi
 	return connections
 }
 
+// validateClientAllowed ensures the client with the given identifier exists and that its
+// client type is registered in the allowlist of the 02-client submodule.
+func (k Keeper) validateClientAllowed(ctx sdk.Context, clientID string) error {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if params := k.clientKeeper.GetParams(ctx); !params.IsAllowedClient(clientState.ClientType()) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientType,
+			"client state type %s is not registered in the allowlist", clientState.ClientType(),
+		)
+	}
+
+	return nil
+}
+
 // addConnectionToClient is used to add a connection identifier to the set of
 // connections associated with a client.
 func (k Keeper) addConnectionToClient(ctx sdk.Context, clientID, connectionID string) error {
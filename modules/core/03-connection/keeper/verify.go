@@ -296,17 +296,25 @@ func (k Keeper) VerifyNextSequenceRecv(
 	return nil
 }
 
-// getBlockDelay calculates the block delay period from the time delay of the connection
-// and the maximum expected time per block.
+// getBlockDelay returns the block delay period of the connection. The block delay is the maximum of
+// the block delay period configured on the connection and the block delay calculated from the time
+// delay of the connection and the maximum expected time per block. An explicit block delay period
+// ensures chains with block times much shorter than expected cannot shorten the block delay.
 func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI) uint64 {
-	// expectedTimePerBlock should never be zero, however if it is then return a 0 blcok delay for safety
-	// as the expectedTimePerBlock parameter was not set.
+	blockDelay := connection.GetBlockDelayPeriod()
+
+	// expectedTimePerBlock should never be zero, however if it is then only the configured block delay
+	// is enforced for safety as the expectedTimePerBlock parameter was not set.
 	expectedTimePerBlock := k.GetMaxExpectedTimePerBlock(ctx)
 	if expectedTimePerBlock == 0 {
-		return 0
+		return blockDelay
 	}
 	// calculate minimum block delay by dividing time delay period
 	// by the expected time per block. Round up the block delay.
 	timeDelay := connection.GetDelayPeriod()
-	return uint64(math.Ceil(float64(timeDelay) / float64(expectedTimePerBlock)))
+	if derivedBlockDelay := uint64(math.Ceil(float64(timeDelay) / float64(expectedTimePerBlock))); derivedBlockDelay > blockDelay {
+		return derivedBlockDelay
+	}
+
+	return blockDelay
 }
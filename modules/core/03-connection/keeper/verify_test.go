@@ -290,11 +290,12 @@ func (suite *KeeperTestSuite) TestVerifyChannelState() {
 // packet is sent from chainA to chainB, but has not been received.
 func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 	var (
-		path            *ibctesting.Path
-		packet          channeltypes.Packet
-		heightDiff      uint64
-		delayTimePeriod uint64
-		timePerBlock    uint64
+		path             *ibctesting.Path
+		packet           channeltypes.Packet
+		heightDiff       uint64
+		delayTimePeriod  uint64
+		blockDelayPeriod uint64
+		timePerBlock     uint64
 	)
 	cases := []struct {
 		name     string
@@ -314,6 +315,21 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
 			timePerBlock = 1
 		}, false},
+		{"verification success: explicit block delay period passed", func() {
+			blockDelayPeriod = 1
+		}, true},
+		{"delay block period has not passed: explicit block delay period exceeds derived block delay", func() {
+			// the time delay and the block delay derived from it have passed, as occurs on
+			// chains producing blocks much faster than the max expected time per block
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			blockDelayPeriod = 100
+		}, false},
+		{"verification success: explicit block delay period passed on chain with short block times", func() {
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			blockDelayPeriod = 10
+
+			suite.coordinator.CommitNBlocks(suite.chainB, blockDelayPeriod)
+		}, true},
 		{"client state not found- changed client ID", func() {
 			connection := path.EndpointB.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
@@ -348,11 +364,13 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 			// reset variables
 			heightDiff = 0
 			delayTimePeriod = 0
+			blockDelayPeriod = 0
 			timePerBlock = 0
 			tc.malleate()
 
 			connection := path.EndpointB.GetConnection()
 			connection.DelayPeriod = delayTimePeriod
+			connection.BlockDelayPeriod = blockDelayPeriod
 			commitmentKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 			proof, proofHeight := suite.chainA.QueryProof(commitmentKey)
 
@@ -381,11 +399,12 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 // is sent from chainA to chainB and received.
 func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 	var (
-		path            *ibctesting.Path
-		ack             exported.Acknowledgement
-		heightDiff      uint64
-		delayTimePeriod uint64
-		timePerBlock    uint64
+		path             *ibctesting.Path
+		ack              exported.Acknowledgement
+		heightDiff       uint64
+		delayTimePeriod  uint64
+		blockDelayPeriod uint64
+		timePerBlock     uint64
 	)
 
 	cases := []struct {
@@ -406,6 +425,15 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
 			timePerBlock = 1
 		}, false},
+		{"verification success: explicit block delay period passed", func() {
+			blockDelayPeriod = 1
+		}, true},
+		{"delay block period has not passed: explicit block delay period exceeds derived block delay", func() {
+			// the time delay and the block delay derived from it have passed, as occurs on
+			// chains producing blocks much faster than the max expected time per block
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			blockDelayPeriod = 100
+		}, false},
 		{"client state not found- changed client ID", func() {
 			connection := path.EndpointA.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
@@ -452,11 +480,13 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 			// reset variables
 			heightDiff = 0
 			delayTimePeriod = 0
+			blockDelayPeriod = 0
 			timePerBlock = 0
 			tc.malleate()
 
 			connection := path.EndpointA.GetConnection()
 			connection.DelayPeriod = delayTimePeriod
+			connection.BlockDelayPeriod = blockDelayPeriod
 
 			// set time per block param
 			if timePerBlock != 0 {
@@ -482,11 +512,12 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 // a packet is sent from chainA to chainB and not received.
 func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 	var (
-		path            *ibctesting.Path
-		packet          channeltypes.Packet
-		heightDiff      uint64
-		delayTimePeriod uint64
-		timePerBlock    uint64
+		path             *ibctesting.Path
+		packet           channeltypes.Packet
+		heightDiff       uint64
+		delayTimePeriod  uint64
+		blockDelayPeriod uint64
+		timePerBlock     uint64
 	)
 
 	cases := []struct {
@@ -507,6 +538,15 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
 			timePerBlock = 1
 		}, false},
+		{"verification success: explicit block delay period passed", func() {
+			blockDelayPeriod = 1
+		}, true},
+		{"delay block period has not passed: explicit block delay period exceeds derived block delay", func() {
+			// the time delay and the block delay derived from it have passed, as occurs on
+			// chains producing blocks much faster than the max expected time per block
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			blockDelayPeriod = 100
+		}, false},
 		{"client state not found - changed client ID", func() {
 			connection := path.EndpointA.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
@@ -547,11 +587,13 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 			// reset variables
 			heightDiff = 0
 			delayTimePeriod = 0
+			blockDelayPeriod = 0
 			timePerBlock = 0
 			tc.malleate()
 
 			connection := path.EndpointA.GetConnection()
 			connection.DelayPeriod = delayTimePeriod
+			connection.BlockDelayPeriod = blockDelayPeriod
 
 			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
 			if clientState.FrozenHeight.IsZero() {
@@ -587,11 +629,12 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 // is sent from chainA to chainB and received.
 func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 	var (
-		path            *ibctesting.Path
-		heightDiff      uint64
-		delayTimePeriod uint64
-		timePerBlock    uint64
-		offsetSeq       uint64
+		path             *ibctesting.Path
+		heightDiff       uint64
+		delayTimePeriod  uint64
+		blockDelayPeriod uint64
+		timePerBlock     uint64
+		offsetSeq        uint64
 	)
 
 	cases := []struct {
@@ -612,6 +655,15 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
 			timePerBlock = 1
 		}, false},
+		{"verification success: explicit block delay period passed", func() {
+			blockDelayPeriod = 1
+		}, true},
+		{"delay block period has not passed: explicit block delay period exceeds derived block delay", func() {
+			// the time delay and the block delay derived from it have passed, as occurs on
+			// chains producing blocks much faster than the max expected time per block
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			blockDelayPeriod = 100
+		}, false},
 		{"client state not found- changed client ID", func() {
 			connection := path.EndpointA.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
@@ -657,6 +709,7 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 			// reset variables
 			heightDiff = 0
 			delayTimePeriod = 0
+			blockDelayPeriod = 0
 			timePerBlock = 0
 			tc.malleate()
 
@@ -667,6 +720,7 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 
 			connection := path.EndpointA.GetConnection()
 			connection.DelayPeriod = delayTimePeriod
+			connection.BlockDelayPeriod = blockDelayPeriod
 			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyNextSequenceRecv(
 				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
 				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()+offsetSeq,
@@ -10,13 +10,14 @@ import (
 var _ exported.ConnectionI = (*ConnectionEnd)(nil)
 
 // NewConnectionEnd creates a new ConnectionEnd instance.
-func NewConnectionEnd(state State, clientID string, counterparty Counterparty, versions []*Version, delayPeriod uint64) ConnectionEnd {
+func NewConnectionEnd(state State, clientID string, counterparty Counterparty, versions []*Version, delayPeriod, blockDelayPeriod uint64) ConnectionEnd {
 	return ConnectionEnd{
-		ClientId:     clientID,
-		Versions:     versions,
-		State:        state,
-		Counterparty: counterparty,
-		DelayPeriod:  delayPeriod,
+		ClientId:         clientID,
+		Versions:         versions,
+		State:            state,
+		Counterparty:     counterparty,
+		DelayPeriod:      delayPeriod,
+		BlockDelayPeriod: blockDelayPeriod,
 	}
 }
 
@@ -45,6 +46,11 @@ func (c ConnectionEnd) GetDelayPeriod() uint64 {
 	return c.DelayPeriod
 }
 
+// GetBlockDelayPeriod implements the Connection interface
+func (c ConnectionEnd) GetBlockDelayPeriod() uint64 {
+	return c.BlockDelayPeriod
+}
+
 // ValidateBasic implements the Connection interface.
 // NOTE: the protocol supports that the connection and client IDs match the
 // counterparty's.
@@ -108,12 +114,13 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedConnection creates a new IdentifiedConnection instance
 func NewIdentifiedConnection(connectionID string, conn ConnectionEnd) IdentifiedConnection {
 	return IdentifiedConnection{
-		Id:           connectionID,
-		ClientId:     conn.ClientId,
-		Versions:     conn.Versions,
-		State:        conn.State,
-		Counterparty: conn.Counterparty,
-		DelayPeriod:  conn.DelayPeriod,
+		Id:               connectionID,
+		ClientId:         conn.ClientId,
+		Versions:         conn.Versions,
+		State:            conn.State,
+		Counterparty:     conn.Counterparty,
+		DelayPeriod:      conn.DelayPeriod,
+		BlockDelayPeriod: conn.BlockDelayPeriod,
 	}
 }
 
@@ -122,6 +129,6 @@ func (ic IdentifiedConnection) ValidateBasic() error {
 	if err := host.ConnectionIdentifierValidator(ic.Id); err != nil {
 		return sdkerrors.Wrap(err, "invalid connection ID")
 	}
-	connection := NewConnectionEnd(ic.State, ic.ClientId, ic.Counterparty, ic.Versions, ic.DelayPeriod)
+	connection := NewConnectionEnd(ic.State, ic.ClientId, ic.Counterparty, ic.Versions, ic.DelayPeriod, ic.BlockDelayPeriod)
 	return connection.ValidateBasic()
 }
@@ -80,6 +80,11 @@ type ConnectionEnd struct {
 	// packet-verification NOTE: delay period logic is only implemented by some
 	// clients.
 	DelayPeriod uint64 `protobuf:"varint,5,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
+	// number of blocks that must pass before a consensus state can be used for
+	// packet-verification. The block delay enforced is the maximum of this value
+	// and the block delay derived from the delay period and the max expected
+	// time per block parameter.
+	BlockDelayPeriod uint64 `protobuf:"varint,6,opt,name=block_delay_period,json=blockDelayPeriod,proto3" json:"block_delay_period,omitempty" yaml:"block_delay_period"`
 }
 
 func (m *ConnectionEnd) Reset()         { *m = ConnectionEnd{} }
@@ -131,6 +136,8 @@ type IdentifiedConnection struct {
 	Counterparty Counterparty `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty"`
 	// delay period associated with this connection.
 	DelayPeriod uint64 `protobuf:"varint,6,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
+	// block delay period associated with this connection.
+	BlockDelayPeriod uint64 `protobuf:"varint,7,opt,name=block_delay_period,json=blockDelayPeriod,proto3" json:"block_delay_period,omitempty" yaml:"block_delay_period"`
 }
 
 func (m *IdentifiedConnection) Reset()         { *m = IdentifiedConnection{} }
@@ -418,52 +425,54 @@ func init() {
 }
 
 var fileDescriptor_90572467c054e43a = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xd2, 0x60,
-	0x18, 0xa7, 0xa5, 0x30, 0x78, 0x19, 0x8a, 0x95, 0xb8, 0x06, 0xb3, 0xb6, 0xa9, 0x46, 0x89, 0xc9,
-	0xa8, 0x8c, 0xc4, 0xc3, 0xd4, 0xc3, 0x60, 0x98, 0x34, 0x2a, 0x92, 0x8e, 0x2d, 0x71, 0x17, 0x52,
-	0xda, 0x77, 0xec, 0xcd, 0x68, 0x5f, 0xd2, 0xbe, 0x10, 0xf8, 0x06, 0xcb, 0x4e, 0x5e, 0x3d, 0x2c,
-	0x31, 0xf1, 0xbb, 0x98, 0xc5, 0xd3, 0x8e, 0x9e, 0x88, 0xd9, 0xae, 0x9e, 0xf8, 0x04, 0xa6, 0x7d,
-	0x0b, 0x74, 0x8b, 0x33, 0xd9, 0xf4, 0xf6, 0x3c, 0xfd, 0xfd, 0xe1, 0x79, 0x7e, 0x3c, 0x14, 0xf0,
-	0x14, 0x75, 0x4c, 0xd5, 0xc4, 0x2e, 0x54, 0x4d, 0xec, 0x38, 0xd0, 0x24, 0x08, 0x3b, 0xea, 0xb0,
-	0x1c, 0xe9, 0x4a, 0x7d, 0x17, 0x13, 0xcc, 0x3f, 0x40, 0x1d, 0xb3, 0xe4, 0x13, 0x4b, 0x11, 0x68,
-	0x58, 0x2e, 0xe4, 0xbb, 0xb8, 0x8b, 0x03, 0x8a, 0xea, 0x57, 0x94, 0x5d, 0x88, 0xda, 0xda, 0x36,
-	0x22, 0x36, 0x74, 0x08, 0xb5, 0x9d, 0x75, 0x94, 0xa8, 0x7c, 0x63, 0x41, 0xb6, 0x36, 0x37, 0xac,
-	0x3b, 0x16, 0x5f, 0x06, 0x69, 0xb3, 0x87, 0xa0, 0x43, 0xda, 0xc8, 0x12, 0x18, 0x99, 0x29, 0xa6,
-	0xab, 0xf9, 0xe9, 0x44, 0xca, 0x8d, 0x0d, 0xbb, 0xb7, 0xa1, 0xcc, 0x21, 0x45, 0x4f, 0xd1, 0x5a,
-	0xb3, 0xf8, 0x97, 0x20, 0x35, 0x84, 0xae, 0x87, 0xb0, 0xe3, 0x09, 0xac, 0x1c, 0x2f, 0x66, 0xd6,
-	0xa5, 0xd2, 0x9f, 0xc7, 0x2d, 0xed, 0x52, 0x9e, 0x3e, 0x17, 0xf0, 0x15, 0x90, 0xf0, 0x88, 0x41,
-	0xa0, 0x10, 0x97, 0x99, 0xe2, 0x9d, 0xf5, 0xd5, 0xeb, 0x94, 0xdb, 0x3e, 0x49, 0xa7, 0x5c, 0xbe,
-	0x01, 0x96, 0x4d, 0x3c, 0x70, 0x08, 0x74, 0xfb, 0x86, 0x4b, 0xc6, 0x02, 0x27, 0x33, 0xc5, 0xcc,
-	0xfa, 0xe3, 0xeb, 0xb4, 0xb5, 0x08, 0xb7, 0xca, 0x9d, 0x4e, 0xa4, 0x98, 0x7e, 0x49, 0xcf, 0x6f,
-	0x80, 0x65, 0x0b, 0xf6, 0x8c, 0x71, 0xbb, 0x0f, 0x5d, 0x84, 0x2d, 0x21, 0x21, 0x33, 0x45, 0xae,
-	0xba, 0x32, 0x9d, 0x48, 0xf7, 0xe9, 0xde, 0x51, 0x54, 0xd1, 0x33, 0x41, 0xdb, 0x0c, 0xba, 0x0d,
-	0xee, 0xe8, 0x8b, 0x14, 0x53, 0x7e, 0xb1, 0x20, 0xaf, 0x59, 0xd0, 0x21, 0x68, 0x1f, 0x41, 0x6b,
-	0x11, 0x29, 0xbf, 0x0a, 0xd8, 0x79, 0x90, 0xd9, 0xe9, 0x44, 0x4a, 0x53, 0x43, 0x3f, 0x41, 0x16,
-	0x5d, 0x89, 0x9b, 0xbd, 0x71, 0xdc, 0xf1, 0x5b, 0xc7, 0xcd, 0xfd, 0x43, 0xdc, 0x89, 0xff, 0x1c,
-	0x77, 0xf2, 0xc6, 0x71, 0x7f, 0x67, 0xc0, 0x72, 0xf4, 0x63, 0x6e, 0x73, 0xb6, 0xaf, 0x41, 0x76,
-	0x31, 0xf7, 0x22, 0x7e, 0x61, 0x3a, 0x91, 0xf2, 0xa1, 0x2c, 0x0a, 0x2b, 0xfe, 0x12, 0xb3, 0x5e,
-	0xb3, 0xf8, 0x2a, 0x48, 0xf6, 0x5d, 0xb8, 0x8f, 0x46, 0xc1, 0xe5, 0x5e, 0x89, 0x63, 0xfe, 0x33,
-	0x1b, 0x96, 0x4b, 0xef, 0xa1, 0x7b, 0xd8, 0x83, 0xcd, 0x80, 0x1b, 0xc6, 0x11, 0x2a, 0xc3, 0x65,
-	0x1e, 0x81, 0x4c, 0x2d, 0x18, 0xaa, 0x69, 0x90, 0x03, 0x8f, 0xcf, 0x83, 0x44, 0xdf, 0x2f, 0x04,
-	0x46, 0x8e, 0x17, 0xd3, 0x3a, 0x6d, 0x94, 0x3d, 0x70, 0x77, 0x71, 0x55, 0x94, 0x78, 0x8b, 0x9d,
-	0xe7, 0xde, 0x6c, 0xd4, 0xfb, 0x2d, 0x58, 0x0a, 0x2f, 0x85, 0x17, 0x01, 0x40, 0xb3, 0x33, 0x76,
-	0xa9, 0xa9, 0x1e, 0x79, 0xc2, 0x17, 0x40, 0x6a, 0x1f, 0x1a, 0x64, 0xe0, 0xc2, 0x99, 0xc7, 0xbc,
-	0x0f, 0xb7, 0x71, 0x40, 0xb2, 0x69, 0xb8, 0x86, 0xed, 0xf1, 0x16, 0x78, 0x68, 0x1b, 0xa3, 0x36,
-	0x1c, 0xf5, 0xa1, 0x49, 0xa0, 0xd5, 0x26, 0xc8, 0x86, 0xfe, 0x97, 0xda, 0xee, 0xf4, 0xb0, 0x79,
-	0x18, 0x98, 0x73, 0xd5, 0x27, 0xd3, 0x89, 0xa4, 0xd0, 0x89, 0xff, 0x42, 0x56, 0xf4, 0x15, 0xdb,
-	0x18, 0xd5, 0x43, 0xb0, 0x85, 0x6c, 0xd8, 0x84, 0x6e, 0xd5, 0x47, 0x9e, 0x7d, 0x66, 0x40, 0x22,
-	0xb8, 0x56, 0xfe, 0x05, 0x90, 0xb6, 0x5b, 0x9b, 0xad, 0x7a, 0x7b, 0xa7, 0xa1, 0x35, 0xb4, 0x96,
-	0xb6, 0xf9, 0x4e, 0xdb, 0xab, 0x6f, 0xb5, 0x77, 0x1a, 0xdb, 0xcd, 0x7a, 0x4d, 0x7b, 0xa3, 0xd5,
-	0xb7, 0x72, 0xb1, 0xc2, 0xbd, 0xe3, 0x13, 0x39, 0x7b, 0x89, 0xc0, 0x0b, 0x00, 0x50, 0x9d, 0xff,
-	0x30, 0xc7, 0x14, 0x52, 0xc7, 0x27, 0x32, 0xe7, 0xd7, 0xbc, 0x08, 0xb2, 0x14, 0x69, 0xe9, 0x1f,
-	0x3f, 0x34, 0xeb, 0x8d, 0x1c, 0x5b, 0xc8, 0x1c, 0x9f, 0xc8, 0x4b, 0x61, 0xbb, 0x50, 0x06, 0x60,
-	0x9c, 0x2a, 0xfd, 0xba, 0xc0, 0x1d, 0x7d, 0x15, 0x63, 0xd5, 0xdd, 0xd3, 0x73, 0x91, 0x39, 0x3b,
-	0x17, 0x99, 0x9f, 0xe7, 0x22, 0xf3, 0xe9, 0x42, 0x8c, 0x9d, 0x5d, 0x88, 0xb1, 0x1f, 0x17, 0x62,
-	0x6c, 0xef, 0x55, 0x17, 0x91, 0x83, 0x41, 0xc7, 0x3f, 0x15, 0xd5, 0xc4, 0x9e, 0x8d, 0x3d, 0x15,
-	0x75, 0xcc, 0xb5, 0x2e, 0x56, 0x87, 0x15, 0xd5, 0xc6, 0xd6, 0xa0, 0x07, 0x3d, 0xfa, 0x06, 0x7f,
-	0x5e, 0x59, 0x8b, 0xfc, 0x37, 0x90, 0x71, 0x1f, 0x7a, 0x9d, 0x64, 0xf0, 0xf6, 0xae, 0xfc, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0xf4, 0xbe, 0xf1, 0xb5, 0x3f, 0x06, 0x00, 0x00,
+	// 749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6e, 0xda, 0x48,
+	0x18, 0xc7, 0xd8, 0x10, 0x18, 0x60, 0x97, 0x9d, 0x45, 0x1b, 0x2f, 0xab, 0xd8, 0x96, 0x77, 0xb5,
+	0x8b, 0x56, 0x0a, 0x5e, 0x82, 0xb4, 0x87, 0xb4, 0x3d, 0x04, 0x42, 0x25, 0x2b, 0x2d, 0x45, 0x0e,
+	0x89, 0xd4, 0x5c, 0x2c, 0x63, 0x4f, 0xc8, 0x28, 0xd8, 0x46, 0xf6, 0x80, 0xe0, 0x0d, 0xa2, 0x9c,
+	0x2a, 0xf5, 0xd4, 0x43, 0xa4, 0x4a, 0x7d, 0x8a, 0xbe, 0x41, 0xd4, 0x53, 0x8e, 0x3d, 0xa1, 0x2a,
+	0x79, 0x03, 0x9e, 0xa0, 0xb2, 0xc7, 0x01, 0xa7, 0x69, 0x2a, 0x35, 0xe9, 0xed, 0xfb, 0xe6, 0xf7,
+	0x47, 0x33, 0xf3, 0xfb, 0xec, 0x01, 0xff, 0xe0, 0x9e, 0xa9, 0x98, 0xae, 0x87, 0x14, 0xd3, 0x75,
+	0x1c, 0x64, 0x12, 0xec, 0x3a, 0xca, 0xb8, 0x16, 0xeb, 0xaa, 0x43, 0xcf, 0x25, 0x2e, 0xfc, 0x0d,
+	0xf7, 0xcc, 0x6a, 0x40, 0xac, 0xc6, 0xa0, 0x71, 0xad, 0x5c, 0xea, 0xbb, 0x7d, 0x37, 0xa4, 0x28,
+	0x41, 0x45, 0xd9, 0xe5, 0xb8, 0xad, 0x6d, 0x63, 0x62, 0x23, 0x87, 0x50, 0xdb, 0xeb, 0x8e, 0x12,
+	0xe5, 0xd7, 0x2c, 0x28, 0x34, 0x17, 0x86, 0x2d, 0xc7, 0x82, 0x35, 0x90, 0x35, 0x07, 0x18, 0x39,
+	0x44, 0xc7, 0x16, 0xcf, 0x48, 0x4c, 0x25, 0xdb, 0x28, 0xcd, 0x67, 0x62, 0x71, 0x6a, 0xd8, 0x83,
+	0x4d, 0x79, 0x01, 0xc9, 0x5a, 0x86, 0xd6, 0xaa, 0x05, 0x1f, 0x81, 0xcc, 0x18, 0x79, 0x3e, 0x76,
+	0x1d, 0x9f, 0x4f, 0x4a, 0x6c, 0x25, 0xb7, 0x21, 0x56, 0xbf, 0xbe, 0xdd, 0xea, 0x3e, 0xe5, 0x69,
+	0x0b, 0x01, 0xac, 0x83, 0x94, 0x4f, 0x0c, 0x82, 0x78, 0x56, 0x62, 0x2a, 0x3f, 0x6d, 0xac, 0xdd,
+	0xa5, 0xdc, 0x0d, 0x48, 0x1a, 0xe5, 0xc2, 0x36, 0xc8, 0x9b, 0xee, 0xc8, 0x21, 0xc8, 0x1b, 0x1a,
+	0x1e, 0x99, 0xf2, 0x9c, 0xc4, 0x54, 0x72, 0x1b, 0x7f, 0xdd, 0xa5, 0x6d, 0xc6, 0xb8, 0x0d, 0xee,
+	0x7c, 0x26, 0x26, 0xb4, 0x1b, 0x7a, 0xb8, 0x09, 0xf2, 0x16, 0x1a, 0x18, 0x53, 0x7d, 0x88, 0x3c,
+	0xec, 0x5a, 0x7c, 0x4a, 0x62, 0x2a, 0x5c, 0x63, 0x75, 0x3e, 0x13, 0x7f, 0xa5, 0xe7, 0x8e, 0xa3,
+	0xb2, 0x96, 0x0b, 0xdb, 0x4e, 0xd8, 0xc1, 0x1d, 0x00, 0x7b, 0x03, 0xd7, 0x3c, 0xd6, 0x6f, 0x38,
+	0xa4, 0x43, 0x87, 0xb5, 0xf9, 0x4c, 0xfc, 0x9d, 0x3a, 0xdc, 0xe6, 0xc8, 0x5a, 0x31, 0x5c, 0xdc,
+	0x5e, 0x9a, 0x6d, 0x72, 0x27, 0x6f, 0xc5, 0x84, 0xfc, 0x9e, 0x05, 0x25, 0xd5, 0x42, 0x0e, 0xc1,
+	0x87, 0x18, 0x59, 0xcb, 0x7c, 0xe0, 0x1a, 0x48, 0x2e, 0x52, 0x29, 0xcc, 0x67, 0x62, 0x96, 0x7a,
+	0x07, 0x71, 0x24, 0xf1, 0x17, 0xd9, 0x25, 0xbf, 0x3b, 0x3b, 0xf6, 0xde, 0xd9, 0x71, 0x0f, 0xc8,
+	0x2e, 0xf5, 0x83, 0xb3, 0x4b, 0x3f, 0x38, 0xbb, 0x95, 0x87, 0x64, 0xf7, 0x81, 0x01, 0xf9, 0xf8,
+	0x9e, 0xef, 0xf3, 0x41, 0x3d, 0x01, 0x85, 0xe5, 0x25, 0x2c, 0xb3, 0xe4, 0xe7, 0x33, 0xb1, 0x14,
+	0xc9, 0xe2, 0xb0, 0xac, 0xe5, 0x97, 0xbd, 0x6a, 0xc1, 0x06, 0x48, 0x0f, 0x3d, 0x74, 0x88, 0x27,
+	0x3c, 0x7b, 0xfb, 0x6e, 0x17, 0x3f, 0x80, 0x71, 0xad, 0xfa, 0x1c, 0x79, 0xc7, 0x03, 0xd4, 0x09,
+	0xb9, 0xd1, 0xdd, 0x46, 0xca, 0xe8, 0x30, 0x7f, 0x82, 0x5c, 0x33, 0xdc, 0x54, 0xc7, 0x20, 0x47,
+	0x3e, 0x2c, 0x81, 0xd4, 0x30, 0x28, 0x78, 0x46, 0x62, 0x2b, 0x59, 0x8d, 0x36, 0xf2, 0x01, 0xf8,
+	0x79, 0x39, 0xa2, 0x94, 0x78, 0x8f, 0x33, 0x2f, 0xbc, 0x93, 0x71, 0xef, 0x1d, 0xb0, 0x12, 0x8d,
+	0x1d, 0x14, 0x00, 0xc0, 0xd7, 0xdf, 0x84, 0x47, 0x4d, 0xb5, 0xd8, 0x0a, 0x2c, 0x83, 0xcc, 0x21,
+	0x32, 0xc8, 0xc8, 0x43, 0xd7, 0x1e, 0x8b, 0x3e, 0x3a, 0x8d, 0x03, 0xd2, 0x1d, 0xc3, 0x33, 0x6c,
+	0x1f, 0x5a, 0xe0, 0x0f, 0xdb, 0x98, 0xe8, 0x68, 0x32, 0x44, 0x26, 0x41, 0x96, 0x4e, 0xb0, 0x8d,
+	0x82, 0x64, 0xf5, 0x30, 0xd4, 0xd0, 0x9c, 0x6b, 0xfc, 0x3d, 0x9f, 0x89, 0x32, 0xdd, 0xf1, 0x37,
+	0xc8, 0xb2, 0xb6, 0x6a, 0x1b, 0x93, 0x56, 0x04, 0x76, 0xb1, 0x8d, 0x3a, 0xc8, 0x6b, 0x04, 0xc8,
+	0xbf, 0x6f, 0x18, 0x90, 0x0a, 0x47, 0x1f, 0xfe, 0x0f, 0xc4, 0xdd, 0xee, 0x56, 0xb7, 0xa5, 0xef,
+	0xb5, 0xd5, 0xb6, 0xda, 0x55, 0xb7, 0x9e, 0xa9, 0x07, 0xad, 0x6d, 0x7d, 0xaf, 0xbd, 0xdb, 0x69,
+	0x35, 0xd5, 0xa7, 0x6a, 0x6b, 0xbb, 0x98, 0x28, 0xff, 0x72, 0x7a, 0x26, 0x15, 0x6e, 0x10, 0x20,
+	0x0f, 0x00, 0xd5, 0x05, 0x8b, 0x45, 0xa6, 0x9c, 0x39, 0x3d, 0x93, 0xb8, 0xa0, 0x86, 0x02, 0x28,
+	0x50, 0xa4, 0xab, 0xbd, 0x7c, 0xd1, 0x69, 0xb5, 0x8b, 0xc9, 0x72, 0xee, 0xf4, 0x4c, 0x5a, 0x89,
+	0xda, 0xa5, 0x32, 0x04, 0x59, 0xaa, 0x0c, 0xea, 0x32, 0x77, 0xf2, 0x4e, 0x48, 0x34, 0xf6, 0xcf,
+	0x2f, 0x05, 0xe6, 0xe2, 0x52, 0x60, 0x3e, 0x5d, 0x0a, 0xcc, 0xab, 0x2b, 0x21, 0x71, 0x71, 0x25,
+	0x24, 0x3e, 0x5e, 0x09, 0x89, 0x83, 0xc7, 0x7d, 0x4c, 0x8e, 0x46, 0xbd, 0x60, 0x54, 0x14, 0xd3,
+	0xf5, 0x6d, 0xd7, 0x57, 0x70, 0xcf, 0x5c, 0xef, 0xbb, 0xca, 0xb8, 0xae, 0xd8, 0xae, 0x35, 0x1a,
+	0x20, 0x9f, 0xbe, 0x2d, 0xff, 0xd5, 0xd7, 0x63, 0xaf, 0x16, 0x99, 0x0e, 0x91, 0xdf, 0x4b, 0x87,
+	0xef, 0x4a, 0xfd, 0xf3, 0x00, 0xfb, 0x03, 0x8f, 0x3b, 0xd9, 0x06, 0x00, 0x00,
 }
 
 func (m *ConnectionEnd) Marshal() (dAtA []byte, err error) {
@@ -486,6 +495,11 @@ func (m *ConnectionEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockDelayPeriod != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.BlockDelayPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -550,6 +564,11 @@ func (m *IdentifiedConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockDelayPeriod != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.BlockDelayPeriod))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -821,6 +840,9 @@ func (m *ConnectionEnd) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.BlockDelayPeriod != 0 {
+		n += 1 + sovConnection(uint64(m.BlockDelayPeriod))
+	}
 	return n
 }
 
@@ -852,6 +874,9 @@ func (m *IdentifiedConnection) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.BlockDelayPeriod != 0 {
+		n += 1 + sovConnection(uint64(m.BlockDelayPeriod))
+	}
 	return n
 }
 
@@ -1111,6 +1136,25 @@ func (m *ConnectionEnd) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelayPeriod", wireType)
+			}
+			m.BlockDelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -1330,6 +1374,25 @@ func (m *IdentifiedConnection) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelayPeriod", wireType)
+			}
+			m.BlockDelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
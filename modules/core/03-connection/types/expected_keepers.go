@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -13,4 +15,5 @@ type ClientKeeper interface {
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
 	IterateClients(ctx sdk.Context, cb func(string, exported.ClientState) bool)
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	GetParams(ctx sdk.Context) clienttypes.Params
 }
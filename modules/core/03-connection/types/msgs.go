@@ -26,16 +26,17 @@ var (
 func NewMsgConnectionOpenInit(
 	clientID, counterpartyClientID string,
 	counterpartyPrefix commitmenttypes.MerklePrefix,
-	version *Version, delayPeriod uint64, signer string,
+	version *Version, delayPeriod, blockDelayPeriod uint64, signer string,
 ) *MsgConnectionOpenInit {
 	// counterparty must have the same delay period
 	counterparty := NewCounterparty(counterpartyClientID, "", counterpartyPrefix)
 	return &MsgConnectionOpenInit{
-		ClientId:     clientID,
-		Counterparty: counterparty,
-		Version:      version,
-		DelayPeriod:  delayPeriod,
-		Signer:       signer,
+		ClientId:         clientID,
+		Counterparty:     counterparty,
+		Version:          version,
+		DelayPeriod:      delayPeriod,
+		BlockDelayPeriod: blockDelayPeriod,
+		Signer:           signer,
 	}
 }
 
@@ -76,7 +77,7 @@ func NewMsgConnectionOpenTry(
 	previousConnectionID, clientID, counterpartyConnectionID,
 	counterpartyClientID string, counterpartyClient exported.ClientState,
 	counterpartyPrefix commitmenttypes.MerklePrefix,
-	counterpartyVersions []*Version, delayPeriod uint64,
+	counterpartyVersions []*Version, delayPeriod, blockDelayPeriod uint64,
 	proofInit, proofClient, proofConsensus []byte,
 	proofHeight, consensusHeight clienttypes.Height, signer string,
 ) *MsgConnectionOpenTry {
@@ -89,6 +90,7 @@ func NewMsgConnectionOpenTry(
 		Counterparty:         counterparty,
 		CounterpartyVersions: counterpartyVersions,
 		DelayPeriod:          delayPeriod,
+		BlockDelayPeriod:     blockDelayPeriod,
 		ProofInit:            proofInit,
 		ProofClient:          proofClient,
 		ProofConsensus:       proofConsensus,
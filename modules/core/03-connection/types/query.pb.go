@@ -261,6 +261,8 @@ func (m *QueryConnectionsResponse) GetHeight() types.Height {
 type QueryClientConnectionsRequest struct {
 	// client identifier associated with a connection
 	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// pagination request, all connection paths are returned if it is not set
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryClientConnectionsRequest) Reset()         { *m = QueryClientConnectionsRequest{} }
@@ -303,6 +305,13 @@ func (m *QueryClientConnectionsRequest) GetClientId() string {
 	return ""
 }
 
+func (m *QueryClientConnectionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryClientConnectionsResponse is the response type for the
 // Query/ClientConnections RPC method
 type QueryClientConnectionsResponse struct {
@@ -312,6 +321,8 @@ type QueryClientConnectionsResponse struct {
 	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 	// height at which the proof was generated
 	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryClientConnectionsResponse) Reset()         { *m = QueryClientConnectionsResponse{} }
@@ -368,6 +379,13 @@ func (m *QueryClientConnectionsResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+func (m *QueryClientConnectionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryConnectionClientStateRequest is the request type for the
 // Query/ConnectionClientState RPC method
 type QueryConnectionClientStateRequest struct {
@@ -617,6 +635,241 @@ func (m *QueryConnectionConsensusStateResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryConnectionDetailsRequest is the request type for the
+// Query/ConnectionDetails RPC method
+type QueryConnectionDetailsRequest struct {
+	// connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *QueryConnectionDetailsRequest) Reset()         { *m = QueryConnectionDetailsRequest{} }
+func (m *QueryConnectionDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionDetailsRequest) ProtoMessage()    {}
+func (*QueryConnectionDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{10}
+}
+func (m *QueryConnectionDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionDetailsRequest.Merge(m, src)
+}
+func (m *QueryConnectionDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionDetailsRequest proto.InternalMessageInfo
+
+func (m *QueryConnectionDetailsRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryConnectionDetailsResponse is the response type for the
+// Query/ConnectionDetails RPC method
+type QueryConnectionDetailsResponse struct {
+	// connection end associated with the connection identifier
+	Connection ConnectionEnd `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection"`
+	// client identifier of the connection on this chain
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// client identifier of the connection on the counterparty chain
+	CounterpartyClientId string `protobuf:"bytes,3,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty" yaml:"counterparty_client_id"`
+	// connection identifier on the counterparty chain, empty until the
+	// counterparty connection end is created
+	CounterpartyConnectionId string `protobuf:"bytes,4,opt,name=counterparty_connection_id,json=counterpartyConnectionId,proto3" json:"counterparty_connection_id,omitempty" yaml:"counterparty_connection_id"`
+	// time delay period (in nanoseconds) of the connection
+	DelayPeriod uint64 `protobuf:"varint,5,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
+	// block delay enforced for packet-verification, the maximum of the block
+	// delay period of the connection and the block delay derived from the time
+	// delay period
+	BlockDelay uint64 `protobuf:"varint,6,opt,name=block_delay,json=blockDelay,proto3" json:"block_delay,omitempty" yaml:"block_delay"`
+	// features of the negotiated version once the connection is open, or of
+	// the proposed versions otherwise
+	Versions []VersionDetails `protobuf:"bytes,7,rep,name=versions,proto3" json:"versions"`
+	// query block height
+	Height types.Height `protobuf:"bytes,8,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryConnectionDetailsResponse) Reset()         { *m = QueryConnectionDetailsResponse{} }
+func (m *QueryConnectionDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionDetailsResponse) ProtoMessage()    {}
+func (*QueryConnectionDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{11}
+}
+func (m *QueryConnectionDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionDetailsResponse.Merge(m, src)
+}
+func (m *QueryConnectionDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionDetailsResponse proto.InternalMessageInfo
+
+func (m *QueryConnectionDetailsResponse) GetConnection() ConnectionEnd {
+	if m != nil {
+		return m.Connection
+	}
+	return ConnectionEnd{}
+}
+
+func (m *QueryConnectionDetailsResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConnectionDetailsResponse) GetCounterpartyClientId() string {
+	if m != nil {
+		return m.CounterpartyClientId
+	}
+	return ""
+}
+
+func (m *QueryConnectionDetailsResponse) GetCounterpartyConnectionId() string {
+	if m != nil {
+		return m.CounterpartyConnectionId
+	}
+	return ""
+}
+
+func (m *QueryConnectionDetailsResponse) GetDelayPeriod() uint64 {
+	if m != nil {
+		return m.DelayPeriod
+	}
+	return 0
+}
+
+func (m *QueryConnectionDetailsResponse) GetBlockDelay() uint64 {
+	if m != nil {
+		return m.BlockDelay
+	}
+	return 0
+}
+
+func (m *QueryConnectionDetailsResponse) GetVersions() []VersionDetails {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *QueryConnectionDetailsResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+// VersionDetails defines the features of a connection version parsed into a
+// structured form
+type VersionDetails struct {
+	// unique version identifier
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// true if ordered channels may be opened over the connection
+	SupportsOrdered bool `protobuf:"varint,2,opt,name=supports_ordered,json=supportsOrdered,proto3" json:"supports_ordered,omitempty" yaml:"supports_ordered"`
+	// true if unordered channels may be opened over the connection
+	SupportsUnordered bool `protobuf:"varint,3,opt,name=supports_unordered,json=supportsUnordered,proto3" json:"supports_unordered,omitempty" yaml:"supports_unordered"`
+	// version features not known to this chain
+	UnknownFeatures []string `protobuf:"bytes,4,rep,name=unknown_features,json=unknownFeatures,proto3" json:"unknown_features,omitempty" yaml:"unknown_features"`
+}
+
+func (m *VersionDetails) Reset()         { *m = VersionDetails{} }
+func (m *VersionDetails) String() string { return proto.CompactTextString(m) }
+func (*VersionDetails) ProtoMessage()    {}
+func (*VersionDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{12}
+}
+func (m *VersionDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionDetails.Merge(m, src)
+}
+func (m *VersionDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionDetails proto.InternalMessageInfo
+
+func (m *VersionDetails) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *VersionDetails) GetSupportsOrdered() bool {
+	if m != nil {
+		return m.SupportsOrdered
+	}
+	return false
+}
+
+func (m *VersionDetails) GetSupportsUnordered() bool {
+	if m != nil {
+		return m.SupportsUnordered
+	}
+	return false
+}
+
+func (m *VersionDetails) GetUnknownFeatures() []string {
+	if m != nil {
+		return m.UnknownFeatures
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConnectionRequest)(nil), "ibc.core.connection.v1.QueryConnectionRequest")
 	proto.RegisterType((*QueryConnectionResponse)(nil), "ibc.core.connection.v1.QueryConnectionResponse")
@@ -628,6 +881,9 @@ func init() {
 	proto.RegisterType((*QueryConnectionClientStateResponse)(nil), "ibc.core.connection.v1.QueryConnectionClientStateResponse")
 	proto.RegisterType((*QueryConnectionConsensusStateRequest)(nil), "ibc.core.connection.v1.QueryConnectionConsensusStateRequest")
 	proto.RegisterType((*QueryConnectionConsensusStateResponse)(nil), "ibc.core.connection.v1.QueryConnectionConsensusStateResponse")
+	proto.RegisterType((*QueryConnectionDetailsRequest)(nil), "ibc.core.connection.v1.QueryConnectionDetailsRequest")
+	proto.RegisterType((*QueryConnectionDetailsResponse)(nil), "ibc.core.connection.v1.QueryConnectionDetailsResponse")
+	proto.RegisterType((*VersionDetails)(nil), "ibc.core.connection.v1.VersionDetails")
 }
 
 func init() {
@@ -635,63 +891,85 @@ func init() {
 }
 
 var fileDescriptor_cd8d529f8c7cd06b = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x31, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0x38, 0xb9, 0xd3, 0x65, 0x1c, 0xee, 0x60, 0xe4, 0xbb, 0x33, 0x0b, 0x38, 0x61, 0x8f,
-	0x90, 0x1c, 0x70, 0x33, 0xe7, 0x58, 0x77, 0x3a, 0x8e, 0x18, 0x81, 0xa3, 0x40, 0xd2, 0x44, 0x61,
-	0x91, 0x28, 0x68, 0xa2, 0xdd, 0xf5, 0x64, 0xbd, 0x92, 0xbd, 0xe3, 0x78, 0xd6, 0x46, 0x56, 0x64,
-	0x21, 0xf1, 0x07, 0x40, 0xa2, 0xa1, 0xa1, 0xa5, 0xe0, 0x0f, 0x50, 0xd0, 0x51, 0xa5, 0x8c, 0x44,
-	0x93, 0x2a, 0x42, 0x0e, 0x2d, 0x0d, 0xbf, 0x00, 0xed, 0xcc, 0x38, 0x3b, 0x6b, 0xaf, 0x13, 0xc7,
-	0x22, 0xdd, 0xe6, 0xcd, 0x7b, 0xf3, 0xbe, 0xef, 0x7b, 0x6f, 0x3e, 0x07, 0x9a, 0xbe, 0xe3, 0x12,
-	0x97, 0xb5, 0x29, 0x71, 0x59, 0x10, 0x50, 0x37, 0xf4, 0x59, 0x40, 0xba, 0x25, 0x72, 0xd8, 0xa1,
-	0xed, 0x1e, 0x6e, 0xb5, 0x59, 0xc8, 0xd0, 0x03, 0xdf, 0x71, 0x71, 0x94, 0x83, 0xe3, 0x1c, 0xdc,
-	0x2d, 0x19, 0x79, 0x8f, 0x79, 0x4c, 0xa4, 0x90, 0xe8, 0x4b, 0x66, 0x1b, 0xef, 0xb9, 0x8c, 0x37,
-	0x19, 0x27, 0x8e, 0xcd, 0xa9, 0xbc, 0x86, 0x74, 0x4b, 0x0e, 0x0d, 0xed, 0x12, 0x69, 0xd9, 0x9e,
-	0x1f, 0xd8, 0xa2, 0x5c, 0xe6, 0x2e, 0xc5, 0xdd, 0x1b, 0x3e, 0x0d, 0xc2, 0xa8, 0xb3, 0xfc, 0x52,
-	0x09, 0xab, 0x13, 0xe0, 0x69, 0x40, 0x64, 0xe2, 0x9b, 0x1e, 0x63, 0x5e, 0x83, 0x12, 0xbb, 0xe5,
-	0x13, 0x3b, 0x08, 0x58, 0x28, 0xda, 0x70, 0x75, 0xfa, 0xba, 0x3a, 0x15, 0x7f, 0x39, 0x9d, 0x03,
-	0x62, 0x07, 0x8a, 0x9c, 0x59, 0x81, 0x0f, 0xbe, 0x88, 0x40, 0x6e, 0x5e, 0xdc, 0x68, 0xd1, 0xc3,
-	0x0e, 0xe5, 0x21, 0x7a, 0x04, 0x5f, 0x89, 0xdb, 0xec, 0xfb, 0xb5, 0x02, 0x58, 0x06, 0x6b, 0x0b,
-	0xd6, 0x62, 0x1c, 0xdc, 0xa9, 0x99, 0xbf, 0x03, 0xf8, 0x70, 0xac, 0x9e, 0xb7, 0x58, 0xc0, 0x29,
-	0xda, 0x82, 0x30, 0xce, 0x15, 0xd5, 0xb9, 0xf5, 0x15, 0x9c, 0x2e, 0x26, 0x8e, 0xeb, 0xb7, 0x82,
-	0x9a, 0xa5, 0x15, 0xa2, 0x3c, 0xbc, 0xd5, 0x6a, 0x33, 0x76, 0x50, 0xc8, 0x2e, 0x83, 0xb5, 0x45,
-	0x4b, 0xfe, 0x81, 0x36, 0xe1, 0xa2, 0xf8, 0xd8, 0xaf, 0x53, 0xdf, 0xab, 0x87, 0x85, 0x39, 0x71,
-	0xbd, 0xa1, 0x5d, 0x2f, 0x75, 0xec, 0x96, 0xf0, 0xb6, 0xc8, 0xa8, 0xce, 0x1f, 0x9f, 0x2d, 0x65,
-	0xac, 0x9c, 0xa8, 0x92, 0x21, 0xd3, 0x1e, 0x03, 0xcf, 0x87, 0xec, 0x3f, 0x83, 0x30, 0x1e, 0x97,
-	0x02, 0xff, 0x2e, 0x96, 0xb3, 0xc5, 0xd1, 0x6c, 0xb1, 0x5c, 0x11, 0x35, 0x5b, 0xbc, 0x67, 0x7b,
-	0x54, 0xd5, 0x5a, 0x5a, 0xa5, 0xf9, 0x0f, 0x80, 0x85, 0xf1, 0x1e, 0x4a, 0xa1, 0x5d, 0x98, 0x8b,
-	0x89, 0xf2, 0x02, 0x58, 0x9e, 0x5b, 0xcb, 0xad, 0x7f, 0x30, 0x49, 0xa2, 0x9d, 0x1a, 0x0d, 0x42,
-	0xff, 0xc0, 0xa7, 0x35, 0x4d, 0x6c, 0xfd, 0x02, 0xf4, 0x79, 0x02, 0x74, 0x56, 0x80, 0x5e, 0xbd,
-	0x12, 0xb4, 0x04, 0xa3, 0xa3, 0x46, 0x2f, 0xe0, 0xed, 0x6b, 0xea, 0xaa, 0xf2, 0xcd, 0x0d, 0xf8,
-	0x96, 0xa4, 0x2b, 0xd2, 0x52, 0x84, 0x7d, 0x03, 0x2e, 0xc8, 0x2b, 0xe2, 0x95, 0xba, 0x23, 0x03,
-	0x3b, 0x35, 0xf3, 0x17, 0x00, 0x8b, 0x93, 0xca, 0x95, 0x66, 0x8f, 0xe1, 0xab, 0xda, 0x5a, 0xb6,
-	0xec, 0xb0, 0x2e, 0x85, 0x5b, 0xb0, 0xee, 0xc5, 0xf1, 0xbd, 0x28, 0x7c, 0x93, 0x9b, 0xe3, 0xc0,
-	0xb7, 0x47, 0xa6, 0x2a, 0x11, 0x7f, 0x19, 0xda, 0xe1, 0x70, 0x0f, 0x50, 0x25, 0xf5, 0x05, 0x55,
-	0x0b, 0xff, 0x9e, 0x2d, 0xe5, 0x7b, 0x76, 0xb3, 0xf1, 0xd2, 0x4c, 0x1c, 0x9b, 0x23, 0x6f, 0x6b,
-	0x00, 0xa0, 0x79, 0x59, 0x13, 0x25, 0x88, 0x0d, 0x1f, 0xfa, 0x17, 0x9b, 0xb1, 0xaf, 0xb4, 0xe5,
-	0x51, 0x8a, 0x5a, 0xdb, 0xc7, 0x69, 0xd4, 0xb4, 0x65, 0xd2, 0xee, 0xbc, 0xef, 0xa7, 0x85, 0x6f,
-	0x52, 0xc8, 0xdf, 0x00, 0x7c, 0x67, 0x94, 0x64, 0x44, 0x2b, 0xe0, 0x1d, 0xfe, 0x3f, 0x8a, 0x89,
-	0x56, 0xe1, 0xbd, 0x36, 0xed, 0xfa, 0x3c, 0x3a, 0x0d, 0x3a, 0x4d, 0x87, 0xb6, 0x05, 0x99, 0x79,
-	0xeb, 0xee, 0x30, 0xbc, 0x2b, 0xa2, 0x89, 0x44, 0x8d, 0x98, 0x96, 0xa8, 0x90, 0x9f, 0x01, 0xb8,
-	0x72, 0x05, 0x72, 0x35, 0xa1, 0x0a, 0x8c, 0x56, 0x53, 0x9e, 0x24, 0x26, 0x93, 0xc7, 0xd2, 0x98,
-	0xf1, 0xd0, 0x98, 0xf1, 0xa7, 0x41, 0xcf, 0xba, 0xeb, 0x26, 0xae, 0x49, 0xbe, 0x98, 0x6c, 0xf2,
-	0xc5, 0xc4, 0xa3, 0x99, 0xbb, 0x6c, 0x34, 0xf3, 0x33, 0x8c, 0x66, 0xfd, 0xfb, 0x3b, 0xf0, 0x96,
-	0x20, 0x88, 0x7e, 0x05, 0x10, 0xc6, 0x2c, 0x11, 0x9e, 0xe4, 0x50, 0xe9, 0xbf, 0x24, 0x06, 0x99,
-	0x3a, 0x5f, 0x0a, 0x66, 0x7e, 0xf4, 0xdd, 0x9f, 0x7f, 0xff, 0x98, 0x7d, 0x86, 0xca, 0xe4, 0xca,
-	0xdf, 0x3f, 0x4e, 0x8e, 0x12, 0x73, 0xef, 0xa3, 0x9f, 0x01, 0xcc, 0x69, 0xc6, 0x81, 0xa6, 0xed,
-	0x3e, 0x74, 0x28, 0xe3, 0xe9, 0xf4, 0x05, 0x0a, 0xef, 0xfb, 0x02, 0xef, 0x0a, 0x7a, 0x34, 0x05,
-	0x5e, 0xf4, 0x07, 0x80, 0xaf, 0x8d, 0xd9, 0x1b, 0x7a, 0x76, 0x79, 0xd3, 0x09, 0x6e, 0x6a, 0x3c,
-	0xbf, 0x6e, 0x99, 0x42, 0xfc, 0xb1, 0x40, 0xfc, 0x02, 0x3d, 0x9f, 0x88, 0x58, 0x6e, 0x5c, 0x52,
-	0xe8, 0xe1, 0x16, 0xf6, 0xd1, 0x29, 0x80, 0xf7, 0x53, 0x6d, 0x09, 0x7d, 0x38, 0xa5, 0x7a, 0xe3,
-	0x7e, 0x69, 0xbc, 0x9c, 0xa5, 0x54, 0x11, 0xda, 0x16, 0x84, 0xaa, 0xe8, 0x93, 0x19, 0x56, 0x86,
-	0xe8, 0xa6, 0x89, 0x7e, 0xca, 0xc2, 0xc2, 0xa4, 0x27, 0x8d, 0x36, 0xa6, 0x85, 0x98, 0xe6, 0x61,
-	0x46, 0x65, 0xc6, 0x6a, 0xc5, 0xf1, 0x5b, 0xc1, 0xb1, 0x87, 0xbe, 0x99, 0x89, 0x63, 0xd2, 0x81,
-	0xc8, 0xd0, 0xcd, 0xc8, 0xd1, 0x88, 0x2f, 0xf6, 0x89, 0x34, 0x0d, 0xed, 0x40, 0x06, 0xfa, 0xd5,
-	0xaf, 0x8e, 0x07, 0x45, 0x70, 0x32, 0x28, 0x82, 0xbf, 0x06, 0x45, 0xf0, 0xc3, 0x79, 0x31, 0x73,
-	0x72, 0x5e, 0xcc, 0x9c, 0x9e, 0x17, 0x33, 0x5f, 0x6f, 0x78, 0x7e, 0x58, 0xef, 0x38, 0xd8, 0x65,
-	0x4d, 0xa2, 0xfe, 0x01, 0xf6, 0x1d, 0xf7, 0x89, 0xc7, 0x48, 0xb7, 0x4c, 0x9a, 0xac, 0xd6, 0x69,
-	0x50, 0x2e, 0x11, 0x3f, 0x2d, 0x3f, 0xd1, 0x40, 0x87, 0xbd, 0x16, 0xe5, 0xce, 0x6d, 0xe1, 0x7f,
-	0xe5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xd7, 0xeb, 0x28, 0x8e, 0x0b, 0x00, 0x00,
+	// 1246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x6e, 0x49, 0xc6, 0x21, 0x49, 0x07, 0x37, 0x71, 0x5d, 0x62, 0x27, 0x5b, 0xd2,
+	0xa4, 0x40, 0x77, 0xeb, 0x44, 0x0d, 0x25, 0x6d, 0x10, 0x38, 0x6d, 0x48, 0x04, 0x2a, 0x61, 0x11,
+	0x45, 0xe2, 0x80, 0xb5, 0x5e, 0x4f, 0x9c, 0x55, 0x9d, 0x9d, 0xed, 0xce, 0xae, 0x2b, 0xab, 0x8a,
+	0x90, 0x10, 0x67, 0x84, 0xc4, 0x85, 0x0b, 0x5f, 0x80, 0x2f, 0xc0, 0x81, 0x1b, 0x17, 0x7a, 0xac,
+	0xc4, 0x81, 0x72, 0xb1, 0x50, 0xc2, 0x15, 0x21, 0xf9, 0x13, 0xa0, 0x9d, 0x99, 0xf5, 0xce, 0xae,
+	0xd7, 0x89, 0x63, 0xb5, 0x37, 0xef, 0x9b, 0xf7, 0xe7, 0xf7, 0x7e, 0xef, 0xcd, 0xbc, 0x27, 0x03,
+	0xd9, 0xac, 0x1a, 0xaa, 0x81, 0x1d, 0xa4, 0x1a, 0xd8, 0xb2, 0x90, 0xe1, 0x9a, 0xd8, 0x52, 0x9b,
+	0x25, 0xf5, 0x91, 0x87, 0x9c, 0x96, 0x62, 0x3b, 0xd8, 0xc5, 0x70, 0xc6, 0xac, 0x1a, 0x8a, 0xaf,
+	0xa3, 0x84, 0x3a, 0x4a, 0xb3, 0x94, 0xcf, 0xd6, 0x71, 0x1d, 0x53, 0x15, 0xd5, 0xff, 0xc5, 0xb4,
+	0xf3, 0x6f, 0x1a, 0x98, 0x1c, 0x60, 0xa2, 0x56, 0x75, 0x82, 0x98, 0x1b, 0xb5, 0x59, 0xaa, 0x22,
+	0x57, 0x2f, 0xa9, 0xb6, 0x5e, 0x37, 0x2d, 0x9d, 0x9a, 0x33, 0xdd, 0x62, 0x18, 0xbd, 0x61, 0x22,
+	0xcb, 0xf5, 0x23, 0xb3, 0x5f, 0x5c, 0x61, 0xa9, 0x0f, 0xbc, 0xf0, 0x8b, 0x2b, 0xbe, 0x5e, 0xc7,
+	0xb8, 0xde, 0x40, 0xaa, 0x6e, 0x9b, 0xaa, 0x6e, 0x59, 0xd8, 0xa5, 0x61, 0x08, 0x3f, 0xbd, 0xc4,
+	0x4f, 0xe9, 0x57, 0xd5, 0xdb, 0x53, 0x75, 0x8b, 0x27, 0x27, 0x6f, 0x80, 0x99, 0x4f, 0x7d, 0x90,
+	0x9b, 0x5d, 0x8f, 0x1a, 0x7a, 0xe4, 0x21, 0xe2, 0xc2, 0x2b, 0xe0, 0xd5, 0x30, 0x4c, 0xc5, 0xac,
+	0xe5, 0xa4, 0x79, 0x69, 0x79, 0x5c, 0x9b, 0x08, 0x85, 0x3b, 0x35, 0xf9, 0x57, 0x09, 0xcc, 0xf6,
+	0xd8, 0x13, 0x1b, 0x5b, 0x04, 0xc1, 0x7b, 0x00, 0x84, 0xba, 0xd4, 0x3a, 0xb3, 0xb2, 0xa8, 0x24,
+	0x93, 0xa9, 0x84, 0xf6, 0xf7, 0xac, 0x9a, 0x26, 0x18, 0xc2, 0x2c, 0x38, 0x67, 0x3b, 0x18, 0xef,
+	0xe5, 0x52, 0xf3, 0xd2, 0xf2, 0x84, 0xc6, 0x3e, 0xe0, 0x26, 0x98, 0xa0, 0x3f, 0x2a, 0xfb, 0xc8,
+	0xac, 0xef, 0xbb, 0xb9, 0x51, 0xea, 0x3e, 0x2f, 0xb8, 0x67, 0x3c, 0x36, 0x4b, 0xca, 0x36, 0xd5,
+	0x28, 0xa7, 0x9f, 0xb6, 0x8b, 0x23, 0x5a, 0x86, 0x5a, 0x31, 0x91, 0xac, 0xf7, 0x80, 0x27, 0x41,
+	0xf6, 0x5b, 0x00, 0x84, 0xe5, 0xe2, 0xe0, 0xaf, 0x2a, 0xac, 0xb6, 0x8a, 0x5f, 0x5b, 0x85, 0xb5,
+	0x08, 0xaf, 0xad, 0xb2, 0xab, 0xd7, 0x11, 0xb7, 0xd5, 0x04, 0x4b, 0xf9, 0x5f, 0x09, 0xe4, 0x7a,
+	0x63, 0x70, 0x86, 0xee, 0x83, 0x4c, 0x98, 0x28, 0xc9, 0x49, 0xf3, 0xa3, 0xcb, 0x99, 0x95, 0xb7,
+	0xfb, 0x51, 0xb4, 0x53, 0x43, 0x96, 0x6b, 0xee, 0x99, 0xa8, 0x26, 0x90, 0x2d, 0x3a, 0x80, 0x1f,
+	0x46, 0x40, 0xa7, 0x28, 0xe8, 0xa5, 0x53, 0x41, 0x33, 0x30, 0x22, 0x6a, 0x78, 0x0b, 0x9c, 0x3f,
+	0x23, 0xaf, 0x5c, 0x5f, 0xfe, 0x56, 0x02, 0x73, 0x2c, 0x5f, 0xaa, 0x97, 0xc0, 0xec, 0x65, 0x30,
+	0xce, 0x7c, 0x84, 0x3d, 0x35, 0xc6, 0x04, 0x3b, 0x35, 0xb8, 0x95, 0x90, 0xc1, 0x30, 0xb4, 0xff,
+	0x27, 0x81, 0x42, 0x3f, 0x18, 0x9c, 0xfc, 0x6b, 0x60, 0x5a, 0xe8, 0x6f, 0x5b, 0x77, 0xf7, 0x59,
+	0x05, 0xc6, 0xb5, 0xa9, 0x50, 0xbe, 0xeb, 0x8b, 0x5f, 0x62, 0x0b, 0xc6, 0x4a, 0x96, 0x1e, 0xba,
+	0x64, 0x72, 0x15, 0x2c, 0xc4, 0xfa, 0x8c, 0xa5, 0xfe, 0x99, 0xab, 0xbb, 0x01, 0x45, 0x70, 0x23,
+	0xf1, 0x4e, 0x97, 0x73, 0x9d, 0x76, 0x31, 0xdb, 0xd2, 0x0f, 0x1a, 0xeb, 0x72, 0xe4, 0x58, 0x8e,
+	0xdd, 0xf6, 0x23, 0x09, 0xc8, 0x27, 0x05, 0xe1, 0xcc, 0xea, 0x60, 0xd6, 0xec, 0xf6, 0x6a, 0x85,
+	0x17, 0x9b, 0xf8, 0x2a, 0xfc, 0x22, 0x5d, 0x4b, 0xe2, 0x48, 0x68, 0x6f, 0xc1, 0xe7, 0x45, 0x33,
+	0x49, 0xfc, 0x32, 0x1f, 0x85, 0x5f, 0x24, 0xf0, 0x46, 0x3c, 0x49, 0x3f, 0x2d, 0x8b, 0x78, 0xe4,
+	0x05, 0x92, 0x09, 0x97, 0xc0, 0x94, 0x83, 0x9a, 0x26, 0xf1, 0x4f, 0x2d, 0xef, 0xa0, 0x8a, 0x1c,
+	0x9a, 0x4c, 0x5a, 0x9b, 0x0c, 0xc4, 0xf7, 0xa9, 0x34, 0xa2, 0x28, 0x24, 0x26, 0x28, 0x72, 0xe4,
+	0x6d, 0x09, 0x2c, 0x9e, 0x82, 0x9c, 0x57, 0x68, 0x03, 0x4c, 0x19, 0xc1, 0x49, 0xa4, 0x32, 0x59,
+	0x85, 0x8d, 0x0a, 0x25, 0x18, 0x15, 0xca, 0x07, 0x56, 0x4b, 0x9b, 0x34, 0x22, 0x6e, 0xa2, 0x57,
+	0x38, 0x15, 0xbb, 0xc2, 0xdd, 0xd2, 0x8c, 0x9e, 0x54, 0x9a, 0xf4, 0x30, 0xa5, 0xf9, 0x0a, 0xcc,
+	0xc5, 0xf2, 0xbb, 0x8b, 0x5c, 0xdd, 0x6c, 0x90, 0x17, 0xd4, 0xdf, 0x7f, 0xa6, 0x83, 0x57, 0xa3,
+	0x37, 0x00, 0x67, 0xee, 0xa3, 0xa1, 0x87, 0x1a, 0x4f, 0x48, 0x1c, 0x6d, 0xa5, 0x1e, 0x1e, 0xcb,
+	0xd9, 0x4e, 0xbb, 0x38, 0xcd, 0xa1, 0x06, 0x47, 0xb2, 0xc0, 0xee, 0x17, 0x60, 0xc6, 0xc0, 0x9e,
+	0xe5, 0x22, 0xc7, 0xd6, 0x1d, 0xb7, 0x55, 0x09, 0xed, 0x47, 0xa9, 0xfd, 0x42, 0xa7, 0x5d, 0x9c,
+	0x0b, 0x52, 0x4d, 0xd2, 0x93, 0xb5, 0xac, 0x78, 0xb0, 0x19, 0x38, 0x36, 0x40, 0x3e, 0x6a, 0x10,
+	0xe1, 0x31, 0x4d, 0x9d, 0x2f, 0x76, 0xda, 0xc5, 0x85, 0x24, 0xe7, 0x51, 0x52, 0x73, 0x91, 0x00,
+	0x62, 0xcf, 0xaf, 0x83, 0x89, 0x1a, 0x6a, 0xe8, 0xad, 0x8a, 0x8d, 0x1c, 0x13, 0xd7, 0x72, 0xe7,
+	0xfc, 0x3e, 0x2e, 0xcf, 0x76, 0xda, 0xc5, 0xd7, 0x98, 0x5b, 0xf1, 0x54, 0xd6, 0x32, 0xf4, 0x73,
+	0x97, 0x7e, 0xc1, 0x77, 0x40, 0xa6, 0xda, 0xc0, 0xc6, 0xc3, 0x0a, 0x15, 0xe6, 0xce, 0x53, 0xd3,
+	0x99, 0x4e, 0xbb, 0x08, 0x99, 0xa9, 0x70, 0x28, 0x6b, 0x80, 0x7e, 0xdd, 0xf5, 0x3f, 0xe0, 0x36,
+	0x18, 0x6b, 0x22, 0x87, 0xd0, 0x11, 0xfb, 0x0a, 0x1d, 0xb1, 0x57, 0xfb, 0x15, 0xec, 0x01, 0xd3,
+	0xe3, 0x45, 0xe7, 0x15, 0xeb, 0x5a, 0x0b, 0x63, 0x71, 0xec, 0x8c, 0x63, 0xf1, 0xbb, 0x14, 0x98,
+	0x8c, 0x3a, 0x87, 0x05, 0x00, 0xba, 0x6f, 0x9b, 0xc3, 0x07, 0xa1, 0x20, 0x81, 0x5b, 0x60, 0x9a,
+	0x78, 0xb6, 0x8d, 0x1d, 0x97, 0x54, 0xb0, 0x53, 0x43, 0x0e, 0x62, 0x3d, 0x32, 0x56, 0xbe, 0xdc,
+	0x69, 0x17, 0x67, 0x59, 0xd2, 0x71, 0x0d, 0x59, 0x9b, 0x0a, 0x44, 0x9f, 0x30, 0x09, 0xfc, 0x18,
+	0xc0, 0xae, 0x96, 0x67, 0x05, 0x9e, 0x46, 0xa9, 0xa7, 0xb9, 0x4e, 0xbb, 0x78, 0x29, 0xe6, 0xa9,
+	0xab, 0x23, 0x6b, 0x17, 0x02, 0xe1, 0xe7, 0x81, 0xcc, 0x47, 0xe5, 0x59, 0x0f, 0x2d, 0xfc, 0xd8,
+	0xaa, 0xec, 0x21, 0xdd, 0xf5, 0x1c, 0x44, 0x72, 0x69, 0x7f, 0x6a, 0x8a, 0xa8, 0xe2, 0x1a, 0xb2,
+	0x36, 0xc5, 0x45, 0x5b, 0x5c, 0xb2, 0xf2, 0xd7, 0x38, 0x38, 0x47, 0xaf, 0x1a, 0xfc, 0x59, 0x02,
+	0x20, 0x6c, 0x12, 0xa8, 0xf4, 0xab, 0x4d, 0xf2, 0x9a, 0x9a, 0x57, 0x07, 0xd6, 0x67, 0x37, 0x58,
+	0xbe, 0xfd, 0xcd, 0x1f, 0xff, 0xfc, 0x90, 0xba, 0x09, 0x57, 0xd5, 0x53, 0x97, 0x6b, 0xa2, 0x3e,
+	0x89, 0xb4, 0xf6, 0x21, 0xfc, 0x49, 0x02, 0x99, 0xd0, 0x27, 0x81, 0x83, 0x46, 0x0f, 0x5e, 0xa8,
+	0xfc, 0x8d, 0xc1, 0x0d, 0x38, 0xde, 0xb7, 0x28, 0xde, 0x45, 0x78, 0x65, 0x00, 0xbc, 0xf0, 0x37,
+	0x09, 0x5c, 0xe8, 0x59, 0x79, 0xe0, 0xcd, 0x93, 0x83, 0xf6, 0xd9, 0xd4, 0xf2, 0x6b, 0x67, 0x35,
+	0xe3, 0x88, 0xdf, 0xa3, 0x88, 0x6f, 0xc1, 0xb5, 0xbe, 0x88, 0xd9, 0x63, 0x14, 0x25, 0x3a, 0x78,
+	0xa0, 0x0e, 0xe1, 0x73, 0x09, 0x5c, 0x4c, 0xdc, 0x30, 0xe0, 0xbb, 0x03, 0xb2, 0xd7, 0xbb, 0xfa,
+	0xe4, 0xd7, 0x87, 0x31, 0xe5, 0x09, 0x6d, 0xd3, 0x84, 0xca, 0xf0, 0xfd, 0x21, 0x5a, 0x46, 0x15,
+	0xf7, 0x1f, 0xf8, 0x63, 0x0a, 0xe4, 0xfa, 0x4d, 0x67, 0x78, 0x67, 0x50, 0x88, 0x49, 0xeb, 0x48,
+	0x7e, 0x63, 0x48, 0x6b, 0x9e, 0xe3, 0xd7, 0x34, 0xc7, 0x16, 0x7c, 0x3c, 0x54, 0x8e, 0xd1, 0x65,
+	0x42, 0x0d, 0x16, 0x13, 0xf5, 0x49, 0x6c, 0xc5, 0x39, 0x54, 0xd9, 0x83, 0x28, 0x1c, 0x30, 0xc1,
+	0x21, 0xfc, 0xdd, 0x6f, 0xdd, 0xf8, 0xdc, 0x3d, 0xad, 0x75, 0xfb, 0x2c, 0x02, 0xf9, 0xb5, 0xb3,
+	0x9a, 0x71, 0x16, 0x36, 0x29, 0x0b, 0x1b, 0xf0, 0xf6, 0x30, 0x2c, 0xd4, 0xf8, 0xd8, 0x78, 0xf0,
+	0xf4, 0xa8, 0x20, 0x3d, 0x3b, 0x2a, 0x48, 0x7f, 0x1f, 0x15, 0xa4, 0xef, 0x8f, 0x0b, 0x23, 0xcf,
+	0x8e, 0x0b, 0x23, 0xcf, 0x8f, 0x0b, 0x23, 0x5f, 0xde, 0xa9, 0x9b, 0xee, 0xbe, 0x57, 0x55, 0x0c,
+	0x7c, 0xa0, 0xf2, 0xff, 0x09, 0xcc, 0xaa, 0x71, 0xbd, 0x8e, 0xd5, 0xe6, 0xaa, 0x7a, 0x80, 0x6b,
+	0x5e, 0x03, 0x11, 0x16, 0xf5, 0xc6, 0xea, 0x75, 0x21, 0xb0, 0xdb, 0xb2, 0x11, 0xa9, 0x9e, 0xa7,
+	0x4b, 0xd9, 0xea, 0xff, 0x03, 0x00, 0xd5, 0xe8, 0x0f, 0xcf, 0xb5, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -719,6 +997,10 @@ type QueryClient interface {
 	// ConnectionConsensusState queries the consensus state associated with the
 	// connection.
 	ConnectionConsensusState(ctx context.Context, in *QueryConnectionConsensusStateRequest, opts ...grpc.CallOption) (*QueryConnectionConsensusStateResponse, error)
+	// ConnectionDetails queries the handshake metadata of an IBC connection,
+	// including the client identifiers on both sides, the delay periods and
+	// the features of the connection versions.
+	ConnectionDetails(ctx context.Context, in *QueryConnectionDetailsRequest, opts ...grpc.CallOption) (*QueryConnectionDetailsResponse, error)
 }
 
 type queryClient struct {
@@ -774,6 +1056,15 @@ func (c *queryClient) ConnectionConsensusState(ctx context.Context, in *QueryCon
 	return out, nil
 }
 
+func (c *queryClient) ConnectionDetails(ctx context.Context, in *QueryConnectionDetailsRequest, opts ...grpc.CallOption) (*QueryConnectionDetailsResponse, error) {
+	out := new(QueryConnectionDetailsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Query/ConnectionDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Connection queries an IBC connection end.
@@ -789,6 +1080,10 @@ type QueryServer interface {
 	// ConnectionConsensusState queries the consensus state associated with the
 	// connection.
 	ConnectionConsensusState(context.Context, *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error)
+	// ConnectionDetails queries the handshake metadata of an IBC connection,
+	// including the client identifiers on both sides, the delay periods and
+	// the features of the connection versions.
+	ConnectionDetails(context.Context, *QueryConnectionDetailsRequest) (*QueryConnectionDetailsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -810,6 +1105,9 @@ func (*UnimplementedQueryServer) ConnectionClientState(ctx context.Context, req
 func (*UnimplementedQueryServer) ConnectionConsensusState(ctx context.Context, req *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionConsensusState not implemented")
 }
+func (*UnimplementedQueryServer) ConnectionDetails(ctx context.Context, req *QueryConnectionDetailsRequest) (*QueryConnectionDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionDetails not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -905,6 +1203,24 @@ func _Query_ConnectionConsensusState_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConnectionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConnectionDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Query/ConnectionDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectionDetails(ctx, req.(*QueryConnectionDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.connection.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -929,6 +1245,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectionConsensusState",
 			Handler:    _Query_ConnectionConsensusState_Handler,
 		},
+		{
+			MethodName: "ConnectionDetails",
+			Handler:    _Query_ConnectionDetails_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/connection/v1/query.proto",
@@ -1130,6 +1450,18 @@ func (m *QueryClientConnectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ClientId) > 0 {
 		i -= len(m.ClientId)
 		copy(dAtA[i:], m.ClientId)
@@ -1160,6 +1492,18 @@ func (m *QueryClientConnectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1370,42 +1714,219 @@ func (m *QueryConnectionConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryConnectionDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryConnectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryConnectionDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryConnectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConnectionDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Connection != nil {
-		l = m.Connection.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Proof)
-	if l > 0 {
+	i--
+	dAtA[i] = 0x42
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.BlockDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockDelay))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DelayPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CounterpartyConnectionId) > 0 {
+		i -= len(m.CounterpartyConnectionId)
+		copy(dAtA[i:], m.CounterpartyConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CounterpartyClientId) > 0 {
+		i -= len(m.CounterpartyClientId)
+		copy(dAtA[i:], m.CounterpartyClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnknownFeatures) > 0 {
+		for iNdEx := len(m.UnknownFeatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnknownFeatures[iNdEx])
+			copy(dAtA[i:], m.UnknownFeatures[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.UnknownFeatures[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SupportsUnordered {
+		i--
+		if m.SupportsUnordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SupportsOrdered {
+		i--
+		if m.SupportsOrdered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConnectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Connection != nil {
+		l = m.Connection.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = m.ProofHeight.Size()
@@ -1457,6 +1978,10 @@ func (m *QueryClientConnectionsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1478,6 +2003,10 @@ func (m *QueryClientConnectionsResponse) Size() (n int) {
 	}
 	l = m.ProofHeight.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1555,6 +2084,81 @@ func (m *QueryConnectionConsensusStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConnectionDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConnectionDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Connection.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.DelayPeriod))
+	}
+	if m.BlockDelay != 0 {
+		n += 1 + sovQuery(uint64(m.BlockDelay))
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VersionDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SupportsOrdered {
+		n += 2
+	}
+	if m.SupportsUnordered {
+		n += 2
+	}
+	if len(m.UnknownFeatures) > 0 {
+		for _, s := range m.UnknownFeatures {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2096,6 +2700,42 @@ func (m *QueryClientConnectionsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2245,6 +2885,42 @@ func (m *QueryClientConnectionsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2806,6 +3482,526 @@ func (m *QueryConnectionConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConnectionDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelay", wireType)
+			}
+			m.BlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, VersionDetails{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsOrdered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupportsOrdered = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsUnordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupportsUnordered = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnknownFeatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnknownFeatures = append(m.UnknownFeatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
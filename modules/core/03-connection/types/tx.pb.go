@@ -38,6 +38,9 @@ type MsgConnectionOpenInit struct {
 	Version      *Version     `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	DelayPeriod  uint64       `protobuf:"varint,4,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
 	Signer       string       `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// number of blocks that must pass before a consensus state can be used for
+	// packet-verification, in addition to the delay period
+	BlockDelayPeriod uint64 `protobuf:"varint,6,opt,name=block_delay_period,json=blockDelayPeriod,proto3" json:"block_delay_period,omitempty" yaml:"block_delay_period"`
 }
 
 func (m *MsgConnectionOpenInit) Reset()         { *m = MsgConnectionOpenInit{} }
@@ -132,6 +135,9 @@ type MsgConnectionOpenTry struct {
 	ProofConsensus  []byte        `protobuf:"bytes,10,opt,name=proof_consensus,json=proofConsensus,proto3" json:"proof_consensus,omitempty" yaml:"proof_consensus"`
 	ConsensusHeight types1.Height `protobuf:"bytes,11,opt,name=consensus_height,json=consensusHeight,proto3" json:"consensus_height" yaml:"consensus_height"`
 	Signer          string        `protobuf:"bytes,12,opt,name=signer,proto3" json:"signer,omitempty"`
+	// number of blocks that must pass before a consensus state can be used for
+	// packet-verification, in addition to the delay period
+	BlockDelayPeriod uint64 `protobuf:"varint,13,opt,name=block_delay_period,json=blockDelayPeriod,proto3" json:"block_delay_period,omitempty" yaml:"block_delay_period"`
 }
 
 func (m *MsgConnectionOpenTry) Reset()         { *m = MsgConnectionOpenTry{} }
@@ -388,65 +394,68 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/connection/v1/tx.proto", fileDescriptor_5d00fde5fc97399e) }
 
 var fileDescriptor_5d00fde5fc97399e = []byte{
-	// 927 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x31, 0x73, 0xe3, 0x44,
-	0x14, 0xb6, 0x62, 0x27, 0xb1, 0xd7, 0x86, 0xbb, 0x5b, 0x9c, 0x44, 0x98, 0x3b, 0xcb, 0xa7, 0x81,
-	0x21, 0x05, 0x91, 0xce, 0x97, 0x63, 0x06, 0x32, 0x50, 0xc4, 0x6e, 0x48, 0x71, 0x70, 0x23, 0x6e,
-	0x8e, 0x99, 0x6b, 0x3c, 0xf6, 0x7a, 0xa3, 0xec, 0xd8, 0xd6, 0x6a, 0xb4, 0xb2, 0x41, 0xb4, 0x34,
-	0x0c, 0x15, 0x0d, 0xfd, 0xfd, 0x07, 0xfe, 0xc4, 0x95, 0x57, 0x52, 0x69, 0x20, 0x69, 0xa8, 0xd5,
-	0xd1, 0x31, 0xda, 0x95, 0xe4, 0xb5, 0x23, 0x0f, 0x31, 0xce, 0x75, 0xfb, 0xf6, 0x7d, 0xef, 0xbd,
-	0xdd, 0xf7, 0xbe, 0x6f, 0x67, 0x81, 0x46, 0x06, 0xc8, 0x44, 0xd4, 0xc3, 0x26, 0xa2, 0x8e, 0x83,
-	0x91, 0x4f, 0xa8, 0x63, 0xce, 0xda, 0xa6, 0xff, 0x83, 0xe1, 0x7a, 0xd4, 0xa7, 0x70, 0x9f, 0x0c,
-	0x90, 0x11, 0x03, 0x8c, 0x39, 0xc0, 0x98, 0xb5, 0x1b, 0x75, 0x9b, 0xda, 0x94, 0x43, 0xcc, 0x78,
-	0x25, 0xd0, 0x8d, 0xf7, 0x6d, 0x4a, 0xed, 0x31, 0x36, 0xb9, 0x35, 0x98, 0x9e, 0x9b, 0x7d, 0x27,
-	0x48, 0x5c, 0x52, 0xa5, 0x31, 0xc1, 0x8e, 0x1f, 0x57, 0x11, 0xab, 0x04, 0xf0, 0xf1, 0x8a, 0xa3,
-	0x48, 0x75, 0x39, 0x50, 0xff, 0x7d, 0x0b, 0xec, 0x3d, 0x65, 0x76, 0x37, 0xdb, 0xff, 0xc6, 0xc5,
-	0xce, 0x99, 0x43, 0x7c, 0xd8, 0x06, 0x15, 0x91, 0xb2, 0x47, 0x86, 0xaa, 0xd2, 0x52, 0x0e, 0x2b,
-	0x9d, 0x7a, 0x14, 0x6a, 0x77, 0x83, 0xfe, 0x64, 0x7c, 0xa2, 0x67, 0x2e, 0xdd, 0x2a, 0x8b, 0xf5,
-	0xd9, 0x10, 0x7e, 0x0d, 0x6a, 0x88, 0x4e, 0x1d, 0x1f, 0x7b, 0x6e, 0xdf, 0xf3, 0x03, 0x75, 0xab,
-	0xa5, 0x1c, 0x56, 0x1f, 0x7f, 0x68, 0xe4, 0x5f, 0xdb, 0xe8, 0x4a, 0xd8, 0x4e, 0xe9, 0x75, 0xa8,
-	0x15, 0xac, 0x85, 0x78, 0xf8, 0x39, 0xd8, 0x9d, 0x61, 0x8f, 0x11, 0xea, 0xa8, 0x45, 0x9e, 0x4a,
-	0x5b, 0x95, 0xea, 0x85, 0x80, 0x59, 0x29, 0x1e, 0x9e, 0x80, 0xda, 0x10, 0x8f, 0xfb, 0x41, 0xcf,
-	0xc5, 0x1e, 0xa1, 0x43, 0xb5, 0xd4, 0x52, 0x0e, 0x4b, 0x9d, 0x83, 0x28, 0xd4, 0xde, 0x13, 0x17,
-	0x90, 0xbd, 0xba, 0x55, 0xe5, 0xe6, 0x33, 0x6e, 0xc1, 0x7d, 0xb0, 0xc3, 0x88, 0xed, 0x60, 0x4f,
-	0xdd, 0x8e, 0xaf, 0x6d, 0x25, 0xd6, 0x49, 0xf9, 0xe7, 0x57, 0x5a, 0xe1, 0xef, 0x57, 0x5a, 0x41,
-	0xd7, 0xc0, 0x83, 0xdc, 0xa6, 0x59, 0x98, 0xb9, 0xd4, 0x61, 0x58, 0xff, 0x6d, 0x17, 0xd4, 0xaf,
-	0x21, 0x9e, 0x7b, 0xc1, 0xff, 0xe9, 0xea, 0x77, 0x60, 0xdf, 0xf5, 0xf0, 0x8c, 0xd0, 0x29, 0xeb,
-	0xcd, 0x6f, 0x1d, 0xc7, 0x6f, 0xf1, 0xf8, 0x87, 0x51, 0xa8, 0x3d, 0x10, 0xf1, 0xf9, 0x38, 0xdd,
-	0xaa, 0xa7, 0x8e, 0xf9, 0x81, 0xce, 0x86, 0xf0, 0x19, 0xa8, 0x25, 0x05, 0x99, 0xdf, 0xf7, 0x71,
-	0xd2, 0xe3, 0xba, 0x21, 0x78, 0x67, 0xa4, 0xbc, 0x33, 0x4e, 0x9d, 0x40, 0xee, 0x9c, 0x1c, 0xa3,
-	0x5b, 0x55, 0x61, 0x7e, 0x1b, 0x5b, 0xd7, 0x08, 0x50, 0xda, 0x90, 0x00, 0xcb, 0x53, 0xdc, 0x5e,
-	0x63, 0x8a, 0x33, 0xb0, 0x27, 0xe7, 0xea, 0x25, 0xcc, 0x60, 0xea, 0x4e, 0xab, 0x78, 0x03, 0x2a,
-	0x75, 0x5a, 0x51, 0xa8, 0xdd, 0x4f, 0x6e, 0x9c, 0x97, 0x47, 0xb7, 0xea, 0xf2, 0x7e, 0x12, 0xc6,
-	0xe0, 0x4b, 0x50, 0x73, 0x3d, 0x4a, 0xcf, 0x7b, 0x17, 0x98, 0xd8, 0x17, 0xbe, 0xba, 0xcb, 0x7b,
-	0xd0, 0x90, 0xca, 0x09, 0xa1, 0xce, 0xda, 0xc6, 0x57, 0x1c, 0xd1, 0xf9, 0x20, 0xbe, 0xf9, 0xfc,
-	0x4e, 0x72, 0xb4, 0x6e, 0x55, 0xb9, 0x29, 0x90, 0xf0, 0x09, 0x00, 0xc2, 0x4b, 0x1c, 0xe2, 0xab,
-	0xe5, 0x96, 0x72, 0x58, 0xeb, 0xec, 0x45, 0xa1, 0x76, 0x4f, 0x8e, 0x8c, 0x7d, 0xba, 0x55, 0xe1,
-	0x06, 0x57, 0xf2, 0x49, 0x7a, 0x22, 0x51, 0x59, 0xad, 0xf0, 0xb8, 0x83, 0xe5, 0x8a, 0xc2, 0x9b,
-	0x56, 0xec, 0x72, 0x0b, 0x76, 0xc1, 0x9d, 0xc4, 0x1b, 0xf3, 0xda, 0x61, 0x53, 0xa6, 0x02, 0x1e,
-	0xde, 0x88, 0x42, 0x6d, 0x7f, 0x21, 0x3c, 0x05, 0xe8, 0xd6, 0xbb, 0x22, 0x43, 0xba, 0x01, 0xcf,
-	0xc1, 0xdd, 0xcc, 0x9b, 0xb6, 0xa5, 0xfa, 0x9f, 0x6d, 0xd1, 0x92, 0xb6, 0x1c, 0xa4, 0x43, 0x58,
-	0xcc, 0xa0, 0x5b, 0x77, 0xb2, 0xad, 0xa4, 0x3d, 0x73, 0xe1, 0xd6, 0x56, 0x08, 0xb7, 0x09, 0xee,
-	0xe7, 0xc9, 0x32, 0xd3, 0xed, 0x5f, 0xdb, 0x39, 0xba, 0x3d, 0x45, 0x23, 0xf8, 0x25, 0x78, 0x67,
-	0x51, 0x7b, 0x42, 0xbb, 0x6a, 0x14, 0x6a, 0xf5, 0xec, 0x7c, 0xb2, 0xe4, 0x6a, 0x48, 0x96, 0x1a,
-	0x02, 0x8d, 0x05, 0x12, 0xe5, 0xe9, 0xf8, 0xa3, 0x28, 0xd4, 0x1e, 0xe6, 0x10, 0x6e, 0x29, 0xb1,
-	0x2a, 0x3b, 0x17, 0xf4, 0xbc, 0xc1, 0x73, 0xb9, 0xfc, 0x14, 0x94, 0x36, 0x7e, 0x0a, 0x96, 0x65,
-	0xb0, 0x7d, 0x8b, 0x32, 0x68, 0x03, 0xc1, 0xee, 0x9e, 0xef, 0x05, 0xea, 0x0e, 0xa7, 0xa3, 0xf4,
-	0x88, 0x66, 0x2e, 0xdd, 0x2a, 0xf3, 0x75, 0xfc, 0xee, 0x2e, 0x6b, 0x60, 0x77, 0x33, 0x0d, 0x94,
-	0x6f, 0x45, 0x03, 0x95, 0xb7, 0xaa, 0x01, 0xb0, 0x86, 0x06, 0x4e, 0xd1, 0x28, 0xd3, 0xc0, 0x2f,
-	0x5b, 0x40, 0xbd, 0x06, 0xe8, 0x52, 0xe7, 0x9c, 0x78, 0x93, 0x4d, 0x75, 0x90, 0x4d, 0xae, 0x8f,
-	0x46, 0x9c, 0xf6, 0x39, 0x93, 0xeb, 0xa3, 0x51, 0x3a, 0xb9, 0x58, 0x79, 0xcb, 0x44, 0x2a, 0xde,
-	0x22, 0x91, 0xe6, 0xcd, 0x2a, 0xad, 0x68, 0x96, 0x0e, 0x5a, 0xab, 0x7a, 0x91, 0x36, 0xec, 0xf1,
-	0x3f, 0x45, 0x50, 0x7c, 0xca, 0x6c, 0xf8, 0x23, 0x80, 0x39, 0xff, 0xa8, 0xa3, 0x55, 0x22, 0xcc,
-	0xfd, 0x41, 0x34, 0x3e, 0x5d, 0x0b, 0x9e, 0x9e, 0x01, 0x7e, 0x0f, 0xee, 0x5d, 0xff, 0x6c, 0x7c,
-	0x72, 0xe3, 0x5c, 0xcf, 0xbd, 0xa0, 0xf1, 0x64, 0x1d, 0xf4, 0xea, 0xc2, 0xf1, 0xcc, 0x6e, 0x5e,
-	0xf8, 0x14, 0x8d, 0xd6, 0x28, 0x2c, 0xd1, 0x14, 0xfe, 0xa4, 0x80, 0xbd, 0x7c, 0x8e, 0x3e, 0xba,
-	0x71, 0xbe, 0x24, 0xa2, 0xf1, 0xd9, 0xba, 0x11, 0xe9, 0x29, 0x3a, 0x2f, 0x5e, 0x5f, 0x36, 0x95,
-	0x37, 0x97, 0x4d, 0xe5, 0xcf, 0xcb, 0xa6, 0xf2, 0xeb, 0x55, 0xb3, 0xf0, 0xe6, 0xaa, 0x59, 0xf8,
-	0xe3, 0xaa, 0x59, 0x78, 0xf9, 0x85, 0x4d, 0xfc, 0x8b, 0xe9, 0xc0, 0x40, 0x74, 0x62, 0x22, 0xca,
-	0x26, 0x94, 0x99, 0x64, 0x80, 0x8e, 0x6c, 0x6a, 0xce, 0x8e, 0xcd, 0x09, 0x1d, 0x4e, 0xc7, 0x98,
-	0x89, 0x2f, 0xfa, 0xa3, 0xe3, 0x23, 0xe9, 0x97, 0xee, 0x07, 0x2e, 0x66, 0x83, 0x1d, 0xfe, 0xe4,
-	0x1e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xef, 0xf1, 0x60, 0x63, 0x54, 0x0c, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x9b, 0xb4, 0x4d, 0x26, 0x59, 0xb6, 0x3b, 0xa4, 0xad, 0x37, 0x6c, 0xe3, 0xac, 0x05,
+	0xa2, 0x07, 0x6a, 0x6f, 0xb6, 0x8b, 0x04, 0x15, 0x1c, 0x9a, 0x70, 0xa0, 0x42, 0x0b, 0x2b, 0xb3,
+	0x5a, 0xa4, 0xbd, 0x44, 0xc9, 0x64, 0xea, 0x5a, 0x49, 0x3c, 0x96, 0xc7, 0x09, 0x98, 0x13, 0x12,
+	0x17, 0xc4, 0x89, 0x8f, 0xb0, 0x1f, 0x67, 0x8f, 0x7b, 0xe4, 0x64, 0x41, 0x7b, 0xe1, 0xec, 0x1b,
+	0x37, 0xe4, 0x19, 0xdb, 0x99, 0x24, 0x8e, 0x36, 0x21, 0xdd, 0xdb, 0xbc, 0x79, 0xbf, 0xf7, 0xde,
+	0xbc, 0x3f, 0xbf, 0x17, 0x07, 0x28, 0x56, 0x0f, 0xe9, 0x88, 0xb8, 0x58, 0x47, 0xc4, 0xb6, 0x31,
+	0xf2, 0x2c, 0x62, 0xeb, 0x93, 0xa6, 0xee, 0xfd, 0xa4, 0x39, 0x2e, 0xf1, 0x08, 0x3c, 0xb0, 0x7a,
+	0x48, 0x8b, 0x00, 0xda, 0x14, 0xa0, 0x4d, 0x9a, 0xb5, 0xaa, 0x49, 0x4c, 0xc2, 0x20, 0x7a, 0x74,
+	0xe2, 0xe8, 0xda, 0x7d, 0x93, 0x10, 0x73, 0x88, 0x75, 0x26, 0xf5, 0xc6, 0x97, 0x7a, 0xd7, 0xf6,
+	0x63, 0x95, 0x10, 0x69, 0x68, 0x61, 0xdb, 0x8b, 0xa2, 0xf0, 0x53, 0x0c, 0xf8, 0x78, 0xc9, 0x53,
+	0x84, 0xb8, 0x0c, 0xa8, 0xfe, 0x92, 0x07, 0xfb, 0x4f, 0xa9, 0xd9, 0x4e, 0xef, 0xbf, 0x73, 0xb0,
+	0x7d, 0x61, 0x5b, 0x1e, 0x6c, 0x82, 0x12, 0x77, 0xd9, 0xb1, 0xfa, 0xb2, 0xd4, 0x90, 0x8e, 0x4b,
+	0xad, 0x6a, 0x18, 0x28, 0x7b, 0x7e, 0x77, 0x34, 0x3c, 0x53, 0x53, 0x95, 0x6a, 0x14, 0xf9, 0xf9,
+	0xa2, 0x0f, 0xbf, 0x05, 0x15, 0x44, 0xc6, 0xb6, 0x87, 0x5d, 0xa7, 0xeb, 0x7a, 0xbe, 0xbc, 0xd5,
+	0x90, 0x8e, 0xcb, 0x8f, 0x3f, 0xd4, 0xb2, 0xd3, 0xd6, 0xda, 0x02, 0xb6, 0x55, 0x78, 0x1d, 0x28,
+	0x39, 0x63, 0xc6, 0x1e, 0x7e, 0x0e, 0x76, 0x27, 0xd8, 0xa5, 0x16, 0xb1, 0xe5, 0x3c, 0x73, 0xa5,
+	0x2c, 0x73, 0xf5, 0x82, 0xc3, 0x8c, 0x04, 0x0f, 0xcf, 0x40, 0xa5, 0x8f, 0x87, 0x5d, 0xbf, 0xe3,
+	0x60, 0xd7, 0x22, 0x7d, 0xb9, 0xd0, 0x90, 0x8e, 0x0b, 0xad, 0xc3, 0x30, 0x50, 0xde, 0xe7, 0x09,
+	0x88, 0x5a, 0xd5, 0x28, 0x33, 0xf1, 0x19, 0x93, 0xe0, 0x01, 0xd8, 0xa1, 0x96, 0x69, 0x63, 0x57,
+	0xde, 0x8e, 0xd2, 0x36, 0x62, 0x09, 0x7e, 0x03, 0x60, 0x6f, 0x48, 0xd0, 0xa0, 0x33, 0xe3, 0x79,
+	0x87, 0x79, 0x3e, 0x0a, 0x03, 0xe5, 0x3e, 0xf7, 0xbc, 0x88, 0x51, 0x8d, 0x3d, 0x76, 0xf9, 0xd5,
+	0x34, 0xc8, 0x59, 0xf1, 0xb7, 0x57, 0x4a, 0xee, 0x9f, 0x57, 0x4a, 0x4e, 0x55, 0xc0, 0x51, 0x66,
+	0x07, 0x0c, 0x4c, 0x1d, 0x62, 0x53, 0xac, 0x5e, 0xef, 0x82, 0xea, 0x02, 0xe2, 0xb9, 0xeb, 0xff,
+	0x9f, 0x16, 0xfd, 0x00, 0x0e, 0x1c, 0x17, 0x4f, 0x2c, 0x32, 0xa6, 0x9d, 0x69, 0x09, 0x23, 0xfb,
+	0x2d, 0x66, 0xff, 0x30, 0x0c, 0x94, 0x23, 0x6e, 0x9f, 0x8d, 0x53, 0x8d, 0x6a, 0xa2, 0x98, 0x3e,
+	0xe8, 0xa2, 0x0f, 0x9f, 0x81, 0x4a, 0x1c, 0x90, 0x7a, 0x5d, 0x0f, 0xc7, 0x0d, 0xab, 0x6a, 0x7c,
+	0x88, 0xb5, 0x64, 0x88, 0xb5, 0x73, 0xdb, 0x17, 0xdb, 0x20, 0xda, 0xa8, 0x46, 0x99, 0x8b, 0xdf,
+	0x47, 0xd2, 0xc2, 0x34, 0x15, 0x36, 0x9c, 0xa6, 0xf9, 0x91, 0xd8, 0x5e, 0x63, 0x24, 0x26, 0x60,
+	0x5f, 0xf4, 0xd5, 0x89, 0xc7, 0x8c, 0xca, 0x3b, 0x8d, 0xfc, 0x0a, 0x73, 0xd9, 0x6a, 0x84, 0x81,
+	0xf2, 0x20, 0xce, 0x38, 0xcb, 0x8f, 0x6a, 0x54, 0xc5, 0xfb, 0xd8, 0x8c, 0xc2, 0x97, 0xa0, 0xe2,
+	0xb8, 0x84, 0x5c, 0x76, 0xae, 0xb0, 0x65, 0x5e, 0x79, 0xf2, 0x2e, 0xab, 0x41, 0x4d, 0x08, 0xc7,
+	0x59, 0x3f, 0x69, 0x6a, 0x5f, 0x33, 0x44, 0xeb, 0x83, 0x28, 0xf3, 0x69, 0x4e, 0xa2, 0xb5, 0x6a,
+	0x94, 0x99, 0xc8, 0x91, 0xf0, 0x09, 0x00, 0x5c, 0x6b, 0xd9, 0x96, 0x27, 0x17, 0x1b, 0xd2, 0x71,
+	0xa5, 0xb5, 0x1f, 0x06, 0xca, 0x3d, 0xd1, 0x32, 0xd2, 0xa9, 0x46, 0x89, 0x09, 0x6c, 0x2d, 0x9c,
+	0x25, 0x2f, 0xe2, 0x91, 0xe5, 0x12, 0xb3, 0x3b, 0x9c, 0x8f, 0xc8, 0xb5, 0x49, 0xc4, 0x36, 0x93,
+	0x60, 0x1b, 0xdc, 0x8d, 0xb5, 0xd1, 0x5c, 0xdb, 0x74, 0x4c, 0x65, 0xc0, 0xcc, 0x6b, 0x61, 0xa0,
+	0x1c, 0xcc, 0x98, 0x27, 0x00, 0xd5, 0x78, 0x8f, 0x7b, 0x48, 0x2e, 0xe0, 0x25, 0xd8, 0x4b, 0xb5,
+	0x49, 0x59, 0xca, 0x6f, 0x2d, 0x8b, 0x12, 0x97, 0xe5, 0x30, 0x69, 0xc2, 0xac, 0x07, 0xd5, 0xb8,
+	0x9b, 0x5e, 0xc5, 0xe5, 0x99, 0x6e, 0x81, 0xca, 0x0a, 0x5b, 0xe0, 0xce, 0xa6, 0x5b, 0xa0, 0x0e,
+	0x1e, 0x64, 0x71, 0x3c, 0x5d, 0x02, 0x7f, 0x6f, 0x67, 0x2c, 0x81, 0x73, 0x34, 0x80, 0x5f, 0x82,
+	0x3b, 0xb3, 0x44, 0xe6, 0x8b, 0x40, 0x0e, 0x03, 0xa5, 0x9a, 0x26, 0x2b, 0xf2, 0xb7, 0x82, 0x44,
+	0xde, 0x22, 0x50, 0x9b, 0x99, 0xc8, 0xac, 0xa5, 0xf0, 0x51, 0x18, 0x28, 0x0f, 0x33, 0xa6, 0x77,
+	0xce, 0xb1, 0x2c, 0x2a, 0x67, 0x96, 0xc3, 0x06, 0x8b, 0x7c, 0x7e, 0xaf, 0x14, 0x36, 0xde, 0x2b,
+	0xf3, 0x9c, 0xda, 0xbe, 0x45, 0x4e, 0x35, 0x01, 0xa7, 0x4a, 0xc7, 0x73, 0x7d, 0xf6, 0xcb, 0x50,
+	0x11, 0x37, 0x72, 0xaa, 0x52, 0x8d, 0x22, 0x3b, 0x47, 0x4b, 0x7c, 0x9e, 0x50, 0xbb, 0x9b, 0x11,
+	0xaa, 0x78, 0x2b, 0x84, 0x2a, 0xbd, 0x53, 0x42, 0x01, 0x91, 0x50, 0x6f, 0xe1, 0xc0, 0x39, 0x1a,
+	0xa4, 0x1c, 0xf8, 0x7d, 0x0b, 0xc8, 0x0b, 0x80, 0x36, 0xb1, 0x2f, 0x2d, 0x77, 0xb4, 0x29, 0x0f,
+	0xd2, 0xce, 0x75, 0xd1, 0x40, 0xde, 0xca, 0xee, 0x5c, 0x17, 0x0d, 0x92, 0xce, 0x45, 0xcc, 0x9b,
+	0x1f, 0xa4, 0xfc, 0x2d, 0x0e, 0xd2, 0xb4, 0x58, 0x85, 0x25, 0xc5, 0x52, 0x41, 0x63, 0x59, 0x2d,
+	0x92, 0x82, 0x3d, 0xfe, 0x37, 0x0f, 0xf2, 0x4f, 0xa9, 0x09, 0x7f, 0x06, 0x30, 0xe3, 0x0b, 0xef,
+	0x64, 0x19, 0x09, 0x33, 0x3f, 0x47, 0x6a, 0x9f, 0xae, 0x05, 0x4f, 0xde, 0x00, 0x7f, 0x04, 0xf7,
+	0x16, 0xbf, 0x5c, 0x3e, 0x59, 0xd9, 0xd7, 0x73, 0xd7, 0xaf, 0x3d, 0x59, 0x07, 0xbd, 0x3c, 0x70,
+	0xd4, 0xb3, 0xd5, 0x03, 0x9f, 0xa3, 0xc1, 0x1a, 0x81, 0x85, 0x31, 0x85, 0xbf, 0x4a, 0x60, 0x3f,
+	0x7b, 0x46, 0x1f, 0xad, 0xec, 0x2f, 0xb6, 0xa8, 0x7d, 0xb6, 0xae, 0x45, 0xf2, 0x8a, 0xd6, 0x8b,
+	0xd7, 0xd7, 0x75, 0xe9, 0xcd, 0x75, 0x5d, 0xfa, 0xeb, 0xba, 0x2e, 0xfd, 0x71, 0x53, 0xcf, 0xbd,
+	0xb9, 0xa9, 0xe7, 0xfe, 0xbc, 0xa9, 0xe7, 0x5e, 0x7e, 0x61, 0x5a, 0xde, 0xd5, 0xb8, 0xa7, 0x21,
+	0x32, 0xd2, 0x11, 0xa1, 0x23, 0x42, 0x75, 0xab, 0x87, 0x4e, 0x4c, 0xa2, 0x4f, 0x4e, 0xf5, 0x11,
+	0xe9, 0x8f, 0x87, 0x98, 0xf2, 0x3f, 0x0f, 0x8f, 0x4e, 0x4f, 0x84, 0xff, 0x0f, 0x9e, 0xef, 0x60,
+	0xda, 0xdb, 0x61, 0x2b, 0xf7, 0xf4, 0xbf, 0x01, 0x00, 0x68, 0xfb, 0x4e, 0x39, 0xee, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -667,6 +676,11 @@ func (m *MsgConnectionOpenInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockDelayPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockDelayPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -754,6 +768,11 @@ func (m *MsgConnectionOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockDelayPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockDelayPeriod))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -1126,6 +1145,9 @@ func (m *MsgConnectionOpenInit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.BlockDelayPeriod != 0 {
+		n += 1 + sovTx(uint64(m.BlockDelayPeriod))
+	}
 	return n
 }
 
@@ -1187,6 +1209,9 @@ func (m *MsgConnectionOpenTry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.BlockDelayPeriod != 0 {
+		n += 1 + sovTx(uint64(m.BlockDelayPeriod))
+	}
 	return n
 }
 
@@ -1472,6 +1497,25 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelayPeriod", wireType)
+			}
+			m.BlockDelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1958,6 +2002,25 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelayPeriod", wireType)
+			}
+			m.BlockDelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
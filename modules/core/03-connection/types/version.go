@@ -105,6 +105,27 @@ func VerifySupportedFeature(version exported.Version, feature string) bool {
 	return false
 }
 
+// NewVersionDetails parses the features of the provided version into a VersionDetails instance.
+// Features other than the supported channel orderings are returned as unknown features.
+func NewVersionDetails(version exported.Version) VersionDetails {
+	details := VersionDetails{
+		Identifier: version.GetIdentifier(),
+	}
+
+	for _, feature := range version.GetFeatures() {
+		switch feature {
+		case "ORDER_ORDERED":
+			details.SupportsOrdered = true
+		case "ORDER_UNORDERED":
+			details.SupportsUnordered = true
+		default:
+			details.UnknownFeatures = append(details.UnknownFeatures, feature)
+		}
+	}
+
+	return details
+}
+
 // GetCompatibleVersions returns a descending ordered set of compatible IBC
 // versions for the caller chain's connection end. The latest supported
 // version should be first element and the set should descend to the oldest
@@ -165,3 +165,16 @@ func TestVerifySupportedFeature(t *testing.T) {
 		require.Equal(t, tc.expPass, supported, "test case %d: %s", i, tc.name)
 	}
 }
+
+func TestNewVersionDetails(t *testing.T) {
+	details := types.NewVersionDetails(types.DefaultIBCVersion)
+	require.Equal(t, types.VersionDetails{Identifier: types.DefaultIBCVersionIdentifier, SupportsOrdered: true, SupportsUnordered: true}, details)
+
+	details = types.NewVersionDetails(types.NewVersion("2", []string{"ORDER_ORDERED", "ORDER_DAG"}))
+	require.Equal(t, types.VersionDetails{Identifier: "2", SupportsOrdered: true, UnknownFeatures: []string{"ORDER_DAG"}}, details)
+
+	details = types.NewVersionDetails(types.NewVersion(types.DefaultIBCVersionIdentifier, nil))
+	require.False(t, details.SupportsOrdered)
+	require.False(t, details.SupportsUnordered)
+	require.Empty(t, details.UnknownFeatures)
+}
@@ -0,0 +1,14 @@
+package channel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+)
+
+// BeginBlocker prunes the results of processed acknowledgements and the histories of closed channels whose
+// retention period has elapsed.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneHistoricalAcks(ctx)
+	k.PruneChannelHistory(ctx)
+}
@@ -21,14 +21,21 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryChannels(),
 		GetCmdQueryChannel(),
 		GetCmdQueryConnectionChannels(),
+		GetCmdQueryChannelsInState(),
+		GetCmdQueryChannelVersion(),
 		GetCmdQueryChannelClientState(),
 		GetCmdQueryPacketCommitment(),
 		GetCmdQueryPacketCommitments(),
 		GetCmdQueryPacketReceipt(),
 		GetCmdQueryPacketAcknowledgement(),
+		GetCmdQueryPacketAcknowledgementsInRange(),
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
+		GetCmdQueryChannelPacketStats(),
+		GetCmdQueryPacketData(),
+		GetCmdQueryHistoricalAck(),
+		GetCmdQueryChannelHistory(),
 		// TODO: next sequence Send ?
 	)
 
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -132,6 +133,85 @@ func GetCmdQueryConnectionChannels() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryChannelsInState defines the command to query all the channels in a given state
+func GetCmdQueryChannelsInState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channels-in-state [state]",
+		Short:   "Query all channels in a given state",
+		Long:    "Query all channels in a given state (STATE_INIT, STATE_TRYOPEN, STATE_OPEN or STATE_CLOSED)",
+		Example: fmt.Sprintf("%s query %s %s channels-in-state STATE_TRYOPEN", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			state, ok := types.State_value[strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid channel state %s", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelsInStateRequest{
+				State:      types.State(state),
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelsInState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channels in a given state")
+
+	return cmd
+}
+
+// GetCmdQueryChannelVersion defines the command to query the version negotiated on a channel
+func GetCmdQueryChannelVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "version [port-id] [channel-id]",
+		Short:   "Query the version negotiated on a channel",
+		Long:    "Query the version negotiated on a channel alongside the layers of the version wrapped by middleware which are encoded in a known format",
+		Example: fmt.Sprintf("%s query %s %s version [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelVersionRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelVersion(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryChannelClientState defines the command to query a client state from a channel
 func GetCmdQueryChannelClientState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -227,7 +307,13 @@ func GetCmdQueryPacketCommitment() *cobra.Command {
 				return err
 			}
 
-			res, err := utils.QueryPacketCommitment(clientCtx, portID, channelID, seq, prove)
+			var res *types.QueryPacketCommitmentResponse
+			if prove && clientCtx.Height != 0 {
+				// ensure the proof is generated at the exact requested height
+				res, err = utils.QueryPacketCommitmentWithProof(clientCtx, portID, channelID, seq, clientCtx.Height)
+			} else {
+				res, err = utils.QueryPacketCommitment(clientCtx, portID, channelID, seq, prove)
+			}
 			if err != nil {
 				return err
 			}
@@ -320,6 +406,51 @@ func GetCmdQueryPacketAcknowledgement() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPacketAcknowledgementsInRange defines the command to query the packet acknowledgements
+// of a channel within a range of sequences
+func GetCmdQueryPacketAcknowledgementsInRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-acks-in-range [port-id] [channel-id] [start-sequence] [end-sequence]",
+		Short: "Query the packet acknowledgements of a channel within a range of sequences",
+		Long:  "Query the packet acknowledgements of a channel whose sequences are within the inclusive range [start-sequence, end-sequence]",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-acks-in-range [port-id] [channel-id] [start-sequence] [end-sequence]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			startSeq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endSeq, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := utils.QueryPacketAcknowledgementsInRange(clientCtx, portID, channelID, startSeq, endSeq, prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, false, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryUnreceivedPackets defines the command to query all the unreceived
 // packets on the receiving chain
 func GetCmdQueryUnreceivedPackets() *cobra.Command {
@@ -455,3 +586,154 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryChannelPacketStats defines the command to query the number of packets sent, received
+// and pending on a channel
+func GetCmdQueryChannelPacketStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-stats [port-id] [channel-id]",
+		Short:   "Query the number of packets sent, received and pending on a channel",
+		Long:    "Query the number of packets sent, received and pending on a channel, computed from its sequence counters and outstanding packet commitments",
+		Example: fmt.Sprintf("%s query %s %s packet-stats [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelPacketStatsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelPacketStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacketData defines the command to query the retained raw data of a sent packet
+// which has not yet been acknowledged or timed out
+func GetCmdQueryPacketData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-data [port-id] [channel-id] [sequence]",
+		Short:   "Query the raw data of an in-flight packet",
+		Long:    "Query the raw data of a sent packet which has not yet been acknowledged or timed out. Packet data is only retained if enabled by the channel parameters",
+		Example: fmt.Sprintf("%s query %s %s packet-data [port-id] [channel-id] [sequence]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketDataRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			res, err := queryClient.PacketData(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHistoricalAck defines the command to query the retained result of an acknowledgement
+// processed for a sent packet
+func GetCmdQueryHistoricalAck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "historical-ack [port-id] [channel-id] [sequence]",
+		Short:   "Query the result of a processed packet acknowledgement",
+		Long:    "Query the result of an acknowledgement processed for a sent packet. Results are only retained for the number of blocks set by the channel parameters",
+		Example: fmt.Sprintf("%s query %s %s historical-ack [port-id] [channel-id] [sequence]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHistoricalAckRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			res, err := queryClient.HistoricalAck(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChannelHistory defines the command to query the recorded state transitions of a channel
+func GetCmdQueryChannelHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history [port-id] [channel-id]",
+		Short:   "Query the recorded state transitions of a channel",
+		Long:    "Query the recorded state transitions of a channel, ordered from the oldest. Transitions are only recorded if enabled by the channel parameters",
+		Example: fmt.Sprintf("%s query %s %s history [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelHistoryRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
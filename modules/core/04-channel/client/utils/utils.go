@@ -13,6 +13,7 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/client"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
 )
 
 // QueryChannel returns a channel end.
@@ -126,6 +127,8 @@ func QueryChannelConsensusState(
 
 // QueryLatestConsensusState uses the channel Querier to return the
 // latest ConsensusState given the source port ID and source channel ID.
+// Solo machine clients do not store consensus states by height, the
+// consensus state contained in the client state is returned instead.
 func QueryLatestConsensusState(
 	clientCtx client.Context, portID, channelID string,
 ) (exported.ConsensusState, clienttypes.Height, clienttypes.Height, error) {
@@ -139,11 +142,17 @@ func QueryLatestConsensusState(
 		return nil, clienttypes.Height{}, clienttypes.Height{}, err
 	}
 
-	clientHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
-	if !ok {
-		return nil, clienttypes.Height{}, clienttypes.Height{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "invalid height type. expected type: %T, got: %T",
-			clienttypes.Height{}, clientHeight)
+	latestHeight := clientState.GetLatestHeight()
+	clientHeight := clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight())
+
+	if solomachine, ok := clientState.(*solomachinetypes.ClientState); ok {
+		if solomachine.ConsensusState == nil {
+			return nil, clienttypes.Height{}, clienttypes.Height{}, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "client %s", clientRes.IdentifiedClientState.ClientId)
+		}
+
+		return solomachine.ConsensusState, clientHeight, clientRes.ProofHeight, nil
 	}
+
 	res, err := QueryChannelConsensusState(clientCtx, portID, channelID, clientHeight, false)
 	if err != nil {
 		return nil, clienttypes.Height{}, clienttypes.Height{}, err
@@ -215,6 +224,30 @@ func QueryPacketCommitment(
 	return queryClient.PacketCommitment(context.Background(), req)
 }
 
+// QueryPacketCommitmentWithProof returns a packet commitment along with its merkle proof and the proof height
+// at the provided block height. It performs an ABCI store query against the IBC store, so the returned proof
+// can be verified against the app hash committed in the provided height by a light client of this chain.
+// An error is returned if no block exists at the provided height.
+func QueryPacketCommitmentWithProof(
+	clientCtx client.Context, portID, channelID string,
+	sequence uint64, height int64,
+) (*types.QueryPacketCommitmentResponse, error) {
+	if height <= 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "height must be positive, got %d", height)
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := node.Block(context.Background(), &height); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "no block found at height %d: %s", height, err)
+	}
+
+	return queryPacketCommitmentABCI(clientCtx.WithHeight(height), portID, channelID, sequence)
+}
+
 func queryPacketCommitmentABCI(
 	clientCtx client.Context, portID, channelID string, sequence uint64,
 ) (*types.QueryPacketCommitmentResponse, error) {
@@ -299,3 +332,51 @@ func queryPacketAcknowledgementABCI(clientCtx client.Context, portID, channelID
 
 	return types.NewQueryPacketAcknowledgementResponse(value, proofBz, proofHeight), nil
 }
+
+// QueryPacketAcknowledgementsInRange returns the packet acknowledgements of a channel whose sequences
+// are within [startSeq, endSeq]. If prove is true, an ABCI store query is performed for each returned
+// acknowledgement in order to retrieve its merkle proof. All proofs are retrieved at the same height.
+func QueryPacketAcknowledgementsInRange(
+	clientCtx client.Context, portID, channelID string, startSeq, endSeq uint64, prove bool,
+) (*types.QueryPacketAcknowledgementsInRangeResponse, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryPacketAcknowledgementsInRangeRequest{
+		PortId:        portID,
+		ChannelId:     channelID,
+		StartSequence: startSeq,
+		EndSequence:   endSeq,
+	}
+
+	res, err := queryClient.PacketAcknowledgementsInRange(context.Background(), req)
+	if err != nil || !prove {
+		return res, err
+	}
+
+	return queryPacketAcknowledgementsInRangeABCI(clientCtx, res.Acknowledgements)
+}
+
+func queryPacketAcknowledgementsInRangeABCI(clientCtx client.Context, acks []*types.PacketState) (*types.QueryPacketAcknowledgementsInRangeResponse, error) {
+	res := &types.QueryPacketAcknowledgementsInRangeResponse{
+		Acknowledgements: make([]*types.PacketState, 0, len(acks)),
+		Proofs:           make([][]byte, 0, len(acks)),
+	}
+
+	for _, ack := range acks {
+		ackRes, err := queryPacketAcknowledgementABCI(clientCtx, ack.PortId, ack.ChannelId, ack.Sequence)
+		if err != nil {
+			return nil, err
+		}
+
+		// pin the remaining queries to the height of the first proof
+		if clientCtx.Height == 0 {
+			clientCtx = clientCtx.WithHeight(int64(ackRes.ProofHeight.RevisionHeight))
+		}
+
+		packetState := types.NewPacketState(ack.PortId, ack.ChannelId, ack.Sequence, ackRes.Acknowledgement)
+		res.Acknowledgements = append(res.Acknowledgements, &packetState)
+		res.Proofs = append(res.Proofs, ackRes.Proof)
+		res.Height = ackRes.ProofHeight
+	}
+
+	return res, nil
+}
@@ -32,6 +32,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
+	k.SetParams(ctx, gs.Params)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
@@ -45,5 +46,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
 		AckSequences:        k.GetAllPacketAckSeqs(ctx),
 		NextChannelSequence: k.GetNextChannelSequence(ctx),
+		Params:              k.GetParams(ctx),
 	}
 }
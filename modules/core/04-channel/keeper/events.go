@@ -117,8 +117,9 @@ func EmitAcknowledgePacketEvent(ctx sdk.Context, packet exported.PacketI, channe
 }
 
 // EmitTimeoutPacketEvent emits a timeout packet event. It will be emitted both the first time a packet
-// is timed out for a certain sequence and for all duplicate timeouts.
-func EmitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+// is timed out for a certain sequence and for all duplicate timeouts. The timeout type distinguishes
+// regular timeouts from timeouts on close.
+func EmitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, timeoutType string) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTimeoutPacket,
@@ -130,6 +131,10 @@ func EmitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel ty
 			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
 			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
 			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+			// we only support 1-hop packets now, and that is the most important hop for a relayer
+			// (is it going to a chain I am connected to)
+			sdk.NewAttribute(types.AttributeKeyConnection, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyTimeoutType, timeoutType),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -374,6 +374,44 @@ func (q Keeper) PacketAcknowledgements(c context.Context, req *types.QueryPacket
 	}, nil
 }
 
+// PacketAcknowledgementsInRange implements the Query/PacketAcknowledgementsInRange gRPC method
+func (q Keeper) PacketAcknowledgementsInRange(c context.Context, req *types.QueryPacketAcknowledgementsInRangeRequest) (*types.QueryPacketAcknowledgementsInRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.StartSequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	if req.StartSequence > req.EndSequence {
+		return nil, status.Errorf(codes.InvalidArgument, "start sequence %d cannot be greater than end sequence %d", req.StartSequence, req.EndSequence)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	packetAcks, err := q.GetPacketAcknowledgementsInRange(ctx, req.PortId, req.ChannelId, req.StartSequence, req.EndSequence)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	acks := []*types.PacketState{}
+	for _, ack := range packetAcks {
+		ack := ack
+		acks = append(acks, &ack)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketAcknowledgementsInRangeResponse{
+		Acknowledgements: acks,
+		Height:           selfHeight,
+	}, nil
+}
+
 // UnreceivedPackets implements the Query/UnreceivedPackets gRPC method. Given
 // a list of counterparty packet commitments, the querier checks if the packet
 // has already been received by checking if a receipt exists on this
@@ -506,3 +544,190 @@ func validategRPCRequest(portID, channelID string) error {
 
 	return nil
 }
+
+// ChannelPacketStats implements the Query/ChannelPacketStats gRPC method
+func (q Keeper) ChannelPacketStats(c context.Context, req *types.QueryChannelPacketStatsRequest) (*types.QueryChannelPacketStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sent, received, pending, err := q.GetChannelPacketStats(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryChannelPacketStatsResponse{
+		PacketsSent:     sent,
+		PacketsReceived: received,
+		PacketsPending:  pending,
+		Height:          selfHeight,
+	}, nil
+}
+
+// PacketData implements the Query/PacketData gRPC method
+func (q Keeper) PacketData(c context.Context, req *types.QueryPacketDataRequest) (*types.QueryPacketDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	data, found := q.GetPacketData(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrPacketDataNotFound, "port-id: %s, channel-id: %s, sequence: %d", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryPacketDataResponse{
+		Data: data,
+	}, nil
+}
+
+// HistoricalAck implements the Query/HistoricalAck gRPC method
+func (q Keeper) HistoricalAck(c context.Context, req *types.QueryHistoricalAckRequest) (*types.QueryHistoricalAckResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	historicalAck, found := q.GetHistoricalAck(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrHistoricalAckNotFound, "port-id: %s, channel-id: %s, sequence: %d", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryHistoricalAckResponse{
+		HistoricalAck: historicalAck,
+	}, nil
+}
+
+// ChannelsInState implements the Query/ChannelsInState gRPC method
+func (q Keeper) ChannelsInState(c context.Context, req *types.QueryChannelsInStateRequest) (*types.QueryChannelsInStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, ok := types.State_name[int32(req.State)]; !ok || req.State == types.UNINITIALIZED {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel state %s", req.State)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channels := []*types.IdentifiedChannel{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyChannelEndPrefix))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var result types.Channel
+		if err := q.cdc.Unmarshal(value, &result); err != nil {
+			return false, err
+		}
+
+		// ignore channel and continue to the next item if the state is
+		// different than the requested one
+		if result.State != req.State {
+			return false, nil
+		}
+
+		if accumulate {
+			portID, channelID, err := host.ParseChannelPath(string(key))
+			if err != nil {
+				return false, err
+			}
+
+			identifiedChannel := types.NewIdentifiedChannel(portID, channelID, result)
+			channels = append(channels, &identifiedChannel)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryChannelsInStateResponse{
+		Channels:   channels,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// ChannelVersion implements the Query/ChannelVersion gRPC method
+func (q Keeper) ChannelVersion(c context.Context, req *types.QueryChannelVersionRequest) (*types.QueryChannelVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryChannelVersionResponse{
+		Version: channel.Version,
+		Layers:  types.ParseChannelVersion(channel.Version),
+		Height:  selfHeight,
+	}, nil
+}
+
+// ChannelHistory implements the Query/ChannelHistory gRPC method
+func (q Keeper) ChannelHistory(c context.Context, req *types.QueryChannelHistoryRequest) (*types.QueryChannelHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	history := q.GetChannelHistory(ctx, req.PortId, req.ChannelId)
+	if len(history) == 0 {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelHistoryNotFound, "port-id: %s, channel-id: %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryChannelHistoryResponse{
+		History: history,
+	}, nil
+}
@@ -314,6 +314,205 @@ func (suite *KeeperTestSuite) TestQueryConnectionChannels() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryChannelsInState() {
+	var (
+		req         *types.QueryChannelsInStateRequest
+		expChannels = []*types.IdentifiedChannel{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"uninitialized state",
+			func() {
+				req = &types.QueryChannelsInStateRequest{
+					State: types.UNINITIALIZED,
+				}
+			},
+			false,
+		},
+		{
+			"unknown state",
+			func() {
+				req = &types.QueryChannelsInStateRequest{
+					State: types.State(10),
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				// open channel on chainA
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				// path1 creates a channel stuck in INIT on chainA
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path1)
+				err := path1.EndpointA.ChanOpenInit()
+				suite.Require().NoError(err)
+
+				channel := path1.EndpointA.GetChannel()
+				idCh := types.NewIdentifiedChannel(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, channel)
+
+				expChannels = []*types.IdentifiedChannel{&idCh}
+
+				req = &types.QueryChannelsInStateRequest{
+					State: types.INIT,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success, empty response",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expChannels = []*types.IdentifiedChannel{}
+				req = &types.QueryChannelsInStateRequest{
+					State: types.CLOSED,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ChannelsInState(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expChannels, res.Channels)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelVersion() {
+	var (
+		req       *types.QueryChannelVersionRequest
+		path      *ibctesting.Path
+		version   string
+		expLayers []types.VersionLayer
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"success: plain version",
+			func() {},
+			true,
+		},
+		{
+			"success: fee wrapped version",
+			func() {
+				version = `{"fee_version":"ics29-1","app_version":"ics20-1"}`
+				expLayers = []types.VersionLayer{
+					{Format: types.VersionFormatFeeMetadata, Version: "ics29-1"},
+					{Format: types.VersionFormatPlain, Version: "ics20-1"},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			req = &types.QueryChannelVersionRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+			version = path.EndpointA.GetChannel().Version
+			expLayers = []types.VersionLayer{{Format: types.VersionFormatPlain, Version: version}}
+
+			tc.malleate()
+
+			channel := path.EndpointA.GetChannel()
+			channel.Version = version
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel)
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ChannelVersion(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(version, res.Version)
+				suite.Require().Equal(expLayers, res.Layers)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryChannelClientState() {
 	var (
 		req                      *types.QueryChannelClientStateRequest
@@ -1100,6 +1299,138 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPacketAcknowledgementsInRange() {
+	var (
+		req                 *types.QueryPacketAcknowledgementsInRangeRequest
+		expAcknowledgements = []*types.PacketState{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req = &types.QueryPacketAcknowledgementsInRangeRequest{
+					PortId:        "",
+					ChannelId:     "test-channel-id",
+					StartSequence: 1,
+					EndSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"invalid start sequence",
+			func() {
+				req = &types.QueryPacketAcknowledgementsInRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 0,
+					EndSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"start sequence greater than end sequence",
+			func() {
+				req = &types.QueryPacketAcknowledgementsInRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 10,
+					EndSequence:   1,
+				}
+			},
+			false,
+		},
+		{
+			"range exceeds the maximum number of sequences",
+			func() {
+				req = &types.QueryPacketAcknowledgementsInRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 1,
+					EndSequence:   types.MaxAcknowledgementsInRange + 1,
+				}
+			},
+			false,
+		},
+		{
+			"success, empty res",
+			func() {
+				expAcknowledgements = []*types.PacketState{}
+
+				req = &types.QueryPacketAcknowledgementsInRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 1,
+					EndSequence:   10,
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expAcknowledgements = []*types.PacketState{}
+
+				for i := uint64(1); i <= 100; i++ {
+					ack := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
+
+					if i >= 5 && i <= 20 { // populate the store with 100 and query for a range of 16 acks
+						expAcknowledgements = append(expAcknowledgements, &ack)
+					}
+				}
+
+				// ack on a different channel must not be returned
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, "channel-100", 10, []byte("hash"))
+
+				req = &types.QueryPacketAcknowledgementsInRangeRequest{
+					PortId:        path.EndpointA.ChannelConfig.PortID,
+					ChannelId:     path.EndpointA.ChannelID,
+					StartSequence: 5,
+					EndSequence:   20,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PacketAcknowledgementsInRange(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAcknowledgements, res.Acknowledgements)
+				suite.Require().Empty(res.Proofs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 	var (
 		req    *types.QueryUnreceivedPacketsRequest
@@ -1444,3 +1775,444 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryChannelPacketStats() {
+	var (
+		req         *types.QueryChannelPacketStatsRequest
+		expSent     uint64
+		expReceived uint64
+		expPending  uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryChannelPacketStatsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryChannelPacketStatsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{"channel not found",
+			func() {
+				req = &types.QueryChannelPacketStatsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success: no activity",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expSent, expReceived, expPending = 0, 0, 0
+
+				req = &types.QueryChannelPacketStatsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"success: unordered channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				ctx := suite.chainA.GetContext()
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+				// 5 packets sent, of which 2 are pending
+				channelKeeper.SetNextSequenceSend(ctx, portID, channelID, 6)
+				channelKeeper.SetPacketCommitment(ctx, portID, channelID, 4, []byte("hash"))
+				channelKeeper.SetPacketCommitment(ctx, portID, channelID, 5, []byte("hash"))
+
+				// 3 packets received out of order, the next receive sequence is not used by unordered channels
+				channelKeeper.SetPacketReceipt(ctx, portID, channelID, 1)
+				channelKeeper.SetPacketReceipt(ctx, portID, channelID, 3)
+				channelKeeper.SetPacketReceipt(ctx, portID, channelID, 10)
+
+				// packet receipts of another channel are not counted
+				channelKeeper.SetPacketReceipt(ctx, portID, ibctesting.InvalidID, 1)
+
+				expSent, expReceived, expPending = 5, 3, 2
+
+				req = &types.QueryChannelPacketStatsRequest{
+					PortId:    portID,
+					ChannelId: channelID,
+				}
+			},
+			true,
+		},
+		{
+			"success: ordered channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				suite.coordinator.Setup(path)
+
+				ctx := suite.chainA.GetContext()
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+				// 3 packets sent, of which 1 is pending
+				channelKeeper.SetNextSequenceSend(ctx, portID, channelID, 4)
+				channelKeeper.SetPacketCommitment(ctx, portID, channelID, 3, []byte("hash"))
+
+				// 7 packets received
+				channelKeeper.SetNextSequenceRecv(ctx, portID, channelID, 8)
+
+				expSent, expReceived, expPending = 3, 7, 1
+
+				req = &types.QueryChannelPacketStatsRequest{
+					PortId:    portID,
+					ChannelId: channelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ChannelPacketStats(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSent, res.PacketsSent)
+				suite.Require().Equal(expReceived, res.PacketsReceived)
+				suite.Require().Equal(expPending, res.PacketsPending)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketData() {
+	var (
+		req     *types.QueryPacketDataRequest
+		expData []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPacketDataRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryPacketDataRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{"invalid sequence",
+			func() {
+				req = &types.QueryPacketDataRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{"packet data not retained",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, []byte("hash"))
+
+				req = &types.QueryPacketDataRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expData = []byte("data")
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expData)
+
+				req = &types.QueryPacketDataRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PacketData(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expData, res.Data)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryHistoricalAck() {
+	var (
+		req              *types.QueryHistoricalAckRequest
+		expHistoricalAck types.HistoricalAck
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryHistoricalAckRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryHistoricalAckRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{"invalid sequence",
+			func() {
+				req = &types.QueryHistoricalAckRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{"historical ack not retained",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				req = &types.QueryHistoricalAckRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expHistoricalAck = types.NewHistoricalAck(types.NewErrorAcknowledgement("error").Acknowledgement(), uint64(suite.chainA.GetContext().BlockHeight()))
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetHistoricalAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expHistoricalAck)
+
+				req = &types.QueryHistoricalAckRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.HistoricalAck(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expHistoricalAck, res.HistoricalAck)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelHistory() {
+	var (
+		req        *types.QueryChannelHistoryRequest
+		expHistory []types.ChannelStateTransition
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryChannelHistoryRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryChannelHistoryRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{"channel history not recorded",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				req = &types.QueryChannelHistoryRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, true, 0))
+				suite.coordinator.CreateChannels(path)
+
+				expHistory = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelHistory(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().Len(expHistory, 2)
+
+				req = &types.QueryChannelHistoryRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ChannelHistory(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expHistory, res.History)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -75,6 +75,7 @@ func (k Keeper) ChanOpenInit(
 	channelID := k.GenerateChannelIdentifier(ctx)
 	channel := types.NewChannel(types.INIT, order, counterparty, connectionHops, version)
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.recordChannelStateTransition(ctx, types.EventTypeChannelOpenInit, portID, channelID, channel)
 
 	capKey, err := k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
 	if err != nil {
@@ -236,6 +237,7 @@ func (k Keeper) ChanOpenTry(
 	}
 
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.recordChannelStateTransition(ctx, types.EventTypeChannelOpenTry, portID, channelID, channel)
 
 	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousChannel.State.String(), "new-state", "TRYOPEN")
 
@@ -328,6 +330,7 @@ func (k Keeper) ChanOpenAck(
 	channel.Version = counterpartyVersion
 	channel.Counterparty.ChannelId = counterpartyChannelID
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.recordChannelStateTransition(ctx, types.EventTypeChannelOpenAck, portID, channelID, channel)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -403,6 +406,7 @@ func (k Keeper) ChanOpenConfirm(
 
 	channel.State = types.OPEN
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.recordChannelStateTransition(ctx, types.EventTypeChannelOpenConfirm, portID, channelID, channel)
 	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "TRYOPEN", "new-state", "OPEN")
 
 	defer func() {
@@ -469,6 +473,7 @@ func (k Keeper) ChanCloseInit(
 
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.recordChannelStateTransition(ctx, types.EventTypeChannelCloseInit, portID, channelID, channel)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -547,6 +552,7 @@ func (k Keeper) ChanCloseConfirm(
 
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.recordChannelStateTransition(ctx, types.EventTypeChannelCloseConfirm, portID, channelID, channel)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -12,6 +12,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -28,6 +29,7 @@ type Keeper struct {
 	types.QueryServer
 
 	storeKey         sdk.StoreKey
+	paramSpace       paramtypes.Subspace
 	cdc              codec.BinaryCodec
 	clientKeeper     types.ClientKeeper
 	connectionKeeper types.ConnectionKeeper
@@ -37,12 +39,18 @@ type Keeper struct {
 
 // NewKeeper creates a new IBC channel Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key sdk.StoreKey,
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	clientKeeper types.ClientKeeper, connectionKeeper types.ConnectionKeeper,
 	portKeeper types.PortKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:         key,
+		paramSpace:       paramSpace,
 		cdc:              cdc,
 		clientKeeper:     clientKeeper,
 		connectionKeeper: connectionKeeper,
@@ -199,6 +207,187 @@ func (k Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string
 	store.Delete(host.PacketCommitmentKey(portID, channelID, sequence))
 }
 
+// GetPacketData gets the retained raw data of a sent packet from the store
+func (k Keeper) GetPacketData(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketDataKey(portID, channelID, sequence))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// SetPacketData stores the raw data of a sent packet. The packet data is not part of the
+// ICS24 provable store and is only retained to be queried while the packet is in-flight.
+func (k Keeper) SetPacketData(ctx sdk.Context, portID, channelID string, sequence uint64, data []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketDataKey(portID, channelID, sequence), data)
+}
+
+func (k Keeper) deletePacketData(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketDataKey(portID, channelID, sequence))
+}
+
+// GetPacketTimeout gets the retained timeout of a sent packet from the store
+func (k Keeper) GetPacketTimeout(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketTimeout, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketTimeoutKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketTimeout{}, false
+	}
+
+	var packetTimeout types.PacketTimeout
+	k.cdc.MustUnmarshal(bz, &packetTimeout)
+	return packetTimeout, true
+}
+
+// SetPacketTimeout stores the timeout of a sent packet. The timeout is not part of the ICS24
+// provable store and is only retained alongside the packet data while the packet is in-flight.
+func (k Keeper) SetPacketTimeout(ctx sdk.Context, portID, channelID string, sequence uint64, packetTimeout types.PacketTimeout) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketTimeoutKey(portID, channelID, sequence), k.cdc.MustMarshal(&packetTimeout))
+}
+
+func (k Keeper) deletePacketTimeout(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketTimeoutKey(portID, channelID, sequence))
+}
+
+// GetHistoricalAck gets the retained result of an acknowledgement processed for a sent packet from the store
+func (k Keeper) GetHistoricalAck(ctx sdk.Context, portID, channelID string, sequence uint64) (types.HistoricalAck, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HistoricalAckKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.HistoricalAck{}, false
+	}
+
+	var historicalAck types.HistoricalAck
+	k.cdc.MustUnmarshal(bz, &historicalAck)
+	return historicalAck, true
+}
+
+// SetHistoricalAck stores the result of an acknowledgement processed for a sent packet and indexes it by
+// the height at which it was processed. The result is not part of the ICS24 provable store and is only
+// retained until it is pruned by PruneHistoricalAcks.
+func (k Keeper) SetHistoricalAck(ctx sdk.Context, portID, channelID string, sequence uint64, historicalAck types.HistoricalAck) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HistoricalAckKey(portID, channelID, sequence), k.cdc.MustMarshal(&historicalAck))
+	store.Set(types.HistoricalAckHeightKey(historicalAck.Height, portID, channelID, sequence), types.HistoricalAckKey(portID, channelID, sequence))
+}
+
+// PruneHistoricalAcks deletes the results of acknowledgements which were processed more than the number of
+// blocks set by the historical ack retention parameter ago. All retained results are pruned if retention
+// is disabled.
+func (k Keeper) PruneHistoricalAcks(ctx sdk.Context) {
+	retention := k.GetHistoricalAckRetention(ctx)
+	height := uint64(ctx.BlockHeight())
+
+	// results processed at or below the prune height have exceeded the retention period
+	pruneHeight := height
+	if retention != 0 {
+		if height <= retention {
+			return
+		}
+
+		pruneHeight = height - retention
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.HistoricalAckHeightPrefix(0), types.HistoricalAckHeightPrefix(pruneHeight+1))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key(), iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetChannelHistory returns the recorded state transitions of a channel, ordered from the oldest
+func (k Keeper) GetChannelHistory(ctx sdk.Context, portID, channelID string) []types.ChannelStateTransition {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChannelHistoryPrefix(portID, channelID))
+	defer iterator.Close()
+
+	var history []types.ChannelStateTransition
+	for ; iterator.Valid(); iterator.Next() {
+		var transition types.ChannelStateTransition
+		k.cdc.MustUnmarshal(iterator.Value(), &transition)
+		history = append(history, transition)
+	}
+
+	return history
+}
+
+// AppendChannelStateTransition appends the provided state transition to the recorded history of a channel. The
+// history of a channel transitioning to CLOSED is indexed by the height of the transition, such that it is retained
+// until it is pruned by PruneChannelHistory. The history is not part of the ICS24 provable store.
+func (k Keeper) AppendChannelStateTransition(ctx sdk.Context, portID, channelID string, transition types.ChannelStateTransition) {
+	store := ctx.KVStore(k.storeKey)
+
+	var index uint64
+	prefix := types.ChannelHistoryPrefix(portID, channelID)
+	iterator := sdk.KVStoreReversePrefixIterator(store, prefix)
+	if iterator.Valid() {
+		index = sdk.BigEndianToUint64(iterator.Key()[len(prefix):]) + 1
+	}
+	iterator.Close()
+
+	store.Set(types.ChannelHistoryKey(portID, channelID, index), k.cdc.MustMarshal(&transition))
+
+	if transition.State == types.CLOSED {
+		store.Set(types.ChannelHistoryClosedHeightKey(transition.Height, portID, channelID), prefix)
+	}
+}
+
+// recordChannelStateTransition appends the state transition of a channel, identified by the type of the event emitted
+// for it, to the recorded history of the channel if channel history is enabled
+func (k Keeper) recordChannelStateTransition(ctx sdk.Context, event, portID, channelID string, channel types.Channel) {
+	if !k.IsChannelHistoryEnabled(ctx) {
+		return
+	}
+
+	k.AppendChannelStateTransition(ctx, portID, channelID, types.NewChannelStateTransition(event, channel, uint64(ctx.BlockHeight())))
+}
+
+// PruneChannelHistory deletes the recorded histories of channels which were closed more than the number of blocks set
+// by the closed channel history retention parameter ago. The history of closed channels is never pruned if retention
+// is disabled.
+func (k Keeper) PruneChannelHistory(ctx sdk.Context) {
+	retention := k.GetClosedChannelHistoryRetention(ctx)
+	height := uint64(ctx.BlockHeight())
+	if retention == 0 || height <= retention {
+		return
+	}
+
+	// histories of channels closed at or below the prune height have exceeded the retention period
+	pruneHeight := height - retention
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ChannelHistoryClosedHeightPrefix(0), types.ChannelHistoryClosedHeightPrefix(pruneHeight+1))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+
+		historyIterator := sdk.KVStorePrefixIterator(store, iterator.Value())
+		for ; historyIterator.Valid(); historyIterator.Next() {
+			keys = append(keys, historyIterator.Key())
+		}
+		historyIterator.Close()
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // SetPacketAcknowledgement sets the packet ack hash to the store
 func (k Keeper) SetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ackHash []byte) {
 	store := ctx.KVStore(k.storeKey)
@@ -335,6 +524,15 @@ func (k Keeper) GetAllPacketReceipts(ctx sdk.Context) (receipts []types.PacketSt
 	return receipts
 }
 
+// IteratePacketReceiptAtChannel provides an iterator over all PacketReceipt objects
+// at a specified channel. For each receipt, cb will be called. If the cb returns
+// true, the iterator will close and stop.
+func (k Keeper) IteratePacketReceiptAtChannel(ctx sdk.Context, portID, channelID string, cb func(_, _ string, sequence uint64, receipt []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.PacketReceiptPrefixPath(portID, channelID)))
+	k.iterateHashes(ctx, iterator, cb)
+}
+
 // IteratePacketAcknowledgement provides an iterator over all PacketAcknowledgement objects. For each
 // aknowledgement, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -354,6 +552,77 @@ func (k Keeper) GetAllPacketAcks(ctx sdk.Context) (acks []types.PacketState) {
 	return acks
 }
 
+// IteratePacketAcknowledgementAtChannel provides an iterator over all PacketAcknowledgement objects
+// at a specified channel. For each acknowledgement, cb will be called. If the cb returns
+// true, the iterator will close and stop.
+func (k Keeper) IteratePacketAcknowledgementAtChannel(ctx sdk.Context, portID, channelID string, cb func(_, _ string, sequence uint64, hash []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.PacketAcknowledgementPrefixPath(portID, channelID)))
+	k.iterateHashes(ctx, iterator, cb)
+}
+
+// GetPacketAcknowledgementsInRange returns the stored PacketAcknowledgements objects for a specified
+// port ID and channel ID whose sequences are within [startSeq, endSeq]. The acknowledgements are
+// returned in ascending sequence order. An error is returned if the range spans more than
+// MaxAcknowledgementsInRange sequences.
+func (k Keeper) GetPacketAcknowledgementsInRange(ctx sdk.Context, portID, channelID string, startSeq, endSeq uint64) ([]types.PacketState, error) {
+	if startSeq > endSeq {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "start sequence %d cannot be greater than end sequence %d", startSeq, endSeq)
+	}
+
+	if endSeq-startSeq >= types.MaxAcknowledgementsInRange {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "range of sequences [%d, %d] exceeds the maximum of %d sequences", startSeq, endSeq, types.MaxAcknowledgementsInRange)
+	}
+
+	var acks []types.PacketState
+	for sequence := startSeq; ; sequence++ {
+		if ack, found := k.GetPacketAcknowledgement(ctx, portID, channelID, sequence); found {
+			acks = append(acks, types.NewPacketState(portID, channelID, sequence, ack))
+		}
+
+		// avoid overflowing the sequence if the range ends at the maximum sequence
+		if sequence == endSeq {
+			break
+		}
+	}
+
+	return acks, nil
+}
+
+// GetChannelPacketStats returns the number of packets sent, received and pending on the specified channel.
+// The number of packets sent is derived from the next send sequence. The number of packets received is
+// derived from the next receive sequence for ORDERED channels and from the stored packet receipts for
+// UNORDERED channels. The number of pending packets is the number of outstanding packet commitments.
+func (k Keeper) GetChannelPacketStats(ctx sdk.Context, portID, channelID string) (sent, received, pending uint64, err error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, 0, 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id: %s", portID, channelID)
+	}
+
+	if nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID); found && nextSequenceSend > 0 {
+		sent = nextSequenceSend - 1
+	}
+
+	switch channel.Ordering {
+	case types.ORDERED:
+		if nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, portID, channelID); found && nextSequenceRecv > 0 {
+			received = nextSequenceRecv - 1
+		}
+	case types.UNORDERED:
+		k.IteratePacketReceiptAtChannel(ctx, portID, channelID, func(_, _ string, _ uint64, _ []byte) bool {
+			received++
+			return false
+		})
+	}
+
+	k.IteratePacketCommitmentAtChannel(ctx, portID, channelID, func(_, _ string, _ uint64, _ []byte) bool {
+		pending++
+		return false
+	})
+
+	return sent, received, pending, nil
+}
+
 // IterateChannels provides an iterator over all Channel objects. For each
 // Channel, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -403,6 +672,115 @@ func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string)
 	return connection.ClientId, clientState, nil
 }
 
+// MigrateChannelConnection replaces the connection hops of the channel with the provided connection identifier.
+// The new connection must be OPEN, support the channel ordering and its light client must track the same counterparty
+// chain as the light client of the connection currently used by the channel. The channel sequences and all stored
+// packet commitments, receipts and acknowledgements are preserved.
+// NOTE: the counterparty chain must migrate its channel end to the counterparty of the new connection in order for
+// packets to continue being relayed. This function should only be invoked by governance.
+func (k Keeper) MigrateChannelConnection(ctx sdk.Context, portID, channelID, connectionID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel state is not OPEN (got %s)", channel.State.String())
+	}
+
+	if channel.ConnectionHops[0] == connectionID {
+		return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "channel is already using connection %s", connectionID)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	getVersions := connectionEnd.GetVersions()
+	if len(getVersions) != 1 {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"single version must be negotiated on connection before migrating channel, got: %v",
+			getVersions,
+		)
+	}
+
+	if !connectiontypes.VerifySupportedFeature(getVersions[0], channel.Ordering.String()) {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"connection version %s does not support channel ordering: %s",
+			getVersions[0], channel.Ordering.String(),
+		)
+	}
+
+	_, clientState, err := k.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	newClientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.ClientId)
+	if !found {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", connectionEnd.ClientId)
+	}
+
+	chainID, err := getCounterpartyChainID(clientState)
+	if err != nil {
+		return err
+	}
+
+	newChainID, err := getCounterpartyChainID(newClientState)
+	if err != nil {
+		return err
+	}
+
+	if chainID != newChainID {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClient,
+			"connection %s tracks counterparty chain %s, expected %s", connectionID, newChainID, chainID,
+		)
+	}
+
+	previousConnectionID := channel.ConnectionHops[0]
+	channel.ConnectionHops = []string{connectionID}
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.recordChannelStateTransition(ctx, types.EventTypeChannelConnectionMigrated, portID, channelID, channel)
+
+	k.Logger(ctx).Info("channel connection migrated", "port-id", portID, "channel-id", channelID, "previous-connection-id", previousConnectionID, "new-connection-id", connectionID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelConnectionMigrated,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return nil
+}
+
+// getCounterpartyChainID returns the chain identifier of the counterparty chain tracked by the provided client state
+func getCounterpartyChainID(clientState exported.ClientState) (string, error) {
+	cs, ok := clientState.(interface{ GetChainID() string })
+	if !ok || cs.GetChainID() == "" {
+		return "", sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "client type %s does not track a counterparty chain identifier", clientState.ClientType())
+	}
+
+	return cs.GetChainID(), nil
+}
+
 // LookupModuleByChannel will return the IBCModule along with the capability associated with a given channel defined by its portID and channelID
 func (k Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error) {
 	modules, cap, err := k.scopedKeeper.LookupModules(ctx, host.ChannelCapabilityPath(portID, channelID))
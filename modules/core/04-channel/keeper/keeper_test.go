@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -364,3 +368,213 @@ func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
 	suite.Require().Equal(ackHash, storedAckHash)
 	suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq))
 }
+
+func (suite *KeeperTestSuite) TestGetPacketAcknowledgementsInRange() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctxA := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	for _, seq := range []uint64{1, 2, 10, 11, math.MaxUint64} {
+		channelKeeper.SetPacketAcknowledgement(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq, []byte(fmt.Sprintf("hash_%d", seq)))
+	}
+
+	// acknowledgements are returned in numeric rather than lexicographic sequence order
+	acks, err := channelKeeper.GetPacketAcknowledgementsInRange(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PacketState{
+		types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2, []byte("hash_2")),
+		types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10, []byte("hash_10")),
+	}, acks)
+
+	// a range ending at the maximum sequence does not overflow
+	acks, err = channelKeeper.GetPacketAcknowledgementsInRange(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, math.MaxUint64-1, math.MaxUint64)
+	suite.Require().NoError(err)
+	suite.Require().Len(acks, 1)
+	suite.Require().Equal(uint64(math.MaxUint64), acks[0].Sequence)
+
+	// the largest permitted range is accepted
+	acks, err = channelKeeper.GetPacketAcknowledgementsInRange(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, types.MaxAcknowledgementsInRange)
+	suite.Require().NoError(err)
+	suite.Require().Len(acks, 4)
+
+	// ranges exceeding the maximum are rejected
+	_, err = channelKeeper.GetPacketAcknowledgementsInRange(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, types.MaxAcknowledgementsInRange+1)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacket)
+
+	_, err = channelKeeper.GetPacketAcknowledgementsInRange(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, math.MaxUint64)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacket)
+}
+
+func (suite *KeeperTestSuite) TestMigrateChannelConnection() {
+	var (
+		path         *ibctesting.Path
+		newPath      *ibctesting.Path
+		connectionID string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = "channel-100"
+		}, false},
+		{"channel not OPEN", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+		}, false},
+		{"channel already uses the connection", func() {
+			connectionID = path.EndpointA.ConnectionID
+		}, false},
+		{"connection not found", func() {
+			connectionID = "connection-100"
+		}, false},
+		{"connection not OPEN", func() {
+			connection := newPath.EndpointA.GetConnection()
+			connection.State = connectiontypes.INIT
+			suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), connectionID, connection)
+		}, false},
+		{"connection tracks a different counterparty chain", func() {
+			clientState := newPath.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			clientState.ChainId = "different-chain"
+			newPath.EndpointA.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			newPath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(newPath)
+			connectionID = newPath.EndpointA.ConnectionID
+
+			nextSeqSend, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.MigrateChannelConnection(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, connectionID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal([]string{connectionID}, channel.ConnectionHops)
+				suite.Require().Equal(types.OPEN, channel.State)
+
+				seq, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(nextSeqSend, seq)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChannelHistory tests that the state transitions of channels are recorded if enabled by the
+// channel parameters and that the history of closed channels is pruned once the retention period elapses.
+func (suite *KeeperTestSuite) TestChannelHistory() {
+	const retention = 3
+
+	testCases := []struct {
+		msg       string
+		enabled   bool
+		retention uint64
+	}{
+		{"history disabled", false, 0},
+		{"history recorded and never pruned", true, 0},
+		{"history recorded and pruned after retention", true, retention},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			params := types.NewParams(false, 0, tc.enabled, tc.retention)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			suite.coordinator.CreateChannels(path)
+
+			err := path.EndpointA.ChanCloseInit()
+			suite.Require().NoError(err)
+
+			historyA := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelHistory(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			historyB := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannelHistory(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			if !tc.enabled {
+				suite.Require().Empty(historyA)
+				suite.Require().Empty(historyB)
+				return
+			}
+
+			suite.Require().Len(historyA, 3)
+			suite.Require().Equal(types.EventTypeChannelOpenInit, historyA[0].Event)
+			suite.Require().Equal(types.INIT, historyA[0].State)
+			suite.Require().Equal(types.EventTypeChannelOpenAck, historyA[1].Event)
+			suite.Require().Equal(types.OPEN, historyA[1].State)
+			suite.Require().Equal(path.EndpointA.GetChannel().Version, historyA[1].Version)
+			suite.Require().Equal(types.EventTypeChannelCloseInit, historyA[2].Event)
+			suite.Require().Equal(types.CLOSED, historyA[2].State)
+			suite.Require().Equal([]string{path.EndpointA.ConnectionID}, historyA[2].ConnectionHops)
+
+			suite.Require().Len(historyB, 2)
+			suite.Require().Equal(types.EventTypeChannelOpenTry, historyB[0].Event)
+			suite.Require().Equal(types.TRYOPEN, historyB[0].State)
+			suite.Require().Equal(types.EventTypeChannelOpenConfirm, historyB[1].Event)
+			suite.Require().Equal(types.OPEN, historyB[1].State)
+
+			// the history of the closed channel is retained until the retention period elapses
+			closeHeight := int64(historyA[2].Height)
+			for suite.chainA.GetContext().BlockHeight() < closeHeight+retention-1 {
+				suite.coordinator.CommitBlock(suite.chainA)
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelHistory(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), 3)
+			}
+
+			suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+
+			historyA = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelHistory(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.retention == 0 {
+				suite.Require().Len(historyA, 3)
+			} else {
+				suite.Require().Empty(historyA)
+			}
+
+			// the history of open channels is never pruned
+			historyB = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannelHistory(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().Len(historyB, 2)
+		})
+	}
+}
+
+// TestMigrateChannelConnectionHistory tests that the migration of a channel to a new connection is recorded in the
+// history of the channel.
+func (suite *KeeperTestSuite) TestMigrateChannelConnectionHistory() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, true, 0))
+	suite.coordinator.CreateChannels(path)
+
+	newPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(newPath)
+
+	err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.MigrateChannelConnection(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, newPath.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+
+	history := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelHistory(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Len(history, 3)
+	suite.Require().Equal(types.EventTypeChannelConnectionMigrated, history[2].Event)
+	suite.Require().Equal(types.OPEN, history[2].State)
+	suite.Require().Equal([]string{newPath.EndpointA.ConnectionID}, history[2].ConnectionHops)
+}
@@ -125,6 +125,12 @@ func (k Keeper) SendPacket(
 	k.SetNextSequenceSend(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceSend)
 	k.SetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment)
 
+	// retain the raw packet data and timeout until the packet is acknowledged or timed out if enabled
+	if k.IsPacketDataRetained(ctx) {
+		k.SetPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), packet.GetData())
+		k.SetPacketTimeout(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.NewPacketTimeout(clienttypes.NewHeight(timeoutHeight.GetRevisionNumber(), timeoutHeight.GetRevisionHeight()), packet.GetTimeoutTimestamp()))
+	}
+
 	EmitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
 	k.Logger(ctx).Info(
@@ -470,6 +476,12 @@ func (k Keeper) AcknowledgePacket(
 
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deletePacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deletePacketTimeout(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if k.GetHistoricalAckRetention(ctx) != 0 {
+		k.SetHistoricalAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.NewHistoricalAck(acknowledgement, uint64(ctx.BlockHeight())))
+	}
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info("packet acknowledged", "packet", fmt.Sprintf("%v", packet))
@@ -223,6 +223,150 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 
 }
 
+// TestPacketDataRetention tests that the raw data and timeout of sent packets are retained if enabled and
+// pruned once the packet is acknowledged or timed out
+func (suite *KeeperTestSuite) TestPacketDataRetention() {
+	var (
+		path   *ibctesting.Path
+		packet types.Packet
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		prune    func()
+		retained bool
+	}{
+		{"packet data not retained", func() {
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+		}, nil, false},
+		{"packet data pruned on acknowledgement", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, 0, false, 0))
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+		}, func() {
+			err := path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement())
+			suite.Require().NoError(err)
+		}, true},
+		{"packet data pruned on timeout", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, 0, false, 0))
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+		}, func() {
+			// need to update chainA's client representing chainB to prove missing receipt
+			err := path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+		}, true},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			data, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketData(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Equal(tc.retained, found)
+			if tc.retained {
+				suite.Require().Equal(packet.GetData(), data)
+			}
+
+			packetTimeout, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketTimeout(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Equal(tc.retained, found)
+			if tc.retained {
+				suite.Require().Equal(types.NewPacketTimeout(packet.TimeoutHeight, packet.TimeoutTimestamp), packetTimeout)
+			}
+
+			if tc.prune != nil {
+				tc.prune()
+
+				_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketData(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().False(found)
+
+				_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketTimeout(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+// TestHistoricalAckRetention tests that the results of processed acknowledgements are retained
+// if enabled by the channel parameters and pruned once the retention period elapses.
+func (suite *KeeperTestSuite) TestHistoricalAckRetention() {
+	const retention = 3
+
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg        string
+		retention  uint64
+		packetData []byte
+		expSuccess bool
+	}{
+		{"retention disabled", 0, ibctesting.MockPacketData, true},
+		{"successful acknowledgement retained", retention, ibctesting.MockPacketData, true},
+		{"failed acknowledgement retained", retention, ibctesting.MockFailPacketData, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, tc.retention, false, 0))
+
+			packet := types.NewPacket(tc.packetData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			ack := ibcmock.MockAcknowledgement
+			if !tc.expSuccess {
+				ack = ibcmock.MockFailAcknowledgement
+			}
+
+			err = path.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement())
+			suite.Require().NoError(err)
+
+			historicalAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetHistoricalAck(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Equal(tc.retention != 0, found)
+			if tc.retention == 0 {
+				return
+			}
+
+			suite.Require().Equal(ack.Acknowledgement(), historicalAck.Acknowledgement)
+			suite.Require().Equal(tc.expSuccess, historicalAck.Success)
+
+			// the result is retained until the retention period elapses
+			for suite.chainA.GetContext().BlockHeight() < int64(historicalAck.Height+retention)-1 {
+				suite.coordinator.CommitBlock(suite.chainA)
+
+				_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetHistoricalAck(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().True(found)
+			}
+
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetHistoricalAck(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().False(found)
+		})
+	}
+}
+
 // TestRecvPacket test RecvPacket on chainB. Since packet commitment verification will always
 // occur last (resource instensive), only tests expected to succeed and packet commitment
 // verification tests need to simulate sending a packet from chainA to chainB.
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// IsPacketDataRetained retrieves the retain packet data boolean from the paramstore.
+// True is returned if the raw data and timeout of sent packets are retained until they are acknowledged or timed out.
+func (k Keeper) IsPacketDataRetained(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyRetainPacketData, &res)
+	return res
+}
+
+// GetHistoricalAckRetention retrieves the historical ack retention from the paramstore.
+// The results of processed acknowledgements are retained for the returned number of blocks,
+// a value of 0 disables retention.
+func (k Keeper) GetHistoricalAckRetention(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyHistoricalAckRetention, &res)
+	return res
+}
+
+// IsChannelHistoryEnabled retrieves the channel history enabled boolean from the paramstore.
+// True is returned if the state transitions of channels are recorded.
+func (k Keeper) IsChannelHistoryEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyChannelHistoryEnabled, &res)
+	return res
+}
+
+// GetClosedChannelHistoryRetention retrieves the closed channel history retention from the paramstore.
+// The recorded history of a channel is retained for the returned number of blocks after the channel is
+// closed, it is never pruned if 0 is returned.
+func (k Keeper) GetClosedChannelHistoryRetention(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyClosedChannelHistoryRetention, &res)
+	return res
+}
+
+// GetParams returns the total set of ibc-channel parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.IsPacketDataRetained(ctx), k.GetHistoricalAckRetention(ctx), k.IsChannelHistoryEnabled(ctx), k.GetClosedChannelHistoryRetention(ctx))
+}
+
+// SetParams sets the total set of ibc-channel parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
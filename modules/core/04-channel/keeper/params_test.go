@@ -0,0 +1,20 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestParams() {
+	expParams := types.DefaultParams()
+
+	params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+
+	expParams.RetainPacketData = true
+	expParams.HistoricalAckRetention = 100
+	expParams.ChannelHistoryEnabled = true
+	expParams.ClosedChannelHistoryRetention = 100
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+}
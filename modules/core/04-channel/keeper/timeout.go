@@ -74,7 +74,7 @@ func (k Keeper) TimeoutPacket(
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if len(commitment) == 0 {
-		EmitTimeoutPacketEvent(ctx, packet, channel)
+		EmitTimeoutPacketEvent(ctx, packet, channel, types.AttributeValueTimeoutTypeTimeout)
 		// This error indicates that the timeout has already been relayed
 		// or there is a misconfigured relayer attempting to prove a timeout
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
@@ -136,6 +136,28 @@ func (k Keeper) TimeoutExecuted(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
 	packet exported.PacketI,
+) error {
+	return k.timeoutExecuted(ctx, chanCap, packet, types.AttributeValueTimeoutTypeTimeout)
+}
+
+// TimeoutOnCloseExecuted deletes the commitment send from this chain after it verifies
+// timeout on close. It is equivalent to TimeoutExecuted, except that the emitted timeout
+// packet event marks the timeout as a timeout on close.
+//
+// CONTRACT: this function must be called in the IBC handler
+func (k Keeper) TimeoutOnCloseExecuted(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+) error {
+	return k.timeoutExecuted(ctx, chanCap, packet, types.AttributeValueTimeoutTypeTimeoutOnClose)
+}
+
+func (k Keeper) timeoutExecuted(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	timeoutType string,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -151,16 +173,19 @@ func (k Keeper) TimeoutExecuted(
 	}
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deletePacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deletePacketTimeout(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if channel.Ordering == types.ORDERED {
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+		k.recordChannelStateTransition(ctx, types.EventTypeTimeoutPacket, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
 	}
 
 	k.Logger(ctx).Info("packet timed-out", "packet", fmt.Sprintf("%v", packet))
 
 	// emit an event marking that we have processed the timeout
-	EmitTimeoutPacketEvent(ctx, packet, channel)
+	EmitTimeoutPacketEvent(ctx, packet, channel, timeoutType)
 
 	return nil
 }
@@ -212,7 +237,7 @@ func (k Keeper) TimeoutOnClose(
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if len(commitment) == 0 {
-		EmitTimeoutPacketEvent(ctx, packet, channel)
+		EmitTimeoutPacketEvent(ctx, packet, channel, types.AttributeValueTimeoutTypeTimeoutOnClose)
 		// This error indicates that the timeout has already been relayed
 		// or there is a misconfigured relayer attempting to prove a timeout
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
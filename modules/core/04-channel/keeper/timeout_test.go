@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
@@ -266,6 +268,63 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 	}
 }
 
+// TestTimeoutExecutedEvent tests the timeout packet event emitted by TimeoutExecuted and
+// TimeoutOnCloseExecuted for both a regular timeout and a timeout on close.
+func (suite *KeeperTestSuite) TestTimeoutExecutedEvent() {
+	testCases := []struct {
+		msg         string
+		timeoutType string
+	}{
+		{"timeout", types.AttributeValueTimeoutTypeTimeout},
+		{"timeout on close", types.AttributeValueTimeoutTypeTimeoutOnClose},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			chanCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+
+			if tc.timeoutType == types.AttributeValueTimeoutTypeTimeoutOnClose {
+				err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnCloseExecuted(ctx, chanCap, packet)
+			} else {
+				err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutExecuted(ctx, chanCap, packet)
+			}
+			suite.Require().NoError(err)
+
+			expAttributes := []abci.EventAttribute{
+				{Key: []byte(types.AttributeKeyTimeoutHeight), Value: []byte(packet.GetTimeoutHeight().String())},
+				{Key: []byte(types.AttributeKeyTimeoutTimestamp), Value: []byte(fmt.Sprintf("%d", packet.GetTimeoutTimestamp()))},
+				{Key: []byte(types.AttributeKeySequence), Value: []byte("1")},
+				{Key: []byte(types.AttributeKeySrcPort), Value: []byte(path.EndpointA.ChannelConfig.PortID)},
+				{Key: []byte(types.AttributeKeySrcChannel), Value: []byte(path.EndpointA.ChannelID)},
+				{Key: []byte(types.AttributeKeyDstPort), Value: []byte(path.EndpointB.ChannelConfig.PortID)},
+				{Key: []byte(types.AttributeKeyDstChannel), Value: []byte(path.EndpointB.ChannelID)},
+				{Key: []byte(types.AttributeKeyChannelOrdering), Value: []byte(types.UNORDERED.String())},
+				{Key: []byte(types.AttributeKeyConnection), Value: []byte(path.EndpointA.ConnectionID)},
+				{Key: []byte(types.AttributeKeyTimeoutType), Value: []byte(tc.timeoutType)},
+			}
+
+			var found bool
+			for _, event := range ctx.EventManager().ABCIEvents() {
+				if event.Type == types.EventTypeTimeoutPacket {
+					found = true
+					suite.Require().Equal(expAttributes, event.Attributes)
+				}
+			}
+			suite.Require().True(found)
+		})
+	}
+}
+
 // TestTimeoutOnClose tests the call TimeoutOnClose on chainA by closing the corresponding
 // channel on chainB after the packet commitment has been created.
 func (suite *KeeperTestSuite) TestTimeoutOnClose() {
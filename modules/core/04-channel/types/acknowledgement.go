@@ -58,3 +58,24 @@ func (ack Acknowledgement) Success() bool {
 func (ack Acknowledgement) Acknowledgement() []byte {
 	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(&ack))
 }
+
+// NewHistoricalAck returns a new instance of HistoricalAck recording the result of the provided
+// acknowledgement bytes processed at the given height. The acknowledgement is only considered
+// failed if it is an error acknowledgement of the standard acknowledgement envelope.
+func NewHistoricalAck(acknowledgement []byte, height uint64) HistoricalAck {
+	historicalAck := HistoricalAck{
+		Acknowledgement: acknowledgement,
+		Success:         true,
+		Height:          height,
+	}
+
+	var ack Acknowledgement
+	if err := SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil {
+		if resp, ok := ack.Response.(*Acknowledgement_Error); ok {
+			historicalAck.Success = false
+			historicalAck.Error = resp.Error
+		}
+	}
+
+	return historicalAck
+}
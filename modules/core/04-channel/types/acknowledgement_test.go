@@ -68,3 +68,25 @@ func (suite TypesTestSuite) TestAcknowledgement() {
 		})
 	}
 }
+
+func (suite TypesTestSuite) TestNewHistoricalAck() {
+	testCases := []struct {
+		name       string
+		ack        []byte
+		expSuccess bool
+		expError   string
+	}{
+		{"successful ack", types.NewResultAcknowledgement([]byte("success")).Acknowledgement(), true, ""},
+		{"failed ack", types.NewErrorAcknowledgement("error").Acknowledgement(), false, "error"},
+		{"application specific ack", []byte("application specific acknowledgement"), true, ""},
+	}
+
+	for _, tc := range testCases {
+		historicalAck := types.NewHistoricalAck(tc.ack, 10)
+
+		suite.Require().Equal(tc.ack, historicalAck.Acknowledgement, tc.name)
+		suite.Require().Equal(tc.expSuccess, historicalAck.Success, tc.name)
+		suite.Require().Equal(tc.expError, historicalAck.Error, tc.name)
+		suite.Require().Equal(uint64(10), historicalAck.Height, tc.name)
+	}
+}
@@ -125,3 +125,15 @@ func (ic IdentifiedChannel) ValidateBasic() error {
 	channel := NewChannel(ic.State, ic.Ordering, ic.Counterparty, ic.ConnectionHops, ic.Version)
 	return channel.ValidateBasic()
 }
+
+// NewChannelStateTransition returns a new instance of ChannelStateTransition recording the provided
+// channel end as the result of the transition identified by the event type, occurring at the given height.
+func NewChannelStateTransition(event string, ch Channel, height uint64) ChannelStateTransition {
+	return ChannelStateTransition{
+		Event:          event,
+		State:          ch.State,
+		ConnectionHops: ch.ConnectionHops,
+		Version:        ch.Version,
+		Height:         height,
+	}
+}
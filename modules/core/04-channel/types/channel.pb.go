@@ -441,6 +441,305 @@ func (*Acknowledgement) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Params defines the set of IBC channel parameters.
+type Params struct {
+	// retain_packet_data enables the retention of the raw data and timeout of sent packets
+	// until they are acknowledged or timed out. Retained packet data may be queried to diagnose
+	// packets which have not been relayed. Retention is disabled by default due to the storage cost.
+	RetainPacketData bool `protobuf:"varint,1,opt,name=retain_packet_data,json=retainPacketData,proto3" json:"retain_packet_data,omitempty" yaml:"retain_packet_data"`
+	// historical_ack_retention is the number of blocks for which the results of
+	// acknowledged packets are retained after the acknowledgement is processed.
+	// Retention is disabled if set to 0, which is the default.
+	HistoricalAckRetention uint64 `protobuf:"varint,2,opt,name=historical_ack_retention,json=historicalAckRetention,proto3" json:"historical_ack_retention,omitempty" yaml:"historical_ack_retention"`
+	// channel_history_enabled enables the recording of the state transitions of channels, which
+	// may be queried to reconstruct the lifecycle of a channel. Recording is disabled by default
+	// due to the storage cost.
+	ChannelHistoryEnabled bool `protobuf:"varint,3,opt,name=channel_history_enabled,json=channelHistoryEnabled,proto3" json:"channel_history_enabled,omitempty" yaml:"channel_history_enabled"`
+	// closed_channel_history_retention is the number of blocks for which the recorded history of
+	// a channel is retained after the channel is closed. The history of closed channels is never
+	// pruned if set to 0, which is the default.
+	ClosedChannelHistoryRetention uint64 `protobuf:"varint,4,opt,name=closed_channel_history_retention,json=closedChannelHistoryRetention,proto3" json:"closed_channel_history_retention,omitempty" yaml:"closed_channel_history_retention"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{6}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetainPacketData() bool {
+	if m != nil {
+		return m.RetainPacketData
+	}
+	return false
+}
+
+func (m *Params) GetHistoricalAckRetention() uint64 {
+	if m != nil {
+		return m.HistoricalAckRetention
+	}
+	return 0
+}
+
+func (m *Params) GetChannelHistoryEnabled() bool {
+	if m != nil {
+		return m.ChannelHistoryEnabled
+	}
+	return false
+}
+
+func (m *Params) GetClosedChannelHistoryRetention() uint64 {
+	if m != nil {
+		return m.ClosedChannelHistoryRetention
+	}
+	return 0
+}
+
+// PacketTimeout records the timeout of a sent packet. It is not part of the ICS24
+// provable store and is only retained alongside the packet data until the packet is
+// acknowledged or timed out.
+type PacketTimeout struct {
+	// block height after which the packet times out
+	TimeoutHeight types.Height `protobuf:"bytes,1,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// block timestamp (in nanoseconds) after which the packet times out
+	TimeoutTimestamp uint64 `protobuf:"varint,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *PacketTimeout) Reset()         { *m = PacketTimeout{} }
+func (m *PacketTimeout) String() string { return proto.CompactTextString(m) }
+func (*PacketTimeout) ProtoMessage()    {}
+func (*PacketTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{7}
+}
+func (m *PacketTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketTimeout.Merge(m, src)
+}
+func (m *PacketTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketTimeout proto.InternalMessageInfo
+
+func (m *PacketTimeout) GetTimeoutHeight() types.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types.Height{}
+}
+
+func (m *PacketTimeout) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+// HistoricalAck records the result of an acknowledgement processed for a sent
+// packet. It is not part of the ICS24 provable store and is only retained for the
+// number of blocks set by the historical_ack_retention channel parameter.
+type HistoricalAck struct {
+	// acknowledgement bytes written by the counterparty application
+	Acknowledgement []byte `protobuf:"bytes,1,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// success is false if the acknowledgement is an error acknowledgement of the
+	// standard acknowledgement envelope. Acknowledgements of another format are
+	// considered successful.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error of a failed acknowledgement
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// block height at which the acknowledgement was processed
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *HistoricalAck) Reset()         { *m = HistoricalAck{} }
+func (m *HistoricalAck) String() string { return proto.CompactTextString(m) }
+func (*HistoricalAck) ProtoMessage()    {}
+func (*HistoricalAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{8}
+}
+func (m *HistoricalAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalAck.Merge(m, src)
+}
+func (m *HistoricalAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalAck proto.InternalMessageInfo
+
+func (m *HistoricalAck) GetAcknowledgement() []byte {
+	if m != nil {
+		return m.Acknowledgement
+	}
+	return nil
+}
+
+func (m *HistoricalAck) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *HistoricalAck) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *HistoricalAck) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ChannelStateTransition records a state transition of a channel end, such as a
+// step of the channel handshake, a connection migration or the closing of the
+// channel.
+type ChannelStateTransition struct {
+	// event type of the transition, matching the type of the event emitted for it
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// state of the channel after the transition
+	State State `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	// connection hops of the channel after the transition
+	ConnectionHops []string `protobuf:"bytes,3,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty" yaml:"connection_hops"`
+	// version of the channel after the transition
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// block height at which the transition occurred
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ChannelStateTransition) Reset()         { *m = ChannelStateTransition{} }
+func (m *ChannelStateTransition) String() string { return proto.CompactTextString(m) }
+func (*ChannelStateTransition) ProtoMessage()    {}
+func (*ChannelStateTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{9}
+}
+func (m *ChannelStateTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelStateTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelStateTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelStateTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelStateTransition.Merge(m, src)
+}
+func (m *ChannelStateTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelStateTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelStateTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelStateTransition proto.InternalMessageInfo
+
+func (m *ChannelStateTransition) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *ChannelStateTransition) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return UNINITIALIZED
+}
+
+func (m *ChannelStateTransition) GetConnectionHops() []string {
+	if m != nil {
+		return m.ConnectionHops
+	}
+	return nil
+}
+
+func (m *ChannelStateTransition) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ChannelStateTransition) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -450,69 +749,91 @@ func init() {
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
+	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
+	proto.RegisterType((*PacketTimeout)(nil), "ibc.core.channel.v1.PacketTimeout")
+	proto.RegisterType((*HistoricalAck)(nil), "ibc.core.channel.v1.HistoricalAck")
+	proto.RegisterType((*ChannelStateTransition)(nil), "ibc.core.channel.v1.ChannelStateTransition")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x8e, 0xda, 0x56,
-	0x14, 0xc6, 0x83, 0xf9, 0x3b, 0x0c, 0x0c, 0x73, 0xd3, 0x21, 0xae, 0x9b, 0x60, 0x62, 0x75, 0x31,
-	0x4a, 0x15, 0xc8, 0x24, 0x51, 0xab, 0x66, 0xd5, 0xe1, 0x27, 0x1a, 0xab, 0x11, 0x8c, 0x0c, 0xb3,
-	0x68, 0x36, 0x14, 0xec, 0x5b, 0xb0, 0x02, 0xbe, 0xd4, 0xbe, 0x30, 0x9a, 0x37, 0x88, 0x58, 0xf5,
-	0x05, 0x90, 0x2a, 0x55, 0xed, 0x2b, 0xf4, 0x15, 0xb2, 0xcc, 0xb2, 0x2b, 0xab, 0x9a, 0x59, 0x74,
-	0xcf, 0x0b, 0xb4, 0xf2, 0xbd, 0xd7, 0xfc, 0x4c, 0xa2, 0x2c, 0xbb, 0xca, 0x8a, 0x7b, 0xbe, 0xef,
-	0x3b, 0x3f, 0x3e, 0xe7, 0x70, 0x2f, 0x3c, 0x70, 0x06, 0x56, 0xd5, 0x22, 0x1e, 0xae, 0x5a, 0xa3,
-	0xbe, 0xeb, 0xe2, 0x71, 0x75, 0x7e, 0x12, 0x1d, 0x2b, 0x53, 0x8f, 0x50, 0x82, 0xee, 0x38, 0x03,
-	0xab, 0x12, 0x4a, 0x2a, 0x11, 0x3e, 0x3f, 0x51, 0x3f, 0x1b, 0x92, 0x21, 0x61, 0x7c, 0x35, 0x3c,
-	0x71, 0xa9, 0xaa, 0x6d, 0xa2, 0x8d, 0x1d, 0xec, 0x52, 0x16, 0x8c, 0x9d, 0xb8, 0x40, 0xff, 0x7d,
-	0x0f, 0x52, 0x75, 0x1e, 0x05, 0x3d, 0x86, 0x84, 0x4f, 0xfb, 0x14, 0x2b, 0x52, 0x59, 0x3a, 0xce,
-	0x3f, 0x51, 0x2b, 0x1f, 0xc8, 0x53, 0xe9, 0x84, 0x0a, 0x93, 0x0b, 0xd1, 0xd7, 0x90, 0x26, 0x9e,
-	0x8d, 0x3d, 0xc7, 0x1d, 0x2a, 0x7b, 0x1f, 0x71, 0x6a, 0x87, 0x22, 0x73, 0xad, 0x45, 0xdf, 0xc3,
-	0xbe, 0x45, 0x66, 0x2e, 0xc5, 0xde, 0xb4, 0xef, 0xd1, 0x2b, 0x25, 0x5e, 0x96, 0x8e, 0xb3, 0x4f,
-	0x1e, 0x7c, 0xd0, 0xb7, 0xbe, 0x25, 0xac, 0xc9, 0x6f, 0x03, 0x2d, 0x66, 0xee, 0x38, 0xa3, 0x3a,
-	0x1c, 0x58, 0xc4, 0x75, 0xb1, 0x45, 0x1d, 0xe2, 0xf6, 0x46, 0x64, 0xea, 0x2b, 0x72, 0x39, 0x7e,
-	0x9c, 0xa9, 0xa9, 0xab, 0x40, 0x2b, 0x5e, 0xf5, 0x27, 0xe3, 0xe7, 0xfa, 0x2d, 0x81, 0x6e, 0xe6,
-	0x37, 0xc8, 0x19, 0x99, 0xfa, 0x48, 0x81, 0xd4, 0x1c, 0x7b, 0xbe, 0x43, 0x5c, 0x25, 0x51, 0x96,
-	0x8e, 0x33, 0x66, 0x64, 0x3e, 0x97, 0xdf, 0xfc, 0xaa, 0xc5, 0xf4, 0x7f, 0xf6, 0xe0, 0xd0, 0xb0,
-	0xb1, 0x4b, 0x9d, 0x9f, 0x1c, 0x6c, 0x7f, 0xea, 0xd8, 0x47, 0x3a, 0x86, 0xee, 0x42, 0x6a, 0x4a,
-	0x3c, 0xda, 0x73, 0x6c, 0x25, 0xc9, 0x98, 0x64, 0x68, 0x1a, 0x36, 0xba, 0x0f, 0x20, 0xca, 0x0c,
-	0xb9, 0x14, 0xe3, 0x32, 0x02, 0x31, 0x6c, 0xd1, 0xe9, 0x4b, 0xd8, 0xdf, 0xfe, 0x00, 0xf4, 0xd5,
-	0x26, 0x5a, 0xd8, 0xe5, 0x4c, 0x0d, 0xad, 0x02, 0x2d, 0xcf, 0x8b, 0x14, 0x84, 0xbe, 0xce, 0xf0,
-	0x6c, 0x27, 0xc3, 0x1e, 0xd3, 0x1f, 0xad, 0x02, 0xed, 0x50, 0x7c, 0xd4, 0x9a, 0xd3, 0xdf, 0x4f,
-	0xfc, 0x6f, 0x1c, 0x92, 0xe7, 0x7d, 0xeb, 0x35, 0xa6, 0x48, 0x85, 0xb4, 0x8f, 0x7f, 0x9e, 0x61,
-	0xd7, 0xe2, 0xa3, 0x95, 0xcd, 0xb5, 0x8d, 0xbe, 0x81, 0xac, 0x4f, 0x66, 0x9e, 0x85, 0x7b, 0x61,
-	0x4e, 0x91, 0xa3, 0xb8, 0x0a, 0x34, 0xc4, 0x73, 0x6c, 0x91, 0xba, 0x09, 0xdc, 0x3a, 0x27, 0x1e,
-	0x45, 0xdf, 0x41, 0x5e, 0x70, 0x22, 0x33, 0x1b, 0x62, 0xa6, 0xf6, 0xf9, 0x2a, 0xd0, 0x8e, 0x76,
-	0x7c, 0x05, 0xaf, 0x9b, 0x39, 0x0e, 0x44, 0xeb, 0xf6, 0x02, 0x0a, 0x36, 0xf6, 0xa9, 0xe3, 0xf6,
-	0xd9, 0x5c, 0x58, 0x7e, 0x99, 0xc5, 0xf8, 0x62, 0x15, 0x68, 0x77, 0x79, 0x8c, 0xdb, 0x0a, 0xdd,
-	0x3c, 0xd8, 0x82, 0x58, 0x25, 0x6d, 0xb8, 0xb3, 0xad, 0x8a, 0xca, 0x61, 0x63, 0xac, 0x95, 0x56,
-	0x81, 0xa6, 0xbe, 0x1f, 0x6a, 0x5d, 0x13, 0xda, 0x42, 0xa3, 0xc2, 0x10, 0xc8, 0x76, 0x9f, 0xf6,
-	0xd9, 0xb8, 0xf7, 0x4d, 0x76, 0x46, 0x3f, 0x42, 0x9e, 0x3a, 0x13, 0x4c, 0x66, 0xb4, 0x37, 0xc2,
-	0xce, 0x70, 0x44, 0xd9, 0xc0, 0xb3, 0x3b, 0xfb, 0xce, 0x6f, 0xa2, 0xf9, 0x49, 0xe5, 0x8c, 0x29,
-	0x6a, 0xf7, 0xc3, 0x65, 0xdd, 0xb4, 0x63, 0xd7, 0x5f, 0x37, 0x73, 0x02, 0xe0, 0x6a, 0x64, 0xc0,
-	0x61, 0xa4, 0x08, 0x7f, 0x7d, 0xda, 0x9f, 0x4c, 0x95, 0x74, 0x38, 0xae, 0xda, 0xbd, 0x55, 0xa0,
-	0x29, 0xbb, 0x41, 0xd6, 0x12, 0xdd, 0x2c, 0x08, 0xac, 0x1b, 0x41, 0x62, 0x03, 0xfe, 0x90, 0x20,
-	0xcb, 0x37, 0x80, 0xfd, 0x67, 0xff, 0x87, 0xd5, 0xdb, 0xd9, 0xb4, 0xf8, 0xad, 0x4d, 0x8b, 0xba,
-	0x2a, 0x6f, 0xba, 0x2a, 0x0a, 0x6d, 0xc3, 0xc1, 0xa9, 0xf5, 0xda, 0x25, 0x97, 0x63, 0x6c, 0x0f,
-	0xf1, 0x04, 0xbb, 0x14, 0x29, 0x90, 0xf4, 0xb0, 0x3f, 0x1b, 0x53, 0xe5, 0x28, 0x94, 0x9f, 0xc5,
-	0x4c, 0x61, 0xa3, 0x22, 0x24, 0xb0, 0xe7, 0x11, 0x4f, 0x29, 0x86, 0x35, 0x9d, 0xc5, 0x4c, 0x6e,
-	0xd6, 0x00, 0xd2, 0x1e, 0xf6, 0xa7, 0xc4, 0xf5, 0xf1, 0xc3, 0x3f, 0x25, 0x48, 0x74, 0xc4, 0x05,
-	0xa5, 0x75, 0xba, 0xa7, 0xdd, 0x66, 0xef, 0xa2, 0x65, 0xb4, 0x8c, 0xae, 0x71, 0xfa, 0xd2, 0x78,
-	0xd5, 0x6c, 0xf4, 0x2e, 0x5a, 0x9d, 0xf3, 0x66, 0xdd, 0x78, 0x61, 0x34, 0x1b, 0x85, 0x98, 0x7a,
-	0xb8, 0x58, 0x96, 0x73, 0x3b, 0x02, 0xa4, 0x00, 0x70, 0xbf, 0x10, 0x2c, 0x48, 0x6a, 0x7a, 0xb1,
-	0x2c, 0xcb, 0xe1, 0x19, 0x95, 0x20, 0xc7, 0x99, 0xae, 0xf9, 0x43, 0xfb, 0xbc, 0xd9, 0x2a, 0xec,
-	0xa9, 0xd9, 0xc5, 0xb2, 0x9c, 0x12, 0xe6, 0xc6, 0x93, 0x91, 0x71, 0xee, 0xc9, 0x98, 0x7b, 0xb0,
-	0xcf, 0x99, 0xfa, 0xcb, 0x76, 0xa7, 0xd9, 0x28, 0xc8, 0x2a, 0x2c, 0x96, 0xe5, 0x24, 0xb7, 0x54,
-	0xf9, 0xcd, 0x6f, 0xa5, 0xd8, 0xc3, 0x4b, 0x48, 0xb0, 0xbb, 0x12, 0x7d, 0x09, 0xc5, 0xb6, 0xd9,
-	0x68, 0x9a, 0xbd, 0x56, 0xbb, 0xd5, 0xbc, 0x55, 0x2f, 0x0b, 0x19, 0xe2, 0x48, 0x87, 0x03, 0xae,
-	0xba, 0x68, 0xb1, 0xdf, 0x66, 0xa3, 0x20, 0xa9, 0xb9, 0xc5, 0xb2, 0x9c, 0x59, 0x03, 0x61, 0xc1,
-	0x5c, 0x13, 0x29, 0x44, 0xc1, 0xc2, 0xe4, 0x89, 0x6b, 0x9d, 0xb7, 0xd7, 0x25, 0xe9, 0xdd, 0x75,
-	0x49, 0xfa, 0xfb, 0xba, 0x24, 0xfd, 0x72, 0x53, 0x8a, 0xbd, 0xbb, 0x29, 0xc5, 0xfe, 0xba, 0x29,
-	0xc5, 0x5e, 0x7d, 0x3b, 0x74, 0xe8, 0x68, 0x36, 0xa8, 0x58, 0x64, 0x52, 0xb5, 0x88, 0x3f, 0x21,
-	0x7e, 0xd5, 0x19, 0x58, 0x8f, 0x86, 0xa4, 0x3a, 0x7f, 0x5a, 0x9d, 0x10, 0x7b, 0x36, 0xc6, 0x3e,
-	0x7f, 0x94, 0x1f, 0x3f, 0x7b, 0x14, 0xbd, 0xf2, 0xf4, 0x6a, 0x8a, 0xfd, 0x41, 0x92, 0xbd, 0xca,
-	0x4f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x31, 0x31, 0xe5, 0x06, 0x08, 0x00, 0x00,
+	// 1186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0xb7, 0x1c, 0xc5, 0xb1, 0x99, 0x38, 0x71, 0xd8, 0xc6, 0x55, 0xb5, 0xc6, 0x72, 0xb5, 0x01,
+	0x0b, 0x5a, 0xd4, 0xee, 0x3f, 0x6c, 0x58, 0x4f, 0x8b, 0x12, 0x17, 0x31, 0x5a, 0xd8, 0x01, 0xed,
+	0x1e, 0x56, 0x60, 0xd0, 0x64, 0x89, 0xb3, 0x85, 0xd8, 0xa2, 0x27, 0xd1, 0x2e, 0x72, 0xdc, 0x65,
+	0x28, 0x72, 0xda, 0x17, 0x08, 0x30, 0x60, 0xd8, 0xbe, 0xc2, 0x2e, 0xfb, 0x00, 0x3d, 0xf6, 0xb6,
+	0x9d, 0x84, 0xa1, 0x3d, 0xec, 0xae, 0x2f, 0xb0, 0x41, 0x24, 0x65, 0x5b, 0x6e, 0xd6, 0x0d, 0x1b,
+	0xd0, 0xd3, 0x4e, 0xe2, 0x7b, 0xbf, 0xdf, 0xfb, 0xc3, 0xf7, 0x1e, 0x45, 0x82, 0xeb, 0x6e, 0xcf,
+	0xae, 0xdb, 0xc4, 0xc7, 0x75, 0x7b, 0x60, 0x79, 0x1e, 0x1e, 0xd6, 0xa7, 0x77, 0x92, 0x65, 0x6d,
+	0xec, 0x13, 0x4a, 0xe0, 0x25, 0xb7, 0x67, 0xd7, 0x62, 0x4a, 0x2d, 0xd1, 0x4f, 0xef, 0xa8, 0x97,
+	0xfb, 0xa4, 0x4f, 0x18, 0x5e, 0x8f, 0x57, 0x9c, 0xaa, 0x6a, 0x73, 0x6f, 0x43, 0x17, 0x7b, 0x94,
+	0x39, 0x63, 0x2b, 0x4e, 0xd0, 0x7f, 0xc8, 0x82, 0xb5, 0x03, 0xee, 0x05, 0xde, 0x06, 0xab, 0x01,
+	0xb5, 0x28, 0x56, 0xa4, 0xaa, 0xb4, 0xb7, 0x79, 0x57, 0xad, 0x5d, 0x10, 0xa7, 0xd6, 0x89, 0x19,
+	0x88, 0x13, 0xe1, 0x47, 0x20, 0x4f, 0x7c, 0x07, 0xfb, 0xae, 0xd7, 0x57, 0xb2, 0x6f, 0x31, 0x6a,
+	0xc7, 0x24, 0x34, 0xe3, 0xc2, 0x47, 0x60, 0xc3, 0x26, 0x13, 0x8f, 0x62, 0x7f, 0x6c, 0xf9, 0xf4,
+	0x54, 0x59, 0xa9, 0x4a, 0x7b, 0xeb, 0x77, 0xaf, 0x5f, 0x68, 0x7b, 0xb0, 0x40, 0x34, 0xe4, 0x17,
+	0xa1, 0x96, 0x41, 0x29, 0x63, 0x78, 0x00, 0xb6, 0x6c, 0xe2, 0x79, 0xd8, 0xa6, 0x2e, 0xf1, 0xcc,
+	0x01, 0x19, 0x07, 0x8a, 0x5c, 0x5d, 0xd9, 0x2b, 0x18, 0x6a, 0x14, 0x6a, 0xe5, 0x53, 0x6b, 0x34,
+	0x7c, 0xa0, 0x2f, 0x11, 0x74, 0xb4, 0x39, 0xd7, 0x1c, 0x91, 0x71, 0x00, 0x15, 0xb0, 0x36, 0xc5,
+	0x7e, 0xe0, 0x12, 0x4f, 0x59, 0xad, 0x4a, 0x7b, 0x05, 0x94, 0x88, 0x0f, 0xe4, 0xe7, 0xdf, 0x69,
+	0x19, 0xfd, 0xf7, 0x2c, 0xd8, 0x6e, 0x3a, 0xd8, 0xa3, 0xee, 0x97, 0x2e, 0x76, 0xfe, 0xaf, 0xd8,
+	0x5b, 0x2a, 0x06, 0xaf, 0x80, 0xb5, 0x31, 0xf1, 0xa9, 0xe9, 0x3a, 0x4a, 0x8e, 0x21, 0xb9, 0x58,
+	0x6c, 0x3a, 0x70, 0x17, 0x00, 0x91, 0x66, 0x8c, 0xad, 0x31, 0xac, 0x20, 0x34, 0x4d, 0x47, 0x54,
+	0xfa, 0x19, 0xd8, 0x58, 0xdc, 0x00, 0xbc, 0x39, 0xf7, 0x16, 0x57, 0xb9, 0x60, 0xc0, 0x28, 0xd4,
+	0x36, 0x79, 0x92, 0x02, 0xd0, 0x67, 0x11, 0xee, 0xa7, 0x22, 0x64, 0x19, 0x7f, 0x27, 0x0a, 0xb5,
+	0x6d, 0xb1, 0xa9, 0x19, 0xa6, 0xbf, 0x19, 0xf8, 0x8f, 0x15, 0x90, 0x3b, 0xb6, 0xec, 0x13, 0x4c,
+	0xa1, 0x0a, 0xf2, 0x01, 0xfe, 0x6a, 0x82, 0x3d, 0x9b, 0xb7, 0x56, 0x46, 0x33, 0x19, 0x7e, 0x0c,
+	0xd6, 0x03, 0x32, 0xf1, 0x6d, 0x6c, 0xc6, 0x31, 0x45, 0x8c, 0x72, 0x14, 0x6a, 0x90, 0xc7, 0x58,
+	0x00, 0x75, 0x04, 0xb8, 0x74, 0x4c, 0x7c, 0x0a, 0x3f, 0x05, 0x9b, 0x02, 0x13, 0x91, 0x59, 0x13,
+	0x0b, 0xc6, 0xd5, 0x28, 0xd4, 0x76, 0x52, 0xb6, 0x02, 0xd7, 0x51, 0x91, 0x2b, 0x92, 0x71, 0x7b,
+	0x08, 0x4a, 0x0e, 0x0e, 0xa8, 0xeb, 0x59, 0xac, 0x2f, 0x2c, 0xbe, 0xcc, 0x7c, 0xbc, 0x17, 0x85,
+	0xda, 0x15, 0xee, 0x63, 0x99, 0xa1, 0xa3, 0xad, 0x05, 0x15, 0xcb, 0xa4, 0x0d, 0x2e, 0x2d, 0xb2,
+	0x92, 0x74, 0x58, 0x1b, 0x8d, 0x4a, 0x14, 0x6a, 0xea, 0x9b, 0xae, 0x66, 0x39, 0xc1, 0x05, 0x6d,
+	0x92, 0x18, 0x04, 0xb2, 0x63, 0x51, 0x8b, 0xb5, 0x7b, 0x03, 0xb1, 0x35, 0xfc, 0x02, 0x6c, 0x52,
+	0x77, 0x84, 0xc9, 0x84, 0x9a, 0x03, 0xec, 0xf6, 0x07, 0x94, 0x35, 0x7c, 0x3d, 0x35, 0xef, 0xfc,
+	0x4f, 0x34, 0xbd, 0x53, 0x3b, 0x62, 0x0c, 0x63, 0x37, 0x1e, 0xd6, 0x79, 0x39, 0xd2, 0xf6, 0x3a,
+	0x2a, 0x0a, 0x05, 0x67, 0xc3, 0x26, 0xd8, 0x4e, 0x18, 0xf1, 0x37, 0xa0, 0xd6, 0x68, 0xac, 0xe4,
+	0xe3, 0x76, 0x19, 0xd7, 0xa2, 0x50, 0x53, 0xd2, 0x4e, 0x66, 0x14, 0x1d, 0x95, 0x84, 0xae, 0x9b,
+	0xa8, 0xc4, 0x04, 0xfc, 0x28, 0x81, 0x75, 0x3e, 0x01, 0xec, 0xcc, 0xbe, 0x83, 0xd1, 0x4b, 0x4d,
+	0xda, 0xca, 0xd2, 0xa4, 0x25, 0x55, 0x95, 0xe7, 0x55, 0x15, 0x89, 0xb6, 0xc1, 0xd6, 0xbe, 0x7d,
+	0xe2, 0x91, 0x67, 0x43, 0xec, 0xf4, 0xf1, 0x08, 0x7b, 0x14, 0x2a, 0x20, 0xe7, 0xe3, 0x60, 0x32,
+	0xa4, 0xca, 0x4e, 0x4c, 0x3f, 0xca, 0x20, 0x21, 0xc3, 0x32, 0x58, 0xc5, 0xbe, 0x4f, 0x7c, 0xa5,
+	0x1c, 0xe7, 0x74, 0x94, 0x41, 0x5c, 0x34, 0x00, 0xc8, 0xfb, 0x38, 0x18, 0x13, 0x2f, 0xc0, 0xfa,
+	0x37, 0x6c, 0xf6, 0x7d, 0x6b, 0x14, 0xc0, 0x47, 0x00, 0xfa, 0x98, 0x5a, 0xae, 0x67, 0x8e, 0x59,
+	0x29, 0x4c, 0x96, 0x43, 0xbc, 0xff, 0xbc, 0xb1, 0x1b, 0x85, 0xda, 0x55, 0xbe, 0x9f, 0x37, 0x39,
+	0x3a, 0x2a, 0x71, 0x25, 0x2f, 0xe1, 0x61, 0x3c, 0x04, 0x9f, 0x03, 0x65, 0xe0, 0x06, 0x94, 0xf8,
+	0xae, 0x6d, 0x0d, 0x4d, 0xcb, 0x3e, 0x31, 0x7d, 0x4c, 0xe3, 0xbf, 0x28, 0xf1, 0x58, 0x89, 0x64,
+	0xe3, 0xfd, 0x28, 0xd4, 0x34, 0xee, 0xf2, 0xaf, 0x98, 0x3a, 0x2a, 0xcf, 0xa1, 0x7d, 0xfb, 0x04,
+	0x25, 0x00, 0x7c, 0x0a, 0xae, 0x24, 0x75, 0xe5, 0x8c, 0x53, 0x13, 0x7b, 0x56, 0x6f, 0x88, 0x1d,
+	0x56, 0xcc, 0xbc, 0xa1, 0x47, 0xa1, 0x56, 0x49, 0x37, 0x60, 0x89, 0xa8, 0xa3, 0x1d, 0x81, 0x1c,
+	0x71, 0xa0, 0xc1, 0xf5, 0x90, 0x82, 0xaa, 0x3d, 0x24, 0x01, 0x76, 0xcc, 0x65, 0xcb, 0xf9, 0x16,
+	0x64, 0xb6, 0x85, 0x9b, 0x51, 0xa8, 0x7d, 0x28, 0x82, 0xfc, 0x8d, 0x85, 0x8e, 0x76, 0x39, 0xe5,
+	0x20, 0x15, 0x73, 0xb6, 0x23, 0xfd, 0x67, 0x09, 0x14, 0x79, 0xfd, 0xba, 0x7c, 0x46, 0x2f, 0x38,
+	0x47, 0xd2, 0xbb, 0x38, 0x47, 0xd9, 0x7f, 0x73, 0x8e, 0xf4, 0xaf, 0x25, 0x50, 0x3c, 0x5a, 0xec,
+	0x15, 0xdc, 0x03, 0x5b, 0x56, 0x7a, 0x54, 0x59, 0xfe, 0x1b, 0x68, 0x59, 0x1d, 0x5f, 0x28, 0xc1,
+	0xc4, 0xb6, 0x71, 0x10, 0xb0, 0xe0, 0x79, 0x94, 0x88, 0xf0, 0x72, 0x32, 0xc1, 0xec, 0x87, 0x29,
+	0xe6, 0x17, 0x96, 0x41, 0x4e, 0x14, 0x84, 0xb5, 0x01, 0x09, 0x49, 0xff, 0x45, 0x02, 0x65, 0x51,
+	0x5e, 0x76, 0x8c, 0xbb, 0xbe, 0xe5, 0x05, 0x2e, 0x9b, 0x97, 0xd8, 0xd1, 0x34, 0x49, 0xa1, 0x80,
+	0xb8, 0x30, 0xbf, 0xc5, 0xb3, 0xff, 0xf4, 0x16, 0xbf, 0xe0, 0x02, 0x5d, 0xf9, 0x2f, 0x17, 0xa8,
+	0x9c, 0xbe, 0x40, 0xe7, 0x3b, 0x5b, 0x5d, 0xdc, 0xd9, 0x8d, 0x9f, 0x24, 0xb0, 0xda, 0x11, 0xcf,
+	0x08, 0xad, 0xd3, 0xdd, 0xef, 0x36, 0xcc, 0x27, 0xad, 0x66, 0xab, 0xd9, 0x6d, 0xee, 0x3f, 0x6e,
+	0x3e, 0x6d, 0x1c, 0x9a, 0x4f, 0x5a, 0x9d, 0xe3, 0xc6, 0x41, 0xf3, 0x61, 0xb3, 0x71, 0x58, 0xca,
+	0xa8, 0xdb, 0x67, 0xe7, 0xd5, 0x62, 0x8a, 0x00, 0x15, 0x00, 0xb8, 0x5d, 0xac, 0x2c, 0x49, 0x6a,
+	0xfe, 0xec, 0xbc, 0x2a, 0xc7, 0x6b, 0x58, 0x01, 0x45, 0x8e, 0x74, 0xd1, 0x67, 0xed, 0xe3, 0x46,
+	0xab, 0x94, 0x55, 0xd7, 0xcf, 0xce, 0xab, 0x6b, 0x42, 0x9c, 0x5b, 0x32, 0x70, 0x85, 0x5b, 0x32,
+	0xe4, 0x1a, 0xd8, 0xe0, 0xc8, 0xc1, 0xe3, 0x76, 0xa7, 0x71, 0x58, 0x92, 0x55, 0x70, 0x76, 0x5e,
+	0xcd, 0x71, 0x49, 0x95, 0x9f, 0x7f, 0x5f, 0xc9, 0xdc, 0x78, 0x06, 0x56, 0xd9, 0x8b, 0x06, 0x7e,
+	0x00, 0xca, 0x6d, 0x74, 0xd8, 0x40, 0x66, 0xab, 0xdd, 0x6a, 0x2c, 0xe5, 0xcb, 0x5c, 0xc6, 0x7a,
+	0xa8, 0x83, 0x2d, 0xce, 0x7a, 0xd2, 0x62, 0xdf, 0xc6, 0x61, 0x49, 0x52, 0x8b, 0x67, 0xe7, 0xd5,
+	0xc2, 0x4c, 0x11, 0x27, 0xcc, 0x39, 0x09, 0x43, 0x24, 0x2c, 0x44, 0x1e, 0xd8, 0xe8, 0xbc, 0x78,
+	0x55, 0x91, 0x5e, 0xbe, 0xaa, 0x48, 0xbf, 0xbd, 0xaa, 0x48, 0xdf, 0xbe, 0xae, 0x64, 0x5e, 0xbe,
+	0xae, 0x64, 0x7e, 0x7d, 0x5d, 0xc9, 0x3c, 0xfd, 0xa4, 0xef, 0xd2, 0xc1, 0xa4, 0x57, 0xb3, 0xc9,
+	0xa8, 0x6e, 0x93, 0x60, 0x44, 0x82, 0xba, 0xdb, 0xb3, 0x6f, 0xf5, 0x49, 0x7d, 0x7a, 0xaf, 0x3e,
+	0x22, 0xce, 0x64, 0x88, 0x03, 0xfe, 0x74, 0xbe, 0x7d, 0xff, 0x56, 0xf2, 0x16, 0xa7, 0xa7, 0x63,
+	0x1c, 0xf4, 0x72, 0xec, 0xed, 0x7c, 0xef, 0xcf, 0x01, 0x00, 0x9e, 0xf2, 0xd3, 0x19, 0xac, 0x0b,
+	0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -877,97 +1198,296 @@ func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0xb2
 	return len(dAtA) - i, nil
 }
-func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovChannel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Channel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.State != 0 {
-		n += 1 + sovChannel(uint64(m.State))
-	}
-	if m.Ordering != 0 {
-		n += 1 + sovChannel(uint64(m.Ordering))
-	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovChannel(uint64(l))
-	if len(m.ConnectionHops) > 0 {
-		for _, s := range m.ConnectionHops {
-			l = len(s)
-			n += 1 + l + sovChannel(uint64(l))
-		}
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
-	}
-	return n
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IdentifiedChannel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.State != 0 {
-		n += 1 + sovChannel(uint64(m.State))
-	}
-	if m.Ordering != 0 {
-		n += 1 + sovChannel(uint64(m.Ordering))
+	if m.ClosedChannelHistoryRetention != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.ClosedChannelHistoryRetention))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovChannel(uint64(l))
-	if len(m.ConnectionHops) > 0 {
-		for _, s := range m.ConnectionHops {
-			l = len(s)
-			n += 1 + l + sovChannel(uint64(l))
+	if m.ChannelHistoryEnabled {
+		i--
+		if m.ChannelHistoryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if m.HistoricalAckRetention != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.HistoricalAckRetention))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if m.RetainPacketData {
+		i--
+		if m.RetainPacketData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Counterparty) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PacketTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Packet) Size() (n int) {
+func (m *HistoricalAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelStateTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelStateTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelStateTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionHops) > 0 {
+		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ConnectionHops[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.State != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Channel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovChannel(uint64(m.State))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovChannel(uint64(m.Ordering))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func (m *IdentifiedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovChannel(uint64(m.State))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovChannel(uint64(m.Ordering))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func (m *Counterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func (m *Packet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1062,6 +1582,92 @@ func (m *Acknowledgement_Error) Size() (n int) {
 	n += 2 + l + sovChannel(uint64(l))
 	return n
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetainPacketData {
+		n += 2
+	}
+	if m.HistoricalAckRetention != 0 {
+		n += 1 + sovChannel(uint64(m.HistoricalAckRetention))
+	}
+	if m.ChannelHistoryEnabled {
+		n += 2
+	}
+	if m.ClosedChannelHistoryRetention != 0 {
+		n += 1 + sovChannel(uint64(m.ClosedChannelHistoryRetention))
+	}
+	return n
+}
+
+func (m *PacketTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovChannel(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *HistoricalAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovChannel(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ChannelStateTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovChannel(uint64(m.State))
+	}
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovChannel(uint64(m.Height))
+	}
+	return n
+}
 
 func sovChannel(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2182,6 +2788,575 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainPacketData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetainPacketData = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalAckRetention", wireType)
+			}
+			m.HistoricalAckRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalAckRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelHistoryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChannelHistoryEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedChannelHistoryRetention", wireType)
+			}
+			m.ClosedChannelHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedChannelHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelStateTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelStateTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelStateTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipChannel(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelCloseInit{},
 		&MsgChannelCloseConfirm{},
 		&MsgRecvPacket{},
+		&MsgRecvPacketWithUpdate{},
 		&MsgAcknowledgement{},
 		&MsgTimeout{},
 		&MsgTimeoutOnClose{},
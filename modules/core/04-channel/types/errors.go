@@ -37,5 +37,9 @@ var (
 	// Perform a no-op on the current Msg
 	ErrNoOpMsg = sdkerrors.Register(SubModuleName, 23, "message is redundant, no-op will be performed")
 
-	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
+	ErrInvalidChannelVersion  = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
+	ErrPacketDataNotFound     = sdkerrors.Register(SubModuleName, 25, "packet data not found")
+	ErrHistoricalAckNotFound  = sdkerrors.Register(SubModuleName, 26, "historical acknowledgement not found")
+	ErrPacketTimeoutNotFound  = sdkerrors.Register(SubModuleName, 27, "packet timeout not found")
+	ErrChannelHistoryNotFound = sdkerrors.Register(SubModuleName, 28, "channel history not found")
 )
@@ -37,6 +37,11 @@ const (
 	AttributeKeyDstChannel       = "packet_dst_channel"
 	AttributeKeyChannelOrdering  = "packet_channel_ordering"
 	AttributeKeyConnection       = "packet_connection"
+	AttributeKeyTimeoutType      = "packet_timeout_type"
+
+	// timeout types of the timeout packet event
+	AttributeValueTimeoutTypeTimeout        = "timeout"
+	AttributeValueTimeoutTypeTimeoutOnClose = "timeout_on_close"
 )
 
 // IBC channel events vars
@@ -48,5 +53,7 @@ var (
 	EventTypeChannelCloseInit    = "channel_close_init"
 	EventTypeChannelCloseConfirm = "channel_close_confirm"
 
+	EventTypeChannelConnectionMigrated = "channel_connection_migrated"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
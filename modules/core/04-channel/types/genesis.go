@@ -44,7 +44,7 @@ func (ps PacketSequence) Validate() error {
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
-	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64,
+	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64, params Params,
 ) GenesisState {
 	return GenesisState{
 		Channels:            channels,
@@ -54,6 +54,7 @@ func NewGenesisState(
 		RecvSequences:       recvSeqs,
 		AckSequences:        ackSeqs,
 		NextChannelSequence: nextChannelSequence,
+		Params:              params,
 	}
 }
 
@@ -68,6 +69,7 @@ func DefaultGenesisState() GenesisState {
 		RecvSequences:       []PacketSequence{},
 		AckSequences:        []PacketSequence{},
 		NextChannelSequence: 0,
+		Params:              DefaultParams(),
 	}
 }
 
@@ -139,6 +141,10 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -34,6 +34,7 @@ type GenesisState struct {
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences" yaml:"ack_sequences"`
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty" yaml:"next_channel_sequence"`
+	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +126,13 @@ func (m *GenesisState) GetNextChannelSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,39 +203,41 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0x87, 0xe3, 0x36, 0x4d, 0xd3, 0x6d, 0x13, 0xd1, 0x6d, 0x23, 0x99, 0xa8, 0xd8, 0xc6, 0x48,
-	0x28, 0x12, 0xaa, 0x4d, 0x69, 0x2f, 0x70, 0x34, 0x07, 0xc8, 0x0d, 0x6d, 0x39, 0x21, 0xa1, 0xc8,
-	0x5e, 0x4f, 0xdd, 0x55, 0x62, 0x6f, 0xf0, 0x6e, 0x02, 0x7d, 0x0a, 0x78, 0xac, 0x1e, 0x7b, 0xe4,
-	0x64, 0xa1, 0xe4, 0x0d, 0x72, 0xe4, 0x84, 0xfc, 0x37, 0x89, 0x1a, 0x21, 0xca, 0xcd, 0x3b, 0xf3,
-	0x9b, 0xef, 0x9b, 0x83, 0x07, 0x3d, 0x65, 0x1e, 0xb5, 0x29, 0x8f, 0xc1, 0xa6, 0xd7, 0x6e, 0x14,
-	0xc1, 0xc8, 0x9e, 0x9e, 0xd9, 0x01, 0x44, 0x20, 0x98, 0xb0, 0xc6, 0x31, 0x97, 0x1c, 0x1f, 0x31,
-	0x8f, 0x5a, 0x69, 0xc4, 0x2a, 0x22, 0xd6, 0xf4, 0xac, 0x7b, 0x1c, 0xf0, 0x80, 0x67, 0x7d, 0x3b,
-	0xfd, 0xca, 0xa3, 0xdd, 0x8d, 0xb4, 0x72, 0x2a, 0x8b, 0x98, 0xf3, 0x1d, 0x74, 0xf0, 0x2e, 0xe7,
-	0x5f, 0x4a, 0x57, 0x02, 0xfe, 0x8c, 0x9a, 0x45, 0x42, 0xa8, 0x8a, 0xb1, 0xdd, 0xdb, 0x7f, 0xf5,
-	0xdc, 0xda, 0x60, 0xb4, 0xfa, 0x3e, 0x44, 0x92, 0x5d, 0x31, 0xf0, 0xdf, 0xe6, 0x45, 0xe7, 0xf1,
-	0x6d, 0xa2, 0xd7, 0x7e, 0x27, 0xfa, 0xe1, 0xbd, 0x16, 0xa9, 0x90, 0x98, 0xa0, 0x47, 0x2e, 0x1d,
-	0x46, 0xfc, 0xeb, 0x08, 0xfc, 0x00, 0x42, 0x88, 0xa4, 0x50, 0xb7, 0x32, 0x8d, 0xb1, 0x51, 0xf3,
-	0xc1, 0xa5, 0x43, 0x90, 0xd9, 0x6a, 0x4e, 0x3d, 0x15, 0x90, 0x7b, 0xf3, 0xf8, 0x3d, 0xda, 0xa7,
-	0x3c, 0x0c, 0x99, 0xcc, 0x71, 0xdb, 0x0f, 0xc2, 0xad, 0x8e, 0x62, 0x07, 0x35, 0x63, 0xa0, 0xc0,
-	0xc6, 0x52, 0xa8, 0xf5, 0x07, 0x61, 0xaa, 0x39, 0xcc, 0x50, 0x5b, 0x40, 0xe4, 0x0f, 0x04, 0x7c,
-	0x99, 0x40, 0x44, 0x41, 0xa8, 0x3b, 0x19, 0xe9, 0xd9, 0xdf, 0x48, 0x45, 0xd6, 0x79, 0x92, 0xc2,
-	0x16, 0x89, 0xde, 0xb9, 0x71, 0xc3, 0xd1, 0x1b, 0x73, 0x1d, 0x64, 0x92, 0x56, 0x5a, 0x28, 0xc3,
-	0x99, 0x2a, 0x06, 0x3a, 0x5d, 0x51, 0x35, 0xfe, 0x5b, 0xb5, 0x0e, 0x32, 0x49, 0x2b, 0x2d, 0x2c,
-	0x55, 0x57, 0xa8, 0xe5, 0xd2, 0xe1, 0x8a, 0x69, 0xf7, 0xdf, 0x4d, 0x27, 0x85, 0xe9, 0x38, 0x37,
-	0xad, 0x71, 0x4c, 0x72, 0xe0, 0xd2, 0xe1, 0xd2, 0xf3, 0x11, 0x75, 0x22, 0xf8, 0x26, 0x07, 0x05,
-	0xad, 0x0a, 0xaa, 0x4d, 0x43, 0xe9, 0xd5, 0x1d, 0x63, 0x91, 0xe8, 0x27, 0x39, 0x66, 0x63, 0xcc,
-	0x24, 0x47, 0x69, 0xbd, 0xf8, 0xef, 0x4a, 0xac, 0xf9, 0x5d, 0x41, 0xed, 0xf5, 0xa5, 0xf0, 0x0b,
-	0xb4, 0x3b, 0xe6, 0xb1, 0x1c, 0x30, 0x5f, 0x55, 0x0c, 0xa5, 0xb7, 0xe7, 0xe0, 0x45, 0xa2, 0xb7,
-	0x73, 0x74, 0xd1, 0x30, 0x49, 0x23, 0xfd, 0xea, 0xfb, 0xf8, 0x02, 0xa1, 0xd2, 0xc4, 0x7c, 0x75,
-	0x2b, 0xcb, 0x77, 0x16, 0x89, 0x7e, 0x98, 0xe7, 0x97, 0x3d, 0x93, 0xec, 0x15, 0x8f, 0xbe, 0x8f,
-	0xbb, 0xa8, 0x59, 0xad, 0xbf, 0x9d, 0xae, 0x4f, 0xaa, 0xb7, 0x73, 0x79, 0x3b, 0xd3, 0x94, 0xbb,
-	0x99, 0xa6, 0xfc, 0x9a, 0x69, 0xca, 0x8f, 0xb9, 0x56, 0xbb, 0x9b, 0x6b, 0xb5, 0x9f, 0x73, 0xad,
-	0xf6, 0xe9, 0x75, 0xc0, 0xe4, 0xf5, 0xc4, 0xb3, 0x28, 0x0f, 0x6d, 0xca, 0x45, 0xc8, 0x85, 0xcd,
-	0x3c, 0x7a, 0x1a, 0x70, 0x7b, 0x7a, 0x6e, 0x87, 0xdc, 0x9f, 0x8c, 0x40, 0xe4, 0x47, 0xfd, 0xf2,
-	0xe2, 0xb4, 0xbc, 0x6b, 0x79, 0x33, 0x06, 0xe1, 0x35, 0xb2, 0x9b, 0x3e, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x29, 0xa1, 0xc4, 0xd2, 0x46, 0x04, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0xe3, 0x36, 0xa4, 0xc9, 0xa6, 0x89, 0xe8, 0xb6, 0x91, 0x4c, 0x28, 0xb6, 0x31, 0x12,
+	0x8a, 0x84, 0x6a, 0xd3, 0x3f, 0x97, 0x72, 0x34, 0x07, 0xc8, 0x0d, 0xb9, 0x9c, 0x90, 0x50, 0xe4,
+	0xac, 0xa7, 0xee, 0x2a, 0xb1, 0x37, 0x78, 0x37, 0x81, 0x3e, 0x05, 0x3c, 0x01, 0xcf, 0xd3, 0x63,
+	0x8f, 0x9c, 0x2c, 0x94, 0xbc, 0x41, 0x8e, 0x9c, 0x90, 0xed, 0x8d, 0x93, 0xa8, 0x01, 0x51, 0x6e,
+	0xde, 0x99, 0xdf, 0x7c, 0xdf, 0xac, 0x12, 0x2d, 0x7a, 0x4a, 0xfb, 0xc4, 0x26, 0x2c, 0x06, 0x9b,
+	0x5c, 0x79, 0x51, 0x04, 0x43, 0x7b, 0x72, 0x6c, 0x07, 0x10, 0x01, 0xa7, 0xdc, 0x1a, 0xc5, 0x4c,
+	0x30, 0xbc, 0x4f, 0xfb, 0xc4, 0x4a, 0x23, 0x96, 0x8c, 0x58, 0x93, 0xe3, 0xf6, 0x41, 0xc0, 0x02,
+	0x96, 0xf5, 0xed, 0xf4, 0x2b, 0x8f, 0xb6, 0x37, 0xd2, 0x16, 0x53, 0x59, 0xc4, 0xfc, 0x5e, 0x41,
+	0xbb, 0x6f, 0x72, 0xfe, 0x85, 0xf0, 0x04, 0xe0, 0x8f, 0xa8, 0x2a, 0x13, 0x5c, 0x55, 0x8c, 0xed,
+	0x4e, 0xfd, 0xe4, 0xb9, 0xb5, 0xc1, 0x68, 0x75, 0x7d, 0x88, 0x04, 0xbd, 0xa4, 0xe0, 0xbf, 0xce,
+	0x8b, 0xce, 0xa3, 0x9b, 0x44, 0x2f, 0xfd, 0x4a, 0xf4, 0xbd, 0x3b, 0x2d, 0xb7, 0x40, 0x62, 0x17,
+	0x3d, 0xf4, 0xc8, 0x20, 0x62, 0x9f, 0x87, 0xe0, 0x07, 0x10, 0x42, 0x24, 0xb8, 0xba, 0x95, 0x69,
+	0x8c, 0x8d, 0x9a, 0x77, 0x1e, 0x19, 0x80, 0xc8, 0x56, 0x73, 0xca, 0xa9, 0xc0, 0xbd, 0x33, 0x8f,
+	0xdf, 0xa2, 0x3a, 0x61, 0x61, 0x48, 0x45, 0x8e, 0xdb, 0xbe, 0x17, 0x6e, 0x75, 0x14, 0x3b, 0xa8,
+	0x1a, 0x03, 0x01, 0x3a, 0x12, 0x5c, 0x2d, 0xdf, 0x0b, 0x53, 0xcc, 0x61, 0x8a, 0x9a, 0x1c, 0x22,
+	0xbf, 0xc7, 0xe1, 0xd3, 0x18, 0x22, 0x02, 0x5c, 0x7d, 0x90, 0x91, 0x9e, 0xfd, 0x8d, 0x24, 0xb3,
+	0xce, 0x93, 0x14, 0x36, 0x4f, 0xf4, 0xd6, 0xb5, 0x17, 0x0e, 0x5f, 0x99, 0xeb, 0x20, 0xd3, 0x6d,
+	0xa4, 0x85, 0x45, 0x38, 0x53, 0xc5, 0x40, 0x26, 0x2b, 0xaa, 0xca, 0x7f, 0xab, 0xd6, 0x41, 0xa6,
+	0xdb, 0x48, 0x0b, 0x4b, 0xd5, 0x25, 0x6a, 0x78, 0x64, 0xb0, 0x62, 0xda, 0xf9, 0x77, 0xd3, 0xa1,
+	0x34, 0x1d, 0xe4, 0xa6, 0x35, 0x8e, 0xe9, 0xee, 0x7a, 0x64, 0xb0, 0xf4, 0xbc, 0x47, 0xad, 0x08,
+	0xbe, 0x88, 0x9e, 0xa4, 0x15, 0x41, 0xb5, 0x6a, 0x28, 0x9d, 0xb2, 0x63, 0xcc, 0x13, 0xfd, 0x30,
+	0xc7, 0x6c, 0x8c, 0x99, 0xee, 0x7e, 0x5a, 0x97, 0xff, 0xbb, 0x05, 0x16, 0x9f, 0xa3, 0xca, 0xc8,
+	0x8b, 0xbd, 0x90, 0xab, 0x35, 0x43, 0xe9, 0xd4, 0x4f, 0x1e, 0xff, 0x61, 0xed, 0x34, 0x22, 0x7f,
+	0x50, 0x39, 0x60, 0x7e, 0x55, 0x50, 0x73, 0xfd, 0x3e, 0xf8, 0x05, 0xda, 0x19, 0xb1, 0x58, 0xf4,
+	0xa8, 0xaf, 0x2a, 0x86, 0xd2, 0xa9, 0x39, 0x78, 0x9e, 0xe8, 0xcd, 0x7c, 0x2b, 0xd9, 0x30, 0xdd,
+	0x4a, 0xfa, 0xd5, 0xf5, 0xf1, 0x19, 0x42, 0x8b, 0x25, 0xa9, 0xaf, 0x6e, 0x65, 0xf9, 0xd6, 0x3c,
+	0xd1, 0xf7, 0xf2, 0xfc, 0xb2, 0x67, 0xba, 0x35, 0x79, 0xe8, 0xfa, 0xb8, 0x8d, 0xaa, 0xc5, 0xcd,
+	0xb7, 0xd3, 0x9b, 0xbb, 0xc5, 0xd9, 0xb9, 0xb8, 0x99, 0x6a, 0xca, 0xed, 0x54, 0x53, 0x7e, 0x4e,
+	0x35, 0xe5, 0xdb, 0x4c, 0x2b, 0xdd, 0xce, 0xb4, 0xd2, 0x8f, 0x99, 0x56, 0xfa, 0x70, 0x1e, 0x50,
+	0x71, 0x35, 0xee, 0x5b, 0x84, 0x85, 0x36, 0x61, 0x3c, 0x64, 0xdc, 0xa6, 0x7d, 0x72, 0x14, 0x30,
+	0x7b, 0x72, 0x6a, 0x87, 0xcc, 0x1f, 0x0f, 0x81, 0xe7, 0xef, 0xc1, 0xcb, 0xb3, 0xa3, 0xc5, 0x93,
+	0x20, 0xae, 0x47, 0xc0, 0xfb, 0x95, 0xec, 0x39, 0x38, 0xfd, 0x3d, 0x00, 0xab, 0xba, 0xe6, 0x97,
+	0x81, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +260,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if m.NextChannelSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelSequence))
 		i--
@@ -460,6 +480,8 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextChannelSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -775,6 +797,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
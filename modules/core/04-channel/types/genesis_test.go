@@ -67,6 +67,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				2,
+				types.NewParams(true, 0, false, 0),
 			),
 			expPass: true,
 		},
@@ -171,6 +172,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				types.NewParams(true, 0, false, 0),
 			),
 			expPass: false,
 		},
@@ -208,6 +210,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				types.NewParams(true, 0, false, 0),
 			),
 			expPass: false,
 		},
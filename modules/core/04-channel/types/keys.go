@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
@@ -27,8 +28,80 @@ const (
 
 	// ChannelPrefix is the prefix used when creating a channel identifier
 	ChannelPrefix = "channel-"
+
+	// KeyPacketDataPrefix is the key prefix used to store the retained raw data of sent packets
+	KeyPacketDataPrefix = "packetData"
+
+	// KeyPacketTimeoutPrefix is the key prefix used to store the retained timeouts of sent packets
+	KeyPacketTimeoutPrefix = "packetTimeout"
+
+	// KeyHistoricalAckPrefix is the key prefix used to store the results of processed acknowledgements
+	KeyHistoricalAckPrefix = "historicalAck"
+
+	// KeyHistoricalAckHeightPrefix is the key prefix used to index the results of processed acknowledgements
+	// by the block height at which they were processed
+	KeyHistoricalAckHeightPrefix = "historicalAckHeight"
+
+	// KeyChannelHistoryPrefix is the key prefix used to store the recorded state transitions of channels
+	KeyChannelHistoryPrefix = "channelHistory"
+
+	// KeyChannelHistoryClosedHeightPrefix is the key prefix used to index the recorded histories of closed
+	// channels by the block height at which the channels were closed
+	KeyChannelHistoryClosedHeightPrefix = "channelHistoryClosedHeight"
 )
 
+// PacketDataKey returns the store key under which the retained raw data of a sent packet is stored
+func PacketDataKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s/%s/%d", KeyPacketDataPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID, host.KeySequencePrefix, sequence))
+}
+
+// PacketTimeoutKey returns the store key under which the retained timeout of a sent packet is stored
+func PacketTimeoutKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s/%s/%d", KeyPacketTimeoutPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID, host.KeySequencePrefix, sequence))
+}
+
+// HistoricalAckKey returns the store key under which the result of an acknowledgement processed for a sent packet is stored
+func HistoricalAckKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s/%s/%d", KeyHistoricalAckPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID, host.KeySequencePrefix, sequence))
+}
+
+// HistoricalAckHeightPrefix returns the store key prefix under which the results of acknowledgements processed
+// at the provided block height are indexed. The height is big endian encoded so that the index is iterated in
+// ascending height order.
+func HistoricalAckHeightPrefix(height uint64) []byte {
+	return append([]byte(KeyHistoricalAckHeightPrefix+"/"), sdk.Uint64ToBigEndian(height)...)
+}
+
+// HistoricalAckHeightKey returns the store key under which the result of an acknowledgement processed at the
+// provided block height is indexed
+func HistoricalAckHeightKey(height uint64, portID, channelID string, sequence uint64) []byte {
+	return append(HistoricalAckHeightPrefix(height), []byte(fmt.Sprintf("/%s/%s/%s/%s/%s/%d", host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID, host.KeySequencePrefix, sequence))...)
+}
+
+// ChannelHistoryPrefix returns the store key prefix under which the recorded state transitions of a channel are stored
+func ChannelHistoryPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s/", KeyChannelHistoryPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID))
+}
+
+// ChannelHistoryKey returns the store key under which the state transition of a channel with the provided index is
+// stored. The index is big endian encoded so that the history is iterated in the order it was recorded.
+func ChannelHistoryKey(portID, channelID string, index uint64) []byte {
+	return append(ChannelHistoryPrefix(portID, channelID), sdk.Uint64ToBigEndian(index)...)
+}
+
+// ChannelHistoryClosedHeightPrefix returns the store key prefix under which the recorded histories of channels closed
+// at the provided block height are indexed. The height is big endian encoded so that the index is iterated in
+// ascending height order.
+func ChannelHistoryClosedHeightPrefix(height uint64) []byte {
+	return append([]byte(KeyChannelHistoryClosedHeightPrefix+"/"), sdk.Uint64ToBigEndian(height)...)
+}
+
+// ChannelHistoryClosedHeightKey returns the store key under which the recorded history of a channel closed at the
+// provided block height is indexed
+func ChannelHistoryClosedHeightKey(height uint64, portID, channelID string) []byte {
+	return append(ChannelHistoryClosedHeightPrefix(height), []byte(fmt.Sprintf("/%s/%s/%s/%s", host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID))...)
+}
+
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
 // This is a SDK specific format not enforced by IBC protocol.
 func FormatChannelIdentifier(sequence uint64) string {
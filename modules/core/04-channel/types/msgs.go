@@ -3,11 +3,13 @@ package types
 import (
 	"encoding/base64"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 var _ sdk.Msg = &MsgChannelOpenInit{}
@@ -354,6 +356,63 @@ func (msg MsgRecvPacket) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+var (
+	_ sdk.Msg                            = &MsgRecvPacketWithUpdate{}
+	_ codectypes.UnpackInterfacesMessage = MsgRecvPacketWithUpdate{}
+)
+
+// NewMsgRecvPacketWithUpdate constructs new MsgRecvPacketWithUpdate
+// nolint:interfacer
+func NewMsgRecvPacketWithUpdate(
+	packet Packet, proofCommitment []byte, proofHeight clienttypes.Height,
+	header exported.Header, signer string,
+) (*MsgRecvPacketWithUpdate, error) {
+	anyHeader, err := clienttypes.PackHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRecvPacketWithUpdate{
+		Packet:          packet,
+		ProofCommitment: proofCommitment,
+		ProofHeight:     proofHeight,
+		Header:          anyHeader,
+		Signer:          signer,
+	}, nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPacketWithUpdate) ValidateBasic() error {
+	header, err := clienttypes.UnpackHeader(msg.Header)
+	if err != nil {
+		return err
+	}
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+	return msg.RecvPacketMsg().ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRecvPacketWithUpdate) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRecvPacketWithUpdate) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var header exported.Header
+	return unpacker.UnpackAny(msg.Header, &header)
+}
+
+// RecvPacketMsg returns the MsgRecvPacket processed once the client has been updated
+func (msg MsgRecvPacketWithUpdate) RecvPacketMsg() *MsgRecvPacket {
+	return NewMsgRecvPacket(msg.Packet, msg.ProofCommitment, msg.ProofHeight, msg.Signer)
+}
+
 var _ sdk.Msg = &MsgTimeout{}
 
 // NewMsgTimeout constructs new MsgTimeout
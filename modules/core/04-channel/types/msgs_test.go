@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	"github.com/cosmos/ibc-go/v3/testing/simapp"
 )
 
@@ -352,6 +353,49 @@ func (suite *TypesTestSuite) TestMsgRecvPacketGetSigners() {
 	suite.Equal(expected, fmt.Sprintf("%v", res))
 }
 
+func (suite *TypesTestSuite) TestMsgRecvPacketWithUpdateValidateBasic() {
+	coordinator := ibctesting.NewCoordinator(suite.T(), 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(0))
+	header := chain.CurrentTMClientHeader()
+
+	invalidHeader := chain.CurrentTMClientHeader()
+	invalidHeader.ValidatorSet = nil
+
+	testCases := []struct {
+		name    string
+		packet  types.Packet
+		header  exported.Header
+		signer  string
+		expPass bool
+	}{
+		{"success", packet, header, addr, true},
+		{"invalid header", packet, invalidHeader, addr, false},
+		{"missing signer address", packet, header, emptyAddr, false},
+		{"invalid packet", invalidPacket, header, addr, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg, err := types.NewMsgRecvPacketWithUpdate(tc.packet, suite.proof, height, tc.header, tc.signer)
+			suite.Require().NoError(err)
+
+			err = msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	// header must be set
+	msg := &types.MsgRecvPacketWithUpdate{Packet: packet, ProofCommitment: suite.proof, ProofHeight: height, Signer: addr}
+	suite.Require().Error(msg.ValidateBasic())
+}
+
 func (suite *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 	testCases := []struct {
 		name    string
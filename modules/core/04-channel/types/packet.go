@@ -61,6 +61,14 @@ func NewPacket(
 	}
 }
 
+// NewPacketTimeout creates a new PacketTimeout instance recording the provided timeout height and timestamp
+func NewPacketTimeout(timeoutHeight clienttypes.Height, timeoutTimestamp uint64) PacketTimeout {
+	return PacketTimeout{
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
 // GetSequence implements PacketI interface
 func (p Packet) GetSequence() uint64 { return p.Sequence }
 
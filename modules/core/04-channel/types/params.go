@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultRetainPacketData is the default value for the retain packet data parameter (set to false)
+const DefaultRetainPacketData = false
+
+// DefaultHistoricalAckRetention is the default value for the historical ack retention parameter (set to 0, disabled)
+const DefaultHistoricalAckRetention uint64 = 0
+
+// DefaultChannelHistoryEnabled is the default value for the channel history enabled parameter (set to false)
+const DefaultChannelHistoryEnabled = false
+
+// DefaultClosedChannelHistoryRetention is the default value for the closed channel history retention parameter
+// (set to 0, never pruned)
+const DefaultClosedChannelHistoryRetention uint64 = 0
+
+var (
+	// KeyRetainPacketData is store's key for RetainPacketData parameter
+	KeyRetainPacketData = []byte("RetainPacketData")
+	// KeyHistoricalAckRetention is store's key for HistoricalAckRetention parameter
+	KeyHistoricalAckRetention = []byte("HistoricalAckRetention")
+	// KeyChannelHistoryEnabled is store's key for ChannelHistoryEnabled parameter
+	KeyChannelHistoryEnabled = []byte("ChannelHistoryEnabled")
+	// KeyClosedChannelHistoryRetention is store's key for ClosedChannelHistoryRetention parameter
+	KeyClosedChannelHistoryRetention = []byte("ClosedChannelHistoryRetention")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the ibc channel module
+func NewParams(retainPacketData bool, historicalAckRetention uint64, channelHistoryEnabled bool, closedChannelHistoryRetention uint64) Params {
+	return Params{
+		RetainPacketData:              retainPacketData,
+		HistoricalAckRetention:        historicalAckRetention,
+		ChannelHistoryEnabled:         channelHistoryEnabled,
+		ClosedChannelHistoryRetention: closedChannelHistoryRetention,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc channel module
+func DefaultParams() Params {
+	return NewParams(DefaultRetainPacketData, DefaultHistoricalAckRetention, DefaultChannelHistoryEnabled, DefaultClosedChannelHistoryRetention)
+}
+
+// Validate performs basic validation of the channel parameters
+func (p Params) Validate() error {
+	if err := validateRetainPacketData(p.RetainPacketData); err != nil {
+		return err
+	}
+
+	if err := validateHistoricalAckRetention(p.HistoricalAckRetention); err != nil {
+		return err
+	}
+
+	if err := validateChannelHistoryEnabled(p.ChannelHistoryEnabled); err != nil {
+		return err
+	}
+
+	return validateClosedChannelHistoryRetention(p.ClosedChannelHistoryRetention)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRetainPacketData, p.RetainPacketData, validateRetainPacketData),
+		paramtypes.NewParamSetPair(KeyHistoricalAckRetention, p.HistoricalAckRetention, validateHistoricalAckRetention),
+		paramtypes.NewParamSetPair(KeyChannelHistoryEnabled, p.ChannelHistoryEnabled, validateChannelHistoryEnabled),
+		paramtypes.NewParamSetPair(KeyClosedChannelHistoryRetention, p.ClosedChannelHistoryRetention, validateClosedChannelHistoryRetention),
+	}
+}
+
+func validateRetainPacketData(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", false, i)
+	}
+	return nil
+}
+
+func validateHistoricalAckRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(0), i)
+	}
+	return nil
+}
+
+func validateChannelHistoryEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", false, i)
+	}
+	return nil
+}
+
+func validateClosedChannelHistoryRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(0), i)
+	}
+	return nil
+}
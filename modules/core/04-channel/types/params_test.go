@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"packet data retention enabled", types.NewParams(true, 0, false, 0), true},
+		{"historical ack retention enabled", types.NewParams(false, 100, false, 0), true},
+		{"channel history enabled", types.NewParams(false, 0, true, 0), true},
+		{"closed channel history retention enabled", types.NewParams(false, 0, true, 100), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
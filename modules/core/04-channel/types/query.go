@@ -11,6 +11,10 @@ var (
 	_ codectypes.UnpackInterfacesMessage = QueryChannelConsensusStateResponse{}
 )
 
+// MaxAcknowledgementsInRange is the maximum number of packet sequences spanned by the range
+// of a PacketAcknowledgementsInRange query.
+const MaxAcknowledgementsInRange = 1000
+
 // NewQueryChannelResponse creates a new QueryChannelResponse instance
 func NewQueryChannelResponse(channel Channel, proof []byte, height clienttypes.Height) *QueryChannelResponse {
 	return &QueryChannelResponse{
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -591,6 +592,88 @@ func (m *MsgRecvPacketResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRecvPacketResponse proto.InternalMessageInfo
 
+// MsgRecvPacketWithUpdate receives an incoming IBC packet after updating the client of the receiving channel with the
+// provided header. The header is verified and applied before the packet commitment proof is verified, allowing the
+// proof to be verified against the consensus state added by the header.
+type MsgRecvPacketWithUpdate struct {
+	Packet          Packet       `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	ProofCommitment []byte       `protobuf:"bytes,2,opt,name=proof_commitment,json=proofCommitment,proto3" json:"proof_commitment,omitempty" yaml:"proof_commitment"`
+	ProofHeight     types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height" yaml:"proof_height"`
+	// header to update the client of the receiving channel with
+	Header *types1.Any `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	Signer string      `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRecvPacketWithUpdate) Reset()         { *m = MsgRecvPacketWithUpdate{} }
+func (m *MsgRecvPacketWithUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPacketWithUpdate) ProtoMessage()    {}
+func (*MsgRecvPacketWithUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{14}
+}
+func (m *MsgRecvPacketWithUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPacketWithUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPacketWithUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPacketWithUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPacketWithUpdate.Merge(m, src)
+}
+func (m *MsgRecvPacketWithUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPacketWithUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPacketWithUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPacketWithUpdate proto.InternalMessageInfo
+
+// MsgRecvPacketWithUpdateResponse defines the Msg/RecvPacketWithUpdate response type.
+type MsgRecvPacketWithUpdateResponse struct {
+}
+
+func (m *MsgRecvPacketWithUpdateResponse) Reset()         { *m = MsgRecvPacketWithUpdateResponse{} }
+func (m *MsgRecvPacketWithUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPacketWithUpdateResponse) ProtoMessage()    {}
+func (*MsgRecvPacketWithUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{15}
+}
+func (m *MsgRecvPacketWithUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPacketWithUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPacketWithUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPacketWithUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPacketWithUpdateResponse.Merge(m, src)
+}
+func (m *MsgRecvPacketWithUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPacketWithUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPacketWithUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPacketWithUpdateResponse proto.InternalMessageInfo
+
 // MsgTimeout receives timed-out packet
 type MsgTimeout struct {
 	Packet           Packet       `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
@@ -604,7 +687,7 @@ func (m *MsgTimeout) Reset()         { *m = MsgTimeout{} }
 func (m *MsgTimeout) String() string { return proto.CompactTextString(m) }
 func (*MsgTimeout) ProtoMessage()    {}
 func (*MsgTimeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{14}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{16}
 }
 func (m *MsgTimeout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +724,7 @@ func (m *MsgTimeoutResponse) Reset()         { *m = MsgTimeoutResponse{} }
 func (m *MsgTimeoutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTimeoutResponse) ProtoMessage()    {}
 func (*MsgTimeoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{15}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{17}
 }
 func (m *MsgTimeoutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +767,7 @@ func (m *MsgTimeoutOnClose) Reset()         { *m = MsgTimeoutOnClose{} }
 func (m *MsgTimeoutOnClose) String() string { return proto.CompactTextString(m) }
 func (*MsgTimeoutOnClose) ProtoMessage()    {}
 func (*MsgTimeoutOnClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{16}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{18}
 }
 func (m *MsgTimeoutOnClose) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +804,7 @@ func (m *MsgTimeoutOnCloseResponse) Reset()         { *m = MsgTimeoutOnCloseResp
 func (m *MsgTimeoutOnCloseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTimeoutOnCloseResponse) ProtoMessage()    {}
 func (*MsgTimeoutOnCloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{17}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{19}
 }
 func (m *MsgTimeoutOnCloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +846,7 @@ func (m *MsgAcknowledgement) Reset()         { *m = MsgAcknowledgement{} }
 func (m *MsgAcknowledgement) String() string { return proto.CompactTextString(m) }
 func (*MsgAcknowledgement) ProtoMessage()    {}
 func (*MsgAcknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{18}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{20}
 }
 func (m *MsgAcknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +883,7 @@ func (m *MsgAcknowledgementResponse) Reset()         { *m = MsgAcknowledgementRe
 func (m *MsgAcknowledgementResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAcknowledgementResponse) ProtoMessage()    {}
 func (*MsgAcknowledgementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{19}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{21}
 }
 func (m *MsgAcknowledgementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,6 +927,8 @@ func init() {
 	proto.RegisterType((*MsgChannelCloseConfirmResponse)(nil), "ibc.core.channel.v1.MsgChannelCloseConfirmResponse")
 	proto.RegisterType((*MsgRecvPacket)(nil), "ibc.core.channel.v1.MsgRecvPacket")
 	proto.RegisterType((*MsgRecvPacketResponse)(nil), "ibc.core.channel.v1.MsgRecvPacketResponse")
+	proto.RegisterType((*MsgRecvPacketWithUpdate)(nil), "ibc.core.channel.v1.MsgRecvPacketWithUpdate")
+	proto.RegisterType((*MsgRecvPacketWithUpdateResponse)(nil), "ibc.core.channel.v1.MsgRecvPacketWithUpdateResponse")
 	proto.RegisterType((*MsgTimeout)(nil), "ibc.core.channel.v1.MsgTimeout")
 	proto.RegisterType((*MsgTimeoutResponse)(nil), "ibc.core.channel.v1.MsgTimeoutResponse")
 	proto.RegisterType((*MsgTimeoutOnClose)(nil), "ibc.core.channel.v1.MsgTimeoutOnClose")
@@ -855,78 +940,83 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/tx.proto", fileDescriptor_bc4637e0ac3fc7b7) }
 
 var fileDescriptor_bc4637e0ac3fc7b7 = []byte{
-	// 1127 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0xd6, 0x97, 0x65, 0x7b, 0xec, 0x37, 0xb6, 0x29, 0x7f, 0x28, 0x94, 0x2d, 0xfa, 0xe5, 0x21,
-	0x11, 0x52, 0x44, 0x8c, 0x3f, 0x80, 0x22, 0x41, 0x2f, 0x92, 0x81, 0xa2, 0x41, 0xe1, 0xa6, 0xa0,
-	0xdd, 0x1e, 0x8c, 0x02, 0x82, 0xb4, 0xda, 0x50, 0x84, 0x24, 0xae, 0x4a, 0x52, 0x4a, 0xf4, 0x0f,
-	0x7a, 0xcc, 0xb9, 0xa7, 0xf4, 0xdc, 0x43, 0xfa, 0x33, 0x72, 0xcc, 0xa9, 0x2d, 0x7a, 0x20, 0x0a,
-	0xfb, 0xd2, 0x33, 0x7f, 0x41, 0xc1, 0xe5, 0x92, 0xa2, 0x24, 0xb2, 0xa2, 0x92, 0xca, 0xcd, 0x6d,
-	0x39, 0xf3, 0xec, 0xec, 0xec, 0xf3, 0x0c, 0x67, 0x97, 0x84, 0x7d, 0xb5, 0x81, 0x24, 0x44, 0x74,
-	0x2c, 0xa1, 0x56, 0x5d, 0xd3, 0x70, 0x47, 0x1a, 0x1c, 0x49, 0xe6, 0xcb, 0x72, 0x4f, 0x27, 0x26,
-	0xe1, 0x72, 0x6a, 0x03, 0x95, 0x1d, 0x6f, 0x99, 0x79, 0xcb, 0x83, 0x23, 0x7e, 0x5b, 0x21, 0x0a,
-	0xa1, 0x7e, 0xc9, 0x19, 0xb9, 0x50, 0x5e, 0x18, 0x05, 0xea, 0xa8, 0x58, 0x33, 0x9d, 0x38, 0xee,
-	0x88, 0x01, 0xfe, 0x1f, 0xb6, 0x92, 0x17, 0x96, 0x42, 0xc4, 0x9f, 0x92, 0xc0, 0x9d, 0x1b, 0xca,
-	0x99, 0x6b, 0x7c, 0xd6, 0xc3, 0xda, 0x53, 0x4d, 0x35, 0xb9, 0x4f, 0x60, 0xb9, 0x47, 0x74, 0xb3,
-	0xa6, 0x36, 0xf3, 0xc9, 0xc3, 0x64, 0x69, 0xb5, 0xca, 0xd9, 0x96, 0x70, 0x67, 0x58, 0xef, 0x76,
-	0x9e, 0x88, 0xcc, 0x21, 0xca, 0x59, 0x67, 0xf4, 0xb4, 0xc9, 0x7d, 0x06, 0xcb, 0x2c, 0x68, 0x3e,
-	0x75, 0x98, 0x2c, 0xad, 0x1d, 0xef, 0x97, 0x43, 0x36, 0x51, 0x66, 0x6b, 0x54, 0x33, 0x6f, 0x2d,
-	0x21, 0x21, 0x7b, 0x53, 0xb8, 0x5d, 0xc8, 0x1a, 0xaa, 0xa2, 0x61, 0x3d, 0x9f, 0x76, 0x56, 0x92,
-	0xd9, 0xd3, 0x93, 0x95, 0x1f, 0x5e, 0x0b, 0x89, 0xbf, 0x5e, 0x0b, 0x09, 0x71, 0x1f, 0xf8, 0xe9,
-	0x14, 0x65, 0x6c, 0xf4, 0x88, 0x66, 0x60, 0xf1, 0xd7, 0x34, 0x6c, 0x8d, 0xbb, 0x2f, 0xf5, 0xe1,
-	0x7c, 0x1b, 0xf8, 0x0a, 0x72, 0x3d, 0x1d, 0x0f, 0x54, 0xd2, 0x37, 0x6a, 0x2c, 0x2d, 0x67, 0x62,
-	0x8a, 0x4e, 0x2c, 0xda, 0x96, 0xc0, 0xb3, 0x89, 0xd3, 0x20, 0x51, 0xde, 0xf2, 0xac, 0x2c, 0x83,
-	0x71, 0x42, 0xd2, 0xf3, 0x13, 0x22, 0xc3, 0x36, 0x22, 0x7d, 0xcd, 0xc4, 0x7a, 0xaf, 0xae, 0x9b,
-	0xc3, 0xda, 0x00, 0xeb, 0x86, 0x4a, 0xb4, 0x7c, 0x86, 0xa6, 0x23, 0xd8, 0x96, 0x50, 0x70, 0xd3,
-	0x09, 0x43, 0x89, 0x72, 0x2e, 0x68, 0xfe, 0xd6, 0xb5, 0x72, 0xa7, 0x00, 0x3d, 0x9d, 0x90, 0xe7,
-	0x35, 0x55, 0x53, 0xcd, 0xfc, 0xd2, 0x61, 0xb2, 0xb4, 0x5e, 0xdd, 0xb1, 0x2d, 0x61, 0xcb, 0xdb,
-	0x98, 0xe7, 0x13, 0xe5, 0x55, 0xfa, 0x40, 0xab, 0xe0, 0x0a, 0xd6, 0x5d, 0x4f, 0x0b, 0xab, 0x4a,
-	0xcb, 0xcc, 0x67, 0xe9, 0x66, 0xf8, 0xc0, 0x66, 0xdc, 0x6a, 0x1b, 0x1c, 0x95, 0xbf, 0xa0, 0x88,
-	0x6a, 0xc1, 0xd9, 0x8a, 0x6d, 0x09, 0xb9, 0x60, 0x5c, 0x77, 0xb6, 0x28, 0xaf, 0xd1, 0x47, 0x17,
-	0x19, 0x90, 0x7d, 0x39, 0x42, 0xf6, 0x02, 0xdc, 0x9d, 0xd2, 0xd5, 0x57, 0xfd, 0xb7, 0x29, 0xd5,
-	0x2b, 0xa8, 0x3d, 0x9f, 0xea, 0xa7, 0x00, 0x53, 0x62, 0x07, 0x38, 0x09, 0x6a, 0xbc, 0x8a, 0x7c,
-	0x6d, 0xaf, 0x60, 0x6f, 0x8c, 0xf7, 0x40, 0x08, 0x5a, 0xbf, 0x55, 0xd1, 0xb6, 0x84, 0x62, 0x88,
-	0x40, 0xc1, 0x78, 0x3b, 0x41, 0xcf, 0xa8, 0x6e, 0x16, 0xa1, 0xfc, 0x11, 0xb8, 0x82, 0xd6, 0x4c,
-	0x7d, 0xc8, 0x84, 0xdf, 0xb6, 0x2d, 0x61, 0x33, 0x28, 0x90, 0xa9, 0x0f, 0x45, 0x79, 0x85, 0x8e,
-	0x9d, 0x77, 0xe7, 0x23, 0x93, 0xbd, 0x82, 0xda, 0xbe, 0xec, 0x3f, 0xa7, 0x60, 0x67, 0xdc, 0x7b,
-	0x46, 0xb4, 0xe7, 0xaa, 0xde, 0xbd, 0x0d, 0xe9, 0x7d, 0x2a, 0xeb, 0xa8, 0x4d, 0xc5, 0x0e, 0xa1,
-	0xb2, 0x8e, 0xda, 0x1e, 0x95, 0x4e, 0x41, 0x4e, 0x52, 0x99, 0x59, 0x08, 0x95, 0x4b, 0x11, 0x54,
-	0x0a, 0x70, 0x10, 0x4a, 0x96, 0x4f, 0xe7, 0x8f, 0x49, 0xc8, 0x8d, 0x10, 0x67, 0x1d, 0x62, 0xe0,
-	0xf9, 0xdb, 0xff, 0xfb, 0x91, 0x39, 0xbb, 0xed, 0x1f, 0x40, 0x21, 0x24, 0x37, 0x3f, 0xf7, 0x37,
-	0x29, 0xd8, 0x9d, 0xf0, 0xdf, 0x62, 0x2d, 0x8c, 0x37, 0xd4, 0xf4, 0x7b, 0x36, 0xd4, 0xdb, 0x2d,
-	0x87, 0x43, 0x28, 0x86, 0x13, 0xe6, 0x73, 0xfa, 0x2a, 0x05, 0xff, 0x3b, 0x37, 0x14, 0x19, 0xa3,
-	0xc1, 0xd7, 0x75, 0xd4, 0xc6, 0x26, 0xf7, 0x18, 0xb2, 0x3d, 0x3a, 0xa2, 0x4c, 0xae, 0x1d, 0x17,
-	0x42, 0x4f, 0x32, 0x17, 0xcc, 0x0e, 0x32, 0x36, 0x81, 0xfb, 0x1c, 0x36, 0xdd, 0x74, 0x11, 0xe9,
-	0x76, 0x55, 0xb3, 0x8b, 0x35, 0x93, 0xd2, 0xbb, 0x5e, 0x2d, 0xd8, 0x96, 0xb0, 0x17, 0xdc, 0xd0,
-	0x08, 0x21, 0xca, 0x1b, 0xd4, 0x74, 0xe6, 0x5b, 0xa6, 0x48, 0x4b, 0x2f, 0x84, 0xb4, 0x4c, 0x04,
-	0x69, 0x7b, 0xb4, 0xe1, 0x8c, 0x18, 0xf1, 0xb9, 0xfa, 0x23, 0x05, 0x70, 0x6e, 0x28, 0x97, 0x6a,
-	0x17, 0x93, 0xfe, 0xbf, 0x43, 0x54, 0x5f, 0xd3, 0x31, 0xc2, 0xea, 0x00, 0x37, 0xa3, 0x88, 0x1a,
-	0x21, 0x3c, 0xa2, 0xbe, 0xf1, 0x2d, 0x0b, 0x25, 0xea, 0x4b, 0xe0, 0x34, 0xfc, 0xd2, 0xac, 0x19,
-	0xf8, 0xfb, 0x3e, 0xd6, 0x10, 0xae, 0xe9, 0x18, 0x0d, 0x28, 0x69, 0x99, 0xea, 0x81, 0x6d, 0x09,
-	0x77, 0xdd, 0x08, 0xd3, 0x18, 0x51, 0xde, 0x74, 0x8c, 0x17, 0xcc, 0xe6, 0x10, 0x19, 0xa3, 0x54,
-	0xb7, 0xe9, 0xad, 0x94, 0x71, 0x3b, 0x6a, 0x57, 0xee, 0xa1, 0xcf, 0xcc, 0xcf, 0x34, 0x5a, 0xc3,
-	0x1f, 0x03, 0xf3, 0x9f, 0xc2, 0x1a, 0x2b, 0x64, 0x27, 0x23, 0xd6, 0x0e, 0x76, 0x6d, 0x4b, 0xe0,
-	0xc6, 0xaa, 0xdc, 0x71, 0x8a, 0xb2, 0xdb, 0x38, 0xdc, 0xdc, 0x17, 0xd9, 0x10, 0xc2, 0x25, 0x5b,
-	0xfa, 0x50, 0xc9, 0xb2, 0xff, 0x78, 0x6e, 0x8f, 0x6b, 0xe3, 0x2b, 0xf7, 0x4b, 0x8a, 0x0a, 0x5a,
-	0x41, 0x6d, 0x8d, 0xbc, 0xe8, 0xe0, 0xa6, 0x82, 0xe9, 0xab, 0xfd, 0x01, 0xd2, 0x95, 0x60, 0xa3,
-	0x3e, 0x1e, 0xcd, 0x55, 0x4e, 0x9e, 0x34, 0x8f, 0xc4, 0x71, 0x26, 0x36, 0xa3, 0xc4, 0xa1, 0x4e,
-	0x4f, 0x9c, 0x8a, 0xf3, 0xf0, 0x1f, 0x77, 0x6b, 0xf7, 0xab, 0x67, 0x82, 0x31, 0x8f, 0xd0, 0xe3,
-	0x37, 0x2b, 0x90, 0x3e, 0x37, 0x14, 0xae, 0x0d, 0x1b, 0x93, 0xdf, 0x6e, 0xf7, 0x43, 0x49, 0x9c,
-	0xfe, 0x82, 0xe2, 0xa5, 0x98, 0x40, 0x6f, 0x51, 0xae, 0x05, 0x77, 0x26, 0x3e, 0xb3, 0xee, 0xc5,
-	0x08, 0x71, 0xa9, 0x0f, 0xf9, 0x72, 0x3c, 0x5c, 0xc4, 0x4a, 0xce, 0x4d, 0x2a, 0xce, 0x4a, 0x15,
-	0xd4, 0x8e, 0xb5, 0x52, 0xe0, 0x46, 0xc9, 0x99, 0xc0, 0x85, 0xdc, 0x26, 0x1f, 0xc4, 0x88, 0xc2,
-	0xb0, 0xfc, 0x71, 0x7c, 0xac, 0xbf, 0xaa, 0x06, 0x9b, 0x53, 0x97, 0xae, 0xd2, 0x8c, 0x38, 0x3e,
-	0x92, 0x7f, 0x14, 0x17, 0xe9, 0xaf, 0xf7, 0x02, 0x72, 0xa1, 0x17, 0xa5, 0x38, 0x81, 0xbc, 0x7d,
-	0x9e, 0xcc, 0x01, 0xf6, 0x17, 0xfe, 0x0e, 0x20, 0x70, 0x9b, 0x10, 0xa3, 0x42, 0x8c, 0x30, 0xfc,
-	0x83, 0xd9, 0x18, 0x3f, 0xfa, 0x05, 0x2c, 0x7b, 0xe7, 0xaf, 0x10, 0x35, 0x8d, 0x01, 0xf8, 0xfb,
-	0x33, 0x00, 0xc1, 0xda, 0x9b, 0x38, 0x61, 0xee, 0xcd, 0x98, 0xca, 0x70, 0xd1, 0xb5, 0x17, 0xde,
-	0x15, 0x9d, 0x97, 0x77, 0xb2, 0x23, 0x46, 0x66, 0x39, 0x01, 0x8c, 0x7e, 0x79, 0x23, 0x3a, 0x46,
-	0xf5, 0xe2, 0xed, 0x75, 0x31, 0xf9, 0xee, 0xba, 0x98, 0xfc, 0xf3, 0xba, 0x98, 0x7c, 0x75, 0x53,
-	0x4c, 0xbc, 0xbb, 0x29, 0x26, 0x7e, 0xbf, 0x29, 0x26, 0xae, 0x1e, 0x2b, 0xaa, 0xd9, 0xea, 0x37,
-	0xca, 0x88, 0x74, 0x25, 0x44, 0x8c, 0x2e, 0x31, 0x24, 0xb5, 0x81, 0x1e, 0x2a, 0x44, 0x1a, 0x9c,
-	0x48, 0x5d, 0xd2, 0xec, 0x77, 0xb0, 0xe1, 0xfe, 0x46, 0x7a, 0x74, 0xfa, 0xd0, 0xfb, 0x93, 0x64,
-	0x0e, 0x7b, 0xd8, 0x68, 0x64, 0xe9, 0x5f, 0xa4, 0x93, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9b,
-	0xb1, 0x4b, 0xd5, 0xd4, 0x12, 0x00, 0x00,
+	// 1205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xd6, 0x8f, 0x2d, 0xc7, 0x63, 0x37, 0xb6, 0x29, 0xd9, 0x96, 0x29, 0x5b, 0x74, 0x78, 0x48,
+	0x8c, 0x34, 0x21, 0xe3, 0x1f, 0xa0, 0x48, 0xd0, 0x8b, 0x64, 0xa0, 0x68, 0x50, 0xb8, 0x29, 0x68,
+	0xa7, 0x05, 0x8c, 0x02, 0x82, 0xb4, 0x5a, 0x53, 0x84, 0x24, 0xae, 0x4a, 0x52, 0x4a, 0xd4, 0x27,
+	0xe8, 0x31, 0xe7, 0x9e, 0xd2, 0x73, 0x0e, 0xed, 0x63, 0xf8, 0x98, 0x53, 0x5b, 0xf4, 0x40, 0x14,
+	0xf6, 0xa5, 0x67, 0x3d, 0x41, 0xc1, 0xe5, 0x92, 0xa2, 0x24, 0xaa, 0xa2, 0x92, 0xca, 0x0d, 0x7a,
+	0x23, 0x67, 0xbe, 0x9d, 0x99, 0xfd, 0xbe, 0xd5, 0xec, 0x50, 0xb0, 0xad, 0x55, 0x90, 0x8c, 0x88,
+	0x81, 0x65, 0x54, 0x2b, 0xeb, 0x3a, 0x6e, 0xc8, 0x9d, 0x7d, 0xd9, 0x7a, 0x29, 0xb5, 0x0c, 0x62,
+	0x11, 0x2e, 0xad, 0x55, 0x90, 0xe4, 0x78, 0x25, 0xe6, 0x95, 0x3a, 0xfb, 0x7c, 0x46, 0x25, 0x2a,
+	0xa1, 0x7e, 0xd9, 0x79, 0x72, 0xa1, 0xfc, 0x96, 0x4a, 0x88, 0xda, 0xc0, 0x32, 0x7d, 0xab, 0xb4,
+	0x2f, 0xe4, 0xb2, 0xde, 0x65, 0x2e, 0xa1, 0x9f, 0xa3, 0xa1, 0x61, 0xdd, 0x72, 0x52, 0xb8, 0x4f,
+	0x0c, 0x70, 0x27, 0xac, 0x08, 0x2f, 0x23, 0x85, 0x88, 0x3f, 0xc5, 0x81, 0x3b, 0x31, 0xd5, 0x63,
+	0xd7, 0xf8, 0xac, 0x85, 0xf5, 0xa7, 0xba, 0x66, 0x71, 0x1f, 0xc3, 0x42, 0x8b, 0x18, 0x56, 0x49,
+	0xab, 0x66, 0xe3, 0xbb, 0xf1, 0xbd, 0xc5, 0x22, 0xd7, 0xb3, 0x85, 0xdb, 0xdd, 0x72, 0xb3, 0xf1,
+	0x44, 0x64, 0x0e, 0x51, 0x49, 0x39, 0x4f, 0x4f, 0xab, 0xdc, 0xa7, 0xb0, 0xc0, 0x82, 0x66, 0x13,
+	0xbb, 0xf1, 0xbd, 0xa5, 0x83, 0x6d, 0x29, 0x64, 0x7f, 0x12, 0xcb, 0x51, 0x9c, 0xbb, 0xb4, 0x85,
+	0x98, 0xe2, 0x2d, 0xe1, 0x36, 0x20, 0x65, 0x6a, 0xaa, 0x8e, 0x8d, 0x6c, 0xd2, 0xc9, 0xa4, 0xb0,
+	0xb7, 0x27, 0xb7, 0x7e, 0x78, 0x2d, 0xc4, 0xfe, 0x7a, 0x2d, 0xc4, 0xc4, 0x6d, 0xe0, 0x47, 0x4b,
+	0x54, 0xb0, 0xd9, 0x22, 0xba, 0x89, 0xc5, 0x5f, 0x93, 0xb0, 0x36, 0xe8, 0x3e, 0x33, 0xba, 0xd3,
+	0x6d, 0xe0, 0x4b, 0x48, 0xb7, 0x0c, 0xdc, 0xd1, 0x48, 0xdb, 0x2c, 0xb1, 0xb2, 0x9c, 0x85, 0x09,
+	0xba, 0x30, 0xdf, 0xb3, 0x05, 0x9e, 0x2d, 0x1c, 0x05, 0x89, 0xca, 0x9a, 0x67, 0x65, 0x15, 0x0c,
+	0x12, 0x92, 0x9c, 0x9e, 0x10, 0x05, 0x32, 0x88, 0xb4, 0x75, 0x0b, 0x1b, 0xad, 0xb2, 0x61, 0x75,
+	0x4b, 0x1d, 0x6c, 0x98, 0x1a, 0xd1, 0xb3, 0x73, 0xb4, 0x1c, 0xa1, 0x67, 0x0b, 0x39, 0xb7, 0x9c,
+	0x30, 0x94, 0xa8, 0xa4, 0x83, 0xe6, 0xaf, 0x5d, 0x2b, 0x77, 0x04, 0xd0, 0x32, 0x08, 0xb9, 0x28,
+	0x69, 0xba, 0x66, 0x65, 0xe7, 0x77, 0xe3, 0x7b, 0xcb, 0xc5, 0xf5, 0x9e, 0x2d, 0xac, 0x79, 0x1b,
+	0xf3, 0x7c, 0xa2, 0xb2, 0x48, 0x5f, 0xe8, 0x29, 0x38, 0x87, 0x65, 0xd7, 0x53, 0xc3, 0x9a, 0x5a,
+	0xb3, 0xb2, 0x29, 0xba, 0x19, 0x3e, 0xb0, 0x19, 0xf7, 0xb4, 0x75, 0xf6, 0xa5, 0xcf, 0x29, 0xa2,
+	0x98, 0x73, 0xb6, 0xd2, 0xb3, 0x85, 0x74, 0x30, 0xae, 0xbb, 0x5a, 0x54, 0x96, 0xe8, 0xab, 0x8b,
+	0x0c, 0xc8, 0xbe, 0x30, 0x46, 0xf6, 0x1c, 0x6c, 0x8d, 0xe8, 0xea, 0xab, 0xfe, 0xdb, 0x88, 0xea,
+	0x05, 0x54, 0x9f, 0x4e, 0xf5, 0x23, 0x80, 0x11, 0xb1, 0x03, 0x9c, 0x04, 0x35, 0x5e, 0x44, 0xbe,
+	0xb6, 0xe7, 0xb0, 0x39, 0xc0, 0x7b, 0x20, 0x04, 0x3d, 0xbf, 0x45, 0xb1, 0x67, 0x0b, 0xf9, 0x10,
+	0x81, 0x82, 0xf1, 0xd6, 0x83, 0x9e, 0xfe, 0xb9, 0x99, 0x85, 0xf2, 0xfb, 0xe0, 0x0a, 0x5a, 0xb2,
+	0x8c, 0x2e, 0x13, 0x3e, 0xd3, 0xb3, 0x85, 0xd5, 0xa0, 0x40, 0x96, 0xd1, 0x15, 0x95, 0x5b, 0xf4,
+	0xd9, 0xf9, 0xed, 0x7c, 0x60, 0xb2, 0x17, 0x50, 0xdd, 0x97, 0xfd, 0x4d, 0x02, 0xd6, 0x07, 0xbd,
+	0xc7, 0x44, 0xbf, 0xd0, 0x8c, 0xe6, 0x4d, 0x48, 0xef, 0x53, 0x59, 0x46, 0xf5, 0x6c, 0x32, 0x9c,
+	0xca, 0x32, 0xaa, 0x7b, 0x54, 0x3a, 0x07, 0x72, 0x98, 0xca, 0xb9, 0x99, 0x50, 0x39, 0x3f, 0x86,
+	0x4a, 0x01, 0x76, 0x42, 0xc9, 0xf2, 0xe9, 0xfc, 0x31, 0x0e, 0xe9, 0x3e, 0xe2, 0xb8, 0x41, 0x4c,
+	0x3c, 0x7d, 0xfb, 0x7f, 0x37, 0x32, 0x27, 0xb7, 0xfd, 0x1d, 0xc8, 0x85, 0xd4, 0xe6, 0xd7, 0xfe,
+	0x73, 0x02, 0x36, 0x86, 0xfc, 0x37, 0x78, 0x16, 0x06, 0x1b, 0x6a, 0xf2, 0x1d, 0x1b, 0xea, 0xcd,
+	0x1e, 0x87, 0x5d, 0xc8, 0x87, 0x13, 0xe6, 0x73, 0xfa, 0x2a, 0x01, 0x1f, 0x9d, 0x98, 0xaa, 0x82,
+	0x51, 0xe7, 0xab, 0x32, 0xaa, 0x63, 0x8b, 0x7b, 0x0c, 0xa9, 0x16, 0x7d, 0xa2, 0x4c, 0x2e, 0x1d,
+	0xe4, 0x42, 0x6f, 0x32, 0x17, 0xcc, 0x2e, 0x32, 0xb6, 0x80, 0xfb, 0x0c, 0x56, 0xdd, 0x72, 0x11,
+	0x69, 0x36, 0x35, 0xab, 0x89, 0x75, 0x8b, 0xd2, 0xbb, 0x5c, 0xcc, 0xf5, 0x6c, 0x61, 0x33, 0xb8,
+	0xa1, 0x3e, 0x42, 0x54, 0x56, 0xa8, 0xe9, 0xd8, 0xb7, 0x8c, 0x90, 0x96, 0x9c, 0x09, 0x69, 0x73,
+	0x63, 0x48, 0xdb, 0x84, 0xf5, 0x01, 0x46, 0x7c, 0xae, 0x2e, 0x13, 0xb0, 0x39, 0xe0, 0xf9, 0x46,
+	0xb3, 0x6a, 0xcf, 0x5b, 0xd5, 0xb2, 0x85, 0xff, 0xef, 0xac, 0x3d, 0x80, 0x54, 0x0d, 0x97, 0xab,
+	0x8c, 0xb5, 0xa5, 0x83, 0x8c, 0xe4, 0x0e, 0xa9, 0x92, 0x37, 0xa4, 0x4a, 0x05, 0xbd, 0xab, 0x30,
+	0x4c, 0x84, 0x83, 0x79, 0x07, 0x84, 0x31, 0x4c, 0xfa, 0x6c, 0xff, 0x91, 0x00, 0x38, 0x31, 0xd5,
+	0x33, 0xad, 0x89, 0x49, 0xfb, 0xdf, 0x39, 0x96, 0x6d, 0xdd, 0xc0, 0x08, 0x6b, 0x1d, 0x5c, 0x1d,
+	0x47, 0x70, 0x1f, 0xe1, 0x11, 0xfc, 0xdc, 0xb7, 0xcc, 0x94, 0xe0, 0x2f, 0x80, 0xd3, 0xf1, 0x4b,
+	0xab, 0x64, 0xe2, 0xef, 0xda, 0x58, 0x47, 0xb8, 0x64, 0x60, 0xd4, 0xa1, 0x64, 0xcf, 0x15, 0x77,
+	0x7a, 0xb6, 0xb0, 0xe5, 0x46, 0x18, 0xc5, 0x88, 0xca, 0xaa, 0x63, 0x3c, 0x65, 0x36, 0x87, 0xd2,
+	0x08, 0xfc, 0x67, 0x80, 0xeb, 0x73, 0xdb, 0xbf, 0x1c, 0xdc, 0x11, 0x8b, 0x99, 0x9f, 0xe9, 0xb4,
+	0x63, 0x7c, 0x08, 0xcc, 0x7f, 0x02, 0x2e, 0x59, 0x25, 0xe4, 0x54, 0xc4, 0x9a, 0xef, 0x46, 0xcf,
+	0x16, 0xb8, 0x81, 0x5f, 0x87, 0xe3, 0x14, 0x15, 0xb7, 0x4d, 0xbb, 0xb5, 0xcf, 0xb2, 0xfd, 0x86,
+	0x4b, 0x36, 0xff, 0xbe, 0x92, 0xa5, 0xfe, 0x71, 0x4a, 0x1a, 0xd4, 0xc6, 0x57, 0xee, 0x97, 0x04,
+	0x15, 0xb4, 0x80, 0xea, 0x3a, 0x79, 0xd1, 0xc0, 0x55, 0x15, 0xd3, 0x96, 0xf0, 0x1e, 0xd2, 0xed,
+	0xc1, 0x4a, 0x79, 0x30, 0x9a, 0xab, 0x9c, 0x32, 0x6c, 0xee, 0x8b, 0xe3, 0x2c, 0xac, 0x8e, 0x13,
+	0x87, 0x3a, 0x3d, 0x71, 0x0a, 0xce, 0xcb, 0x7f, 0x7c, 0x37, 0xba, 0xdf, 0x98, 0x43, 0x8c, 0x79,
+	0x84, 0x1e, 0xbc, 0x59, 0x84, 0xe4, 0x89, 0xa9, 0x72, 0x75, 0x58, 0x19, 0xfe, 0x52, 0xbe, 0x17,
+	0x4a, 0xe2, 0xe8, 0xf7, 0x2a, 0x2f, 0x47, 0x04, 0x7a, 0x49, 0xb9, 0x1a, 0xdc, 0x1e, 0xfa, 0xa8,
+	0xbd, 0x1b, 0x21, 0xc4, 0x99, 0xd1, 0xe5, 0xa5, 0x68, 0xb8, 0x31, 0x99, 0x9c, 0xb9, 0x35, 0x4a,
+	0xa6, 0x02, 0xaa, 0x47, 0xca, 0x14, 0x98, 0xdf, 0x39, 0x0b, 0xb8, 0x90, 0xd9, 0xfd, 0x7e, 0x84,
+	0x28, 0x0c, 0xcb, 0x1f, 0x44, 0xc7, 0xfa, 0x59, 0x75, 0x58, 0x1d, 0x19, 0x71, 0xf7, 0x26, 0xc4,
+	0xf1, 0x91, 0xfc, 0xa3, 0xa8, 0x48, 0x3f, 0xdf, 0x0b, 0x48, 0x87, 0x8e, 0xa5, 0x51, 0x02, 0x79,
+	0xfb, 0x3c, 0x9c, 0x02, 0xec, 0x27, 0xfe, 0x16, 0x20, 0x30, 0xbb, 0x89, 0xe3, 0x42, 0xf4, 0x31,
+	0xfc, 0xfd, 0xc9, 0x18, 0x3f, 0xfa, 0xf7, 0x90, 0x09, 0x9d, 0x76, 0x1e, 0x4c, 0x8e, 0xd1, 0x47,
+	0xf3, 0x47, 0xd3, 0xa0, 0xfd, 0xdc, 0xa7, 0xb0, 0xe0, 0xdd, 0xfd, 0xc2, 0xb8, 0x00, 0x0c, 0xc0,
+	0xdf, 0x9b, 0x00, 0x08, 0x9e, 0xfb, 0xa1, 0xdb, 0xed, 0xee, 0x84, 0xa5, 0x0c, 0xc7, 0x4b, 0xd1,
+	0x70, 0x7e, 0xa6, 0x3a, 0xac, 0x0c, 0x77, 0xe3, 0xb1, 0x55, 0x0e, 0x01, 0x79, 0x39, 0x22, 0xd0,
+	0x4b, 0x56, 0x3c, 0xbd, 0xbc, 0xca, 0xc7, 0xdf, 0x5e, 0xe5, 0xe3, 0x7f, 0x5e, 0xe5, 0xe3, 0xaf,
+	0xae, 0xf3, 0xb1, 0xb7, 0xd7, 0xf9, 0xd8, 0xef, 0xd7, 0xf9, 0xd8, 0xf9, 0x63, 0x55, 0xb3, 0x6a,
+	0xed, 0x8a, 0x84, 0x48, 0x53, 0x46, 0xc4, 0x6c, 0x12, 0x53, 0xd6, 0x2a, 0xe8, 0xa1, 0x4a, 0xe4,
+	0xce, 0xa1, 0xdc, 0x24, 0xd5, 0x76, 0x03, 0x9b, 0xee, 0x1f, 0x86, 0x8f, 0x8e, 0x1e, 0x7a, 0xff,
+	0x19, 0x5a, 0xdd, 0x16, 0x36, 0x2b, 0x29, 0x3a, 0xdb, 0x1d, 0xfe, 0x3d, 0x00, 0x35, 0xf1, 0x85,
+	0x20, 0xd9, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -956,6 +1046,8 @@ type MsgClient interface {
 	ChannelCloseConfirm(ctx context.Context, in *MsgChannelCloseConfirm, opts ...grpc.CallOption) (*MsgChannelCloseConfirmResponse, error)
 	// RecvPacket defines a rpc handler method for MsgRecvPacket.
 	RecvPacket(ctx context.Context, in *MsgRecvPacket, opts ...grpc.CallOption) (*MsgRecvPacketResponse, error)
+	// RecvPacketWithUpdate defines a rpc handler method for MsgRecvPacketWithUpdate.
+	RecvPacketWithUpdate(ctx context.Context, in *MsgRecvPacketWithUpdate, opts ...grpc.CallOption) (*MsgRecvPacketWithUpdateResponse, error)
 	// Timeout defines a rpc handler method for MsgTimeout.
 	Timeout(ctx context.Context, in *MsgTimeout, opts ...grpc.CallOption) (*MsgTimeoutResponse, error)
 	// TimeoutOnClose defines a rpc handler method for MsgTimeoutOnClose.
@@ -1035,6 +1127,15 @@ func (c *msgClient) RecvPacket(ctx context.Context, in *MsgRecvPacket, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) RecvPacketWithUpdate(ctx context.Context, in *MsgRecvPacketWithUpdate, opts ...grpc.CallOption) (*MsgRecvPacketWithUpdateResponse, error) {
+	out := new(MsgRecvPacketWithUpdateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/RecvPacketWithUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Timeout(ctx context.Context, in *MsgTimeout, opts ...grpc.CallOption) (*MsgTimeoutResponse, error) {
 	out := new(MsgTimeoutResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/Timeout", in, out, opts...)
@@ -1079,6 +1180,8 @@ type MsgServer interface {
 	ChannelCloseConfirm(context.Context, *MsgChannelCloseConfirm) (*MsgChannelCloseConfirmResponse, error)
 	// RecvPacket defines a rpc handler method for MsgRecvPacket.
 	RecvPacket(context.Context, *MsgRecvPacket) (*MsgRecvPacketResponse, error)
+	// RecvPacketWithUpdate defines a rpc handler method for MsgRecvPacketWithUpdate.
+	RecvPacketWithUpdate(context.Context, *MsgRecvPacketWithUpdate) (*MsgRecvPacketWithUpdateResponse, error)
 	// Timeout defines a rpc handler method for MsgTimeout.
 	Timeout(context.Context, *MsgTimeout) (*MsgTimeoutResponse, error)
 	// TimeoutOnClose defines a rpc handler method for MsgTimeoutOnClose.
@@ -1112,6 +1215,9 @@ func (*UnimplementedMsgServer) ChannelCloseConfirm(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) RecvPacket(ctx context.Context, req *MsgRecvPacket) (*MsgRecvPacketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecvPacket not implemented")
 }
+func (*UnimplementedMsgServer) RecvPacketWithUpdate(ctx context.Context, req *MsgRecvPacketWithUpdate) (*MsgRecvPacketWithUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecvPacketWithUpdate not implemented")
+}
 func (*UnimplementedMsgServer) Timeout(ctx context.Context, req *MsgTimeout) (*MsgTimeoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Timeout not implemented")
 }
@@ -1252,6 +1358,24 @@ func _Msg_RecvPacket_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RecvPacketWithUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecvPacketWithUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecvPacketWithUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/RecvPacketWithUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecvPacketWithUpdate(ctx, req.(*MsgRecvPacketWithUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Timeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgTimeout)
 	if err := dec(in); err != nil {
@@ -1338,6 +1462,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecvPacket",
 			Handler:    _Msg_RecvPacket_Handler,
 		},
+		{
+			MethodName: "RecvPacketWithUpdate",
+			Handler:    _Msg_RecvPacketWithUpdate_Handler,
+		},
 		{
 			MethodName: "Timeout",
 			Handler:    _Msg_Timeout_Handler,
@@ -1939,6 +2067,98 @@ func (m *MsgRecvPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRecvPacketWithUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecvPacketWithUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecvPacketWithUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofCommitment) > 0 {
+		i -= len(m.ProofCommitment)
+		copy(dAtA[i:], m.ProofCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofCommitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecvPacketWithUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecvPacketWithUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecvPacketWithUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgTimeout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2460,6 +2680,40 @@ func (m *MsgRecvPacketResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRecvPacketWithUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecvPacketWithUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgTimeout) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4376,6 +4630,274 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRecvPacketWithUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketWithUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketWithUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitment == nil {
+				m.ProofCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types1.Any{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacketWithUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketWithUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketWithUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
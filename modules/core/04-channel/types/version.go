@@ -1,9 +1,32 @@
 package types
 
-import "strings"
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
 
 const ChannelVersionDelimiter = ":"
 
+// Formats of the version layers returned by ParseChannelVersion
+const (
+	// VersionFormatPlain defines a version layer consisting of a plain version identifier, e.g. "ics20-1"
+	VersionFormatPlain = "plain"
+	// VersionFormatDelimited defines a middleware version layer prepended to the wrapped version using the
+	// channel version delimiter, e.g. "fee29-1:ics20-1"
+	VersionFormatDelimited = "delimited"
+	// VersionFormatFeeMetadata defines a version layer of the fee middleware encoded as JSON, wrapping the
+	// version of the underlying application in its app_version field
+	VersionFormatFeeMetadata = "fee_metadata"
+	// VersionFormatICAMetadata defines a version layer of interchain accounts encoded as JSON metadata
+	VersionFormatICAMetadata = "ica_metadata"
+	// VersionFormatUnknown defines a version layer which is not encoded in a known format
+	VersionFormatUnknown = "unknown"
+)
+
+// icaVersionPrefix is the version identifier prefix of interchain accounts channel versions
+const icaVersionPrefix = "ics27-"
+
 // SplitChannelVersion splits the channel version string
 // into the outermost middleware version and the underlying app version.
 // It will use the default delimiter `:` for middleware versions.
@@ -25,3 +48,92 @@ func SplitChannelVersion(version string) (middlewareVersion, appVersion string)
 func MergeChannelVersions(versions ...string) string {
 	return strings.Join(versions, ChannelVersionDelimiter)
 }
+
+// ParseChannelVersion decomposes the provided channel version into its layers, ordered from the outermost
+// middleware to the base application. Layers wrapping another version are unwrapped until a version which
+// does not wrap any other version is reached. Versions which are not encoded in a known format result in
+// a single layer of format VersionFormatUnknown.
+func ParseChannelVersion(version string) []VersionLayer {
+	var layers []VersionLayer
+	for {
+		layer, wrapped, ok := parseVersionLayer(version)
+		layers = append(layers, layer)
+		if !ok {
+			return layers
+		}
+
+		version = wrapped
+	}
+}
+
+// parseVersionLayer parses the outermost layer of the provided version. The version wrapped by the
+// layer is returned alongside true if the layer wraps another version.
+func parseVersionLayer(version string) (VersionLayer, string, bool) {
+	if !strings.HasPrefix(strings.TrimSpace(version), "{") {
+		middlewareVersion, appVersion := SplitChannelVersion(version)
+		if middlewareVersion == "" {
+			return VersionLayer{Format: VersionFormatPlain, Version: version}, "", false
+		}
+
+		return VersionLayer{Format: VersionFormatDelimited, Version: middlewareVersion}, appVersion, true
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(version), &fields); err != nil {
+		return VersionLayer{Format: VersionFormatUnknown, Version: version}, "", false
+	}
+
+	feeVersion, hasFeeVersion := jsonString(fields["fee_version"])
+	appVersion, hasAppVersion := jsonString(fields["app_version"])
+	if hasFeeVersion && hasAppVersion {
+		return VersionLayer{Format: VersionFormatFeeMetadata, Version: feeVersion}, appVersion, true
+	}
+
+	icaVersion, hasVersion := jsonString(fields["version"])
+	if hasVersion && strings.HasPrefix(icaVersion, icaVersionPrefix) {
+		delete(fields, "version")
+
+		return VersionLayer{
+			Format:     VersionFormatICAMetadata,
+			Version:    icaVersion,
+			Attributes: versionAttributes(fields),
+		}, "", false
+	}
+
+	return VersionLayer{Format: VersionFormatUnknown, Version: version}, "", false
+}
+
+// versionAttributes returns the provided JSON fields as version attributes sorted by key. String values
+// are unquoted while all other values are returned as their JSON encoding.
+func versionAttributes(fields map[string]json.RawMessage) []VersionAttribute {
+	attributes := make([]VersionAttribute, 0, len(fields))
+	for key, value := range fields {
+		attribute := VersionAttribute{Key: key, Value: string(value)}
+		if str, ok := jsonString(value); ok {
+			attribute.Value = str
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
+
+	return attributes
+}
+
+// jsonString returns the string encoded by the provided JSON value. False is returned if the value does not
+// encode a string.
+func jsonString(value json.RawMessage) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+
+	var str string
+	if err := json.Unmarshal(value, &str); err != nil {
+		return "", false
+	}
+
+	return str, true
+}
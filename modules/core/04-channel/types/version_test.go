@@ -75,3 +75,93 @@ func TestMergeVersions(t *testing.T) {
 		require.Equal(t, tc.merged, actual, "merged versions string does not equal expected value")
 	}
 }
+
+func TestParseChannelVersion(t *testing.T) {
+	testCases := []struct {
+		name      string
+		version   string
+		expLayers []types.VersionLayer
+	}{
+		{
+			"plain version",
+			"ics20-1",
+			[]types.VersionLayer{
+				{Format: types.VersionFormatPlain, Version: "ics20-1"},
+			},
+		},
+		{
+			"delimited middleware versions",
+			"fee29-1:whitelist:ics20-1",
+			[]types.VersionLayer{
+				{Format: types.VersionFormatDelimited, Version: "fee29-1"},
+				{Format: types.VersionFormatDelimited, Version: "whitelist"},
+				{Format: types.VersionFormatPlain, Version: "ics20-1"},
+			},
+		},
+		{
+			"fee wrapped version",
+			`{"fee_version":"ics29-1","app_version":"ics20-1"}`,
+			[]types.VersionLayer{
+				{Format: types.VersionFormatFeeMetadata, Version: "ics29-1"},
+				{Format: types.VersionFormatPlain, Version: "ics20-1"},
+			},
+		},
+		{
+			"interchain accounts metadata",
+			`{"version":"ics27-1","controller_connection_id":"connection-0","host_connection_id":"connection-1","address":"","encoding":"proto3"}`,
+			[]types.VersionLayer{
+				{
+					Format:  types.VersionFormatICAMetadata,
+					Version: "ics27-1",
+					Attributes: []types.VersionAttribute{
+						{Key: "address", Value: ""},
+						{Key: "controller_connection_id", Value: "connection-0"},
+						{Key: "encoding", Value: "proto3"},
+						{Key: "host_connection_id", Value: "connection-1"},
+					},
+				},
+			},
+		},
+		{
+			"fee wrapped interchain accounts metadata",
+			`{"fee_version":"ics29-1","app_version":"{\"version\":\"ics27-1\",\"tx_type\":\"sdk_multi_msg\"}"}`,
+			[]types.VersionLayer{
+				{Format: types.VersionFormatFeeMetadata, Version: "ics29-1"},
+				{
+					Format:     types.VersionFormatICAMetadata,
+					Version:    "ics27-1",
+					Attributes: []types.VersionAttribute{{Key: "tx_type", Value: "sdk_multi_msg"}},
+				},
+			},
+		},
+		{
+			"non-string metadata values are returned as JSON",
+			`{"version":"ics27-1","nested":{"key":1}}`,
+			[]types.VersionLayer{
+				{
+					Format:     types.VersionFormatICAMetadata,
+					Version:    "ics27-1",
+					Attributes: []types.VersionAttribute{{Key: "nested", Value: `{"key":1}`}},
+				},
+			},
+		},
+		{
+			"unknown JSON metadata",
+			`{"version":"ics99-1"}`,
+			[]types.VersionLayer{
+				{Format: types.VersionFormatUnknown, Version: `{"version":"ics99-1"}`},
+			},
+		},
+		{
+			"invalid JSON",
+			`{"fee_version":`,
+			[]types.VersionLayer{
+				{Format: types.VersionFormatUnknown, Version: `{"fee_version":`},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expLayers, types.ParseChannelVersion(tc.version), tc.name)
+	}
+}
@@ -33,6 +33,12 @@ func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				}
 				packetMsgs += 1
 
+			case *channeltypes.MsgRecvPacketWithUpdate:
+				if _, found := ad.k.GetPacketReceipt(ctx, msg.Packet.GetDestPort(), msg.Packet.GetDestChannel(), msg.Packet.GetSequence()); found {
+					redundancies += 1
+				}
+				packetMsgs += 1
+
 			case *channeltypes.MsgAcknowledgement:
 				if commitment := ad.k.GetPacketCommitment(ctx, msg.Packet.GetSourcePort(), msg.Packet.GetSourceChannel(), msg.Packet.GetSequence()); len(commitment) == 0 {
 					redundancies += 1
@@ -283,6 +283,39 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			true,
 		},
+		{
+			"success on recv packet with update msg",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				packet := channeltypes.NewPacket([]byte(mock.MockPacketData), 1,
+					suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+					suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+					clienttypes.NewHeight(1, 0), 0)
+
+				return []sdk.Msg{&channeltypes.MsgRecvPacketWithUpdate{Packet: packet, ProofCommitment: []byte("proof"), ProofHeight: clienttypes.NewHeight(0, 1), Signer: "signer"}}
+			},
+			true,
+		},
+		{
+			"no success on redundant recv packet with update msgs",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				var msgs []sdk.Msg
+
+				for i := 1; i <= 3; i++ {
+					packet := channeltypes.NewPacket([]byte(mock.MockPacketData), uint64(i),
+						suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+						suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+						clienttypes.NewHeight(1, 0), 0)
+
+					// receive all packets
+					suite.path.EndpointA.SendPacket(packet)
+					suite.path.EndpointB.RecvPacket(packet)
+
+					msgs = append(msgs, &channeltypes.MsgRecvPacketWithUpdate{Packet: packet, ProofCommitment: []byte("proof"), ProofHeight: clienttypes.NewHeight(0, 1), Signer: "signer"})
+				}
+				return msgs
+			},
+			false,
+		},
 		{
 			"success of tx with different msg type even if all packet messages are redundant",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -7,6 +7,7 @@ type ConnectionI interface {
 	GetCounterparty() CounterpartyConnectionI
 	GetVersions() []Version
 	GetDelayPeriod() uint64
+	GetBlockDelayPeriod() uint64
 	ValidateBasic() error
 }
 
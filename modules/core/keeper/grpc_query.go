@@ -34,6 +34,16 @@ func (q Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStat
 	return q.ClientKeeper.ClientStatus(c, req)
 }
 
+// ConsensusStateMetadata implements the IBC QueryServer interface
+func (q Keeper) ConsensusStateMetadata(c context.Context, req *clienttypes.QueryConsensusStateMetadataRequest) (*clienttypes.QueryConsensusStateMetadataResponse, error) {
+	return q.ClientKeeper.ConsensusStateMetadata(c, req)
+}
+
+// ClientExpiryInfo implements the IBC QueryServer interface
+func (q Keeper) ClientExpiryInfo(c context.Context, req *clienttypes.QueryClientExpiryInfoRequest) (*clienttypes.QueryClientExpiryInfoResponse, error) {
+	return q.ClientKeeper.ClientExpiryInfo(c, req)
+}
+
 // ClientParams implements the IBC QueryServer interface
 func (q Keeper) ClientParams(c context.Context, req *clienttypes.QueryClientParamsRequest) (*clienttypes.QueryClientParamsResponse, error) {
 	return q.ClientKeeper.ClientParams(c, req)
@@ -44,6 +54,16 @@ func (q Keeper) UpgradedClientState(c context.Context, req *clienttypes.QueryUpg
 	return q.ClientKeeper.UpgradedClientState(c, req)
 }
 
+// SelfConsensusState implements the IBC QueryServer interface
+func (q Keeper) SelfConsensusState(c context.Context, req *clienttypes.QuerySelfConsensusStateRequest) (*clienttypes.QuerySelfConsensusStateResponse, error) {
+	return q.ClientKeeper.SelfConsensusState(c, req)
+}
+
+// ValidateSelfClientState implements the IBC QueryServer interface
+func (q Keeper) ValidateSelfClientState(c context.Context, req *clienttypes.QueryValidateSelfClientStateRequest) (*clienttypes.QueryValidateSelfClientStateResponse, error) {
+	return q.ClientKeeper.ValidateSelfClientState(c, req)
+}
+
 // Connection implements the IBC QueryServer interface
 func (q Keeper) Connection(c context.Context, req *connectiontypes.QueryConnectionRequest) (*connectiontypes.QueryConnectionResponse, error) {
 	return q.ConnectionKeeper.Connection(c, req)
@@ -69,6 +89,11 @@ func (q Keeper) ConnectionConsensusState(c context.Context, req *connectiontypes
 	return q.ConnectionKeeper.ConnectionConsensusState(c, req)
 }
 
+// ConnectionDetails implements the IBC QueryServer interface
+func (q Keeper) ConnectionDetails(c context.Context, req *connectiontypes.QueryConnectionDetailsRequest) (*connectiontypes.QueryConnectionDetailsResponse, error) {
+	return q.ConnectionKeeper.ConnectionDetails(c, req)
+}
+
 // Channel implements the IBC QueryServer interface
 func (q Keeper) Channel(c context.Context, req *channeltypes.QueryChannelRequest) (*channeltypes.QueryChannelResponse, error) {
 	return q.ChannelKeeper.Channel(c, req)
@@ -119,6 +144,11 @@ func (q Keeper) PacketAcknowledgements(c context.Context, req *channeltypes.Quer
 	return q.ChannelKeeper.PacketAcknowledgements(c, req)
 }
 
+// PacketAcknowledgementsInRange implements the IBC QueryServer interface
+func (q Keeper) PacketAcknowledgementsInRange(c context.Context, req *channeltypes.QueryPacketAcknowledgementsInRangeRequest) (*channeltypes.QueryPacketAcknowledgementsInRangeResponse, error) {
+	return q.ChannelKeeper.PacketAcknowledgementsInRange(c, req)
+}
+
 // UnreceivedPackets implements the IBC QueryServer interface
 func (q Keeper) UnreceivedPackets(c context.Context, req *channeltypes.QueryUnreceivedPacketsRequest) (*channeltypes.QueryUnreceivedPacketsResponse, error) {
 	return q.ChannelKeeper.UnreceivedPackets(c, req)
@@ -134,6 +164,36 @@ func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNe
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
 
+// ChannelPacketStats implements the IBC QueryServer interface
+func (q Keeper) ChannelPacketStats(c context.Context, req *channeltypes.QueryChannelPacketStatsRequest) (*channeltypes.QueryChannelPacketStatsResponse, error) {
+	return q.ChannelKeeper.ChannelPacketStats(c, req)
+}
+
+// PacketData implements the IBC QueryServer interface
+func (q Keeper) PacketData(c context.Context, req *channeltypes.QueryPacketDataRequest) (*channeltypes.QueryPacketDataResponse, error) {
+	return q.ChannelKeeper.PacketData(c, req)
+}
+
+// HistoricalAck implements the IBC QueryServer interface
+func (q Keeper) HistoricalAck(c context.Context, req *channeltypes.QueryHistoricalAckRequest) (*channeltypes.QueryHistoricalAckResponse, error) {
+	return q.ChannelKeeper.HistoricalAck(c, req)
+}
+
+// ChannelsInState implements the IBC QueryServer interface
+func (q Keeper) ChannelsInState(c context.Context, req *channeltypes.QueryChannelsInStateRequest) (*channeltypes.QueryChannelsInStateResponse, error) {
+	return q.ChannelKeeper.ChannelsInState(c, req)
+}
+
+// ChannelVersion implements the IBC QueryServer interface
+func (q Keeper) ChannelVersion(c context.Context, req *channeltypes.QueryChannelVersionRequest) (*channeltypes.QueryChannelVersionResponse, error) {
+	return q.ChannelKeeper.ChannelVersion(c, req)
+}
+
+// ChannelHistory implements the IBC QueryServer interface
+func (q Keeper) ChannelHistory(c context.Context, req *channeltypes.QueryChannelHistoryRequest) (*channeltypes.QueryChannelHistoryResponse, error) {
+	return q.ChannelKeeper.ChannelHistory(c, req)
+}
+
 // AppVersion implements the IBC QueryServer interface
 func (q Keeper) AppVersion(c context.Context, req *porttypes.QueryAppVersionRequest) (*porttypes.QueryAppVersionResponse, error) {
 	return q.PortKeeper.AppVersion(c, req)
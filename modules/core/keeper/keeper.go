@@ -10,6 +10,7 @@ import (
 	connectionkeeper "github.com/cosmos/ibc-go/v3/modules/core/03-connection/keeper"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channelkeeper "github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	portkeeper "github.com/cosmos/ibc-go/v3/modules/core/05-port/keeper"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/types"
@@ -42,13 +43,14 @@ func NewKeeper(
 	if !paramSpace.HasKeyTable() {
 		keyTable := clienttypes.ParamKeyTable()
 		keyTable.RegisterParamSet(&connectiontypes.Params{})
+		keyTable.RegisterParamSet(&channeltypes.Params{})
 		paramSpace = paramSpace.WithKeyTable(keyTable)
 	}
 
 	clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper)
 	connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper)
 	portKeeper := portkeeper.NewKeeper(scopedKeeper)
-	channelKeeper := channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
+	channelKeeper := channelkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
 
 	return &Keeper{
 		cdc:              cdc,
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clientkeeper "github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -30,3 +31,12 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration
+// - sets the channel parameters, which are registered on the ibc subspace since version 3, to their defaults
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.ChannelKeeper.SetParams(ctx, channeltypes.DefaultParams())
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+// channelParamKeys holds the store keys of the channel parameters registered on the ibc subspace since version 3
+var channelParamKeys = [][]byte{
+	channeltypes.KeyRetainPacketData,
+	channeltypes.KeyHistoricalAckRetention,
+	channeltypes.KeyChannelHistoryEnabled,
+	channeltypes.KeyClosedChannelHistoryRetention,
+}
+
+// upgradeChain removes the channel parameters from the store of the provided chain, as found on chains upgrading
+// from version 2, and runs the migration to version 3.
+func (suite *KeeperTestSuite) upgradeChain(chain *ibctesting.TestChain) {
+	ctx := chain.GetContext()
+
+	paramStore := prefix.NewStore(ctx.KVStore(chain.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(host.ModuleName+"/"))
+	for _, key := range channelParamKeys {
+		paramStore.Delete(key)
+		suite.Require().False(chain.GetSimApp().GetSubspace(host.ModuleName).Has(ctx, key))
+	}
+
+	err := keeper.NewMigrator(*chain.App.GetIBCKeeper()).Migrate2to3(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(channeltypes.DefaultParams(), chain.App.GetIBCKeeper().ChannelKeeper.GetParams(ctx))
+}
+
+// test that packets are sent and acknowledged over existing channels, and that channels are opened and closed,
+// once chains are upgraded from a store without the channel parameters
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.upgradeChain(suite.chainA)
+	suite.upgradeChain(suite.chainB)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet, ibcmock.MockAcknowledgement.Acknowledgement())
+	suite.Require().NoError(err)
+	suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence()))
+
+	newPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	newPath.EndpointA.ClientID, newPath.EndpointA.ConnectionID = path.EndpointA.ClientID, path.EndpointA.ConnectionID
+	newPath.EndpointB.ClientID, newPath.EndpointB.ConnectionID = path.EndpointB.ClientID, path.EndpointB.ConnectionID
+	suite.coordinator.CreateChannels(newPath)
+
+	err = newPath.EndpointA.ChanCloseInit()
+	suite.Require().NoError(err)
+
+	channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), newPath.EndpointA.ChannelConfig.PortID, newPath.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CLOSED, channel.State)
+}
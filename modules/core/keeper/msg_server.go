@@ -133,7 +133,7 @@ func (k Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSu
 func (k Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenInit) (*connectiontypes.MsgConnectionOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	connectionID, err := k.ConnectionKeeper.ConnOpenInit(ctx, msg.ClientId, msg.Counterparty, msg.Version, msg.DelayPeriod)
+	connectionID, err := k.ConnectionKeeper.ConnOpenInit(ctx, msg.ClientId, msg.Counterparty, msg.Version, msg.DelayPeriod, msg.BlockDelayPeriod)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "connection handshake open init failed")
 	}
@@ -165,7 +165,7 @@ func (k Keeper) ConnectionOpenTry(goCtx context.Context, msg *connectiontypes.Ms
 	}
 
 	connectionID, err := k.ConnectionKeeper.ConnOpenTry(
-		ctx, msg.PreviousConnectionId, msg.Counterparty, msg.DelayPeriod, msg.ClientId, targetClient,
+		ctx, msg.PreviousConnectionId, msg.Counterparty, msg.DelayPeriod, msg.BlockDelayPeriod, msg.ClientId, targetClient,
 		connectiontypes.ProtoVersionsToExported(msg.CounterpartyVersions), msg.ProofInit, msg.ProofClient, msg.ProofConsensus,
 		msg.ProofHeight, msg.ConsensusHeight,
 	)
@@ -547,6 +547,46 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	return &channeltypes.MsgRecvPacketResponse{}, nil
 }
 
+// RecvPacketWithUpdate defines a rpc handler method for MsgRecvPacketWithUpdate.
+// The client of the receiving channel is updated with the provided header before the packet is received,
+// such that the packet commitment proof may be verified against the consensus state added by the header.
+func (k Keeper) RecvPacketWithUpdate(goCtx context.Context, msg *channeltypes.MsgRecvPacketWithUpdate) (*channeltypes.MsgRecvPacketWithUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	header, err := clienttypes.UnpackHeader(msg.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, found := k.ChannelKeeper.GetChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+	}
+
+	connectionEnd, found := k.ConnectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	// the header is verified and applied before the packet commitment proof is verified
+	if err = k.ClientKeeper.UpdateClient(ctx, connectionEnd.GetClientID(), header); err != nil {
+		return nil, sdkerrors.Wrap(err, "client update failed")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+		),
+	)
+
+	if _, err := k.RecvPacket(sdk.WrapSDKContext(ctx), msg.RecvPacketMsg()); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgRecvPacketWithUpdateResponse{}, nil
+}
+
 // Timeout defines a rpc handler method for MsgTimeout.
 func (k Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*channeltypes.MsgTimeoutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -665,7 +705,7 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 	}
 
 	// Delete packet commitment
-	if err = k.ChannelKeeper.TimeoutExecuted(ctx, cap, msg.Packet); err != nil {
+	if err = k.ChannelKeeper.TimeoutOnCloseExecuted(ctx, cap, msg.Packet); err != nil {
 		return nil, err
 	}
 
@@ -224,6 +224,83 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests the IBC handler updating the client of the receiving channel before
+// receiving a packet. The packet commitment proof is only verifiable against
+// the consensus state added by the provided header.
+func (suite *KeeperTestSuite) TestHandleRecvPacketWithUpdate() {
+	var (
+		packet channeltypes.Packet
+		path   *ibctesting.Path
+		header *ibctmtypes.Header
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"invalid header", func() {
+			header.SignedHeader.Commit = nil
+		}, false},
+		{"header for a different client", func() {
+			var err error
+			header, err = suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+			suite.Require().NoError(err)
+		}, false},
+		{"channel not found", func() {
+			packet.DestinationChannel = ibctesting.InvalidID
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			// send the packet without updating the counterparty client
+			channelCap := suite.chainA.GetChannelCapability(packet.GetSourcePort(), packet.GetSourceChannel())
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(suite.chainA.GetContext(), channelCap, packet)
+			suite.Require().NoError(err)
+			// the app hash committing to the packet is included in the header of the following block
+			suite.coordinator.CommitNBlocks(suite.chainA, 2)
+
+			header, err = suite.chainB.ConstructUpdateTMClientHeader(suite.chainA, path.EndpointB.ClientID)
+			suite.Require().NoError(err)
+
+			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := suite.chainA.QueryProof(packetKey)
+
+			tc.malleate()
+
+			msg, err := channeltypes.NewMsgRecvPacketWithUpdate(packet, proof, proofHeight, header, suite.chainB.SenderAccount.GetAddress().String())
+			suite.Require().NoError(err)
+
+			_, err = keeper.Keeper.RecvPacketWithUpdate(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// verify the client was updated to the header height
+				_, found := suite.chainB.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainB.GetContext(), path.EndpointB.ClientID, header.GetHeight())
+				suite.Require().True(found)
+
+				// verify ack was written
+				ack, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().NotNil(ack)
+				suite.Require().True(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
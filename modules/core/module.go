@@ -22,6 +22,7 @@ import (
 	clientkeeper "github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/client/cli"
@@ -140,6 +141,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	m := clientkeeper.NewMigrator(am.keeper.ClientKeeper)
 	cfg.RegisterMigration(host.ModuleName, 1, m.Migrate1to2)
+
+	coreMigrator := keeper.NewMigrator(*am.keeper)
+	cfg.RegisterMigration(host.ModuleName, 2, coreMigrator.Migrate2to3)
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -161,11 +165,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	ibcclient.BeginBlocker(ctx, am.keeper.ClientKeeper)
+	channel.BeginBlocker(ctx, am.keeper.ChannelKeeper)
 }
 
 // EndBlock returns the end blocker for the ibc module. It returns no validator
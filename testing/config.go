@@ -39,14 +39,16 @@ func (tmcfg *TendermintConfig) GetClientType() string {
 }
 
 type ConnectionConfig struct {
-	DelayPeriod uint64
-	Version     *connectiontypes.Version
+	DelayPeriod      uint64
+	BlockDelayPeriod uint64
+	Version          *connectiontypes.Version
 }
 
 func NewConnectionConfig() *ConnectionConfig {
 	return &ConnectionConfig{
-		DelayPeriod: DefaultDelayPeriod,
-		Version:     ConnectionVersion,
+		DelayPeriod:      DefaultDelayPeriod,
+		BlockDelayPeriod: DefaultBlockDelayPeriod,
+		Version:          ConnectionVersion,
 	}
 }
 
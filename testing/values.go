@@ -24,10 +24,11 @@ const (
 	FirstConnectionID = "connection-0"
 
 	// Default params constants used to create a TM client
-	TrustingPeriod     time.Duration = time.Hour * 24 * 7 * 2
-	UnbondingPeriod    time.Duration = time.Hour * 24 * 7 * 3
-	MaxClockDrift      time.Duration = time.Second * 10
-	DefaultDelayPeriod uint64        = 0
+	TrustingPeriod          time.Duration = time.Hour * 24 * 7 * 2
+	UnbondingPeriod         time.Duration = time.Hour * 24 * 7 * 3
+	MaxClockDrift           time.Duration = time.Second * 10
+	DefaultDelayPeriod      uint64        = 0
+	DefaultBlockDelayPeriod uint64        = 0
 
 	DefaultChannelVersion = ibctransfertypes.Version
 	InvalidID             = "IDisInvalid"